@@ -218,3 +218,21 @@ func BenchmarkEmitString(b *testing.B) {
 		_ = stringKeyVal.Value.Emit()
 	}
 }
+
+func BenchmarkNewSet(b *testing.B) {
+	kvs := []attribute.KeyValue{stringKeyVal, intKeyVal, boolKeyVal, float64KeyVal}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = attribute.NewSet(kvs...)
+	}
+}
+
+func BenchmarkNewSetFromSortedFiltered(b *testing.B) {
+	kvs := []attribute.KeyValue{boolKeyVal, float64KeyVal, intKeyVal, stringKeyVal}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = attribute.NewSetFromSortedFiltered(kvs, nil)
+	}
+}
@@ -100,3 +100,53 @@ func (k Key) Array(v interface{}) KeyValue {
 		Value: ArrayValue(v),
 	}
 }
+
+// BoolSlice creates a KeyValue instance with an ARRAY Value of bools.
+func (k Key) BoolSlice(v []bool) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: BoolSliceValue(v),
+	}
+}
+
+// Int64Slice creates a KeyValue instance with an ARRAY Value of int64s.
+func (k Key) Int64Slice(v []int64) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: Int64SliceValue(v),
+	}
+}
+
+// IntSlice creates a KeyValue instance with an ARRAY Value of int64s.
+func (k Key) IntSlice(v []int) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: IntSliceValue(v),
+	}
+}
+
+// Float64Slice creates a KeyValue instance with an ARRAY Value of
+// float64s.
+func (k Key) Float64Slice(v []float64) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: Float64SliceValue(v),
+	}
+}
+
+// StringSlice creates a KeyValue instance with an ARRAY Value of
+// strings.
+func (k Key) StringSlice(v []string) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: StringSliceValue(v),
+	}
+}
+
+// Map creates a KeyValue instance with a MAP Value holding kvs.
+func (k Key) Map(kvs ...KeyValue) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: MapValue(kvs...),
+	}
+}
@@ -63,6 +63,19 @@ func TestJSONValue(t *testing.T) {
 		string(data))
 }
 
+func TestJSONMapValue(t *testing.T) {
+	kv := attribute.Map("M",
+		attribute.String("A", "B"),
+		attribute.Map("C", attribute.Bool("D", true)),
+	)
+
+	data, err := json.Marshal(kv)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"Key":"M","Value":{"Type":"MAP","Value":{"A":"B","C":{"D":true}}}}`,
+		string(data))
+}
+
 func TestEmit(t *testing.T) {
 	for _, testcase := range []struct {
 		name string
@@ -89,6 +102,14 @@ func TestEmit(t *testing.T) {
 			v:    attribute.StringValue("foo"),
 			want: "foo",
 		},
+		{
+			name: `test Key.Emit() can emit a string representing self.MAP`,
+			v: attribute.MapValue(
+				attribute.String("b", "foo"),
+				attribute.Map("a", attribute.Int("c", 1)),
+			),
+			want: "map[a:map[c:1] b:foo]",
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			//proto: func (v attribute.Value) Emit() string {
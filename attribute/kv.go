@@ -74,8 +74,48 @@ func Array(k string, v interface{}) KeyValue {
 	return Key(k).Array(v)
 }
 
+// BoolSlice creates a new key-value pair with a passed name and a bool
+// slice value.
+func BoolSlice(k string, v []bool) KeyValue {
+	return Key(k).BoolSlice(v)
+}
+
+// Int64Slice creates a new key-value pair with a passed name and an int64
+// slice value.
+func Int64Slice(k string, v []int64) KeyValue {
+	return Key(k).Int64Slice(v)
+}
+
+// IntSlice creates a new key-value pair with a passed name and an int
+// slice value, stored as int64s.
+func IntSlice(k string, v []int) KeyValue {
+	return Key(k).IntSlice(v)
+}
+
+// Float64Slice creates a new key-value pair with a passed name and a
+// float64 slice value.
+func Float64Slice(k string, v []float64) KeyValue {
+	return Key(k).Float64Slice(v)
+}
+
+// StringSlice creates a new key-value pair with a passed name and a
+// string slice value.
+func StringSlice(k string, v []string) KeyValue {
+	return Key(k).StringSlice(v)
+}
+
+// Map creates a new key-value pair with a passed name and a map value
+// holding kvs.  See MapValue for how kvs are stored.
+func Map(k string, kvs ...KeyValue) KeyValue {
+	return Key(k).Map(kvs...)
+}
+
 // Any creates a new key-value pair instance with a passed name and
 // automatic type inference. This is slower, and not type-safe.
+//
+// Values that cannot be stored as a scalar or array are converted to
+// a string.  Use ValueOf to convert maps and structs to MAP Values and
+// to get an error for values that cannot be converted.
 func Any(k string, value interface{}) KeyValue {
 	if value == nil {
 		return String(k, "<nil>")
@@ -69,6 +69,38 @@ func TestKeyValueConstructors(t *testing.T) {
 				Value: attribute.IntValue(123),
 			},
 		},
+		{
+			name:   "BoolSlice",
+			actual: attribute.BoolSlice("k1", []bool{true, false}),
+			expected: attribute.KeyValue{
+				Key:   "k1",
+				Value: attribute.BoolSliceValue([]bool{true, false}),
+			},
+		},
+		{
+			name:   "IntSlice",
+			actual: attribute.IntSlice("k1", []int{1, 2}),
+			expected: attribute.KeyValue{
+				Key:   "k1",
+				Value: attribute.Int64SliceValue([]int64{1, 2}),
+			},
+		},
+		{
+			name:   "StringSlice",
+			actual: attribute.StringSlice("k1", []string{"a", "b"}),
+			expected: attribute.KeyValue{
+				Key:   "k1",
+				Value: attribute.StringSliceValue([]string{"a", "b"}),
+			},
+		},
+		{
+			name:   "Map",
+			actual: attribute.Map("k1", attribute.Int("b", 2), attribute.String("a", "1")),
+			expected: attribute.KeyValue{
+				Key:   "k1",
+				Value: attribute.MapValue(attribute.String("a", "1"), attribute.Int("b", 2)),
+			},
+		},
 	}
 
 	for _, test := range tt {
@@ -211,6 +243,11 @@ func TestKeyValueValid(t *testing.T) {
 			valid: true,
 			kv:    attribute.Array("array", []int{}),
 		},
+		{
+			desc:  "non-empty key with MAP type Value should be valid",
+			valid: true,
+			kv:    attribute.Map("map"),
+		},
 	}
 
 	for _, test := range tests {
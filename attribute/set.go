@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"sort"
+	"sync"
 )
 
 type (
@@ -69,6 +70,14 @@ var (
 			iface: [0]KeyValue{},
 		},
 	}
+
+	// sortables holds the `*Sortable` temporaries of the constructors
+	// that are not passed one, so they are not allocated each call.
+	sortables = sync.Pool{
+		New: func() interface{} {
+			return new(Sortable)
+		},
+	}
 )
 
 // EmptySet returns a reference to a Set with no elements.
@@ -193,14 +202,16 @@ func empty() Set {
 // NewSet returns a new `Set`.  See the documentation for
 // `NewSetWithSortableFiltered` for more details.
 //
-// Except for empty sets, this method adds an additional allocation
-// compared with calls that include a `*Sortable`.
+// The `*Sortable` temporary is taken from a pool, so this does not
+// allocate more than calls that include a `*Sortable`.
 func NewSet(kvs ...KeyValue) Set {
 	// Check for empty set.
 	if len(kvs) == 0 {
 		return empty()
 	}
-	s, _ := NewSetWithSortableFiltered(kvs, new(Sortable), nil)
+	tmp := sortables.Get().(*Sortable)
+	s, _ := NewSetWithSortableFiltered(kvs, tmp, nil)
+	sortables.Put(tmp)
 	return s
 }
 
@@ -228,7 +239,10 @@ func NewSetWithFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 	if len(kvs) == 0 {
 		return empty(), nil
 	}
-	return NewSetWithSortableFiltered(kvs, new(Sortable), filter)
+	tmp := sortables.Get().(*Sortable)
+	s, excluded := NewSetWithSortableFiltered(kvs, tmp, filter)
+	sortables.Put(tmp)
+	return s, excluded
 }
 
 // NewSetWithSortableFiltered returns a new `Set`.
@@ -269,6 +283,28 @@ func NewSetWithSortableFiltered(kvs []KeyValue, tmp *Sortable, filter Filter) (S
 
 	*tmp = nil
 
+	return newSetFromSorted(kvs, filter)
+}
+
+// NewSetFromSortedFiltered returns a new `Set` from labels that are
+// already sorted by key, without sorting them again.  See the
+// documentation for `NewSetWithSortableFiltered` for more details.
+//
+// The labels must be sorted as `sort.Stable` sorts a `Sortable`: by key,
+// with duplicate keys in the order they were set, so the last value
+// wins.  The resulting `Set` is not distinct from equivalent sets if
+// they are not.
+func NewSetFromSortedFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
+	// Check for empty set.
+	if len(kvs) == 0 {
+		return empty(), nil
+	}
+	return newSetFromSorted(kvs, filter)
+}
+
+// newSetFromSorted de-duplicates and filters the sorted, non-empty kvs
+// into a `Set`.
+func newSetFromSorted(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 	position := len(kvs) - 1
 	offset := position - 1
 
@@ -319,8 +355,12 @@ func filterSet(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 	}, excluded
 }
 
-// Filter returns a filtered copy of this `Set`.  See the
+// Filter returns a filtered copy of this `Set` holding the labels for
+// which `re` returns true, and the labels that were removed.  See the
 // documentation for `NewSetWithSortableFiltered` for more details.
+//
+// Use `NewAllowKeysFilter` and `NewDenyKeysFilter` to filter labels by
+// key.
 func (l *Set) Filter(re Filter) (Set, []KeyValue) {
 	if re == nil {
 		return Set{
@@ -333,6 +373,16 @@ func (l *Set) Filter(re Filter) (Set, []KeyValue) {
 	return filterSet(l.ToSlice(), re)
 }
 
+// Project returns a copy of this `Set` holding only the labels with one
+// of `keys`.  Keys that are not in the set are ignored.
+func (l *Set) Project(keys ...Key) Set {
+	if len(keys) == 0 {
+		return empty()
+	}
+	projected, _ := l.Filter(NewAllowKeysFilter(keys...))
+	return projected
+}
+
 // computeDistinct returns a `Distinct` using either the fixed- or
 // reflect-oriented code path, depending on the size of the input.
 // The input slice is assumed to already be sorted and de-duplicated.
@@ -188,3 +188,108 @@ func TestLookup(t *testing.T) {
 	value, has = set.Value("D")
 	require.False(t, has)
 }
+
+func TestNewSetFromSortedFiltered(t *testing.T) {
+	sorted := []attribute.KeyValue{
+		attribute.String("A", "0"),
+		attribute.String("A", "1"),
+		attribute.String("B", "2"),
+		attribute.String("C", "3"),
+	}
+	enc := attribute.DefaultEncoder()
+
+	cpy := make([]attribute.KeyValue, len(sorted))
+	copy(cpy, sorted)
+	set, excluded := attribute.NewSetFromSortedFiltered(cpy, nil)
+	require.Nil(t, excluded)
+	require.Equal(t, "A=1,B=2,C=3", set.Encoded(enc))
+	expected := attribute.NewSet(sorted...)
+	require.Equal(t, expected.Equivalent(), set.Equivalent())
+
+	copy(cpy, sorted)
+	set, excluded = attribute.NewSetFromSortedFiltered(cpy, func(kv attribute.KeyValue) bool {
+		return kv.Key != "B"
+	})
+	require.Equal(t, "A=1,C=3", set.Encoded(enc))
+	full := attribute.NewSet(excluded...)
+	require.Equal(t, "B=2", full.Encoded(enc))
+
+	set, excluded = attribute.NewSetFromSortedFiltered(nil, nil)
+	require.Nil(t, excluded)
+	require.Equal(t, 0, set.Len())
+}
+
+func TestNewSetAllocs(t *testing.T) {
+	kvs := []attribute.KeyValue{
+		attribute.String("C", "3"),
+		attribute.String("A", "1"),
+		attribute.String("B", "2"),
+	}
+	var tmp attribute.Sortable
+	withSortable := testing.AllocsPerRun(100, func() {
+		_ = attribute.NewSetWithSortable(kvs, &tmp)
+	})
+	// The pooled temporary adds no allocation.
+	require.Equal(t, withSortable, testing.AllocsPerRun(100, func() {
+		_ = attribute.NewSet(kvs...)
+	}))
+}
+
+func TestSetFilter(t *testing.T) {
+	set := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.Int("B", 1),
+		attribute.Bool("C", true),
+	)
+	enc := attribute.DefaultEncoder()
+
+	filtered, removed := set.Filter(attribute.NewDenyKeysFilter("B"))
+	require.Equal(t, "A=a,C=true", filtered.Encoded(enc))
+	require.Equal(t, []attribute.KeyValue{attribute.Int("B", 1)}, removed)
+
+	filtered, removed = set.Filter(func(kv attribute.KeyValue) bool {
+		return kv.Value.Type() == attribute.STRING
+	})
+	require.Equal(t, "A=a", filtered.Encoded(enc))
+	require.Len(t, removed, 2)
+
+	filtered, removed = set.Filter(nil)
+	require.True(t, filtered.Equals(&set))
+	require.Nil(t, removed)
+
+	// The original set is not modified.
+	require.Equal(t, "A=a,B=1,C=true", set.Encoded(enc))
+}
+
+func TestSetProject(t *testing.T) {
+	set := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.Int("B", 1),
+		attribute.Bool("C", true),
+	)
+	enc := attribute.DefaultEncoder()
+
+	projected := set.Project("C", "A", "missing")
+	require.Equal(t, "A=a,C=true", projected.Encoded(enc))
+	expected := attribute.NewSet(attribute.String("A", "a"), attribute.Bool("C", true))
+	require.Equal(t, expected.Equivalent(), projected.Equivalent())
+
+	none := set.Project()
+	require.Equal(t, 0, none.Len())
+	none = attribute.EmptySet().Project("A")
+	require.Equal(t, 0, none.Len())
+}
+
+func TestKeysFilters(t *testing.T) {
+	a, b := attribute.String("A", "a"), attribute.String("B", "b")
+
+	allow := attribute.NewAllowKeysFilter("A")
+	require.True(t, allow(a))
+	require.False(t, allow(b))
+	require.False(t, attribute.NewAllowKeysFilter()(a))
+
+	deny := attribute.NewDenyKeysFilter("A")
+	require.False(t, deny(a))
+	require.True(t, deny(b))
+	require.True(t, attribute.NewDenyKeysFilter()(a))
+}
@@ -14,11 +14,12 @@ func _() {
 	_ = x[FLOAT64-3]
 	_ = x[STRING-4]
 	_ = x[ARRAY-5]
+	_ = x[MAP-6]
 }
 
-const _Type_name = "INVALIDBOOLINT64FLOAT64STRINGARRAY"
+const _Type_name = "INVALIDBOOLINT64FLOAT64STRINGARRAYMAP"
 
-var _Type_index = [...]uint8{0, 7, 11, 16, 23, 29, 34}
+var _Type_index = [...]uint8{0, 7, 11, 16, 23, 29, 34, 37}
 
 func (i Type) String() string {
 	if i < 0 || i >= Type(len(_Type_index)-1) {
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/otel/internal"
 )
@@ -35,6 +36,8 @@ type Value struct {
 	stringly string
 	// TODO Lazy value type?
 
+	// array holds the frozen array of an ARRAY Value, or the frozen
+	// array of KeyValues of a MAP Value.
 	array interface{}
 }
 
@@ -53,8 +56,16 @@ const (
 	// arrays of bool, int, int32, int64, uint, uint32, uint64, float,
 	// float32, float64, or string types.
 	ARRAY
+	// MAP is a map Type Value used to store a list of KeyValues with
+	// unique keys, sorted by key.  The KeyValues may hold other MAP
+	// Values, up to MaxMapDepth levels deep.
+	MAP
 )
 
+// MaxMapDepth is the maximum number of levels of nested MAP Values,
+// including the outermost one.
+const MaxMapDepth = 8
+
 // BoolValue creates a BOOL Value.
 func BoolValue(v bool) Value {
 	return Value{
@@ -121,6 +132,65 @@ func ArrayValue(v interface{}) Value {
 	return Value{vtype: INVALID}
 }
 
+// BoolSliceValue creates an ARRAY Value of bools.
+func BoolSliceValue(v []bool) Value {
+	return ArrayValue(v)
+}
+
+// Int64SliceValue creates an ARRAY Value of int64s.
+func Int64SliceValue(v []int64) Value {
+	return ArrayValue(v)
+}
+
+// IntSliceValue creates an ARRAY Value of int64s.  Like IntValue, the
+// values are stored as int64s.
+func IntSliceValue(v []int) Value {
+	cp := make([]int64, len(v))
+	for i, n := range v {
+		cp[i] = int64(n)
+	}
+	return Int64SliceValue(cp)
+}
+
+// Float64SliceValue creates an ARRAY Value of float64s.
+func Float64SliceValue(v []float64) Value {
+	return ArrayValue(v)
+}
+
+// StringSliceValue creates an ARRAY Value of strings.
+func StringSliceValue(v []string) Value {
+	return ArrayValue(v)
+}
+
+// MapValue creates a MAP Value from kvs.  Like in a Set, the KeyValues
+// are sorted by key and only the last KeyValue of each key is kept.
+// Invalid KeyValues are dropped.  If kvs holds MAP Values nested more
+// than MaxMapDepth levels deep, including the returned one, an INVALID
+// Value is returned.
+func MapValue(kvs ...KeyValue) Value {
+	depth := uint64(1)
+	for _, kv := range kvs {
+		if kv.Value.vtype == MAP && kv.Value.numeric+1 > depth {
+			depth = kv.Value.numeric + 1
+		}
+	}
+	if depth > MaxMapDepth {
+		return Value{vtype: INVALID}
+	}
+
+	set, _ := NewSetWithFiltered(kvs, func(kv KeyValue) bool {
+		return kv.Valid()
+	})
+	sorted := reflect.ValueOf(set.ToSlice())
+	frozen := reflect.Indirect(reflect.New(reflect.ArrayOf(sorted.Len(), keyValueType)))
+	reflect.Copy(frozen, sorted)
+	return Value{
+		vtype:   MAP,
+		numeric: depth,
+		array:   frozen.Interface(),
+	}
+}
+
 // Type returns a type of the Value.
 func (v Value) Type() Type {
 	return v.vtype
@@ -155,6 +225,90 @@ func (v Value) AsArray() interface{} {
 	return v.array
 }
 
+// AsBoolSlice returns the bool values of an ARRAY Value, or nil if the
+// Value does not hold an array of bools.
+func (v Value) AsBoolSlice() []bool {
+	rv, ok := v.arrayOf(reflect.Bool)
+	if !ok {
+		return nil
+	}
+	out := make([]bool, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Bool()
+	}
+	return out
+}
+
+// AsInt64Slice returns the integral values of an ARRAY Value, or nil if
+// the Value does not hold an array of int or int64 values.
+func (v Value) AsInt64Slice() []int64 {
+	rv, ok := v.arrayOf(reflect.Int, reflect.Int64)
+	if !ok {
+		return nil
+	}
+	out := make([]int64, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Int()
+	}
+	return out
+}
+
+// AsFloat64Slice returns the float64 values of an ARRAY Value, or nil if
+// the Value does not hold an array of float64s.
+func (v Value) AsFloat64Slice() []float64 {
+	rv, ok := v.arrayOf(reflect.Float64)
+	if !ok {
+		return nil
+	}
+	out := make([]float64, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Float()
+	}
+	return out
+}
+
+// AsStringSlice returns the string values of an ARRAY Value, or nil if
+// the Value does not hold an array of strings.
+func (v Value) AsStringSlice() []string {
+	rv, ok := v.arrayOf(reflect.String)
+	if !ok {
+		return nil
+	}
+	out := make([]string, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).String()
+	}
+	return out
+}
+
+// arrayOf returns the array of an ARRAY Value if its elements are of
+// one of kinds.
+func (v Value) arrayOf(kinds ...reflect.Kind) (reflect.Value, bool) {
+	if v.vtype != ARRAY {
+		return reflect.Value{}, false
+	}
+	rv := reflect.ValueOf(v.array)
+	elem := rv.Type().Elem().Kind()
+	for _, k := range kinds {
+		if elem == k {
+			return rv, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// AsMap returns the KeyValues of a MAP Value, sorted by key, or nil if
+// the Value is not a MAP.
+func (v Value) AsMap() []KeyValue {
+	if v.vtype != MAP {
+		return nil
+	}
+	rv := reflect.ValueOf(v.array)
+	kvs := make([]KeyValue, rv.Len())
+	reflect.Copy(reflect.ValueOf(kvs), rv)
+	return kvs
+}
+
 type unknownValueType struct{}
 
 // AsInterface returns Value's data as interface{}.
@@ -162,6 +316,13 @@ func (v Value) AsInterface() interface{} {
 	switch v.Type() {
 	case ARRAY:
 		return v.AsArray()
+	case MAP:
+		kvs := v.AsMap()
+		m := make(map[string]interface{}, len(kvs))
+		for _, kv := range kvs {
+			m[string(kv.Key)] = kv.Value.AsInterface()
+		}
+		return m
 	case BOOL:
 		return v.AsBool()
 	case INT64:
@@ -179,6 +340,19 @@ func (v Value) Emit() string {
 	switch v.Type() {
 	case ARRAY:
 		return fmt.Sprint(v.array)
+	case MAP:
+		var b strings.Builder
+		b.WriteString("map[")
+		for i, kv := range v.AsMap() {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(string(kv.Key))
+			b.WriteByte(':')
+			b.WriteString(kv.Value.Emit())
+		}
+		b.WriteByte(']')
+		return b.String()
 	case BOOL:
 		return strconv.FormatBool(v.AsBool())
 	case INT64:
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -136,3 +138,60 @@ func TestAsArrayValue(t *testing.T) {
 		t.Errorf("AsArray() returned %T, want %T", got, want)
 	}
 }
+
+func TestSliceValues(t *testing.T) {
+	b := attribute.BoolSliceValue([]bool{true, false})
+	assert.Equal(t, attribute.ARRAY, b.Type())
+	assert.Equal(t, []bool{true, false}, b.AsBoolSlice())
+	assert.Nil(t, b.AsStringSlice())
+
+	i := attribute.IntSliceValue([]int{1, 2})
+	assert.Equal(t, [2]int64{1, 2}, i.AsArray())
+	assert.Equal(t, []int64{1, 2}, i.AsInt64Slice())
+	assert.Equal(t, i, attribute.Int64SliceValue([]int64{1, 2}))
+	assert.Equal(t, []int64{3}, attribute.ArrayValue([]int{3}).AsInt64Slice())
+
+	f := attribute.Float64SliceValue([]float64{1.5})
+	assert.Equal(t, []float64{1.5}, f.AsFloat64Slice())
+	assert.Nil(t, f.AsInt64Slice())
+
+	s := attribute.StringSliceValue([]string{"a", "b"})
+	assert.Equal(t, []string{"a", "b"}, s.AsStringSlice())
+	assert.Nil(t, attribute.StringValue("a").AsStringSlice())
+}
+
+func TestMapValue(t *testing.T) {
+	v := attribute.MapValue(
+		attribute.String("b", "first"),
+		attribute.Int("a", 1),
+		attribute.String("b", "last"),
+		attribute.KeyValue{Key: "invalid"},
+	)
+	assert.Equal(t, attribute.MAP, v.Type())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("a", 1),
+		attribute.String("b", "last"),
+	}, v.AsMap())
+	assert.Nil(t, attribute.StringValue("a").AsMap())
+
+	// Maps with the same KeyValues are equal regardless of their order.
+	other := attribute.MapValue(attribute.String("b", "last"), attribute.Int("a", 1))
+	assert.True(t, v == other)
+	set := attribute.NewSet(attribute.KeyValue{Key: "m", Value: v})
+	otherSet := attribute.NewSet(attribute.KeyValue{Key: "m", Value: other})
+	assert.True(t, set.Equals(&otherSet))
+
+	assert.Equal(t, attribute.MAP, attribute.MapValue().Type())
+	assert.Empty(t, attribute.MapValue().AsMap())
+}
+
+func TestMapValueDepth(t *testing.T) {
+	v := attribute.MapValue()
+	for i := 1; i < attribute.MaxMapDepth; i++ {
+		v = attribute.MapValue(attribute.KeyValue{Key: "m", Value: v})
+	}
+	require.Equal(t, attribute.MAP, v.Type())
+
+	tooDeep := attribute.MapValue(attribute.KeyValue{Key: "m", Value: v})
+	assert.Equal(t, attribute.INVALID, tooDeep.Type())
+}
@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute // import "go.opentelemetry.io/otel/attribute"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// ErrUnsupportedValue is returned by ValueOf for values that cannot be
+// converted to a Value.
+var ErrUnsupportedValue = errors.New("unsupported attribute value")
+
+// ValueOf returns the Value of v, converted with explicit typing rules:
+//
+//   - bools are BOOL Values.
+//   - signed integers, and unsigned integers up to math.MaxInt64, are
+//     INT64 Values.
+//   - float32 and float64 values are FLOAT64 Values.
+//   - strings are STRING Values.
+//   - slices and arrays of the above types are ARRAY Values, of int64s
+//     for integers and of float64s for floats.
+//   - maps with string keys are MAP Values.
+//   - structs are MAP Values of their exported fields.  A field is named
+//     by its "attribute" struct tag if it has one, and it is skipped if
+//     the tag is "-".
+//   - pointers and interfaces are converted to the Value they point to.
+//
+// Nil pointers, interfaces, maps, and slices held by maps or structs are
+// skipped.  An error wrapping ErrUnsupportedValue is returned for nil
+// values, values of other types, such as channels, functions, and
+// complex numbers, and maps and structs nested more than MaxMapDepth
+// levels deep.
+//
+// Unlike Any, ValueOf never converts values to strings with their
+// String method or JSON encoding.
+func ValueOf(v interface{}) (Value, error) {
+	if v == nil {
+		return Value{}, fmt.Errorf("%w: nil", ErrUnsupportedValue)
+	}
+	return valueOf(reflect.ValueOf(v), 1)
+}
+
+// valueOf converts rv, held by depth-1 levels of maps or structs.
+func valueOf(rv reflect.Value, depth int) (Value, error) {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return Value{}, fmt.Errorf("%w: nil %s", ErrUnsupportedValue, rv.Type())
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return BoolValue(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Int64Value(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := uintToInt64(rv)
+		if err != nil {
+			return Value{}, err
+		}
+		return Int64Value(n), nil
+	case reflect.Float32, reflect.Float64:
+		return Float64Value(rv.Float()), nil
+	case reflect.String:
+		return StringValue(rv.String()), nil
+	case reflect.Slice, reflect.Array:
+		return arrayValueOf(rv)
+	case reflect.Map:
+		if depth > MaxMapDepth {
+			return Value{}, fmt.Errorf("%w: %s nested more than %d levels deep", ErrUnsupportedValue, rv.Type(), MaxMapDepth)
+		}
+		return mapValueOf(rv, depth)
+	case reflect.Struct:
+		if depth > MaxMapDepth {
+			return Value{}, fmt.Errorf("%w: %s nested more than %d levels deep", ErrUnsupportedValue, rv.Type(), MaxMapDepth)
+		}
+		return structValueOf(rv, depth)
+	}
+	return Value{}, fmt.Errorf("%w: %s", ErrUnsupportedValue, rv.Type())
+}
+
+func uintToInt64(rv reflect.Value) (int64, error) {
+	n := rv.Uint()
+	if n > math.MaxInt64 {
+		return 0, fmt.Errorf("%w: %d overflows int64", ErrUnsupportedValue, n)
+	}
+	return int64(n), nil
+}
+
+// arrayValueOf converts a slice or array of scalars to an ARRAY Value.
+func arrayValueOf(rv reflect.Value) (Value, error) {
+	n := rv.Len()
+	switch rv.Type().Elem().Kind() {
+	case reflect.Bool:
+		out := make([]bool, n)
+		for i := range out {
+			out[i] = rv.Index(i).Bool()
+		}
+		return BoolSliceValue(out), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		out := make([]int64, n)
+		for i := range out {
+			out[i] = rv.Index(i).Int()
+		}
+		return Int64SliceValue(out), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		out := make([]int64, n)
+		for i := range out {
+			v, err := uintToInt64(rv.Index(i))
+			if err != nil {
+				return Value{}, err
+			}
+			out[i] = v
+		}
+		return Int64SliceValue(out), nil
+	case reflect.Float32, reflect.Float64:
+		out := make([]float64, n)
+		for i := range out {
+			out[i] = rv.Index(i).Float()
+		}
+		return Float64SliceValue(out), nil
+	case reflect.String:
+		out := make([]string, n)
+		for i := range out {
+			out[i] = rv.Index(i).String()
+		}
+		return StringSliceValue(out), nil
+	}
+	return Value{}, fmt.Errorf("%w: %s, arrays must hold scalars", ErrUnsupportedValue, rv.Type())
+}
+
+// mapValueOf converts a map with string keys to a MAP Value.
+func mapValueOf(rv reflect.Value, depth int) (Value, error) {
+	if rv.Type().Key().Kind() != reflect.String {
+		return Value{}, fmt.Errorf("%w: %s, map keys must be strings", ErrUnsupportedValue, rv.Type())
+	}
+	kvs := make([]KeyValue, 0, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		if isNil(iter.Value()) {
+			continue
+		}
+		v, err := valueOf(iter.Value(), depth+1)
+		if err != nil {
+			return Value{}, err
+		}
+		kvs = append(kvs, KeyValue{Key: Key(iter.Key().String()), Value: v})
+	}
+	return MapValue(kvs...), nil
+}
+
+// structValueOf converts the exported fields of a struct to a MAP Value.
+func structValueOf(rv reflect.Value, depth int) (Value, error) {
+	t := rv.Type()
+	kvs := make([]KeyValue, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// Unexported field.
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("attribute"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag = strings.TrimSpace(tag); tag != "" {
+				name = tag
+			}
+		}
+		fv := rv.Field(i)
+		if isNil(fv) {
+			continue
+		}
+		v, err := valueOf(fv, depth+1)
+		if err != nil {
+			return Value{}, err
+		}
+		kvs = append(kvs, KeyValue{Key: Key(name), Value: v})
+	}
+	return MapValue(kvs...), nil
+}
+
+// isNil returns true for nil pointers, interfaces, maps, and slices.
+func isNil(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return rv.IsNil()
+	}
+	return false
+}
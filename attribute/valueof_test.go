@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type inner struct {
+	Port uint16
+}
+
+type outer struct {
+	Name     string   `attribute:"name"`
+	Tags     []string `attribute:"tags"`
+	Inner    *inner   `attribute:"inner"`
+	Missing  *inner
+	Skipped  int `attribute:"-"`
+	internal int
+}
+
+func TestValueOf(t *testing.T) {
+	name := "bar"
+	for _, test := range []struct {
+		name  string
+		value interface{}
+		want  attribute.Value
+	}{
+		{"bool", true, attribute.BoolValue(true)},
+		{"int8", int8(-3), attribute.Int64Value(-3)},
+		{"uint32", uint32(7), attribute.Int64Value(7)},
+		{"float32", float32(1.5), attribute.Float64Value(1.5)},
+		{"string", "foo", attribute.StringValue("foo")},
+		{"pointer", &name, attribute.StringValue("bar")},
+		{"[]int32", []int32{1, 2}, attribute.Int64SliceValue([]int64{1, 2})},
+		{"[2]float32", [2]float32{1, 2}, attribute.Float64SliceValue([]float64{1, 2})},
+		{"[]string", []string{"a"}, attribute.StringSliceValue([]string{"a"})},
+		{
+			"map",
+			map[string]interface{}{"b": 1, "a": []bool{true}, "nil": nil},
+			attribute.MapValue(
+				attribute.BoolSlice("a", []bool{true}),
+				attribute.Int("b", 1),
+			),
+		},
+		{
+			"struct",
+			outer{
+				Name:     "svc",
+				Tags:     []string{"x"},
+				Inner:    &inner{Port: 80},
+				Skipped:  1,
+				internal: 2,
+			},
+			attribute.MapValue(
+				attribute.String("name", "svc"),
+				attribute.StringSlice("tags", []string{"x"}),
+				attribute.Map("inner", attribute.Int("Port", 80)),
+			),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := attribute.ValueOf(test.value)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestValueOfErrors(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+	deep := &node{}
+	for i := 0; i < attribute.MaxMapDepth; i++ {
+		deep = &node{Next: deep}
+	}
+
+	for _, test := range []struct {
+		name  string
+		value interface{}
+	}{
+		{"nil", nil},
+		{"nil pointer", (*inner)(nil)},
+		{"channel", make(chan int)},
+		{"complex", complex(1, 2)},
+		{"uint64 overflow", uint64(math.MaxUint64)},
+		{"nested array", [][]int{{1}}},
+		{"array of structs", []inner{{}}},
+		{"int map keys", map[int]string{1: "a"}},
+		{"unsupported field", struct{ F func() }{F: func() {}}},
+		{"too deep", deep},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := attribute.ValueOf(test.value)
+			assert.ErrorIs(t, err, attribute.ErrUnsupportedValue)
+		})
+	}
+
+	// The deepest struct that can be converted.
+	shallow := &node{}
+	for i := 1; i < attribute.MaxMapDepth; i++ {
+		shallow = &node{Next: shallow}
+	}
+	v, err := attribute.ValueOf(shallow)
+	require.NoError(t, err)
+	assert.Equal(t, attribute.MAP, v.Type())
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"context"
+	"fmt"
+)
+
+// Builder constructs a set of baggage Members. Its methods can be chained,
+// the first error encountered is kept and returned by Build or
+// ContextWith.
+//
+//	ctx, err := baggage.NewBuilder().
+//		Member("tenant.id", "acme").
+//		Member("user.id", "alice").KeyValueProperty("ttl", "30").
+//		ContextWith(ctx)
+type Builder struct {
+	members []Member
+	index   map[string]int
+	err     error
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{index: make(map[string]int)}
+}
+
+// Member adds a member with key and value. A member with the same key
+// added before is replaced. Properties added next are added to this
+// member.
+func (b *Builder) Member(key, value string) *Builder {
+	if b.err != nil {
+		return b
+	}
+	m, err := NewMember(key, value)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	if i, ok := b.index[key]; ok {
+		// Move the replaced member to the end so properties are added to
+		// it.
+		b.members = append(b.members[:i], b.members[i+1:]...)
+		for k, j := range b.index {
+			if j > i {
+				b.index[k] = j - 1
+			}
+		}
+	}
+	b.index[key] = len(b.members)
+	b.members = append(b.members, m)
+	return b
+}
+
+// KeyProperty adds a property with only a key to the last added member.
+func (b *Builder) KeyProperty(key string) *Builder {
+	return b.property(NewKeyProperty(key))
+}
+
+// KeyValueProperty adds a key=value property to the last added member.
+func (b *Builder) KeyValueProperty(key, value string) *Builder {
+	return b.property(NewKeyValueProperty(key, value))
+}
+
+func (b *Builder) property(p Property, err error) *Builder {
+	if b.err != nil {
+		return b
+	}
+	if err != nil {
+		b.err = err
+		return b
+	}
+	if len(b.members) == 0 {
+		b.err = fmt.Errorf("%w: property %q added before any member", ErrInvalidProperty, p.key)
+		return b
+	}
+	last := &b.members[len(b.members)-1]
+	last.properties = append(last.properties, p)
+	if err := last.validate(); err != nil {
+		b.err = err
+	}
+	return b
+}
+
+// Build returns the members added to b. An error is returned if any of
+// them is invalid or if together they exceed MaxMembers or MaxBytes.
+func (b *Builder) Build() ([]Member, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if n := len(b.members); n > MaxMembers {
+		return nil, fmt.Errorf("%w: %d members, limit is %d", ErrMemberNumberExceeded, n, MaxMembers)
+	}
+	size := 0
+	for i, m := range b.members {
+		if i > 0 {
+			size += len(listDelimiter)
+		}
+		size += len(m.String())
+	}
+	if size > MaxBytes {
+		return nil, fmt.Errorf("%w: %d bytes, limit is %d", ErrBytesExceeded, size, MaxBytes)
+	}
+	members := make([]Member, len(b.members))
+	copy(members, b.members)
+	return members, nil
+}
+
+// ContextWith returns a copy of parent with the members added to b set in
+// its baggage. See ContextWithMembers.
+func (b *Builder) ContextWith(parent context.Context) (context.Context, error) {
+	members, err := b.Build()
+	if err != nil {
+		return parent, err
+	}
+	return ContextWithMembers(parent, members...)
+}
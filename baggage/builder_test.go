@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	ctx, err := NewBuilder().
+		Member("tenant.id", "acme").
+		Member("user.id", "alice").KeyValueProperty("ttl", "30").KeyProperty("sensitive").
+		ContextWith(context.Background())
+	require.NoError(t, err)
+
+	members := Members(ctx)
+	require.Len(t, members, 2)
+	assert.Equal(t, "tenant.id", members[0].Key())
+	assert.Empty(t, members[0].Properties())
+	assert.Equal(t, "user.id", members[1].Key())
+	assert.Equal(t, "alice", members[1].Value())
+	ttl, ok := members[1].Property("ttl")
+	require.True(t, ok)
+	v, _ := ttl.Value()
+	assert.Equal(t, "30", v)
+	_, ok = members[1].Property("sensitive")
+	assert.True(t, ok)
+}
+
+func TestBuilderReplacesMember(t *testing.T) {
+	members, err := NewBuilder().
+		Member("a", "1").
+		Member("b", "2").
+		Member("a", "3").KeyProperty("p").
+		Build()
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "b", members[0].Key())
+	assert.Equal(t, "a=3;p", members[1].String())
+}
+
+func TestBuilderErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		b    *Builder
+		want error
+	}{
+		{
+			name: "invalid key",
+			b:    NewBuilder().Member("a b", "1").Member("c", "2"),
+			want: ErrInvalidKey,
+		},
+		{
+			name: "property without member",
+			b:    NewBuilder().KeyProperty("p"),
+			want: ErrInvalidProperty,
+		},
+		{
+			name: "invalid property",
+			b:    NewBuilder().Member("a", "1").KeyValueProperty("p", "a b"),
+			want: ErrInvalidProperty,
+		},
+		{
+			name: "member bytes",
+			b:    NewBuilder().Member("a", strings.Repeat("v", MaxMemberBytes-5)).KeyValueProperty("p", "12345"),
+			want: ErrMemberBytesExceeded,
+		},
+		{
+			name: "total bytes",
+			b: NewBuilder().
+				Member("a", strings.Repeat("v", MaxMemberBytes-10)).
+				Member("b", strings.Repeat("v", MaxMemberBytes-10)).
+				Member("c", strings.Repeat("v", MaxMemberBytes-10)),
+			want: ErrBytesExceeded,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.b.Build()
+			assert.True(t, errors.Is(err, tc.want), "got %v, want %v", err, tc.want)
+
+			parent := context.Background()
+			ctx, err := tc.b.ContextWith(parent)
+			assert.Error(t, err)
+			assert.Equal(t, parent, ctx)
+		})
+	}
+}
+
+func TestBuilderMemberNumber(t *testing.T) {
+	b := NewBuilder()
+	for i := 0; i <= MaxMembers; i++ {
+		b.Member(strings.Repeat("k", i+1), "v")
+	}
+	_, err := b.Build()
+	assert.True(t, errors.Is(err, ErrMemberNumberExceeded))
+}
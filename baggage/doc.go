@@ -17,6 +17,12 @@ Package baggage provides functionality for storing and retrieving
 baggage items in Go context. For propagating the baggage, see the
 go.opentelemetry.io/otel/propagation package.
 
+Baggage members with properties, as defined by the W3C Baggage
+specification, can be read with Members and set with ContextWithMembers or a
+Builder. Both enforce the limits of the specification, MaxMembers,
+MaxMemberBytes and MaxBytes, and return errors wrapping the Err variables of
+this package.
+
 This package is currently in a pre-GA phase. Backwards incompatible changes
 may be introduced in subsequent minor version releases as we work to track the
 evolving OpenTelemetry specification and user feedback.
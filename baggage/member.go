@@ -0,0 +1,302 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/baggage"
+)
+
+// Limits of the W3C Baggage specification.
+const (
+	// MaxMembers is the maximum number of members in a baggage.
+	MaxMembers = 180
+	// MaxMemberBytes is the maximum encoded size of a single member.
+	MaxMemberBytes = 4096
+	// MaxBytes is the maximum encoded size of a baggage.
+	MaxBytes = 8192
+)
+
+const (
+	listDelimiter     = ","
+	propertyDelimiter = ";"
+	keyValueDelimiter = "="
+)
+
+var (
+	// ErrInvalidKey is returned for a member or property key that is not a
+	// valid W3C Baggage token.
+	ErrInvalidKey = errors.New("invalid baggage key")
+	// ErrInvalidValue is returned for a member value that cannot be
+	// stored.
+	ErrInvalidValue = errors.New("invalid baggage value")
+	// ErrInvalidProperty is returned for a malformed property.
+	ErrInvalidProperty = errors.New("invalid baggage property")
+	// ErrMemberNumberExceeded is returned if a baggage would contain more
+	// than MaxMembers members.
+	ErrMemberNumberExceeded = errors.New("baggage member number exceeded")
+	// ErrMemberBytesExceeded is returned if the encoded size of a member is
+	// larger than MaxMemberBytes.
+	ErrMemberBytesExceeded = errors.New("baggage member bytes exceeded")
+	// ErrBytesExceeded is returned if the encoded size of a baggage is
+	// larger than MaxBytes.
+	ErrBytesExceeded = errors.New("baggage bytes exceeded")
+)
+
+// Property is metadata of a baggage Member. It is either a key or a
+// key=value pair.
+type Property struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// NewKeyProperty returns a Property with only a key.
+func NewKeyProperty(key string) (Property, error) {
+	if !validKey(key) {
+		return Property{}, fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return Property{key: key}, nil
+}
+
+// NewKeyValueProperty returns a Property with a key and a value.
+func NewKeyValueProperty(key, value string) (Property, error) {
+	if !validKey(key) {
+		return Property{}, fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	if !validPropertyValue(value) {
+		return Property{}, fmt.Errorf("%w: invalid value %q for %q", ErrInvalidProperty, value, key)
+	}
+	return Property{key: key, value: value, hasValue: true}, nil
+}
+
+// parseProperty parses the encoded form of a Property.
+func parseProperty(s string) (Property, error) {
+	s = strings.TrimSpace(s)
+	i := strings.Index(s, keyValueDelimiter)
+	if i < 0 {
+		return NewKeyProperty(s)
+	}
+	return NewKeyValueProperty(strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]))
+}
+
+// Key returns the key of p.
+func (p Property) Key() string { return p.key }
+
+// Value returns the value of p and whether p has a value.
+func (p Property) Value() (string, bool) { return p.value, p.hasValue }
+
+// String returns the encoded form of p.
+func (p Property) String() string {
+	if p.hasValue {
+		return p.key + keyValueDelimiter + p.value
+	}
+	return p.key
+}
+
+// Member is a baggage list-member: a key, a value and optional properties.
+type Member struct {
+	key        string
+	value      string
+	properties []Property
+}
+
+// NewMember returns a Member with key, value and properties.
+//
+// The value is stored in the baggage of a context together with the
+// properties separated by ";", the same way the W3C Baggage propagator
+// stores extracted members, so it must not contain ";".
+func NewMember(key, value string, properties ...Property) (Member, error) {
+	m := Member{key: key, value: value}
+	if len(properties) > 0 {
+		m.properties = make([]Property, len(properties))
+		copy(m.properties, properties)
+	}
+	if err := m.validate(); err != nil {
+		return Member{}, err
+	}
+	return m, nil
+}
+
+func (m Member) validate() error {
+	if !validKey(m.key) {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, m.key)
+	}
+	if strings.Contains(m.value, propertyDelimiter) {
+		return fmt.Errorf("%w: value of %q contains %q", ErrInvalidValue, m.key, propertyDelimiter)
+	}
+	for _, p := range m.properties {
+		if p.key == "" {
+			return fmt.Errorf("%w: empty property of %q", ErrInvalidProperty, m.key)
+		}
+	}
+	if n := len(m.String()); n > MaxMemberBytes {
+		return fmt.Errorf("%w: %q is %d bytes, limit is %d", ErrMemberBytesExceeded, m.key, n, MaxMemberBytes)
+	}
+	return nil
+}
+
+// memberFromKeyValue returns the Member stored as kv in the baggage of a
+// context.
+func memberFromKeyValue(kv attribute.KeyValue) Member {
+	m := Member{key: string(kv.Key)}
+	parts := strings.Split(kv.Value.Emit(), propertyDelimiter)
+	m.value = parts[0]
+	for _, s := range parts[1:] {
+		if p, err := parseProperty(s); err == nil {
+			m.properties = append(m.properties, p)
+		}
+	}
+	return m
+}
+
+// Key returns the key of m.
+func (m Member) Key() string { return m.key }
+
+// Value returns the value of m.
+func (m Member) Value() string { return m.value }
+
+// Properties returns a copy of the properties of m.
+func (m Member) Properties() []Property {
+	if len(m.properties) == 0 {
+		return nil
+	}
+	props := make([]Property, len(m.properties))
+	copy(props, m.properties)
+	return props
+}
+
+// Property returns the property of m with key and whether it exists.
+func (m Member) Property(key string) (Property, bool) {
+	for _, p := range m.properties {
+		if p.key == key {
+			return p, true
+		}
+	}
+	return Property{}, false
+}
+
+// String returns the W3C Baggage encoding of m. The value is
+// percent-encoded.
+func (m Member) String() string {
+	var b strings.Builder
+	b.WriteString(m.key)
+	b.WriteString(keyValueDelimiter)
+	b.WriteString(url.QueryEscape(m.value))
+	for _, p := range m.properties {
+		b.WriteString(propertyDelimiter)
+		b.WriteString(p.String())
+	}
+	return b.String()
+}
+
+// KeyValue returns m as it is stored in the baggage of a context.
+func (m Member) KeyValue() attribute.KeyValue {
+	var b strings.Builder
+	b.WriteString(m.value)
+	for _, p := range m.properties {
+		b.WriteString(propertyDelimiter)
+		b.WriteString(p.String())
+	}
+	return attribute.String(m.key, b.String())
+}
+
+// Members returns the baggage of ctx as Members sorted by key. Properties
+// that cannot be parsed are dropped.
+func Members(ctx context.Context) []Member {
+	m := baggage.MapFromContext(ctx)
+	if m.Len() == 0 {
+		return nil
+	}
+	members := make([]Member, 0, m.Len())
+	m.Foreach(func(kv attribute.KeyValue) bool {
+		members = append(members, memberFromKeyValue(kv))
+		return true
+	})
+	sort.Slice(members, func(i, j int) bool { return members[i].key < members[j].key })
+	return members
+}
+
+// ContextWithMembers returns a copy of parent with members added to the
+// baggage, replacing members with the same key. An error is returned, and
+// parent is not modified, if the resulting baggage exceeds MaxMembers or
+// MaxBytes.
+func ContextWithMembers(parent context.Context, members ...Member) (context.Context, error) {
+	m := baggage.MapFromContext(parent)
+	kvs := make([]attribute.KeyValue, len(members))
+	for i, member := range members {
+		kvs[i] = member.KeyValue()
+	}
+	m = m.Apply(baggage.MapUpdate{MultiKV: kvs})
+
+	if n := m.Len(); n > MaxMembers {
+		return parent, fmt.Errorf("%w: %d members, limit is %d", ErrMemberNumberExceeded, n, MaxMembers)
+	}
+	size := 0
+	m.Foreach(func(kv attribute.KeyValue) bool {
+		if size > 0 {
+			size += len(listDelimiter)
+		}
+		size += len(memberFromKeyValue(kv).String())
+		return true
+	})
+	if size > MaxBytes {
+		return parent, fmt.Errorf("%w: %d bytes, limit is %d", ErrBytesExceeded, size, MaxBytes)
+	}
+	return baggage.ContextWithMap(parent, m), nil
+}
+
+// validKey reports whether s is a token as defined by RFC 7230, which is
+// what the W3C Baggage specification requires keys to be.
+func validKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !isTokenChar(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTokenChar(c rune) bool {
+	if c >= 0x80 {
+		return false
+	}
+	if c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' {
+		return true
+	}
+	return strings.ContainsRune("!#$%&'*+-.^_`|~", c)
+}
+
+// validPropertyValue reports whether s only contains characters that can
+// be encoded in a property value without escaping.
+func validPropertyValue(s string) bool {
+	for _, c := range s {
+		// baggage-octet as defined by the W3C Baggage specification.
+		if !(c == 0x21 || c >= 0x23 && c <= 0x2B || c >= 0x2D && c <= 0x3A || c >= 0x3C && c <= 0x5B || c >= 0x5D && c <= 0x7E) {
+			return false
+		}
+	}
+	return true
+}
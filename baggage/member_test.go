@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestNewProperty(t *testing.T) {
+	p, err := NewKeyProperty("key")
+	require.NoError(t, err)
+	assert.Equal(t, "key", p.Key())
+	_, ok := p.Value()
+	assert.False(t, ok)
+	assert.Equal(t, "key", p.String())
+
+	p, err = NewKeyValueProperty("key", "value")
+	require.NoError(t, err)
+	v, ok := p.Value()
+	assert.True(t, ok)
+	assert.Equal(t, "value", v)
+	assert.Equal(t, "key=value", p.String())
+
+	_, err = NewKeyProperty("invalid key")
+	assert.True(t, errors.Is(err, ErrInvalidKey))
+	_, err = NewKeyValueProperty("key", "a,b")
+	assert.True(t, errors.Is(err, ErrInvalidProperty))
+}
+
+func TestNewMember(t *testing.T) {
+	ttl, err := NewKeyValueProperty("ttl", "30")
+	require.NoError(t, err)
+	m, err := NewMember("user.id", "alice smith", ttl)
+	require.NoError(t, err)
+	assert.Equal(t, "user.id", m.Key())
+	assert.Equal(t, "alice smith", m.Value())
+	assert.Equal(t, []Property{ttl}, m.Properties())
+	assert.Equal(t, "user.id=alice+smith;ttl=30", m.String())
+	assert.Equal(t, attribute.String("user.id", "alice smith;ttl=30"), m.KeyValue())
+
+	got, ok := m.Property("ttl")
+	assert.True(t, ok)
+	assert.Equal(t, ttl, got)
+	_, ok = m.Property("missing")
+	assert.False(t, ok)
+}
+
+func TestNewMemberErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		key   string
+		value string
+		props []Property
+		want  error
+	}{
+		{name: "empty key", key: "", want: ErrInvalidKey},
+		{name: "key with separator", key: "a=b", want: ErrInvalidKey},
+		{name: "value with property delimiter", key: "key", value: "a;b", want: ErrInvalidValue},
+		{name: "zero property", key: "key", props: []Property{{}}, want: ErrInvalidProperty},
+		{name: "too large", key: "key", value: strings.Repeat("a", MaxMemberBytes), want: ErrMemberBytesExceeded},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewMember(tc.key, tc.value, tc.props...)
+			assert.True(t, errors.Is(err, tc.want), "got %v, want %v", err, tc.want)
+		})
+	}
+}
+
+func TestMembersRoundTrip(t *testing.T) {
+	flag, err := NewKeyProperty("flag")
+	require.NoError(t, err)
+	ttl, err := NewKeyValueProperty("ttl", "30")
+	require.NoError(t, err)
+	a, err := NewMember("a", "1", flag, ttl)
+	require.NoError(t, err)
+	b, err := NewMember("b", "2")
+	require.NoError(t, err)
+
+	ctx, err := ContextWithMembers(context.Background(), b, a)
+	require.NoError(t, err)
+	assert.Equal(t, []Member{a, b}, Members(ctx))
+	assert.Equal(t, "1;flag;ttl=30", Value(ctx, "a").AsString())
+}
+
+func TestMembersFromPropagatedValue(t *testing.T) {
+	// The W3C Baggage propagator stores properties as part of the value.
+	ctx := ContextWithValues(context.Background(), attribute.String("key", "value;prop=1;flag"))
+	members := Members(ctx)
+	require.Len(t, members, 1)
+	assert.Equal(t, "value", members[0].Value())
+	require.Len(t, members[0].Properties(), 2)
+	v, ok := members[0].Properties()[0].Value()
+	assert.True(t, ok)
+	assert.Equal(t, "1", v)
+	assert.Equal(t, "flag", members[0].Properties()[1].Key())
+}
+
+func TestContextWithMembersLimits(t *testing.T) {
+	members := make([]Member, MaxMembers+1)
+	for i := range members {
+		var err error
+		members[i], err = NewMember(strings.Repeat("k", i+1), "v")
+		require.NoError(t, err)
+	}
+	parent := context.Background()
+	ctx, err := ContextWithMembers(parent, members...)
+	assert.True(t, errors.Is(err, ErrMemberNumberExceeded))
+	assert.Equal(t, parent, ctx)
+
+	big, err := NewMember("big", strings.Repeat("v", MaxMemberBytes-10))
+	require.NoError(t, err)
+	ctx, err = ContextWithMembers(parent, big)
+	require.NoError(t, err)
+	other, err := NewMember("other", strings.Repeat("v", MaxMemberBytes-10))
+	require.NoError(t, err)
+	another, err := NewMember("another", strings.Repeat("v", MaxMemberBytes-10))
+	require.NoError(t, err)
+	_, err = ContextWithMembers(ctx, other, another)
+	assert.True(t, errors.Is(err, ErrBytesExceeded))
+}
@@ -182,7 +182,22 @@ func (s *span) AddMessageReceiveEvent(messageID, uncompressedByteSize, compresse
 }
 
 func (s *span) AddLink(l octrace.Link) {
-	otel.Handle(fmt.Errorf("ignoring OpenCensus link %+v for span %q because OpenTelemetry doesn't support setting links after creation", l, s.String()))
+	attributes := make([]attribute.KeyValue, 0, len(l.Attributes))
+	for k, v := range l.Attributes {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   attribute.Key(k),
+			Value: convertValue(v),
+		})
+	}
+	// The attributes of the link are sorted by the set.
+	set := attribute.NewSet(attributes...)
+	s.otSpan.AddLink(trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID(l.TraceID),
+			SpanID:  trace.SpanID(l.SpanID),
+		}),
+		Attributes: set.ToSlice(),
+	})
 }
 
 func (s *span) String() string {
@@ -275,3 +275,38 @@ func TestSetThings(t *testing.T) {
 		t.Errorf("Got receiveEvent.Attributes[compressedKey] = %v, expected 369", v.AsInt64())
 	}
 }
+
+func TestAddLink(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	octrace.DefaultTracer = NewTracer(tp.Tracer("addlink"))
+
+	ctx := context.Background()
+	_, ocspan := octrace.StartSpan(ctx, "OpenCensusSpan")
+	ocspan.AddLink(octrace.Link{
+		TraceID:    octrace.TraceID([16]byte{1}),
+		SpanID:     octrace.SpanID([8]byte{2}),
+		Type:       octrace.LinkTypeChild,
+		Attributes: map[string]interface{}{"string": "linkval", "bool": true},
+	})
+	ocspan.End()
+
+	spans := sr.Completed()
+	if len(spans) != 1 {
+		t.Fatalf("Got %d spans, exepected %d.", len(spans), 1)
+	}
+	links := spans[0].Links()
+	if len(links) != 1 {
+		t.Fatalf("Got len(links) = %v, expected 1", len(links))
+	}
+	if got := links[0].SpanContext.TraceID(); got != trace.TraceID([16]byte{1}) {
+		t.Errorf("Got link TraceID %v, expected %v", got, trace.TraceID([16]byte{1}))
+	}
+	if got := links[0].SpanContext.SpanID(); got != trace.SpanID([8]byte{2}) {
+		t.Errorf("Got link SpanID %v, expected %v", got, trace.SpanID([8]byte{2}))
+	}
+	want := []attribute.KeyValue{attribute.Bool("bool", true), attribute.String("string", "linkval")}
+	if got := links[0].Attributes; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Got link attributes %v, expected %v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opencensus
+
+import (
+	"context"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+)
+
+// MetricProducer reads metrics from OpenCensus metric producers, such as
+// the one backing go.opencensus.io/stats/view, and converts them to
+// OpenTelemetry export records.
+type MetricProducer struct {
+	producers func() []metricproducer.Producer
+}
+
+// MetricProducerOption configures a MetricProducer.
+type MetricProducerOption func(*MetricProducer)
+
+// WithProducers configures the MetricProducer to read from producers
+// instead of the producers registered with the OpenCensus global
+// metricproducer.Manager.
+func WithProducers(producers ...metricproducer.Producer) MetricProducerOption {
+	return func(p *MetricProducer) {
+		p.producers = func() []metricproducer.Producer { return producers }
+	}
+}
+
+// NewMetricProducer returns a MetricProducer that, by default, reads from
+// all producers registered with the OpenCensus global
+// metricproducer.Manager.
+func NewMetricProducer(opts ...MetricProducerOption) *MetricProducer {
+	p := &MetricProducer{producers: metricproducer.GlobalManager().GetAll}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// CheckpointSet reads the current value of all OpenCensus metrics and
+// returns them as an OpenTelemetry CheckpointSet. Metrics that cannot be
+// converted are reported to the global error handler and skipped.
+func (p *MetricProducer) CheckpointSet() export.CheckpointSet {
+	var metrics []*metricdata.Metric
+	for _, producer := range p.producers() {
+		metrics = append(metrics, producer.Read()...)
+	}
+	return &checkpointSet{metrics: metrics}
+}
+
+// Exporter returns an OpenTelemetry exporter that exports to base both the
+// records collected by the OpenTelemetry SDK and the OpenCensus metrics
+// read by p. The OpenCensus metrics are read each time the returned
+// exporter is called, so they are exported once per collection cycle of
+// the controller the exporter is configured with.
+func (p *MetricProducer) Exporter(base export.Exporter) export.Exporter {
+	return &producerExporter{Exporter: base, producer: p}
+}
+
+// producerExporter is an OpenTelemetry exporter that appends the metrics of
+// an OpenCensus MetricProducer to every export.
+type producerExporter struct {
+	export.Exporter
+	producer *MetricProducer
+}
+
+// Export exports the records of checkpointSet followed by the current
+// OpenCensus metrics.
+func (e *producerExporter) Export(ctx context.Context, checkpointSet export.CheckpointSet) error {
+	return e.Exporter.Export(ctx, &mergedCheckpointSet{
+		CheckpointSet: checkpointSet,
+		oc:            e.producer.CheckpointSet(),
+	})
+}
+
+// mergedCheckpointSet iterates an OpenTelemetry SDK CheckpointSet followed by
+// a CheckpointSet of OpenCensus metrics. Locking is delegated to the SDK
+// CheckpointSet.
+type mergedCheckpointSet struct {
+	export.CheckpointSet
+	oc export.CheckpointSet
+}
+
+// ForEach iterates through both CheckpointSets, stopping at the first error.
+func (m *mergedCheckpointSet) ForEach(kindSelector export.ExportKindSelector, f func(export.Record) error) error {
+	if err := m.CheckpointSet.ForEach(kindSelector, f); err != nil {
+		return err
+	}
+	return m.oc.ForEach(kindSelector, f)
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opencensus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
+
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+)
+
+type fakeProducer struct {
+	metrics []*metricdata.Metric
+	reads   int
+}
+
+func (f *fakeProducer) Read() []*metricdata.Metric {
+	f.reads++
+	return f.metrics
+}
+
+func newGauge(name string, value int64, now time.Time) *metricdata.Metric {
+	return &metricdata.Metric{
+		Descriptor: metricdata.Descriptor{
+			Name: name,
+			Type: metricdata.TypeGaugeInt64,
+		},
+		TimeSeries: []*metricdata.TimeSeries{
+			{
+				StartTime: now,
+				Points: []metricdata.Point{
+					{Value: value, Time: now},
+				},
+			},
+		},
+	}
+}
+
+func recordNames(t *testing.T, cps export.CheckpointSet) []string {
+	var names []string
+	err := cps.ForEach(export.CumulativeExportKindSelector(), func(r export.Record) error {
+		names = append(names, r.Descriptor().Name())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEach() = %v", err)
+	}
+	return names
+}
+
+func TestMetricProducerCheckpointSet(t *testing.T) {
+	now := time.Now()
+	p1 := &fakeProducer{metrics: []*metricdata.Metric{newGauge("a", 1, now)}}
+	p2 := &fakeProducer{metrics: []*metricdata.Metric{newGauge("b", 2, now), newGauge("c", 3, now)}}
+
+	names := recordNames(t, NewMetricProducer(WithProducers(p1, p2)).CheckpointSet())
+	if want := []string{"a", "b", "c"}; !equalStrings(names, want) {
+		t.Errorf("CheckpointSet() records = %v, want %v", names, want)
+	}
+}
+
+func TestMetricProducerGlobalManager(t *testing.T) {
+	producer := &fakeProducer{metrics: []*metricdata.Metric{newGauge("global", 1, time.Now())}}
+	metricproducer.GlobalManager().AddProducer(producer)
+	defer metricproducer.GlobalManager().DeleteProducer(producer)
+
+	names := recordNames(t, NewMetricProducer().CheckpointSet())
+	if want := []string{"global"}; !equalStrings(names, want) {
+		t.Errorf("CheckpointSet() records = %v, want %v", names, want)
+	}
+}
+
+func TestMetricProducerExporter(t *testing.T) {
+	now := time.Now()
+	producer := &fakeProducer{metrics: []*metricdata.Metric{newGauge("oc", 1, now)}}
+	fakeExporter := &fakeExporter{}
+	exporter := NewMetricProducer(WithProducers(producer)).Exporter(fakeExporter)
+
+	sdk := &checkpointSet{metrics: []*metricdata.Metric{newGauge("otel", 2, now)}}
+	for i := 1; i <= 2; i++ {
+		fakeExporter.records = nil
+		if err := exporter.Export(context.Background(), sdk); err != nil {
+			t.Fatalf("Export() = %v", err)
+		}
+		if producer.reads != i {
+			t.Errorf("producer read %d times after %d exports", producer.reads, i)
+		}
+		var names []string
+		for _, r := range fakeExporter.records {
+			names = append(names, r.Descriptor().Name())
+		}
+		if want := []string{"otel", "oc"}; !equalStrings(names, want) {
+			t.Errorf("Export() records = %v, want %v", names, want)
+		}
+	}
+}
+
+func TestMetricProducerExporterError(t *testing.T) {
+	now := time.Now()
+	producer := &fakeProducer{metrics: []*metricdata.Metric{newGauge("oc", 1, now)}}
+	exportErr := errors.New("failed to export")
+	fakeExporter := &fakeExporter{err: exportErr}
+	exporter := NewMetricProducer(WithProducers(producer)).Exporter(fakeExporter)
+
+	sdk := &checkpointSet{metrics: []*metricdata.Metric{newGauge("otel", 2, now)}}
+	if err := exporter.Export(context.Background(), sdk); !errors.Is(err, exportErr) {
+		t.Errorf("Export() = %v, want %v", err, exportErr)
+	}
+	if len(fakeExporter.records) != 1 {
+		t.Errorf("Export() exported %d records after an error, want 1", len(fakeExporter.records))
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -448,6 +448,13 @@ func (t *BridgeTracer) ContextWithBridgeSpan(ctx context.Context, span trace.Spa
 		otSpanContext = parentSpan.Context()
 	}
 	bCtx := newBridgeSpanContext(span.SpanContext(), otSpanContext)
+	// Make the OpenTelemetry baggage of ctx visible to OpenTracing
+	// instrumentation through the span context of the bridge span.
+	clearCtx, _, _ := baggage.ContextWithNoHooks(ctx)
+	baggage.MapFromContext(clearCtx).Foreach(func(kv attribute.KeyValue) bool {
+		bCtx.setBaggageItem(string(kv.Key), kv.Value.Emit())
+		return true
+	})
 	bSpan := newBridgeSpan(span, bCtx, t)
 	bSpan.skipDeferHook = true
 	return ot.ContextWithSpan(ctx, bSpan)
@@ -463,6 +470,7 @@ func (t *BridgeTracer) ContextWithSpanHook(ctx context.Context, span ot.Span) co
 		t.warningHandler("Encountered a foreign OpenTracing span, will not run a possible deferred context setup hook\n")
 		return ctx
 	}
+	ctx = contextWithBaggageItems(ctx, bSpan.ctx)
 	if bSpan.skipDeferHook {
 		return ctx
 	}
@@ -472,6 +480,32 @@ func (t *BridgeTracer) ContextWithSpanHook(ctx context.Context, span ot.Span) co
 	return ctx
 }
 
+// contextWithBaggageItems returns a copy of ctx with the baggage items of
+// the OpenTracing span context added to the OpenTelemetry baggage, so
+// OpenTelemetry instrumentation sees the items set with SetBaggageItem
+// even if the context was not set up with NewHookedContext. Any baggage
+// hooks of ctx are preserved but not invoked.
+func contextWithBaggageItems(ctx context.Context, bridgeSC *bridgeSpanContext) context.Context {
+	if bridgeSC.baggageItems.Len() == 0 {
+		return ctx
+	}
+	clearCtx, setHook, getHook := baggage.ContextWithNoHooks(ctx)
+	kv := make([]attribute.KeyValue, 0, bridgeSC.baggageItems.Len())
+	bridgeSC.baggageItems.Foreach(func(item attribute.KeyValue) bool {
+		kv = append(kv, item)
+		return true
+	})
+	m := baggage.MapFromContext(clearCtx).Apply(baggage.MapUpdate{MultiKV: kv})
+	ctx = baggage.ContextWithMap(clearCtx, m)
+	if setHook != nil {
+		ctx = baggage.ContextWithSetHook(ctx, setHook)
+	}
+	if getHook != nil {
+		ctx = baggage.ContextWithGetHook(ctx, getHook)
+	}
+	return ctx
+}
+
 func otTagsToOTelAttributesKindAndError(tags map[string]interface{}) ([]attribute.KeyValue, trace.SpanKind, bool) {
 	kind := trace.SpanKindInternal
 	err := false
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	ot "github.com/opentracing/opentracing-go"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+
+	"go.opentelemetry.io/otel/bridge/opentracing/internal"
+)
+
+func TestBaggageItemsToOTelWithoutHooks(t *testing.T) {
+	otTracer, otelProvider := NewTracerPair(internal.NewMockTracer())
+
+	otSpan, ctx := ot.StartSpanFromContextWithTracer(context.Background(), otTracer, "ot")
+	defer otSpan.Finish()
+	otSpan.SetBaggageItem("Ot-Key", "ot-value")
+
+	ctx, otelSpan := otelProvider.Tracer("").Start(ctx, "otel")
+	defer otelSpan.End()
+
+	if got := baggage.Value(ctx, "Ot-Key").AsString(); got != "ot-value" {
+		t.Errorf("OpenTelemetry baggage item %q = %q, want %q", "Ot-Key", got, "ot-value")
+	}
+}
+
+func TestBaggageItemsFromOTelWithoutHooks(t *testing.T) {
+	otTracer, otelProvider := NewTracerPair(internal.NewMockTracer())
+
+	ctx := baggage.ContextWithValues(context.Background(), attribute.String("Otel-Key", "otel-value"))
+	ctx, otelSpan := otelProvider.Tracer("").Start(ctx, "otel")
+	defer otelSpan.End()
+
+	otSpan := ot.SpanFromContext(ctx)
+	if otSpan == nil {
+		t.Fatal("no OpenTracing span in the context")
+	}
+	if got := otSpan.BaggageItem("Otel-Key"); got != "otel-value" {
+		t.Errorf("OpenTracing baggage item %q = %q, want %q", "Otel-Key", got, "otel-value")
+	}
+
+	child, _ := ot.StartSpanFromContextWithTracer(ctx, otTracer, "ot")
+	defer child.Finish()
+	if got := child.BaggageItem("Otel-Key"); got != "otel-value" {
+		t.Errorf("child OpenTracing baggage item %q = %q, want %q", "Otel-Key", got, "otel-value")
+	}
+
+	otTracer.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	header := http.Header{}
+	if err := otTracer.Inject(child.Context(), ot.HTTPHeaders, ot.HTTPHeadersCarrier(header)); err != nil {
+		t.Fatalf("Inject() = %v", err)
+	}
+	if got := header.Get("baggage"); got != "Otel-Key=otel-value" {
+		t.Errorf("injected baggage = %q, want %q", got, "Otel-Key=otel-value")
+	}
+}
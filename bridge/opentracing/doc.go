@@ -92,6 +92,16 @@
 // to have an access to the BridgeTracer instance. This should explain
 // the need for points 3. and 4.
 //
+// Baggage is shared between the two APIs at the same points. When an
+// OpenTracing span is inserted into the context with
+// opentracing.ContextWithSpan(), its baggage items are added to the
+// OpenTelemetry baggage of the context. When ContextWithBridgeSpan()
+// creates the OpenTracing span for an OpenTelemetry span, the
+// OpenTelemetry baggage of the context becomes the baggage of the
+// OpenTracing span context. Baggage items set with SetBaggageItem() on a
+// span that is already in a context are only visible to OpenTelemetry
+// through that context if it was set up with NewHookedContext().
+//
 // Another difference related to the Go context handling is in logging
 // - OpenTracing API does not take a context parameter in the
 // LogFields() function, so when the call to the function gets
@@ -32,8 +32,6 @@ replace go.opentelemetry.io/otel/exporters/metric/prometheus => ../../exporters/
 
 replace go.opentelemetry.io/otel/exporters/otlp => ../../exporters/otlp
 
-replace go.opentelemetry.io/otel/exporters/stdout => ../../exporters/stdout
-
 replace go.opentelemetry.io/otel/exporters/trace/jaeger => ../../exporters/trace/jaeger
 
 replace go.opentelemetry.io/otel/exporters/trace/zipkin => ../../exporters/trace/zipkin
@@ -57,3 +55,43 @@ replace go.opentelemetry.io/otel/example/passthrough => ../../example/passthroug
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace => ../../exporters/otlp/otlptrace
 
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc => ../../exporters/otlp/otlptrace/otlptracegrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs => ../../exporters/otlp/otlplogs
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc => ../../exporters/otlp/otlplogs/otlplogsgrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp => ../../exporters/otlp/otlplogs/otlplogshttp
+
+replace go.opentelemetry.io/otel/samplers/jaegerremote => ../../samplers/jaegerremote
+
+replace go.opentelemetry.io/otel/sdk/config => ../../sdk/config
+
+replace go.opentelemetry.io/otel/exporters/autoexport => ../../exporters/autoexport
+
+replace go.opentelemetry.io/otel/log => ../../log
+
+replace go.opentelemetry.io/otel/bridge/otelslog => ../otelslog
+
+replace go.opentelemetry.io/otel/propagators/aws => ../../propagators/aws
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdoutmetric => ../../exporters/stdout/stdoutmetric
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdouttrace => ../../exporters/stdout/stdouttrace
+
+replace go.opentelemetry.io/otel/exporters/file => ../../exporters/file
+
+replace go.opentelemetry.io/otel/schema => ../../schema
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric => ../../exporters/otlp/otlpmetric
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp => ../../exporters/otlp/otlpmetric/otlpmetrichttp
+
+replace go.opentelemetry.io/otel/zpages => ../../zpages
+
+replace go.opentelemetry.io/otel/instrumentation/runtime => ../../instrumentation/runtime
+
+replace go.opentelemetry.io/otel/instrumentation/host => ../../instrumentation/host
+
+replace go.opentelemetry.io/otel/instrumentation/net/http/otelhttp => ../../instrumentation/net/http/otelhttp
+
+replace go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc => ../../instrumentation/google.golang.org/grpc/otelgrpc
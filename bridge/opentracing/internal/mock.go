@@ -197,6 +197,7 @@ type MockSpan struct {
 	EndTime      time.Time
 	ParentSpanID trace.SpanID
 	Events       []MockEvent
+	Links        []trace.Link
 }
 
 var _ trace.Span = &MockSpan{}
@@ -277,6 +278,10 @@ func (s *MockSpan) AddEvent(name string, o ...trace.EventOption) {
 	})
 }
 
+func (s *MockSpan) AddLink(link trace.Link) {
+	s.Links = append(s.Links, link)
+}
+
 func (s *MockSpan) OverrideTracer(tracer trace.Tracer) {
 	s.officialTracer = tracer
 }
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelslog provides a log/slog Handler that bridges slog records to
+// the OpenTelemetry log signal.
+//
+// Records handled by the Handler are converted to log.Record and emitted
+// through a log.Logger, usually provided by the
+// go.opentelemetry.io/otel/sdk/logs LoggerProvider. The context passed to
+// the slog logging methods is passed along, so records are correlated with
+// the span active in that context:
+//
+//	logger := otelslog.NewLogger(loggerProvider, "github.com/example/app")
+//	logger.InfoContext(ctx, "order placed", "order.id", id)
+//
+// Slog levels are mapped to OpenTelemetry severities so that
+// slog.LevelDebug, slog.LevelInfo, slog.LevelWarn and slog.LevelError map
+// to log.SeverityDebug, log.SeverityInfo, log.SeverityWarn and
+// log.SeverityError respectively. Intermediate levels map to the finer
+// grained severities in between.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
@@ -0,0 +1,104 @@
+module go.opentelemetry.io/otel/bridge/otelslog
+
+go 1.21
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.20.0
+	go.opentelemetry.io/otel/log v0.20.0
+	go.opentelemetry.io/otel/trace v0.20.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
+
+replace go.opentelemetry.io/otel => ../..
+
+replace go.opentelemetry.io/otel/bridge/opencensus => ../opencensus
+
+replace go.opentelemetry.io/otel/bridge/opentracing => ../opentracing
+
+replace go.opentelemetry.io/otel/bridge/otelslog => ./
+
+replace go.opentelemetry.io/otel/example/jaeger => ../../example/jaeger
+
+replace go.opentelemetry.io/otel/example/namedtracer => ../../example/namedtracer
+
+replace go.opentelemetry.io/otel/example/opencensus => ../../example/opencensus
+
+replace go.opentelemetry.io/otel/example/otel-collector => ../../example/otel-collector
+
+replace go.opentelemetry.io/otel/example/passthrough => ../../example/passthrough
+
+replace go.opentelemetry.io/otel/example/prom-collector => ../../example/prom-collector
+
+replace go.opentelemetry.io/otel/example/prometheus => ../../example/prometheus
+
+replace go.opentelemetry.io/otel/example/zipkin => ../../example/zipkin
+
+replace go.opentelemetry.io/otel/exporters/autoexport => ../../exporters/autoexport
+
+replace go.opentelemetry.io/otel/exporters/metric/prometheus => ../../exporters/metric/prometheus
+
+replace go.opentelemetry.io/otel/exporters/otlp => ../../exporters/otlp
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs => ../../exporters/otlp/otlplogs
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc => ../../exporters/otlp/otlplogs/otlplogsgrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp => ../../exporters/otlp/otlplogs/otlplogshttp
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlptrace => ../../exporters/otlp/otlptrace
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc => ../../exporters/otlp/otlptrace/otlptracegrpc
+
+replace go.opentelemetry.io/otel/exporters/trace/jaeger => ../../exporters/trace/jaeger
+
+replace go.opentelemetry.io/otel/exporters/trace/zipkin => ../../exporters/trace/zipkin
+
+replace go.opentelemetry.io/otel/internal/tools => ../../internal/tools
+
+replace go.opentelemetry.io/otel/log => ../../log
+
+replace go.opentelemetry.io/otel/metric => ../../metric
+
+replace go.opentelemetry.io/otel/oteltest => ../../oteltest
+
+replace go.opentelemetry.io/otel/samplers/jaegerremote => ../../samplers/jaegerremote
+
+replace go.opentelemetry.io/otel/sdk => ../../sdk
+
+replace go.opentelemetry.io/otel/sdk/config => ../../sdk/config
+
+replace go.opentelemetry.io/otel/sdk/export/metric => ../../sdk/export/metric
+
+replace go.opentelemetry.io/otel/sdk/metric => ../../sdk/metric
+
+replace go.opentelemetry.io/otel/trace => ../../trace
+
+replace go.opentelemetry.io/otel/propagators/aws => ../../propagators/aws
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdoutmetric => ../../exporters/stdout/stdoutmetric
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdouttrace => ../../exporters/stdout/stdouttrace
+
+replace go.opentelemetry.io/otel/exporters/file => ../../exporters/file
+
+replace go.opentelemetry.io/otel/schema => ../../schema
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric => ../../exporters/otlp/otlpmetric
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp => ../../exporters/otlp/otlpmetric/otlpmetrichttp
+
+replace go.opentelemetry.io/otel/zpages => ../../zpages
+
+replace go.opentelemetry.io/otel/instrumentation/runtime => ../../instrumentation/runtime
+
+replace go.opentelemetry.io/otel/instrumentation/host => ../../instrumentation/host
+
+replace go.opentelemetry.io/otel/instrumentation/net/http/otelhttp => ../../instrumentation/net/http/otelhttp
+
+replace go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc => ../../instrumentation/google.golang.org/grpc/otelgrpc
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+type config struct {
+	version   string
+	schemaURL string
+}
+
+// Option configures a Handler.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(c config) config {
+	return fn(c)
+}
+
+// WithVersion sets the instrumentation version of the Logger used by the
+// Handler.
+func WithVersion(version string) Option {
+	return optionFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL sets the schema URL of the Logger used by the Handler.
+func WithSchemaURL(schemaURL string) Option {
+	return optionFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// Handler is a slog.Handler emitting slog records as OpenTelemetry log
+// records.
+type Handler struct {
+	logger log.Logger
+
+	// attrs are the attributes added with WithAttrs, already qualified by
+	// the groups open when they were added.
+	attrs []attribute.KeyValue
+	// prefix is the key prefix of the open groups.
+	prefix string
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+// NewHandler returns a Handler emitting records through the Logger named
+// name of provider.
+func NewHandler(provider log.LoggerProvider, name string, opts ...Option) *Handler {
+	var c config
+	for _, opt := range opts {
+		c = opt.apply(c)
+	}
+	logger := provider.Logger(
+		name,
+		log.WithInstrumentationVersion(c.version),
+		log.WithSchemaURL(c.schemaURL),
+	)
+	return &Handler{logger: logger}
+}
+
+// NewLogger returns a slog.Logger using a Handler created with NewHandler.
+func NewLogger(provider log.LoggerProvider, name string, opts ...Option) *slog.Logger {
+	return slog.New(NewHandler(provider, name, opts...))
+}
+
+// Enabled reports whether the Logger emits records of the severity level
+// maps to.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.logger.Enabled(ctx, severity(level))
+}
+
+// Handle converts r to a log record and emits it with ctx.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]attribute.KeyValue, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendAttr(attrs, h.prefix, a)
+		return true
+	})
+
+	h.logger.Emit(ctx, log.Record{
+		Timestamp:    r.Time,
+		Severity:     severity(r.Level),
+		SeverityText: r.Level.String(),
+		Body:         attribute.StringValue(r.Message),
+		Attributes:   attrs,
+	})
+	return nil
+}
+
+// WithAttrs returns a Handler adding attrs to all the records it handles.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	h2 := *h
+	h2.attrs = make([]attribute.KeyValue, len(h.attrs), len(h.attrs)+len(attrs))
+	copy(h2.attrs, h.attrs)
+	for _, a := range attrs {
+		h2.attrs = appendAttr(h2.attrs, h.prefix, a)
+	}
+	return &h2
+}
+
+// WithGroup returns a Handler qualifying the keys of all subsequently
+// added attributes with name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+// severity returns the OpenTelemetry severity of level. Each slog level
+// step maps to one severity step, slog.LevelInfo mapping to SeverityInfo.
+func severity(level slog.Level) log.Severity {
+	s := int(log.SeverityInfo) + int(level)
+	switch {
+	case s < int(log.SeverityTrace):
+		return log.SeverityTrace
+	case s > int(log.SeverityFatal4):
+		return log.SeverityFatal4
+	}
+	return log.Severity(s)
+}
+
+// appendAttr appends the attributes a converts to, qualified by prefix.
+// Groups are flattened, their name qualifying the keys of their members.
+func appendAttr(attrs []attribute.KeyValue, prefix string, a slog.Attr) []attribute.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return attrs
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		group := a.Value.Group()
+		if len(group) == 0 {
+			return attrs
+		}
+		// Groups with an empty key are inlined.
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range group {
+			attrs = appendAttr(attrs, prefix, ga)
+		}
+		return attrs
+	}
+
+	return append(attrs, keyValue(prefix+a.Key, a.Value))
+}
+
+// keyValue converts a resolved, non group, slog value.
+func keyValue(key string, v slog.Value) attribute.KeyValue {
+	switch v.Kind() {
+	case slog.KindString:
+		return attribute.String(key, v.String())
+	case slog.KindInt64:
+		return attribute.Int64(key, v.Int64())
+	case slog.KindUint64:
+		if u := v.Uint64(); u <= math.MaxInt64 {
+			return attribute.Int64(key, int64(u))
+		}
+		return attribute.String(key, v.String())
+	case slog.KindFloat64:
+		return attribute.Float64(key, v.Float64())
+	case slog.KindBool:
+		return attribute.Bool(key, v.Bool())
+	case slog.KindDuration:
+		return attribute.Int64(key, v.Duration().Nanoseconds())
+	case slog.KindTime:
+		return attribute.Int64(key, v.Time().UnixNano())
+	}
+
+	switch val := v.Any().(type) {
+	case error:
+		return attribute.String(key, val.Error())
+	case fmt.Stringer:
+		return attribute.String(key, val.String())
+	case []string:
+		return attribute.Array(key, val)
+	}
+	return attribute.String(key, fmt.Sprint(v.Any()))
+}
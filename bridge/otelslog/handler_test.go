@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelslog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type emitted struct {
+	ctx    context.Context
+	record log.Record
+}
+
+type recorder struct {
+	name    string
+	config  *log.LoggerConfig
+	minimum log.Severity
+	records []emitted
+}
+
+func (r *recorder) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	r.name = name
+	r.config = log.NewLoggerConfig(opts...)
+	return r
+}
+
+func (r *recorder) Emit(ctx context.Context, record log.Record) {
+	r.records = append(r.records, emitted{ctx: ctx, record: record})
+}
+
+func (r *recorder) Enabled(_ context.Context, severity log.Severity) bool {
+	return severity >= r.minimum
+}
+
+func TestNewHandler(t *testing.T) {
+	r := &recorder{}
+	NewHandler(r, "test", WithVersion("v1"), WithSchemaURL("https://example.com/schema"))
+	assert.Equal(t, "test", r.name)
+	assert.Equal(t, "v1", r.config.InstrumentationVersion())
+	assert.Equal(t, "https://example.com/schema", r.config.SchemaURL())
+}
+
+func TestHandlerHandle(t *testing.T) {
+	r := &recorder{}
+	logger := NewLogger(r, "test")
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	logger.WarnContext(ctx, "hello", "count", 3, "ok", true)
+
+	require.Len(t, r.records, 1)
+	got := r.records[0]
+	assert.Equal(t, sc, trace.SpanContextFromContext(got.ctx))
+	assert.False(t, got.record.Timestamp.IsZero())
+	assert.Equal(t, log.SeverityWarn, got.record.Severity)
+	assert.Equal(t, "WARN", got.record.SeverityText)
+	assert.Equal(t, attribute.StringValue("hello"), got.record.Body)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int64("count", 3),
+		attribute.Bool("ok", true),
+	}, got.record.Attributes)
+}
+
+func TestHandlerEnabled(t *testing.T) {
+	r := &recorder{minimum: log.SeverityWarn}
+	logger := NewLogger(r, "test")
+
+	assert.False(t, logger.Enabled(context.Background(), slog.LevelInfo))
+	assert.True(t, logger.Enabled(context.Background(), slog.LevelWarn))
+
+	logger.Info("dropped")
+	logger.Error("kept")
+	require.Len(t, r.records, 1)
+	assert.Equal(t, attribute.StringValue("kept"), r.records[0].record.Body)
+}
+
+func TestHandlerAttrsAndGroups(t *testing.T) {
+	r := &recorder{}
+	logger := NewLogger(r, "test").
+		With("service", "checkout").
+		WithGroup("http").
+		With("method", "GET")
+
+	logger.Info("request",
+		"status", 200,
+		slog.Group("client", "ip", "10.0.0.1"),
+		slog.Group("", "inlined", true),
+		slog.Group("empty"),
+	)
+
+	require.Len(t, r.records, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("service", "checkout"),
+		attribute.String("http.method", "GET"),
+		attribute.Int64("http.status", 200),
+		attribute.String("http.client.ip", "10.0.0.1"),
+		attribute.Bool("http.inlined", true),
+	}, r.records[0].record.Attributes)
+}
+
+type valuer struct{}
+
+func (valuer) LogValue() slog.Value { return slog.StringValue("resolved") }
+
+func TestKeyValue(t *testing.T) {
+	now := time.Unix(0, 42)
+	for _, tc := range []struct {
+		value slog.Value
+		want  attribute.Value
+	}{
+		{slog.StringValue("s"), attribute.StringValue("s")},
+		{slog.Int64Value(-1), attribute.Int64Value(-1)},
+		{slog.Uint64Value(1), attribute.Int64Value(1)},
+		{slog.Uint64Value(1 << 63), attribute.StringValue("9223372036854775808")},
+		{slog.Float64Value(1.5), attribute.Float64Value(1.5)},
+		{slog.BoolValue(true), attribute.BoolValue(true)},
+		{slog.DurationValue(time.Second), attribute.Int64Value(int64(time.Second))},
+		{slog.TimeValue(now), attribute.Int64Value(42)},
+		{slog.AnyValue(errors.New("boom")), attribute.StringValue("boom")},
+		{slog.AnyValue([]string{"a", "b"}), attribute.ArrayValue([]string{"a", "b"})},
+		{slog.AnyValue(struct{ A int }{1}), attribute.StringValue("{1}")},
+		{slog.AnyValue(valuer{}).Resolve(), attribute.StringValue("resolved")},
+	} {
+		assert.Equal(t, tc.want, keyValue("k", tc.value).Value, tc.value.String())
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	assert.Equal(t, log.SeverityTrace, severity(slog.LevelDebug-10))
+	assert.Equal(t, log.SeverityDebug, severity(slog.LevelDebug))
+	assert.Equal(t, log.SeverityInfo, severity(slog.LevelInfo))
+	assert.Equal(t, log.SeverityInfo2, severity(slog.LevelInfo+1))
+	assert.Equal(t, log.SeverityWarn, severity(slog.LevelWarn))
+	assert.Equal(t, log.SeverityError, severity(slog.LevelError))
+	assert.Equal(t, log.SeverityFatal4, severity(slog.LevelError+100))
+}
@@ -20,3 +20,43 @@ type ErrorHandler interface {
 	// component.
 	Handle(error)
 }
+
+// Severity classifies how serious an error passed to a ClassifiedErrorHandler
+// is.
+type Severity int
+
+const (
+	// SeverityError is used for errors that result in the loss of
+	// telemetry or a misbehaving component. It is the severity of all
+	// errors passed to Handle.
+	SeverityError Severity = iota
+	// SeverityWarning is used for errors that a component recovered from,
+	// but that may lead to a loss of telemetry if they persist.
+	SeverityWarning
+)
+
+// String returns the lowercase name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifiedErrorHandler is an ErrorHandler that also receives the
+// component reporting an error and the severity of the error.
+//
+// An ErrorHandler registered with SetErrorHandler that does not implement
+// this interface receives only the error of a classified error.
+type ClassifiedErrorHandler interface {
+	ErrorHandler
+
+	// HandleClassified handles err reported by the OpenTelemetry component
+	// named component with severity sev. The component is conventionally
+	// the import path of the reporting package.
+	HandleClassified(component string, sev Severity, err error)
+}
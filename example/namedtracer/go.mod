@@ -4,13 +4,12 @@ go 1.15
 
 replace (
 	go.opentelemetry.io/otel => ../..
-	go.opentelemetry.io/otel/exporters/stdout => ../../exporters/stdout
 	go.opentelemetry.io/otel/sdk => ../../sdk
 )
 
 require (
 	go.opentelemetry.io/otel v0.20.0
-	go.opentelemetry.io/otel/exporters/stdout v0.20.0
+	go.opentelemetry.io/otel/exporters/stdout/stdouttrace v0.20.0
 	go.opentelemetry.io/otel/sdk v0.20.0
 	go.opentelemetry.io/otel/trace v0.20.0
 )
@@ -58,3 +57,43 @@ replace go.opentelemetry.io/otel/example/passthrough => ../passthrough
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace => ../../exporters/otlp/otlptrace
 
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc => ../../exporters/otlp/otlptrace/otlptracegrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs => ../../exporters/otlp/otlplogs
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc => ../../exporters/otlp/otlplogs/otlplogsgrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp => ../../exporters/otlp/otlplogs/otlplogshttp
+
+replace go.opentelemetry.io/otel/samplers/jaegerremote => ../../samplers/jaegerremote
+
+replace go.opentelemetry.io/otel/sdk/config => ../../sdk/config
+
+replace go.opentelemetry.io/otel/exporters/autoexport => ../../exporters/autoexport
+
+replace go.opentelemetry.io/otel/log => ../../log
+
+replace go.opentelemetry.io/otel/bridge/otelslog => ../../bridge/otelslog
+
+replace go.opentelemetry.io/otel/propagators/aws => ../../propagators/aws
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdoutmetric => ../../exporters/stdout/stdoutmetric
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdouttrace => ../../exporters/stdout/stdouttrace
+
+replace go.opentelemetry.io/otel/exporters/file => ../../exporters/file
+
+replace go.opentelemetry.io/otel/schema => ../../schema
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric => ../../exporters/otlp/otlpmetric
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp => ../../exporters/otlp/otlpmetric/otlpmetrichttp
+
+replace go.opentelemetry.io/otel/zpages => ../../zpages
+
+replace go.opentelemetry.io/otel/instrumentation/runtime => ../../instrumentation/runtime
+
+replace go.opentelemetry.io/otel/instrumentation/host => ../../instrumentation/host
+
+replace go.opentelemetry.io/otel/instrumentation/net/http/otelhttp => ../../instrumentation/net/http/otelhttp
+
+replace go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc => ../../instrumentation/google.golang.org/grpc/otelgrpc
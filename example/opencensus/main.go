@@ -31,7 +31,8 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/bridge/opencensus"
-	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	otmetricexport "go.opentelemetry.io/otel/sdk/export/metric"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -51,13 +52,17 @@ var (
 )
 
 func main() {
-	log.Println("Using OpenTelemetry stdout exporter.")
-	otExporter, err := stdout.NewExporter(stdout.WithPrettyPrint())
+	log.Println("Using OpenTelemetry stdout exporters.")
+	traceExporter, err := stdouttrace.NewExporter(stdouttrace.WithPrettyPrint())
 	if err != nil {
 		log.Fatal(err)
 	}
-	tracing(otExporter)
-	monitoring(otExporter)
+	metricExporter, err := stdoutmetric.NewExporter(stdoutmetric.WithPrettyPrint())
+	if err != nil {
+		log.Fatal(err)
+	}
+	tracing(traceExporter)
+	monitoring(metricExporter)
 }
 
 // tracing demonstrates overriding the OpenCensus DefaultTracer to send spans
@@ -92,7 +97,7 @@ func tracing(otExporter sdktrace.SpanExporter) {
 // exporter to send metrics to the exporter by using either an OpenCensus
 // registry or an OpenCensus view.
 func monitoring(otExporter otmetricexport.Exporter) {
-	log.Println("Using the OpenTelemetry stdout exporter to export OpenCensus metrics.  This allows routing telemetry from both OpenTelemetry and OpenCensus to a single exporter.")
+	log.Println("Using the OpenTelemetry stdout exporter to export OpenCensus metrics.  This allows routing telemetry from both OpenTelemetry and OpenCensus to the same exporter.")
 	ocExporter := opencensus.NewMetricExporter(otExporter)
 	intervalReader, err := metricexport.NewIntervalReader(&metricexport.Reader{}, ocExporter)
 	if err != nil {
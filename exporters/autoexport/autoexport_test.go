@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+)
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	for k, v := range env {
+		require.NoError(t, os.Setenv(k, v))
+	}
+	return func() {
+		for k := range env {
+			require.NoError(t, os.Unsetenv(k))
+		}
+	}
+}
+
+func TestNewSpanExporter(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name    string
+		env     string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "default", want: &otlp.Exporter{}},
+		{name: "otlp", env: "otlp", want: &otlp.Exporter{}},
+		{name: "console", env: "console", want: &stdouttrace.Exporter{}},
+		{name: "stdout", env: " STDOUT ", want: &stdouttrace.Exporter{}},
+		{name: "none", env: "none", want: noopSpanExporter{}},
+		{name: "unknown", env: "zipkin", wantErr: true},
+		{name: "multiple", env: "otlp,console", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{TracesExporterEnvKey: tc.env})()
+
+			exp, err := NewSpanExporter(ctx)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, exp)
+			assert.Equal(t, tc.env == "none", IsNoneSpanExporter(exp))
+			assert.NoError(t, exp.Shutdown(ctx))
+		})
+	}
+}
+
+func TestNewMetricReader(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name    string
+		env     string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "default", want: &otlp.Exporter{}},
+		{name: "console", env: "console", want: &stdoutmetric.Exporter{}},
+		{name: "none", env: "none"},
+		{name: "unknown", env: "prometheus", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{MetricsExporterEnvKey: tc.env})()
+
+			r, err := NewMetricReader(ctx)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.want == nil {
+				assert.Nil(t, r.exporter)
+			} else {
+				assert.IsType(t, tc.want, r.exporter)
+			}
+			assert.NotNil(t, r.MeterProvider())
+			assert.True(t, r.IsRunning())
+			assert.NoError(t, r.Shutdown(ctx))
+			assert.False(t, r.IsRunning())
+		})
+	}
+}
+
+func TestOTLPDriver(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		env     map[string]string
+		want    otlp.ProtocolDriver
+		wantErr bool
+	}{
+		{
+			name: "default",
+			want: otlphttp.NewDriver(),
+		},
+		{
+			name: "generic",
+			env:  map[string]string{protocolEnvKey: "grpc"},
+			want: otlpgrpc.NewDriver(),
+		},
+		{
+			name: "signal overrides generic",
+			env: map[string]string{
+				protocolEnvKey:                       "grpc",
+				"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL": "http/json",
+			},
+			want: otlphttp.NewDriver(),
+		},
+		{
+			name:    "unsupported",
+			env:     map[string]string{protocolEnvKey: "http/xml"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer setEnv(t, tc.env)()
+
+			driver, err := otlpDriver("TRACES")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, driver)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autoexport provides exporters selected by environment variables,
+// so libraries and launchers do not need to hard-code a specific exporter.
+//
+// NewSpanExporter selects a span exporter according to the
+// OTEL_TRACES_EXPORTER environment variable, and NewMetricReader builds a
+// push controller exporting to the metric exporter selected by the
+// OTEL_METRICS_EXPORTER environment variable. The supported values are:
+//
+//   - "otlp" (the default): OTLP exporter. The transport protocol is read
+//     from OTEL_EXPORTER_OTLP_TRACES_PROTOCOL or
+//     OTEL_EXPORTER_OTLP_METRICS_PROTOCOL, falling back to
+//     OTEL_EXPORTER_OTLP_PROTOCOL. It is one of "http/protobuf" (the
+//     default), "http/json" or "grpc". The remaining OTEL_EXPORTER_OTLP_*
+//     environment variables are honored by the exporter itself.
+//   - "console": exporter writing to stdout. "stdout" is accepted as an
+//     alias.
+//   - "none": exporter dropping all telemetry.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package autoexport // import "go.opentelemetry.io/otel/exporters/autoexport"
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport // import "go.opentelemetry.io/otel/exporters/autoexport"
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+)
+
+const (
+	// TracesExporterEnvKey is the environment variable selecting the span
+	// exporter.
+	TracesExporterEnvKey = "OTEL_TRACES_EXPORTER"
+	// MetricsExporterEnvKey is the environment variable selecting the
+	// metric exporter.
+	MetricsExporterEnvKey = "OTEL_METRICS_EXPORTER"
+
+	protocolEnvKey = "OTEL_EXPORTER_OTLP_PROTOCOL"
+
+	exporterOTLP    = "otlp"
+	exporterConsole = "console"
+	exporterStdout  = "stdout"
+	exporterNone    = "none"
+
+	protocolGRPC         = "grpc"
+	protocolHTTPProtobuf = "http/protobuf"
+	protocolHTTPJSON     = "http/json"
+)
+
+// exporterName returns the exporter selected by the environment variable
+// key, defaulting to OTLP.
+func exporterName(key string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(os.Getenv(key)))
+	if name == "" {
+		return exporterOTLP, nil
+	}
+	if strings.Contains(name, ",") {
+		return "", fmt.Errorf("autoexport: %s: multiple exporters are not supported: %q", key, name)
+	}
+	return name, nil
+}
+
+// otlpDriver returns the OTLP driver for the protocol configured for
+// signal, which is either "TRACES" or "METRICS".
+func otlpDriver(signal string) (otlp.ProtocolDriver, error) {
+	protocol := os.Getenv(fmt.Sprintf("OTEL_EXPORTER_OTLP_%s_PROTOCOL", signal))
+	if protocol == "" {
+		protocol = os.Getenv(protocolEnvKey)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(protocol)) {
+	case protocolGRPC:
+		return otlpgrpc.NewDriver(), nil
+	case "", protocolHTTPProtobuf:
+		return otlphttp.NewDriver(otlphttp.WithMarshal(otlp.MarshalProto)), nil
+	case protocolHTTPJSON:
+		return otlphttp.NewDriver(otlphttp.WithMarshal(otlp.MarshalJSON)), nil
+	}
+	return nil, fmt.Errorf("autoexport: unsupported OTLP protocol %q", protocol)
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport // import "go.opentelemetry.io/otel/exporters/autoexport"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
+
+// metricExporter is a metric exporter that holds resources released on
+// shutdown.
+type metricExporter interface {
+	export.Exporter
+	Shutdown(context.Context) error
+}
+
+// MetricReader is a started push controller exporting to the metric
+// exporter selected from the environment.
+type MetricReader struct {
+	*controller.Controller
+
+	exporter metricExporter
+}
+
+// NewMetricReader returns a started MetricReader exporting to the metric
+// exporter selected by the OTEL_METRICS_EXPORTER environment variable. An
+// OTLP exporter is used if the variable is not set. When the variable is
+// "none", the returned reader collects metrics but never exports them.
+//
+// The passed options configure the underlying controller. It is the
+// responsibility of the caller to call
+// Shutdown on the returned reader.
+func NewMetricReader(ctx context.Context, opts ...controller.Option) (*MetricReader, error) {
+	exp, err := newMetricExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var kinds export.ExportKindSelector = export.CumulativeExportKindSelector()
+	if exp != nil {
+		kinds = exp
+		opts = append(opts, controller.WithExporter(exp))
+	}
+
+	r := &MetricReader{
+		Controller: controller.New(
+			processor.New(simple.NewWithInexpensiveDistribution(), kinds),
+			opts...,
+		),
+		exporter: exp,
+	}
+	if err := r.Start(ctx); err != nil {
+		_ = r.shutdownExporter(ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+// newMetricExporter returns the selected metric exporter, or nil if metric
+// export is disabled.
+func newMetricExporter(ctx context.Context) (metricExporter, error) {
+	name, err := exporterName(MetricsExporterEnvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case exporterOTLP:
+		driver, err := otlpDriver("METRICS")
+		if err != nil {
+			return nil, err
+		}
+		return otlp.NewExporter(ctx, driver)
+	case exporterConsole, exporterStdout:
+		return stdoutmetric.NewExporter()
+	case exporterNone:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("autoexport: unsupported %s value %q", MetricsExporterEnvKey, name)
+}
+
+// Shutdown stops the controller, exporting metrics a final time, and
+// then shuts down the exporter.
+func (r *MetricReader) Shutdown(ctx context.Context) error {
+	if err := r.Stop(ctx); err != nil {
+		_ = r.shutdownExporter(ctx)
+		return err
+	}
+	return r.shutdownExporter(ctx)
+}
+
+func (r *MetricReader) shutdownExporter(ctx context.Context) error {
+	if r.exporter == nil {
+		return nil
+	}
+	return r.exporter.Shutdown(ctx)
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport // import "go.opentelemetry.io/otel/exporters/autoexport"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// NewSpanExporter returns the span exporter selected by the
+// OTEL_TRACES_EXPORTER environment variable. An OTLP exporter is returned
+// if the variable is not set.
+//
+// It is the responsibility of the caller to shut down the returned
+// exporter, usually by registering it with a TracerProvider.
+func NewSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	name, err := exporterName(TracesExporterEnvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case exporterOTLP:
+		driver, err := otlpDriver("TRACES")
+		if err != nil {
+			return nil, err
+		}
+		return otlp.NewExporter(ctx, driver)
+	case exporterConsole, exporterStdout:
+		return stdouttrace.NewExporter()
+	case exporterNone:
+		return noopSpanExporter{}, nil
+	}
+	return nil, fmt.Errorf("autoexport: unsupported %s value %q", TracesExporterEnvKey, name)
+}
+
+// IsNoneSpanExporter returns whether e is the exporter returned by
+// NewSpanExporter when OTEL_TRACES_EXPORTER is "none". Callers can use it to
+// avoid registering a span processor that would only drop spans.
+func IsNoneSpanExporter(e sdktrace.SpanExporter) bool {
+	_, ok := e.(noopSpanExporter)
+	return ok
+}
+
+// noopSpanExporter drops all spans.
+type noopSpanExporter struct{}
+
+var _ sdktrace.SpanExporter = noopSpanExporter{}
+
+// ExportSpans drops spans.
+func (noopSpanExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error { return nil }
+
+// Shutdown does nothing.
+func (noopSpanExporter) Shutdown(context.Context) error { return nil }
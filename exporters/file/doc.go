@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package file provides exporters writing spans, metrics and log records to
+files in the OTLP/JSON encoding, for environments where telemetry cannot be
+sent over the network and a collector reads the files instead, for example a
+sidecar tailing them.
+
+Each line of a file is a complete JSON encoded OTLP export request:
+ExportTraceServiceRequest, ExportMetricsServiceRequest or
+ExportLogsServiceRequest. Enumerations are encoded as integers and trace and
+span IDs as hexadecimal strings, as required by the OTLP/JSON encoding.
+
+Spans and log records are written by clients used with the OTLP exporters,
+metrics by a MetricExporter:
+
+	traceExporter, err := otlptrace.NewExporter(ctx,
+		file.NewTracesClient("/var/log/myapp/traces.jsonl", file.WithCompression()),
+	)
+	logExporter, err := otlplogs.NewExporter(ctx,
+		file.NewLogsClient("/var/log/myapp/logs.jsonl"),
+	)
+	metricExporter, err := file.NewMetricExporter("/var/log/myapp/metrics.jsonl")
+
+Files are rotated once they reach a maximum size, 100 MiB by default, or
+optionally a maximum age. A rotated file is renamed with the time of its
+rotation inserted before its extension, for example
+traces-20210601T120000.000000000.jsonl, and a new file is created in its
+place. Rotated files can be compressed with gzip and the oldest ones
+removed; this happens in the background and errors are reported to the
+global error handler.
+
+Exponential histograms are not written, as the OTLP/JSON encoding of the
+version of the OTLP protocol in use does not include them.
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+*/
+package file // import "go.opentelemetry.io/otel/exporters/file"
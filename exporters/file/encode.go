@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file // import "go.opentelemetry.io/otel/exporters/file"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// marshalOptions encodes enumerations as integers, as required by the
+// OTLP/JSON encoding.
+var marshalOptions = protojson.MarshalOptions{UseEnumNumbers: true}
+
+// marshalLine returns the OTLP/JSON encoding of m terminated by a newline.
+// Trace and span IDs are encoded as hexadecimal strings instead of the
+// base64 encoding protojson uses for bytes fields, and the output is
+// compact and stable.
+func marshalLine(m proto.Message) ([]byte, error) {
+	b, err := marshalOptions.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	hexIDs(v)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// hexIDs re-encodes all trace and span IDs found in v as hexadecimal
+// strings.
+func hexIDs(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			switch k {
+			case "traceId", "spanId", "parentSpanId":
+				s, ok := e.(string)
+				if !ok {
+					continue
+				}
+				if id, err := base64.StdEncoding.DecodeString(s); err == nil {
+					v[k] = hex.EncodeToString(id)
+				}
+			default:
+				hexIDs(e)
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			hexIDs(e)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/file"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	sdklogs "go.opentelemetry.io/otel/sdk/logs"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func tempPath(t *testing.T, name string) string {
+	dir, err := ioutil.TempDir("", "file-exporter")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return filepath.Join(dir, name)
+}
+
+// readLines returns the JSON objects of each line of the file at path.
+func readLines(t *testing.T, path string) []map[string]interface{} {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(s.Bytes(), &line), s.Text())
+		lines = append(lines, line)
+	}
+	require.NoError(t, s.Err())
+	return lines
+}
+
+func TestTracesClient(t *testing.T) {
+	ctx := context.Background()
+	path := tempPath(t, "traces.jsonl")
+	exp, err := otlptrace.NewExporter(ctx, file.NewTracesClient(path))
+	require.NoError(t, err)
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	start := time.Unix(1600000000, 0)
+	spans := tracetest.SpanStubs{
+		{
+			Name: "<span>",
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+			}),
+			SpanKind:   trace.SpanKindServer,
+			StartTime:  start,
+			EndTime:    start.Add(time.Second),
+			Attributes: []attribute.KeyValue{attribute.Int64("count", 1)},
+			Resource:   resource.NewWithAttributes(attribute.String("service.name", "test")),
+		},
+	}.Snapshots()
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 2)
+	rs := lines[0]["resourceSpans"].([]interface{})[0].(map[string]interface{})
+	ils := rs["instrumentationLibrarySpans"].([]interface{})[0].(map[string]interface{})
+	span := ils["spans"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", span["traceId"])
+	assert.Equal(t, "0102030405060708", span["spanId"])
+	assert.Equal(t, "<span>", span["name"])
+	assert.Equal(t, float64(trace.SpanKindServer), span["kind"])
+	assert.Equal(t, "1600000000000000000", span["startTimeUnixNano"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"key":   "count",
+		"value": map[string]interface{}{"intValue": "1"},
+	}}, span["attributes"])
+
+	// A client that is not started does not write.
+	assert.Error(t, file.NewTracesClient(path).UploadTraces(ctx, nil))
+}
+
+func TestLogsClient(t *testing.T) {
+	ctx := context.Background()
+	path := tempPath(t, "logs.jsonl")
+	exp, err := otlplogs.NewExporter(ctx, file.NewLogsClient(path))
+	require.NoError(t, err)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	require.NoError(t, exp.ExportLogs(ctx, []sdklogs.Record{
+		{Body: attribute.StringValue("hello"), SpanContext: spanContext},
+	}))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 1)
+	rl := lines[0]["resourceLogs"].([]interface{})[0].(map[string]interface{})
+	ill := rl["instrumentationLibraryLogs"].([]interface{})[0].(map[string]interface{})
+	log := ill["logs"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"stringValue": "hello"}, log["body"])
+	assert.Equal(t, "01000000000000000000000000000000", log["traceId"])
+	assert.Equal(t, "0200000000000000", log["spanId"])
+}
+
+func TestMetricExporter(t *testing.T) {
+	ctx := context.Background()
+	path := tempPath(t, "metrics.jsonl")
+	exp, err := file.NewMetricExporter(path)
+	require.NoError(t, err)
+
+	cps := metrictest.NewCheckpointSet(resource.NewWithAttributes(attribute.String("R", "V")))
+
+	counter := metric.NewDescriptor("counter", metric.CounterInstrumentKind, number.Int64Kind)
+	agg, ckpt := metrictest.Unslice2(sum.New(2))
+	aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(42), &counter)
+	require.NoError(t, agg.SynchronizedMove(ckpt, &counter))
+	cps.Add(&counter, ckpt)
+
+	// Exponential histograms are not part of the OTLP/JSON encoding.
+	recorder := metric.NewDescriptor("recorder", metric.ValueRecorderInstrumentKind, number.Float64Kind)
+	eagg, eckpt := metrictest.Unslice2(exponential.New(2, &recorder))
+	aggregatortest.CheckedUpdate(t, eagg, number.NewFloat64Number(1), &recorder)
+	require.NoError(t, eagg.SynchronizedMove(eckpt, &recorder))
+	cps.Add(&recorder, eckpt)
+
+	require.NoError(t, exp.Export(ctx, cps))
+	require.NoError(t, exp.Shutdown(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Error(t, exp.Export(ctx, cps))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 1)
+	rm := lines[0]["resourceMetrics"].([]interface{})[0].(map[string]interface{})
+	ilm := rm["instrumentationLibraryMetrics"].([]interface{})[0].(map[string]interface{})
+	metrics := ilm["metrics"].([]interface{})
+	require.Len(t, metrics, 1)
+	m := metrics[0].(map[string]interface{})
+	assert.Equal(t, "counter", m["name"])
+	s := m["sum"].(map[string]interface{})
+	assert.Equal(t, true, s["isMonotonic"])
+	// AGGREGATION_TEMPORALITY_CUMULATIVE
+	assert.Equal(t, float64(2), s["aggregationTemporality"])
+	dp := s["dataPoints"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "42", dp["asInt"])
+}
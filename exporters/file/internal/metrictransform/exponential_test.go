@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictransform
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+	"go.opentelemetry.io/otel/sdk/resource"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+type decodedBuckets struct {
+	offset int32
+	counts []uint64
+}
+
+type decodedPoint struct {
+	attributes []*commonpb.KeyValue
+	start, end uint64
+	count      uint64
+	sum        float64
+	scale      int32
+	zeroCount  uint64
+	positive   decodedBuckets
+	negative   decodedBuckets
+}
+
+type decodedHistogram struct {
+	points      []decodedPoint
+	temporality metricpb.AggregationTemporality
+}
+
+// consumeFields calls f with the number, type, and value of each field in
+// b. Bytes fields are passed their contents, all others the raw value.
+func consumeFields(t *testing.T, b []byte, f func(protowire.Number, protowire.Type, []byte)) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		if typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			require.GreaterOrEqual(t, n, 0)
+			f(num, typ, v)
+			b = b[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		require.GreaterOrEqual(t, n, 0)
+		f(num, typ, b[:n])
+		b = b[n:]
+	}
+}
+
+func varint(t *testing.T, b []byte) uint64 {
+	v, n := protowire.ConsumeVarint(b)
+	require.GreaterOrEqual(t, n, 0)
+	return v
+}
+
+func fixed64(t *testing.T, b []byte) uint64 {
+	v, n := protowire.ConsumeFixed64(b)
+	require.GreaterOrEqual(t, n, 0)
+	return v
+}
+
+func decodeBuckets(t *testing.T, b []byte) (db decodedBuckets) {
+	consumeFields(t, b, func(num protowire.Number, _ protowire.Type, v []byte) {
+		switch num {
+		case bucketsOffsetField:
+			db.offset = int32(protowire.DecodeZigZag(varint(t, v)))
+		case bucketsCountsField:
+			for len(v) > 0 {
+				c, n := protowire.ConsumeVarint(v)
+				require.GreaterOrEqual(t, n, 0)
+				db.counts = append(db.counts, c)
+				v = v[n:]
+			}
+		}
+	})
+	return db
+}
+
+func decodeExponentialHistogram(t *testing.T, m *metricpb.Metric) (h decodedHistogram) {
+	consumeFields(t, m.ProtoReflect().GetUnknown(), func(num protowire.Number, _ protowire.Type, v []byte) {
+		require.Equal(t, metricExponentialHistogramField, num)
+		consumeFields(t, v, func(num protowire.Number, _ protowire.Type, v []byte) {
+			switch num {
+			case expHistTemporalityField:
+				h.temporality = metricpb.AggregationTemporality(varint(t, v))
+			case expHistDataPointsField:
+				var p decodedPoint
+				consumeFields(t, v, func(num protowire.Number, _ protowire.Type, v []byte) {
+					switch num {
+					case expPointAttributesField:
+						kv := &commonpb.KeyValue{}
+						require.NoError(t, proto.Unmarshal(v, kv))
+						p.attributes = append(p.attributes, kv)
+					case expPointStartTimeField:
+						p.start = fixed64(t, v)
+					case expPointTimeField:
+						p.end = fixed64(t, v)
+					case expPointCountField:
+						p.count = fixed64(t, v)
+					case expPointSumField:
+						p.sum = math.Float64frombits(fixed64(t, v))
+					case expPointScaleField:
+						p.scale = int32(protowire.DecodeZigZag(varint(t, v)))
+					case expPointZeroCountField:
+						p.zeroCount = fixed64(t, v)
+					case expPointPositiveField:
+						p.positive = decodeBuckets(t, v)
+					case expPointNegativeField:
+						p.negative = decodeBuckets(t, v)
+					}
+				})
+				h.points = append(h.points, p)
+			}
+		})
+	})
+	return h
+}
+
+func exponentialRecord(t *testing.T, labels *attribute.Set, values ...float64) export.Record {
+	desc := metric.NewDescriptor("latency", metric.ValueRecorderInstrumentKind, number.Float64Kind)
+	aggs := exponential.New(2, &desc, exponential.WithMaxScale(0))
+	for _, v := range values {
+		require.NoError(t, aggs[0].Update(context.Background(), number.NewFloat64Number(v), &desc))
+	}
+	require.NoError(t, aggs[0].SynchronizedMove(&aggs[1], &desc))
+	return export.NewRecord(&desc, labels, resource.Empty(), aggs[1].Aggregation(), intervalStart, intervalEnd)
+}
+
+func TestExponentialHistogramPoint(t *testing.T) {
+	labels := attribute.NewSet(attribute.String("one", "1"))
+	record := exponentialRecord(t, &labels, 0, 1, 2, 4, -3)
+
+	m, err := Record(export.CumulativeExportKindSelector(), record)
+	require.NoError(t, err)
+	assert.Equal(t, "latency", m.GetName())
+	assert.Nil(t, m.Data)
+
+	h := decodeExponentialHistogram(t, m)
+	assert.Equal(t, otelCumulative, h.temporality)
+	require.Len(t, h.points, 1)
+	p := h.points[0]
+	require.Len(t, p.attributes, 1)
+	assert.Equal(t, "one", p.attributes[0].GetKey())
+	assert.Equal(t, "1", p.attributes[0].GetValue().GetStringValue())
+	p.attributes = nil
+	assert.Equal(t, decodedPoint{
+		start:     uint64(intervalStart.UnixNano()),
+		end:       uint64(intervalEnd.UnixNano()),
+		count:     5,
+		sum:       4,
+		scale:     0,
+		zeroCount: 1,
+		positive:  decodedBuckets{offset: -1, counts: []uint64{1, 1, 1}},
+		negative:  decodedBuckets{offset: 1, counts: []uint64{1}},
+	}, p)
+
+	// The encoding survives a round trip through the binary format.
+	b, err := proto.Marshal(m)
+	require.NoError(t, err)
+	decoded := &metricpb.Metric{}
+	require.NoError(t, proto.Unmarshal(b, decoded))
+	assert.Equal(t, m.ProtoReflect().GetUnknown(), decoded.ProtoReflect().GetUnknown())
+}
+
+func TestExponentialHistogramMerge(t *testing.T) {
+	one := attribute.NewSet(attribute.String("one", "1"))
+	two := attribute.NewSet(attribute.String("two", "2"))
+
+	in := make(chan result, 2)
+	for _, labels := range []*attribute.Set{&one, &two} {
+		m, err := Record(export.DeltaExportKindSelector(), exponentialRecord(t, labels, 1))
+		require.NoError(t, err)
+		in <- result{Resource: resource.Empty(), Metric: m}
+	}
+	close(in)
+
+	rms, err := sink(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, rms, 1)
+	require.Len(t, rms[0].InstrumentationLibraryMetrics, 1)
+	ms := rms[0].InstrumentationLibraryMetrics[0].Metrics
+	require.Len(t, ms, 1)
+
+	h := decodeExponentialHistogram(t, ms[0])
+	assert.Equal(t, otelDelta, h.temporality)
+	assert.Len(t, h.points, 2)
+}
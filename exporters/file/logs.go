@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file // import "go.opentelemetry.io/otel/exporters/file"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type logsClient struct {
+	client
+}
+
+var _ otlplogs.Client = (*logsClient)(nil)
+
+// NewLogsClient returns an otlplogs.Client that appends log records to the
+// file at path as OTLP/JSON, one export request per line. The file and its
+// parent directories are created when the client is started.
+func NewLogsClient(path string, opts ...Option) otlplogs.Client {
+	return &logsClient{client{path: path, cfg: newConfig(opts)}}
+}
+
+// Start opens the file.
+func (c *logsClient) Start(context.Context) error {
+	return c.start()
+}
+
+// Stop closes the file, waiting for rotated files to be compressed.
+func (c *logsClient) Stop(context.Context) error {
+	return c.stop()
+}
+
+// UploadLogs writes protoLogs to the file.
+func (c *logsClient) UploadLogs(ctx context.Context, protoLogs []*logspb.ResourceLogs) error {
+	line, err := marshalLine(&collogspb.ExportLogsServiceRequest{ResourceLogs: protoLogs})
+	if err != nil {
+		return err
+	}
+	return c.write(ctx, line)
+}
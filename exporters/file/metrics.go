@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file // import "go.opentelemetry.io/otel/exporters/file"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/file/internal/metrictransform"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// MetricExporter is a metric exporter that appends metrics to a file as
+// OTLP/JSON, one export request per line.
+type MetricExporter struct {
+	client client
+}
+
+var _ export.Exporter = (*MetricExporter)(nil)
+
+// NewMetricExporter returns a MetricExporter writing to the file at path.
+// The file and its parent directories are created if needed. Metrics are
+// exported with cumulative temporality.
+func NewMetricExporter(path string, opts ...Option) (*MetricExporter, error) {
+	e := &MetricExporter{client: client{path: path, cfg: newConfig(opts)}}
+	if err := e.client.start(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ExportKindFor returns the cumulative export kind for all instruments.
+func (e *MetricExporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) export.ExportKind {
+	return export.CumulativeExportKindSelector().ExportKindFor(desc, kind)
+}
+
+// Export writes the metrics of cps to the file.
+func (e *MetricExporter) Export(ctx context.Context, cps export.CheckpointSet) error {
+	rms, err := metrictransform.CheckpointSet(ctx, e, cps, 1)
+	if err != nil {
+		return err
+	}
+	rms = withData(rms)
+	if len(rms) == 0 {
+		return nil
+	}
+	line, err := marshalLine(&colmetricpb.ExportMetricsServiceRequest{ResourceMetrics: rms})
+	if err != nil {
+		return err
+	}
+	return e.client.write(ctx, line)
+}
+
+// withData removes the metrics without data from rms, along with the
+// libraries and resources left empty. Exponential histograms are encoded as
+// unknown fields which are not part of the OTLP/JSON encoding, and would
+// otherwise be written without data.
+func withData(rms []*metricpb.ResourceMetrics) []*metricpb.ResourceMetrics {
+	resources := rms[:0]
+	for _, rm := range rms {
+		libraries := rm.InstrumentationLibraryMetrics[:0]
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			metrics := ilm.Metrics[:0]
+			for _, m := range ilm.Metrics {
+				if m.Data != nil {
+					metrics = append(metrics, m)
+				}
+			}
+			if ilm.Metrics = metrics; len(metrics) > 0 {
+				libraries = append(libraries, ilm)
+			}
+		}
+		if rm.InstrumentationLibraryMetrics = libraries; len(libraries) > 0 {
+			resources = append(resources, rm)
+		}
+	}
+	return resources
+}
+
+// Shutdown closes the file, waiting for rotated files to be compressed. No
+// metrics can be exported once it returns.
+func (e *MetricExporter) Shutdown(context.Context) error {
+	return e.client.stop()
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file // import "go.opentelemetry.io/otel/exporters/file"
+
+import "time"
+
+// DefaultMaxSize is the default size in bytes at which a file is rotated.
+const DefaultMaxSize int64 = 100 << 20
+
+type config struct {
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+	compress   bool
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		maxSize: DefaultMaxSize,
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+	return cfg
+}
+
+// Option applies an option to a file exporter or client.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(cfg *config) {
+	fn(cfg)
+}
+
+// WithMaxSize sets the size in bytes a file may reach before it is rotated.
+// A single export request is never split across files, so a file holding
+// one large request may exceed it. If unset, DefaultMaxSize is used. If not
+// positive, files are not rotated based on their size.
+func WithMaxSize(bytes int64) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.maxSize = bytes
+	})
+}
+
+// WithMaxAge sets the duration after which a file is rotated, measured from
+// the time it was opened. A file is rotated on the first write after it
+// reached its maximum age, empty files are never rotated. If unset or not
+// positive, files are not rotated based on their age.
+func WithMaxAge(d time.Duration) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.maxAge = d
+	})
+}
+
+// WithMaxBackups sets the number of rotated files that are kept. The oldest
+// rotated files are removed once more are present. If unset or not
+// positive, all rotated files are kept.
+func WithMaxBackups(n int) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.maxBackups = n
+	})
+}
+
+// WithCompression compresses rotated files with gzip. Compressed files have
+// a ".gz" suffix appended to their name. The file being written to is never
+// compressed.
+func WithCompression() Option {
+	return optionFunc(func(cfg *config) {
+		cfg.compress = true
+	})
+}
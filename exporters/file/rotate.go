@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file // import "go.opentelemetry.io/otel/exporters/file"
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// backupTimeFormat is the layout of the time of rotation in the name of
+// rotated files. Names of rotated files sort in the order they were rotated.
+const backupTimeFormat = "20060102T150405.000000000"
+
+var errClosed = errors.New("file: closed")
+
+// rotatingFile is an io.Writer appending to a file that is renamed and
+// replaced by a new one once it reaches its maximum size or age. Rotated
+// files are compressed and removed in the background.
+type rotatingFile struct {
+	path string
+	cfg  config
+	now  func() time.Time
+
+	mu sync.Mutex
+	// file is nil if it could not be reopened after a rotation failed.
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	closed   bool
+
+	rotated chan string
+	done    chan struct{}
+}
+
+// openRotatingFile opens the file at path for appending, creating it and
+// its parent directories if needed.
+func openRotatingFile(path string, cfg config) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:    path,
+		cfg:     cfg,
+		now:     time.Now,
+		rotated: make(chan string, 16),
+		done:    make(chan struct{}),
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	go f.cleanup()
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	f.openedAt = f.now()
+	return nil
+}
+
+// Write writes p to the file in a single write, first rotating the file if
+// appending p would exceed its maximum size or if it reached its maximum
+// age.
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return 0, errClosed
+	}
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return 0, err
+		}
+	}
+	if f.shouldRotate(int64(len(p))) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) shouldRotate(n int64) bool {
+	if f.size == 0 {
+		return false
+	}
+	if f.cfg.maxSize > 0 && f.size+n > f.cfg.maxSize {
+		return true
+	}
+	return f.cfg.maxAge > 0 && f.now().Sub(f.openedAt) >= f.cfg.maxAge
+}
+
+// rotate renames the current file and opens a new one in its place. It must
+// be called while holding f.mu.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	backup := f.backupName(f.now())
+	if err := os.Rename(f.path, backup); err != nil {
+		// Keep appending to the current file.
+		if oerr := f.open(); oerr != nil {
+			return fmt.Errorf("%v: %w", err, oerr)
+		}
+		return err
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	f.rotated <- backup
+	return nil
+}
+
+// backupName returns the name a file rotated at t is renamed to. It is the
+// name of the file with the time of rotation inserted before its extension.
+func (f *rotatingFile) backupName(t time.Time) string {
+	prefix, ext := f.backupPattern()
+	return prefix + t.UTC().Format(backupTimeFormat) + ext
+}
+
+func (f *rotatingFile) backupPattern() (prefix, ext string) {
+	ext = filepath.Ext(f.path)
+	return strings.TrimSuffix(f.path, ext) + "-", ext
+}
+
+// cleanup compresses rotated files and removes the oldest ones until the
+// rotated channel is closed.
+func (f *rotatingFile) cleanup() {
+	defer close(f.done)
+	for backup := range f.rotated {
+		if f.cfg.compress {
+			// The file may already have been removed if more than the
+			// maximum number of backups were rotated in the meantime.
+			if err := compressFile(backup); err != nil && !os.IsNotExist(err) {
+				otel.Handle(fmt.Errorf("file: compressing %s: %w", backup, err))
+			}
+		}
+		if f.cfg.maxBackups > 0 {
+			if err := f.removeOldBackups(); err != nil {
+				otel.Handle(fmt.Errorf("file: removing rotated files: %w", err))
+			}
+		}
+	}
+}
+
+// removeOldBackups removes the oldest rotated files beyond the maximum
+// number of backups.
+func (f *rotatingFile) removeOldBackups() error {
+	prefix, ext := f.backupPattern()
+	matches, err := filepath.Glob(prefix + "*")
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, m := range matches {
+		name := strings.TrimSuffix(m, ".gz")
+		if !strings.HasSuffix(name, ext) {
+			continue
+		}
+		ts := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext)
+		if _, err := time.Parse(backupTimeFormat, ts); err != nil {
+			continue
+		}
+		backups = append(backups, m)
+	}
+	if len(backups) <= f.cfg.maxBackups {
+		return nil
+	}
+	sort.Strings(backups)
+	for _, b := range backups[:len(backups)-f.cfg.maxBackups] {
+		if err := os.Remove(b); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// compressFile replaces the file name by a gzip compressed copy named with a
+// ".gz" suffix.
+func compressFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(name+".gz", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(name + ".gz")
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(name + ".gz")
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		_ = os.Remove(name + ".gz")
+		return err
+	}
+	return os.Remove(name)
+}
+
+// Close closes the file and waits for rotated files to be compressed and
+// removed.
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	if f.closed {
+		f.mu.Unlock()
+		return errClosed
+	}
+	f.closed = true
+	var err error
+	if f.file != nil {
+		err = f.file.Close()
+		f.file = nil
+	}
+	close(f.rotated)
+	f.mu.Unlock()
+
+	<-f.done
+	return err
+}
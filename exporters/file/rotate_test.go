@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "file-exporter")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func backups(t *testing.T, path string) []string {
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(path), "out-*"))
+	require.NoError(t, err)
+	sort.Strings(matches)
+	return matches
+}
+
+func read(t *testing.T, name string) string {
+	b, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestRotatingFileMaxSize(t *testing.T) {
+	path := filepath.Join(tempDir(t), "sub", "out.jsonl")
+	f, err := openRotatingFile(path, newConfig([]Option{WithMaxSize(10)}))
+	require.NoError(t, err)
+	now := time.Unix(1600000000, 0)
+	f.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n", "a line longer than the max\n", "dddd\n"} {
+		_, err := f.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	b := backups(t, path)
+	require.Len(t, b, 3)
+	assert.Equal(t, "aaaa\nbbbb\n", read(t, b[0]))
+	assert.Equal(t, "cccc\n", read(t, b[1]))
+	// A line longer than the maximum size is written to a file of its own.
+	assert.Equal(t, "a line longer than the max\n", read(t, b[2]))
+	assert.Equal(t, "dddd\n", read(t, path))
+	assert.Regexp(t, `out-\d{8}T\d{6}\.\d{9}\.jsonl$`, b[0])
+	// Writes fail after the file is closed.
+	_, err = f.Write([]byte("eeee\n"))
+	assert.Equal(t, errClosed, err)
+	assert.Equal(t, errClosed, f.Close())
+}
+
+func TestRotatingFileMaxAge(t *testing.T) {
+	path := filepath.Join(tempDir(t), "out.jsonl")
+	f, err := openRotatingFile(path, newConfig([]Option{WithMaxSize(0), WithMaxAge(time.Minute)}))
+	require.NoError(t, err)
+	now := time.Unix(1600000000, 0)
+	f.now = func() time.Time { return now }
+	f.openedAt = now
+
+	write := func(s string) {
+		_, err := f.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	write("a\n")
+	now = now.Add(30 * time.Second)
+	write("b\n")
+	now = now.Add(30 * time.Second)
+	write("c\n")
+	// An empty file is not rotated.
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, f.Close())
+
+	b := backups(t, path)
+	require.Len(t, b, 1)
+	assert.Equal(t, "a\nb\n", read(t, b[0]))
+	assert.Equal(t, "c\n", read(t, path))
+}
+
+func TestRotatingFileAppendsToExistingFile(t *testing.T) {
+	path := filepath.Join(tempDir(t), "out.jsonl")
+	require.NoError(t, ioutil.WriteFile(path, []byte("existing\n"), 0o644))
+
+	f, err := openRotatingFile(path, newConfig([]Option{WithMaxSize(12)}))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("new\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	b := backups(t, path)
+	require.Len(t, b, 1)
+	assert.Equal(t, "existing\n", read(t, b[0]))
+	assert.Equal(t, "new\n", read(t, path))
+}
+
+func TestRotatingFileCompressionAndMaxBackups(t *testing.T) {
+	path := filepath.Join(tempDir(t), "out.jsonl")
+	f, err := openRotatingFile(path, newConfig([]Option{WithMaxSize(1), WithMaxBackups(2), WithCompression()}))
+	require.NoError(t, err)
+	now := time.Unix(1600000000, 0)
+	f.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for _, line := range []string{"1\n", "2\n", "3\n", "4\n", "5\n"} {
+		_, err := f.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	b := backups(t, path)
+	require.Len(t, b, 2)
+	for i, want := range []string{"3\n", "4\n"} {
+		require.Regexp(t, `\.jsonl\.gz$`, b[i])
+		gz, err := os.Open(b[i])
+		require.NoError(t, err)
+		zr, err := gzip.NewReader(gz)
+		require.NoError(t, err)
+		got, err := ioutil.ReadAll(zr)
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+		assert.Equal(t, want, string(got))
+	}
+	assert.Equal(t, "5\n", read(t, path))
+}
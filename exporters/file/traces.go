@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file // import "go.opentelemetry.io/otel/exporters/file"
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+var errNotStarted = errors.New("file: client not started")
+
+// client writes OTLP export requests to a rotating file. It is shared by the
+// traces and logs clients.
+type client struct {
+	path string
+	cfg  config
+
+	mu   sync.RWMutex
+	file *rotatingFile
+}
+
+func (c *client) start() error {
+	f, err := openRotatingFile(c.path, c.cfg)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.file = f
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *client) stop() error {
+	c.mu.Lock()
+	f := c.file
+	c.file = nil
+	c.mu.Unlock()
+	if f == nil {
+		return nil
+	}
+	return f.Close()
+}
+
+func (c *client) write(ctx context.Context, line []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.file == nil {
+		return errNotStarted
+	}
+	_, err := c.file.Write(line)
+	return err
+}
+
+type tracesClient struct {
+	client
+}
+
+var _ otlptrace.Client = (*tracesClient)(nil)
+
+// NewTracesClient returns an otlptrace.Client that appends spans to the file
+// at path as OTLP/JSON, one export request per line. The file and its
+// parent directories are created when the client is started.
+func NewTracesClient(path string, opts ...Option) otlptrace.Client {
+	return &tracesClient{client{path: path, cfg: newConfig(opts)}}
+}
+
+// Start opens the file.
+func (c *tracesClient) Start(context.Context) error {
+	return c.start()
+}
+
+// Stop closes the file, waiting for rotated files to be compressed.
+func (c *tracesClient) Stop(context.Context) error {
+	return c.stop()
+}
+
+// UploadTraces writes protoSpans to the file.
+func (c *tracesClient) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	line, err := marshalLine(&coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+	return c.write(ctx, line)
+}
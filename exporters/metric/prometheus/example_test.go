@@ -85,9 +85,9 @@ func ExampleNewExportPipeline() {
 	fmt.Print(string(data))
 
 	// Output:
-	// # HELP a_counter Counts things
-	// # TYPE a_counter counter
-	// a_counter{R="V",key="value"} 100
+	// # HELP a_counter_total Counts things
+	// # TYPE a_counter_total counter
+	// a_counter_total{R="V",key="value"} 100
 	// # HELP a_valuerecorder Records values
 	// # TYPE a_valuerecorder histogram
 	// a_valuerecorder_bucket{R="V",key="value",le="+Inf"} 1
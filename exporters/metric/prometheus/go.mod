@@ -39,8 +39,6 @@ replace go.opentelemetry.io/otel/exporters/metric/prometheus => ./
 
 replace go.opentelemetry.io/otel/exporters/otlp => ../../otlp
 
-replace go.opentelemetry.io/otel/exporters/stdout => ../../stdout
-
 replace go.opentelemetry.io/otel/exporters/trace/jaeger => ../../trace/jaeger
 
 replace go.opentelemetry.io/otel/exporters/trace/zipkin => ../../trace/zipkin
@@ -62,3 +60,43 @@ replace go.opentelemetry.io/otel/example/passthrough => ../../../example/passthr
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace => ../../otlp/otlptrace
 
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc => ../../otlp/otlptrace/otlptracegrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs => ../../otlp/otlplogs
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc => ../../otlp/otlplogs/otlplogsgrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp => ../../otlp/otlplogs/otlplogshttp
+
+replace go.opentelemetry.io/otel/samplers/jaegerremote => ../../../samplers/jaegerremote
+
+replace go.opentelemetry.io/otel/sdk/config => ../../../sdk/config
+
+replace go.opentelemetry.io/otel/exporters/autoexport => ../../autoexport
+
+replace go.opentelemetry.io/otel/log => ../../../log
+
+replace go.opentelemetry.io/otel/bridge/otelslog => ../../../bridge/otelslog
+
+replace go.opentelemetry.io/otel/propagators/aws => ../../../propagators/aws
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdoutmetric => ../../stdout/stdoutmetric
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdouttrace => ../../stdout/stdouttrace
+
+replace go.opentelemetry.io/otel/exporters/file => ../../file
+
+replace go.opentelemetry.io/otel/schema => ../../../schema
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric => ../../otlp/otlpmetric
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp => ../../otlp/otlpmetric/otlpmetrichttp
+
+replace go.opentelemetry.io/otel/zpages => ../../../zpages
+
+replace go.opentelemetry.io/otel/instrumentation/runtime => ../../../instrumentation/runtime
+
+replace go.opentelemetry.io/otel/instrumentation/host => ../../../instrumentation/host
+
+replace go.opentelemetry.io/otel/instrumentation/net/http/otelhttp => ../../../instrumentation/net/http/otelhttp
+
+replace go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc => ../../../instrumentation/google.golang.org/grpc/otelgrpc
@@ -41,7 +41,11 @@ import (
 
 // Exporter supports Prometheus pulls.  It does not implement the
 // sdk/export/metric.Exporter interface--instead it creates a pull
-// controller and reads the latest checkpointed data on-scrape.
+// controller and collects metrics on-scrape, so the values served are
+// always current and no collection interval needs to be tuned.
+//
+// If the controller is started, for example because it is shared with a
+// push exporter, the latest checkpointed data is served instead.
 type Exporter struct {
 	handler http.Handler
 
@@ -104,8 +108,14 @@ func NewExporter(config Config, controller *controller.Controller) (*Exporter, e
 		config.Gatherer = config.Registry
 	}
 
+	handler := promhttp.HandlerFor(config.Gatherer, promhttp.HandlerOpts{
+		// Serve the OpenMetrics format when requested by the scraper in
+		// the Accept header.
+		EnableOpenMetrics: true,
+	})
+
 	e := &Exporter{
-		handler:                    promhttp.HandlerFor(config.Gatherer, promhttp.HandlerOpts{}),
+		handler:                    handler,
 		registerer:                 config.Registerer,
 		gatherer:                   config.Gatherer,
 		controller:                 controller,
@@ -138,8 +148,10 @@ func InstallNewPipeline(config Config, options ...controller.Option) (*Exporter,
 }
 
 // defaultController returns a standard *controller.Controller for use
-// with Prometheus.
+// with Prometheus. It is not started and, unless overridden by options,
+// collects on every scrape.
 func defaultController(config Config, options ...controller.Option) *controller.Controller {
+	options = append([]controller.Option{controller.WithCollectPeriod(0)}, options...)
 	return controller.New(
 		processor.New(
 			selector.NewWithHistogramDistribution(
@@ -203,8 +215,12 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	defer c.exp.lock.RUnlock()
 
 	ctrl := c.exp.Controller()
-	if err := ctrl.Collect(context.Background()); err != nil {
-		otel.Handle(err)
+	// A started controller collects in the background, otherwise collect
+	// on demand.
+	if !ctrl.IsRunning() {
+		if err := ctrl.Collect(context.Background()); err != nil {
+			otel.Handle(err)
+		}
 	}
 
 	err := ctrl.ForEach(c.exp, func(record export.Record) error {
@@ -321,7 +337,10 @@ func (c *collector) exportHistogram(ch chan<- prometheus.Metric, hist aggregatio
 
 func (c *collector) toDesc(record export.Record, labelKeys []string) *prometheus.Desc {
 	desc := record.Descriptor()
-	return prometheus.NewDesc(sanitize(desc.Name()), desc.Description(), labelKeys, nil)
+	_, isSum := record.Aggregation().(aggregation.Sum)
+	_, isHistogram := record.Aggregation().(aggregation.Histogram)
+	counter := isSum && !isHistogram && desc.InstrumentKind().Monotonic()
+	return prometheus.NewDesc(metricName(desc.Name(), desc.Unit(), counter), desc.Description(), labelKeys, nil)
 }
 
 // mergeLabels merges the export.Record's labels and resources into a
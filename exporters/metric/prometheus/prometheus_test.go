@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/metric/prometheus"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/unit"
 	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -104,7 +105,7 @@ func TestPrometheusExporter(t *testing.T) {
 	counter.Add(ctx, 10, labels...)
 	counter.Add(ctx, 5.3, labels...)
 
-	expected = append(expected, expectCounter("counter", `counter{A="B",C="D",R="V"} 15.3`))
+	expected = append(expected, expectCounter("counter_total", `counter_total{A="B",C="D",R="V"} 15.3`))
 
 	_ = metric.Must(meter).NewInt64ValueObserver("intobserver", func(_ context.Context, result metric.Int64ObserverResult) {
 		result.Observe(1, labels...)
@@ -174,12 +175,71 @@ func TestPrometheusStatefulness(t *testing.T) {
 	counter.Add(ctx, 100, attribute.String("key", "value"))
 
 	compareExport(t, exporter, []expectedMetric{
-		expectCounterWithHelp("a_counter", "Counts things", `a_counter{key="value"} 100`),
+		expectCounterWithHelp("a_counter_total", "Counts things", `a_counter_total{key="value"} 100`),
 	})
 
 	counter.Add(ctx, 100, attribute.String("key", "value"))
 
 	compareExport(t, exporter, []expectedMetric{
-		expectCounterWithHelp("a_counter", "Counts things", `a_counter{key="value"} 200`),
+		expectCounterWithHelp("a_counter_total", "Counts things", `a_counter_total{key="value"} 200`),
+	})
+}
+
+func TestPrometheusOpenMetrics(t *testing.T) {
+	exporter, err := prometheus.NewExportPipeline(
+		prometheus.Config{},
+		controller.WithCollectPeriod(0),
+		controller.WithResource(resource.Empty()),
+	)
+	require.NoError(t, err)
+
+	meter := exporter.MeterProvider().Meter("test")
+	counter := metric.Must(meter).NewInt64Counter(
+		"request.size",
+		metric.WithDescription("Size of requests"),
+		metric.WithUnit(unit.Bytes),
+	)
+	counter.Add(context.Background(), 100, attribute.String("key", "value"))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+	exporter.ServeHTTP(rec, req)
+
+	require.Contains(t, rec.Header().Get("Content-Type"), "application/openmetrics-text")
+	require.Equal(t, strings.Join([]string{
+		"# HELP request_size_bytes Size of requests",
+		"# TYPE request_size_bytes counter",
+		`request_size_bytes_total{key="value"} 100.0`,
+		"# EOF",
+		"",
+	}, "\n"), rec.Body.String())
+
+	// The text format is served by default.
+	compareExport(t, exporter, []expectedMetric{
+		expectCounterWithHelp("request_size_bytes_total", "Size of requests", `request_size_bytes_total{key="value"} 100`),
+	})
+}
+
+func TestPrometheusCollectOnScrape(t *testing.T) {
+	// The default controller has no collection period to wait for.
+	exporter, err := prometheus.NewExportPipeline(
+		prometheus.Config{},
+		controller.WithResource(resource.Empty()),
+	)
+	require.NoError(t, err)
+	require.False(t, exporter.Controller().IsRunning())
+
+	meter := exporter.MeterProvider().Meter("test")
+	counter := metric.Must(meter).NewInt64Counter("a.counter")
+
+	counter.Add(context.Background(), 1)
+	compareExport(t, exporter, []expectedMetric{
+		expectCounter("a_counter_total", `a_counter_total 1`),
+	})
+
+	counter.Add(context.Background(), 1)
+	compareExport(t, exporter, []expectedMetric{
+		expectCounter("a_counter_total", `a_counter_total 2`),
 	})
 }
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus // import "go.opentelemetry.io/otel/exporters/metric/prometheus"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+// unitSuffixes maps UCUM units to the suffix used in Prometheus metric
+// names.
+var unitSuffixes = map[string]string{
+	// Time
+	"d":   "days",
+	"h":   "hours",
+	"min": "minutes",
+	"s":   "seconds",
+	"ms":  "milliseconds",
+	"us":  "microseconds",
+	"ns":  "nanoseconds",
+
+	// Bytes
+	"By":   "bytes",
+	"KiBy": "kibibytes",
+	"MiBy": "mebibytes",
+	"GiBy": "gibibytes",
+	"TiBy": "tibibytes",
+	"KBy":  "kilobytes",
+	"MBy":  "megabytes",
+	"GBy":  "gigabytes",
+	"TBy":  "terabytes",
+
+	// SI
+	"m":   "meters",
+	"V":   "volts",
+	"A":   "amperes",
+	"J":   "joules",
+	"W":   "watts",
+	"g":   "grams",
+	"Cel": "celsius",
+	"Hz":  "hertz",
+	"%":   "percent",
+}
+
+// perUnitSuffixes maps UCUM units used as the denominator of a unit, like
+// "s" in "By/s", to the suffix used in Prometheus metric names.
+var perUnitSuffixes = map[string]string{
+	"s":  "second",
+	"m":  "minute",
+	"h":  "hour",
+	"d":  "day",
+	"w":  "week",
+	"mo": "month",
+	"y":  "year",
+}
+
+// unitSuffix returns the suffix appended to the name of a metric with the
+// unit u, or an empty string if none is. Annotations in curly braces and
+// the dimensionless unit are ignored. Units of the form "a/b" are converted
+// to "a_per_b".
+func unitSuffix(u unit.Unit) string {
+	s := string(u)
+	parts := strings.SplitN(s, "/", 2)
+	suffix := convertUnit(parts[0], unitSuffixes)
+	if len(parts) == 2 {
+		if per := convertUnit(parts[1], perUnitSuffixes); per != "" {
+			if suffix == "" {
+				return "per_" + per
+			}
+			suffix += "_per_" + per
+		}
+	}
+	return suffix
+}
+
+// convertUnit returns the suffix for the unit s using the suffixes known.
+func convertUnit(s string, known map[string]string) string {
+	if i := strings.IndexByte(s, '{'); i >= 0 {
+		s = s[:i]
+	}
+	if s == "" || s == string(unit.Dimensionless) {
+		return ""
+	}
+	if suffix, ok := known[s]; ok {
+		return suffix
+	}
+	return strings.Trim(sanitize(s), "_")
+}
+
+// metricName returns the Prometheus name of a metric named name with the
+// unit u. The names of counters are suffixed with "_total" as required by
+// OpenMetrics.
+func metricName(name string, u unit.Unit, counter bool) string {
+	name = sanitize(name)
+	if counter {
+		name = strings.TrimSuffix(name, "_total")
+	}
+	if suffix := unitSuffix(u); suffix != "" && !strings.HasSuffix(name, "_"+suffix) {
+		name += "_" + suffix
+	}
+	if counter {
+		name += "_total"
+	}
+	return name
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+func TestMetricName(t *testing.T) {
+	tests := []struct {
+		name    string
+		unit    unit.Unit
+		counter bool
+		want    string
+	}{
+		{name: "http.duration", want: "http_duration"},
+		{name: "http.duration", unit: unit.Milliseconds, want: "http_duration_milliseconds"},
+		{name: "http.duration.milliseconds", unit: unit.Milliseconds, want: "http_duration_milliseconds"},
+		{name: "memory.usage", unit: unit.Bytes, want: "memory_usage_bytes"},
+		{name: "cpu.utilization", unit: unit.Dimensionless, want: "cpu_utilization"},
+		{name: "requests", unit: "{requests}", want: "requests"},
+		{name: "throughput", unit: "By/s", want: "throughput_bytes_per_second"},
+		{name: "rate", unit: "{requests}/s", want: "rate_per_second"},
+		{name: "distance", unit: "km", want: "distance_km"},
+		{name: "requests", counter: true, want: "requests_total"},
+		{name: "requests_total", counter: true, want: "requests_total"},
+		{name: "request.size", unit: unit.Bytes, counter: true, want: "request_size_bytes_total"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+string(tt.unit), func(t *testing.T) {
+			assert.Equal(t, tt.want, metricName(tt.name, tt.unit, tt.counter))
+		})
+	}
+}
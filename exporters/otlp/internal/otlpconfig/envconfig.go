@@ -16,6 +16,7 @@ package otlpconfig
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -30,7 +31,7 @@ import (
 	"go.opentelemetry.io/otel"
 )
 
-var httpSchemeRegexp = regexp.MustCompile(`(?i)^http://|https://`)
+var httpSchemeRegexp = regexp.MustCompile(`(?i)^(http://|https://)`)
 
 func ApplyGRPCEnvConfigs(cfg *Config) {
 	e := EnvOptionsReader{
@@ -74,54 +75,61 @@ func (e *EnvOptionsReader) GetOptionsFromEnv() []GenericOption {
 
 	// Endpoint
 	if v, ok := e.getEnvValue("ENDPOINT"); ok {
-		if isInsecureEndpoint(v) {
-			opts = append(opts, WithInsecure())
-		} else {
-			opts = append(opts, WithSecure())
+		endpoint, urlPath := splitEndpoint(v)
+		opts = append(opts, WithEndpoint(endpoint))
+		if urlPath != "" {
+			// The generic endpoint is a base URL that the
+			// signal paths are appended to.
+			opts = append(opts,
+				WithTracesURLPath(urlPath+DefaultTracesPath),
+				WithMetricsURLPath(urlPath+DefaultMetricsPath),
+			)
 		}
-
-		opts = append(opts, WithEndpoint(trimSchema(v)))
 	}
 	if v, ok := e.getEnvValue("TRACES_ENDPOINT"); ok {
-		if isInsecureEndpoint(v) {
-			opts = append(opts, WithInsecureTraces())
-		} else {
-			opts = append(opts, WithSecureTraces())
+		endpoint, urlPath := splitEndpoint(v)
+		opts = append(opts, WithTracesEndpoint(endpoint))
+		// The signal specific endpoint is used as is.
+		if urlPath == "" {
+			urlPath = DefaultTracesPath
 		}
-
-		opts = append(opts, WithTracesEndpoint(trimSchema(v)))
+		opts = append(opts, WithTracesURLPath(urlPath))
 	}
 	if v, ok := e.getEnvValue("METRICS_ENDPOINT"); ok {
-		if isInsecureEndpoint(v) {
-			opts = append(opts, WithInsecureMetrics())
-		} else {
-			opts = append(opts, WithSecureMetrics())
+		endpoint, urlPath := splitEndpoint(v)
+		opts = append(opts, WithMetricsEndpoint(endpoint))
+		if urlPath == "" {
+			urlPath = DefaultMetricsPath
 		}
-
-		opts = append(opts, WithMetricsEndpoint(trimSchema(v)))
+		opts = append(opts, WithMetricsURLPath(urlPath))
 	}
 
-	// Certificate File
-	if path, ok := e.getEnvValue("CERTIFICATE"); ok {
-		if tls, err := e.readTLSConfig(path); err == nil {
-			opts = append(opts, WithTLSClientConfig(tls))
+	// Insecure
+	if insecure, ok := e.signalInsecure("TRACES"); ok {
+		if insecure {
+			opts = append(opts, WithInsecureTraces())
 		} else {
-			otel.Handle(fmt.Errorf("failed to configure otlp exporter certificate '%s': %w", path, err))
+			opts = append(opts, WithSecureTraces())
 		}
 	}
-	if path, ok := e.getEnvValue("TRACES_CERTIFICATE"); ok {
-		if tls, err := e.readTLSConfig(path); err == nil {
-			opts = append(opts, WithTracesTLSClientConfig(tls))
+	if insecure, ok := e.signalInsecure("METRICS"); ok {
+		if insecure {
+			opts = append(opts, WithInsecureMetrics())
 		} else {
-			otel.Handle(fmt.Errorf("failed to configure otlp traces exporter certificate '%s': %w", path, err))
+			opts = append(opts, WithSecureMetrics())
 		}
 	}
-	if path, ok := e.getEnvValue("METRICS_CERTIFICATE"); ok {
-		if tls, err := e.readTLSConfig(path); err == nil {
-			opts = append(opts, WithMetricsTLSClientConfig(tls))
-		} else {
-			otel.Handle(fmt.Errorf("failed to configure otlp metrics exporter certificate '%s': %w", path, err))
-		}
+
+	// Certificate and client certificate files
+	if tls, err := e.readSignalTLSConfig("TRACES"); err != nil {
+		otel.Handle(fmt.Errorf("failed to configure otlp traces exporter TLS: %w", err))
+	} else if tls != nil {
+		opts = append(opts, WithTracesTLSClientConfig(tls))
+	}
+	if tls, err := e.readSignalTLSConfig("METRICS"); err != nil {
+		otel.Handle(fmt.Errorf("failed to configure otlp metrics exporter TLS: %w", err))
+	} else if tls != nil {
+		opts = append(opts, WithMetricsTLSClientConfig(tls))
 	}
 
 	// Headers
@@ -163,9 +171,39 @@ func (e *EnvOptionsReader) GetOptionsFromEnv() []GenericOption {
 		}
 	}
 
+	// Protocol. The HTTP driver sends both signals with the same
+	// encoding, so the signal specific values are not supported.
+	if p, ok := e.getEnvValue("PROTOCOL"); ok {
+		if opt, err := protocolOption(p); err != nil {
+			otel.Handle(err)
+		} else if opt != nil {
+			opts = append(opts, opt)
+		}
+	}
+
 	return opts
 }
 
+// signalInsecure returns whether the exporter of signal connects
+// without transport security, and false if that is not configured. The
+// scheme of the endpoint takes precedence over the INSECURE variables.
+func (e *EnvOptionsReader) signalInsecure(signal string) (insecure bool, ok bool) {
+	endpoint, hasEndpoint := e.getSignalEnvValue(signal, "ENDPOINT")
+	if hasEndpoint && hasScheme(endpoint) {
+		return isInsecureEndpoint(endpoint), true
+	}
+	if v, ok := e.getSignalEnvValue(signal, "INSECURE"); ok {
+		if insecure, err := strconv.ParseBool(v); err == nil {
+			return insecure, true
+		}
+	}
+	return false, hasEndpoint
+}
+
+func hasScheme(endpoint string) bool {
+	return httpSchemeRegexp.MatchString(endpoint)
+}
+
 func isInsecureEndpoint(endpoint string) bool {
 	return strings.HasPrefix(strings.ToLower(endpoint), "http://")
 }
@@ -174,6 +212,38 @@ func trimSchema(endpoint string) string {
 	return httpSchemeRegexp.ReplaceAllString(endpoint, "")
 }
 
+// splitEndpoint splits an endpoint URL into its host and port, and its
+// path without a trailing slash. Endpoints without a scheme are
+// returned unchanged.
+func splitEndpoint(endpoint string) (hostport, urlPath string) {
+	if !hasScheme(endpoint) {
+		return endpoint, ""
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return trimSchema(endpoint), ""
+	}
+	return u.Host, strings.TrimSuffix(u.Path, "/")
+}
+
+// protocolOption returns the option that configures the driver for the
+// OTLP transport protocol p, or nil if there is nothing to configure.
+func protocolOption(p string) (GenericOption, error) {
+	switch p {
+	case "grpc":
+		return nil, nil
+	case "http/protobuf":
+		return newSplitOption(func(cfg *Config) {
+			cfg.Marshaler = otlp.MarshalProto
+		}, func(*Config) {}), nil
+	case "http/json":
+		return newSplitOption(func(cfg *Config) {
+			cfg.Marshaler = otlp.MarshalJSON
+		}, func(*Config) {}), nil
+	}
+	return nil, fmt.Errorf("unsupported otlp exporter protocol %q", p)
+}
+
 // getEnvValue gets an OTLP environment variable value of the specified key using the GetEnv function.
 // This function already prepends the OTLP prefix to all key lookup.
 func (e *EnvOptionsReader) getEnvValue(key string) (string, bool) {
@@ -181,18 +251,64 @@ func (e *EnvOptionsReader) getEnvValue(key string) (string, bool) {
 	return v, v != ""
 }
 
-func (e *EnvOptionsReader) readTLSConfig(path string) (*tls.Config, error) {
-	b, err := e.ReadFile(path)
-	if err != nil {
-		return nil, err
+// getSignalEnvValue gets the signal specific OTLP environment variable
+// value of key, falling back to the generic value of key.
+func (e *EnvOptionsReader) getSignalEnvValue(signal, key string) (string, bool) {
+	if v, ok := e.getEnvValue(signal + "_" + key); ok {
+		return v, ok
+	}
+	return e.getEnvValue(key)
+}
+
+// readSignalTLSConfig reads the certificate, client certificate, and
+// client key files of signal into a tls.Config. It returns nil if none
+// of them are set.
+func (e *EnvOptionsReader) readSignalTLSConfig(signal string) (*tls.Config, error) {
+	certPath, hasCert := e.getSignalEnvValue(signal, "CERTIFICATE")
+	clientCertPath, hasClientCert := e.getSignalEnvValue(signal, "CLIENT_CERTIFICATE")
+	clientKeyPath, hasClientKey := e.getSignalEnvValue(signal, "CLIENT_KEY")
+
+	cfg := &tls.Config{}
+	switch {
+	case hasCert:
+		b, err := e.ReadFile(certPath)
+		if err != nil {
+			return nil, fmt.Errorf("certificate '%s': %w", certPath, err)
+		}
+		if cfg, err = CreateTLSConfig(b); err != nil {
+			return nil, fmt.Errorf("certificate '%s': %w", certPath, err)
+		}
+	case !hasClientCert && !hasClientKey:
+		return nil, nil
+	}
+
+	if hasClientCert != hasClientKey {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+	if hasClientCert {
+		certPEM, err := e.ReadFile(clientCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate '%s': %w", clientCertPath, err)
+		}
+		keyPEM, err := e.ReadFile(clientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("client key '%s': %w", clientKeyPath, err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate '%s': %w", clientCertPath, err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
 	}
-	return CreateTLSConfig(b)
+	return cfg, nil
 }
 
 func stringToCompression(value string) otlp.Compression {
 	switch value {
 	case "gzip":
 		return otlp.GzipCompression
+	case "zstd":
+		return otlp.ZstdCompression
 	}
 
 	return otlp.NoCompression
@@ -15,8 +15,12 @@
 package otlpconfig // import "go.opentelemetry.io/otel/exporters/otlp/internal/otlpconfig"
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
@@ -73,15 +77,22 @@ type (
 		Metrics SignalConfig
 		Traces  SignalConfig
 
+		// Dialer establishes the connections to the collector,
+		// instead of the default dialer of the transport.
+		Dialer func(ctx context.Context, network, address string) (net.Conn, error)
+
 		// HTTP configurations
-		Marshaler   otlp.Marshaler
-		MaxAttempts int
-		Backoff     time.Duration
+		Marshaler    otlp.Marshaler
+		MaxAttempts  int
+		Backoff      time.Duration
+		HTTPClient   *http.Client
+		RoundTripper http.RoundTripper
 
 		// gRPC configurations
 		ReconnectionPeriod time.Duration
 		ServiceConfig      string
 		DialOptions        []grpc.DialOption
+		GRPCConn           *grpc.ClientConn
 		RetrySettings      otlp.RetrySettings
 	}
 )
@@ -227,6 +238,12 @@ func WithTracesEndpoint(endpoint string) GenericOption {
 	})
 }
 
+func WithDialer(dialer func(ctx context.Context, network, address string) (net.Conn, error)) GenericOption {
+	return newGenericOption(func(cfg *Config) {
+		cfg.Dialer = dialer
+	})
+}
+
 func WithMetricsEndpoint(endpoint string) GenericOption {
 	return newGenericOption(func(cfg *Config) {
 		cfg.Metrics.Endpoint = endpoint
@@ -280,6 +297,10 @@ func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	})
 }
 
+func WithTLSFiles(certificate, clientCertificate, clientKey string) GenericOption {
+	return WithTLSClientConfig(NewFileTLSConfig(certificate, clientCertificate, clientKey))
+}
+
 func WithTracesTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg *Config) {
 		cfg.Traces.TLSCfg = tlsCfg.Clone()
@@ -383,3 +404,17 @@ func WithBackoff(duration time.Duration) GenericOption {
 		cfg.Backoff = duration
 	})
 }
+
+// UnixSocketPath returns the path of the Unix domain socket endpoint
+// refers to, and whether endpoint uses the unix scheme. Both the
+// unix:relative/path and unix:///absolute/path forms are accepted.
+func UnixSocketPath(endpoint string) (string, bool) {
+	if !strings.HasPrefix(endpoint, "unix:") {
+		return "", false
+	}
+	socket := strings.TrimPrefix(endpoint, "unix:")
+	if strings.HasPrefix(socket, "//") {
+		socket = socket[2:]
+	}
+	return socket, true
+}
@@ -299,6 +299,86 @@ func TestConfigs(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Test Environment Endpoint URL",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "https://env_endpoint:4318/base/",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, "env_endpoint:4318", c.Traces.Endpoint)
+				assert.Equal(t, "/base/v1/traces", c.Traces.URLPath)
+				assert.Equal(t, "env_endpoint:4318", c.Metrics.Endpoint)
+				assert.Equal(t, "/base/v1/metrics", c.Metrics.URLPath)
+				assert.False(t, c.Traces.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Endpoint URL",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":         "https://env_endpoint:4318/base",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT":  "http://env_traces_endpoint:4318/custom/traces",
+				"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT": "https://env_metrics_endpoint:4318",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, "env_traces_endpoint:4318", c.Traces.Endpoint)
+				assert.Equal(t, "/custom/traces", c.Traces.URLPath)
+				assert.True(t, c.Traces.Insecure)
+				assert.Equal(t, "env_metrics_endpoint:4318", c.Metrics.Endpoint)
+				assert.Equal(t, DefaultMetricsPath, c.Metrics.URLPath)
+				assert.False(t, c.Metrics.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Insecure",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":         "env_endpoint:4317",
+				"OTEL_EXPORTER_OTLP_INSECURE":         "true",
+				"OTEL_EXPORTER_OTLP_METRICS_INSECURE": "false",
+				"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT": "env_metrics_endpoint:4317",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.True(t, c.Traces.Insecure)
+				assert.False(t, c.Metrics.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Client Certificate",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE":         "client_cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":                 "client_key_path",
+				"OTEL_EXPORTER_OTLP_METRICS_CLIENT_CERTIFICATE": "invalid_cert",
+			},
+			fileReader: fileReader{
+				"client_cert_path": []byte(WeakCertificate),
+				"client_key_path":  []byte(WeakPrivateKey),
+				"invalid_cert":     []byte("invalid certificate file."),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				if grpcOption {
+					assert.NotNil(t, c.Traces.GRPCCredentials)
+					assert.Nil(t, c.Metrics.GRPCCredentials)
+				} else {
+					assert.Len(t, c.Traces.TLSCfg.Certificates, 1)
+					assert.Nil(t, c.Metrics.TLSCfg)
+				}
+			},
+		},
+
+		// Protocol tests
+		{
+			name: "Test Environment Protocol",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_PROTOCOL": "http/json",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				if grpcOption {
+					assert.Equal(t, otlp.MarshalProto, c.Marshaler)
+				} else {
+					assert.Equal(t, otlp.MarshalJSON, c.Marshaler)
+				}
+			},
+		},
+
 		// Headers tests
 		{
 			name: "Test With Headers",
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// tlsFiles holds the certificates read from PEM files, and reads them
+// again when the files are modified.
+type tlsFiles struct {
+	certificate       string
+	clientCertificate string
+	clientKey         string
+
+	mu         sync.Mutex
+	rootCAs    *x509.CertPool
+	rootCAsMod time.Time
+	keyPair    *tls.Certificate
+	certMod    time.Time
+	keyMod     time.Time
+}
+
+// NewFileTLSConfig returns a tls.Config that verifies the server
+// certificate with the CA certificates of the certificate PEM file, and
+// presents the client certificate and key of the clientCertificate and
+// clientKey PEM files. Empty paths are ignored. The files are read on
+// the first handshake, and read again on the handshakes that follow a
+// change of their modification time, so that rotated certificates are
+// used without restarting the exporter.
+func NewFileTLSConfig(certificate, clientCertificate, clientKey string) *tls.Config {
+	f := &tlsFiles{
+		certificate:       certificate,
+		clientCertificate: clientCertificate,
+		clientKey:         clientKey,
+	}
+	cfg := &tls.Config{}
+	if certificate != "" {
+		// The default verification uses a fixed RootCAs pool, it
+		// is replaced by verifyConnection that uses the pool read
+		// from the current certificate file.
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = f.verifyConnection
+	}
+	if clientCertificate != "" || clientKey != "" {
+		cfg.GetClientCertificate = f.getClientCertificate
+	}
+	return cfg
+}
+
+func (f *tlsFiles) verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no server certificate")
+	}
+	roots, err := f.loadRootCAs()
+	if err != nil {
+		return err
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err = cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+func (f *tlsFiles) loadRootCAs() (*x509.CertPool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	mod, err := modTime(f.certificate)
+	if err != nil {
+		return nil, fmt.Errorf("certificate '%s': %w", f.certificate, err)
+	}
+	if f.rootCAs != nil && mod.Equal(f.rootCAsMod) {
+		return f.rootCAs, nil
+	}
+	b, err := ioutil.ReadFile(f.certificate)
+	if err != nil {
+		return nil, fmt.Errorf("certificate '%s': %w", f.certificate, err)
+	}
+	pool := x509.NewCertPool()
+	if ok := pool.AppendCertsFromPEM(b); !ok {
+		return nil, fmt.Errorf("certificate '%s': failed to append certificate to the cert pool", f.certificate)
+	}
+	f.rootCAs, f.rootCAsMod = pool, mod
+	return pool, nil
+}
+
+func (f *tlsFiles) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if f.clientCertificate == "" || f.clientKey == "" {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	certMod, err := modTime(f.clientCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("client certificate '%s': %w", f.clientCertificate, err)
+	}
+	keyMod, err := modTime(f.clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("client key '%s': %w", f.clientKey, err)
+	}
+	if f.keyPair != nil && certMod.Equal(f.certMod) && keyMod.Equal(f.keyMod) {
+		return f.keyPair, nil
+	}
+	cert, err := tls.LoadX509KeyPair(f.clientCertificate, f.clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("client certificate '%s': %w", f.clientCertificate, err)
+	}
+	f.keyPair, f.certMod, f.keyMod = &cert, certMod, keyMod
+	return f.keyPair, nil
+}
+
+func modTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
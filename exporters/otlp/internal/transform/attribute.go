@@ -78,6 +78,12 @@ func toAttribute(v attribute.KeyValue) *commonpb.KeyValue {
 				Values: arrayValues(v),
 			},
 		}
+	case attribute.MAP:
+		result.Value.Value = &commonpb.AnyValue_KvlistValue{
+			KvlistValue: &commonpb.KeyValueList{
+				Values: Attributes(v.Value.AsMap()),
+			},
+		}
 	default:
 		result.Value.Value = &commonpb.AnyValue_StringValue{
 			StringValue: "INVALID",
@@ -208,6 +208,12 @@ func sink(ctx context.Context, in <-chan result) ([]*metricpb.ResourceMetrics, e
 			m.GetHistogram().DataPoints = append(m.GetHistogram().DataPoints, res.Metric.GetHistogram().DataPoints...)
 		case *metricpb.Metric_Summary:
 			m.GetSummary().DataPoints = append(m.GetSummary().DataPoints, res.Metric.GetSummary().DataPoints...)
+		case nil:
+			if isExponentialHistogram(m) && isExponentialHistogram(res.Metric) {
+				mergeExponentialHistograms(m, res.Metric)
+				continue
+			}
+			errStrings = append(errStrings, "unsupported metric type: <nil>")
 		default:
 			err := fmt.Sprintf("unsupported metric type: %T", res.Metric.Data)
 			errStrings = append(errStrings, err)
@@ -265,6 +271,13 @@ func Record(exportSelector export.ExportKindSelector, r export.Record) (*metricp
 		}
 		return histogramPoint(r, exportSelector.ExportKindFor(r.Descriptor(), aggregation.HistogramKind), h)
 
+	case aggregation.ExponentialHistogramKind:
+		h, ok := agg.(aggregation.ExponentialHistogram)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrIncompatibleAgg, agg)
+		}
+		return exponentialHistogramPoint(r, exportSelector.ExportKindFor(r.Descriptor(), aggregation.ExponentialHistogramKind), h)
+
 	case aggregation.SumKind:
 		s, ok := agg.(aggregation.Sum)
 		if !ok {
@@ -433,6 +446,7 @@ func sumPoint(record export.Record, num number.Number, start, end time.Time, ek
 						Attributes:        keyValues(labels.Iter()),
 						StartTimeUnixNano: toNanos(start),
 						TimeUnixNano:      toNanos(end),
+						Exemplars:         exemplars(record),
 					},
 				},
 			},
@@ -450,6 +464,7 @@ func sumPoint(record export.Record, num number.Number, start, end time.Time, ek
 						Attributes:        keyValues(labels.Iter()),
 						StartTimeUnixNano: toNanos(start),
 						TimeUnixNano:      toNanos(end),
+						Exemplars:         exemplars(record),
 					},
 				},
 			},
@@ -567,6 +582,7 @@ func histogramPoint(record export.Record, ek export.ExportKind, a aggregation.Hi
 						Count:             uint64(count),
 						BucketCounts:      counts,
 						ExplicitBounds:    boundaries,
+						Exemplars:         exemplars(record),
 					},
 				},
 			},
@@ -575,6 +591,40 @@ func histogramPoint(record export.Record, ek export.ExportKind, a aggregation.Hi
 	return m, nil
 }
 
+// exemplars transforms the exemplars of the record Aggregation, if it
+// samples any, into OTLP Exemplars.
+func exemplars(record export.Record) []*metricpb.Exemplar {
+	ea, ok := record.Aggregation().(aggregation.Exemplars)
+	if !ok {
+		return nil
+	}
+	es, err := ea.Exemplars()
+	if err != nil || len(es) == 0 {
+		return nil
+	}
+
+	kind := record.Descriptor().NumberKind()
+	out := make([]*metricpb.Exemplar, 0, len(es))
+	for _, e := range es {
+		traceID := e.SpanContext.TraceID()
+		spanID := e.SpanContext.SpanID()
+		filtered := attribute.NewSet(e.FilteredAttributes...)
+		ex := &metricpb.Exemplar{
+			FilteredAttributes: keyValues(filtered.Iter()),
+			TimeUnixNano:       toNanos(e.Time),
+			TraceId:            traceID[:],
+			SpanId:             spanID[:],
+		}
+		if kind == number.Int64Kind {
+			ex.Value = &metricpb.Exemplar_AsInt{AsInt: e.Value.AsInt64()}
+		} else {
+			ex.Value = &metricpb.Exemplar_AsDouble{AsDouble: e.Value.CoerceToFloat64(kind)}
+		}
+		out = append(out, ex)
+	}
+	return out
+}
+
 // keyValues transforms an attribute iterator into an OTLP KeyValues.
 func keyValues(iter attribute.Iterator) []*commonpb.KeyValue {
 	l := iter.Len()
@@ -37,6 +37,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
 	sumAgg "go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
@@ -253,6 +254,40 @@ func TestSumFloatDataPoints(t *testing.T) {
 	}
 }
 
+func TestSumExemplars(t *testing.T) {
+	desc := metric.NewDescriptor("", metric.CounterInstrumentKind, number.Int64Kind)
+	labels := attribute.NewSet(attribute.String("one", "1"))
+	s, ckpt := metrictest.Unslice2(sumAgg.New(2))
+
+	traceID := trace.TraceID{0x01}
+	spanID := trace.SpanID{0x02}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	assert.NoError(t, s.Update(ctx, number.NewInt64Number(3), &desc))
+	require.NoError(t, s.SynchronizedMove(ckpt, &desc))
+	ckpt.(*sumAgg.Aggregator).AddFilteredAttributes([]attribute.KeyValue{attribute.String("user", "a")})
+	record := export.NewRecord(&desc, &labels, nil, ckpt.Aggregation(), intervalStart, intervalEnd)
+
+	m, err := Record(export.CumulativeExportKindSelector(), record)
+	require.NoError(t, err)
+	require.Len(t, m.GetSum().DataPoints, 1)
+	exemplars := m.GetSum().DataPoints[0].Exemplars
+	require.Len(t, exemplars, 1)
+	assert.Equal(t, int64(3), exemplars[0].GetAsInt())
+	assert.Equal(t, traceID[:], exemplars[0].TraceId)
+	assert.Equal(t, spanID[:], exemplars[0].SpanId)
+	assert.NotZero(t, exemplars[0].TimeUnixNano)
+	assert.Equal(t, []*commonpb.KeyValue{
+		{
+			Key:   "user",
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "a"}},
+		},
+	}, exemplars[0].FilteredAttributes)
+}
+
 func TestLastValueIntDataPoints(t *testing.T) {
 	desc := metric.NewDescriptor("", metric.ValueRecorderInstrumentKind, number.Int64Kind)
 	labels := attribute.NewSet(attribute.String("one", "1"))
@@ -15,6 +15,7 @@
 package otlp // import "go.opentelemetry.io/otel/exporters/otlp"
 
 import (
+	"go.opentelemetry.io/otel/metric"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 )
 
@@ -44,14 +45,78 @@ type config struct {
 
 // WithMetricExportKindSelector defines the ExportKindSelector used
 // for selecting AggregationTemporality (i.e., Cumulative vs. Delta
-// aggregation). If not specified otherwise, exporter will use a
-// cumulative export kind selector.
+// aggregation). If not specified otherwise, exporter will use the
+// selector of the OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE
+// environment variable, or a cumulative export kind selector if it is not
+// set.
 func WithMetricExportKindSelector(selector metricsdk.ExportKindSelector) ExporterOption {
 	return exporterOptionFunc(func(cfg *config) {
 		cfg.exportKindSelector = selector
 	})
 }
 
+// TemporalityPreference selects the aggregation temporality of metrics
+// by instrument kind, as defined by the OpenTelemetry specification.
+type TemporalityPreference string
+
+const (
+	// CumulativeTemporality exports all metrics as cumulative.
+	CumulativeTemporality TemporalityPreference = "cumulative"
+	// DeltaTemporality exports counters, value recorders, sum
+	// observers and value observers as deltas, and up-down counters and
+	// up-down sum observers as cumulative.
+	DeltaTemporality TemporalityPreference = "delta"
+	// LowMemoryTemporality exports counters, value recorders and value
+	// observers as deltas, and sum observers, up-down counters and
+	// up-down sum observers as cumulative. This avoids keeping the state
+	// needed to convert between temporalities.
+	LowMemoryTemporality TemporalityPreference = "lowmemory"
+)
+
+// temporalityEnvKey is the environment variable the default
+// TemporalityPreference is read from.
+const temporalityEnvKey = "OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE"
+
+// exportKindSelector returns the ExportKindSelector of p, and false if p
+// is not a known preference.
+func (p TemporalityPreference) exportKindSelector() (metricsdk.ExportKindSelector, bool) {
+	switch p {
+	case CumulativeTemporality:
+		return metricsdk.CumulativeExportKindSelector(), true
+	case DeltaTemporality:
+		return metricsdk.InstrumentKindExportKindSelector(map[metric.InstrumentKind]metricsdk.ExportKind{
+			metric.CounterInstrumentKind:       metricsdk.DeltaExportKind,
+			metric.ValueRecorderInstrumentKind: metricsdk.DeltaExportKind,
+			metric.SumObserverInstrumentKind:   metricsdk.DeltaExportKind,
+			metric.ValueObserverInstrumentKind: metricsdk.DeltaExportKind,
+			metric.GaugeInstrumentKind:         metricsdk.DeltaExportKind,
+		}, metricsdk.CumulativeExportKindSelector()), true
+	case LowMemoryTemporality:
+		return metricsdk.InstrumentKindExportKindSelector(map[metric.InstrumentKind]metricsdk.ExportKind{
+			metric.CounterInstrumentKind:       metricsdk.DeltaExportKind,
+			metric.ValueRecorderInstrumentKind: metricsdk.DeltaExportKind,
+			metric.ValueObserverInstrumentKind: metricsdk.DeltaExportKind,
+			metric.GaugeInstrumentKind:         metricsdk.DeltaExportKind,
+		}, metricsdk.CumulativeExportKindSelector()), true
+	}
+	return nil, false
+}
+
+// WithMetricTemporalityPreference sets the ExportKindSelector used for
+// selecting AggregationTemporality to the one of the preference. Unknown
+// preferences are ignored.
+//
+// If neither this option nor WithMetricExportKindSelector are used, the
+// preference is read from the
+// OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE environment variable.
+func WithMetricTemporalityPreference(preference TemporalityPreference) ExporterOption {
+	return exporterOptionFunc(func(cfg *config) {
+		if selector, ok := preference.exportKindSelector(); ok {
+			cfg.exportKindSelector = selector
+		}
+	})
+}
+
 // SplitDriverOption provides options for setting up a split driver.
 type SplitDriverOption interface {
 	apply(*splitDriver)
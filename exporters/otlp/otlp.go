@@ -17,6 +17,9 @@ package otlp // import "go.opentelemetry.io/otel/exporters/otlp"
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"strings"
 	"sync"
 
 	"go.opentelemetry.io/otel"
@@ -65,6 +68,13 @@ func NewUnstartedExporter(driver ProtocolDriver, opts ...ExporterOption) *Export
 		// https://github.com/open-telemetry/opentelemetry-specification/issues/731
 		exportKindSelector: metricsdk.CumulativeExportKindSelector(),
 	}
+	if v := strings.TrimSpace(os.Getenv(temporalityEnvKey)); v != "" {
+		if selector, ok := TemporalityPreference(strings.ToLower(v)).exportKindSelector(); ok {
+			cfg.exportKindSelector = selector
+		} else {
+			otel.Handle(fmt.Errorf("invalid %s: %q", temporalityEnvKey, v))
+		}
+	}
 	for _, opt := range opts {
 		opt.apply(&cfg)
 	}
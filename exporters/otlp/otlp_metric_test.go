@@ -25,6 +25,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp"
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/number"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
@@ -867,3 +868,51 @@ func TestEmptyMetricExport(t *testing.T) {
 		assert.Equal(t, test.want, driver.rm)
 	}
 }
+
+func TestTemporalityPreference(t *testing.T) {
+	cumulative := metricsdk.CumulativeExportKind
+	delta := metricsdk.DeltaExportKind
+	kinds := []metric.InstrumentKind{
+		metric.CounterInstrumentKind,
+		metric.UpDownCounterInstrumentKind,
+		metric.ValueRecorderInstrumentKind,
+		metric.SumObserverInstrumentKind,
+		metric.UpDownSumObserverInstrumentKind,
+		metric.ValueObserverInstrumentKind,
+	}
+
+	for _, tt := range []struct {
+		preference otlp.TemporalityPreference
+		want       []metricsdk.ExportKind
+	}{
+		{otlp.CumulativeTemporality, []metricsdk.ExportKind{cumulative, cumulative, cumulative, cumulative, cumulative, cumulative}},
+		{otlp.DeltaTemporality, []metricsdk.ExportKind{delta, cumulative, delta, delta, cumulative, delta}},
+		{otlp.LowMemoryTemporality, []metricsdk.ExportKind{delta, cumulative, delta, cumulative, cumulative, delta}},
+		{"unknown", []metricsdk.ExportKind{cumulative, cumulative, cumulative, cumulative, cumulative, cumulative}},
+	} {
+		t.Run(string(tt.preference), func(t *testing.T) {
+			exp := otlp.NewUnstartedExporter(nil, otlp.WithMetricTemporalityPreference(tt.preference))
+			for i, ikind := range kinds {
+				desc := metric.NewDescriptor("instrument", ikind, number.Int64Kind)
+				assert.Equal(t, tt.want[i], exp.ExportKindFor(&desc, aggregation.SumKind), ikind)
+			}
+		})
+	}
+}
+
+func TestTemporalityPreferenceEnv(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "Delta",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	desc := metric.NewDescriptor("instrument", metric.CounterInstrumentKind, number.Int64Kind)
+
+	exp := otlp.NewUnstartedExporter(nil)
+	assert.Equal(t, metricsdk.DeltaExportKind, exp.ExportKindFor(&desc, aggregation.SumKind))
+
+	// Options take precedence over the environment.
+	exp = otlp.NewUnstartedExporter(nil, otlp.WithMetricExportKindSelector(metricsdk.CumulativeExportKindSelector()))
+	assert.Equal(t, metricsdk.CumulativeExportKind, exp.ExportKindFor(&desc, aggregation.SumKind))
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -32,6 +33,7 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/zstd"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -186,9 +188,15 @@ func (c *connection) indefiniteBackgroundConnection() {
 }
 
 func (c *connection) connect(ctx context.Context) error {
-	cc, err := c.dialToCollector(ctx)
-	if err != nil {
-		return err
+	// A connection provided by the user is used as is, it is not
+	// dialed or closed.
+	cc := c.cfg.GRPCConn
+	if cc == nil {
+		var err error
+		cc, err = c.dialToCollector(ctx)
+		if err != nil {
+			return err
+		}
 	}
 	c.setConnection(cc)
 	c.newConnectionHandler(cc)
@@ -209,7 +217,7 @@ func (c *connection) setConnection(cc *grpc.ClientConn) bool {
 	}
 
 	// If the previous clientConn was non-nil, close it
-	if c.cc != nil {
+	if c.cc != nil && c.cc != c.cfg.GRPCConn {
 		_ = c.cc.Close()
 	}
 	c.cc = cc
@@ -226,8 +234,14 @@ func (c *connection) dialToCollector(ctx context.Context) (*grpc.ClientConn, err
 	} else if c.sCfg.Insecure {
 		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
-	if c.sCfg.Compression == otlp.GzipCompression {
+	switch c.sCfg.Compression {
+	case otlp.GzipCompression:
 		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	case otlp.ZstdCompression:
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(zstd.Name)))
+	}
+	if c.cfg.Dialer != nil {
+		dialOpts = append(dialOpts, grpc.WithContextDialer(c.contextDialer()))
 	}
 	if len(c.cfg.DialOptions) != 0 {
 		dialOpts = append(dialOpts, c.cfg.DialOptions...)
@@ -239,6 +253,19 @@ func (c *connection) dialToCollector(ctx context.Context) (*grpc.ClientConn, err
 	return grpc.DialContext(ctx, c.sCfg.Endpoint, dialOpts...)
 }
 
+// contextDialer adapts the configured Dialer to gRPC, which only passes
+// the address to dial. Unix socket endpoints are resolved by gRPC into
+// the socket path.
+func (c *connection) contextDialer() func(context.Context, string) (net.Conn, error) {
+	network := "tcp"
+	if _, ok := otlpconfig.UnixSocketPath(c.sCfg.Endpoint); ok {
+		network = "unix"
+	}
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		return c.cfg.Dialer(ctx, network, address)
+	}
+}
+
 func (c *connection) contextWithMetadata(ctx context.Context) context.Context {
 	if c.metadata.Len() > 0 {
 		return metadata.NewOutgoingContext(ctx, c.metadata)
@@ -260,7 +287,7 @@ func (c *connection) shutdown(ctx context.Context) error {
 	c.cc = nil
 	c.mu.Unlock()
 
-	if cc != nil {
+	if cc != nil && cc != c.cfg.GRPCConn {
 		return cc.Close()
 	}
 
@@ -17,6 +17,13 @@ Package otlpgrpc provides an implementation of otlp.ProtocolDriver
 that connects to the collector and sends traces and metrics using
 gRPC.
 
+The driver is also configured by the OTEL_EXPORTER_OTLP_ENDPOINT,
+_INSECURE, _HEADERS, _TIMEOUT, _COMPRESSION, _CERTIFICATE,
+_CLIENT_CERTIFICATE, and _CLIENT_KEY environment variables, and their
+OTEL_EXPORTER_OTLP_TRACES_ and OTEL_EXPORTER_OTLP_METRICS_ signal
+specific variants, as defined by the OpenTelemetry specification.
+Options passed to NewDriver take precedence over the environment.
+
 This package is currently in a pre-GA phase. Backwards incompatible
 changes may be introduced in subsequent minor version releases as we
 work to track the evolving OpenTelemetry specification and user
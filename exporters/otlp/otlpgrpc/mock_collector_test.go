@@ -214,7 +214,11 @@ func runMockCollectorAtEndpoint(t *testing.T, endpoint string) *mockCollector {
 }
 
 func runMockCollectorWithConfig(t *testing.T, mockConfig *mockConfig) *mockCollector {
-	ln, err := net.Listen("tcp", mockConfig.endpoint)
+	network, address := "tcp", mockConfig.endpoint
+	if strings.HasPrefix(address, "unix://") {
+		network, address = "unix", strings.TrimPrefix(address, "unix://")
+	}
+	ln, err := net.Listen(network, address)
 	if err != nil {
 		t.Fatalf("Failed to get an endpoint: %v", err)
 	}
@@ -229,6 +233,9 @@ func runMockCollectorWithConfig(t *testing.T, mockConfig *mockConfig) *mockColle
 	}()
 
 	mc.endpoint = ln.Addr().String()
+	if network == "unix" {
+		mc.endpoint = "unix://" + mc.endpoint
+	}
 	// srv.Stop calls Close on mc.ln.
 	mc.stopFunc = srv.Stop
 
@@ -15,7 +15,9 @@
 package otlpgrpc
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -62,7 +64,9 @@ func WithInsecureMetrics() Option {
 
 // WithEndpoint allows one to set the endpoint that the exporter will
 // connect to the collector on. If unset, it will instead try to use
-// connect to DefaultCollectorHost:DefaultCollectorPort.
+// connect to DefaultCollectorHost:DefaultCollectorPort. An endpoint with
+// the unix scheme, like unix:///var/run/otel.sock, connects to the
+// collector through a Unix domain socket.
 func WithEndpoint(endpoint string) Option {
 	return wrappedOption{otlpconfig.WithEndpoint(endpoint)}
 }
@@ -93,6 +97,8 @@ func compressorToCompression(compressor string) otlp.Compression {
 	switch compressor {
 	case "gzip":
 		return otlp.GzipCompression
+	case "zstd":
+		return otlp.ZstdCompression
 	}
 
 	otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
@@ -103,7 +109,8 @@ func compressorToCompression(compressor string) otlp.Compression {
 // It is the responsibility of the caller to ensure that the compressor set has been registered
 // with google.golang.org/grpc/encoding. This can be done by encoding.RegisterCompressor. Some
 // compressors auto-register on import, such as gzip, which can be registered by calling
-// `import _ "google.golang.org/grpc/encoding/gzip"`.
+// `import _ "google.golang.org/grpc/encoding/gzip"`. The zstd compressor
+// is registered by this package.
 func WithCompressor(compressor string) Option {
 	return wrappedOption{otlpconfig.WithCompression(compressorToCompression(compressor))}
 }
@@ -112,7 +119,8 @@ func WithCompressor(compressor string) Option {
 // It is the responsibility of the caller to ensure that the compressor set has been registered
 // with google.golang.org/grpc/encoding. This can be done by encoding.RegisterCompressor. Some
 // compressors auto-register on import, such as gzip, which can be registered by calling
-// `import _ "google.golang.org/grpc/encoding/gzip"`.
+// `import _ "google.golang.org/grpc/encoding/gzip"`. The zstd compressor
+// is registered by this package.
 func WithTracesCompression(compressor string) Option {
 	return wrappedOption{otlpconfig.WithTracesCompression(compressorToCompression(compressor))}
 }
@@ -121,7 +129,8 @@ func WithTracesCompression(compressor string) Option {
 // It is the responsibility of the caller to ensure that the compressor set has been registered
 // with google.golang.org/grpc/encoding. This can be done by encoding.RegisterCompressor. Some
 // compressors auto-register on import, such as gzip, which can be registered by calling
-// `import _ "google.golang.org/grpc/encoding/gzip"`.
+// `import _ "google.golang.org/grpc/encoding/gzip"`. The zstd compressor
+// is registered by this package.
 func WithMetricsCompression(compressor string) Option {
 	return wrappedOption{otlpconfig.WithMetricsCompression(compressorToCompression(compressor))}
 }
@@ -153,6 +162,18 @@ func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 	})}
 }
 
+// WithTLSFiles configures the transport security from PEM files, like
+// the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables. The server
+// certificate is verified with the CA certificates of the certificate
+// file, and the clientCertificate and clientKey files are used for
+// mutual TLS. Empty paths are ignored. The files are read again when
+// they are modified, so rotated certificates are picked up by the next
+// connection to the collector.
+func WithTLSFiles(certificate, clientCertificate, clientKey string) Option {
+	return wrappedOption{otlpconfig.WithTLSFiles(certificate, clientCertificate, clientKey)}
+}
+
 // WithTracesTLSCredentials allows the connection to use TLS credentials
 // when talking to the traces server. It takes in grpc.TransportCredentials instead
 // of say a Certificate file or a tls.Certificate, because the retrieving of
@@ -191,6 +212,26 @@ func WithDialOption(opts ...grpc.DialOption) Option {
 	})}
 }
 
+// WithDialer sets the function used to establish the connection to the
+// collector, instead of the default dialer of gRPC. The network passed
+// to dialer is "unix" for Unix domain socket endpoints and "tcp"
+// otherwise. Options passed with WithDialOption take precedence.
+func WithDialer(dialer func(ctx context.Context, network, address string) (net.Conn, error)) Option {
+	return wrappedOption{otlpconfig.WithDialer(dialer)}
+}
+
+// WithGRPCConn sets conn as the gRPC ClientConn used for all
+// communication with the collector, so that it can be shared with other
+// exporters.  When set, the endpoint, credentials, compression, service
+// config and dial options are ignored, as conn is not dialed by
+// the driver.  It is not closed on shutdown either, the caller remains
+// responsible for closing it.
+func WithGRPCConn(conn *grpc.ClientConn) Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg *otlpconfig.Config) {
+		cfg.GRPCConn = conn
+	})}
+}
+
 // WithTimeout tells the driver the max waiting time for the backend to process
 // each spans or metrics batch. If unset, the default will be 10 seconds.
 func WithTimeout(duration time.Duration) Option {
@@ -17,13 +17,18 @@ package otlpgrpc_test
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -912,3 +917,71 @@ func TestMultiConnectionDriver(t *testing.T) {
 	}()
 	otlptest.RunEndToEndTest(ctx, t, exp, mcTraces, mcMetrics)
 }
+
+func TestNewExporter_WithGRPCConn(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	conn, err := grpc.Dial(mc.endpoint, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+
+	ctx := context.Background()
+	// The endpoint is ignored, the passed connection is used instead.
+	exp := newGRPCExporter(t, ctx, "invalid", otlpgrpc.WithGRPCConn(conn))
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Len(t, mc.getSpans(), 1)
+	assert.NotEqual(t, connectivity.Shutdown, conn.GetState(), "shared connection closed by the driver")
+}
+
+func TestNewExporter_withUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otlpgrpc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	mc := runMockCollectorAtEndpoint(t, "unix://"+filepath.Join(dir, "otel.sock"))
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Len(t, mc.getSpans(), 1)
+}
+
+func TestNewExporter_withDialer(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	var (
+		mu     sync.Mutex
+		dialed []string
+	)
+	dialer := func(ctx context.Context, network, address string) (net.Conn, error) {
+		mu.Lock()
+		dialed = append(dialed, network+" "+address)
+		mu.Unlock()
+		return (&net.Dialer{}).DialContext(ctx, network, mc.endpoint)
+	}
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "collector.invalid:4317", otlpgrpc.WithDialer(dialer))
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	// The driver dials a connection for each signal.
+	assert.Equal(t, []string{"tcp collector.invalid:4317", "tcp collector.invalid:4317"}, dialed)
+	assert.Len(t, mc.getSpans(), 1)
+}
@@ -16,6 +16,15 @@
 Package otlphttp implements a protocol driver that sends traces and
 metrics to the collector using HTTP with binary protobuf payloads.
 
+The driver is also configured by the OTEL_EXPORTER_OTLP_ENDPOINT,
+_INSECURE, _HEADERS, _TIMEOUT, _COMPRESSION, _CERTIFICATE,
+_CLIENT_CERTIFICATE, _CLIENT_KEY, and _PROTOCOL environment variables,
+and their OTEL_EXPORTER_OTLP_TRACES_ and OTEL_EXPORTER_OTLP_METRICS_
+signal specific variants, as defined by the OpenTelemetry
+specification. Setting OTEL_EXPORTER_OTLP_PROTOCOL to "http/json"
+sends JSON payloads. Options passed to NewDriver take precedence over
+the environment.
+
 This package is currently in a pre-GA phase. Backwards incompatible
 changes may be introduced in subsequent minor version releases as we
 work to track the evolving OpenTelemetry specification and user
@@ -28,9 +28,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.opentelemetry.io/otel/exporters/otlp/internal/otlpconfig"
 
-	jsonpb "google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
@@ -114,25 +115,8 @@ func NewDriver(opts ...Option) otlp.ProtocolDriver {
 		cfg.Backoff = DefaultBackoff
 	}
 
-	metricsClient := &http.Client{
-		Transport: ourTransport,
-		Timeout:   cfg.Metrics.Timeout,
-	}
-	if cfg.Metrics.TLSCfg != nil {
-		transport := ourTransport.Clone()
-		transport.TLSClientConfig = cfg.Metrics.TLSCfg
-		metricsClient.Transport = transport
-	}
-
-	tracesClient := &http.Client{
-		Transport: ourTransport,
-		Timeout:   cfg.Traces.Timeout,
-	}
-	if cfg.Traces.TLSCfg != nil {
-		transport := ourTransport.Clone()
-		transport.TLSClientConfig = cfg.Traces.TLSCfg
-		tracesClient.Transport = transport
-	}
+	metricsClient := newHTTPClient(cfg, &cfg.Metrics)
+	tracesClient := newHTTPClient(cfg, &cfg.Traces)
 
 	stopCh := make(chan struct{})
 	return &driver{
@@ -155,6 +139,46 @@ func NewDriver(opts ...Option) otlp.ProtocolDriver {
 	}
 }
 
+// newHTTPClient returns the client sending the payloads of the signal
+// configured by sCfg, unless the client or its transport are set by the
+// user. Endpoints with the unix scheme are dialed through
+// their socket, and are replaced in sCfg by the host of the request URL.
+func newHTTPClient(cfg otlpconfig.Config, sCfg *otlpconfig.SignalConfig) *http.Client {
+	if cfg.HTTPClient != nil {
+		return cfg.HTTPClient
+	}
+	client := &http.Client{
+		Transport: ourTransport,
+		Timeout:   sCfg.Timeout,
+	}
+	if cfg.RoundTripper != nil {
+		client.Transport = cfg.RoundTripper
+		return client
+	}
+	socket, isUnix := otlpconfig.UnixSocketPath(sCfg.Endpoint)
+	if sCfg.TLSCfg == nil && cfg.Dialer == nil && !isUnix {
+		return client
+	}
+
+	transport := ourTransport.Clone()
+	if sCfg.TLSCfg != nil {
+		transport.TLSClientConfig = sCfg.TLSCfg
+	}
+	if cfg.Dialer != nil {
+		transport.DialContext = cfg.Dialer
+	}
+	if isUnix {
+		dial := transport.DialContext
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dial(ctx, "unix", socket)
+		}
+		transport.Proxy = nil
+		sCfg.Endpoint = "localhost"
+	}
+	client.Transport = transport
+	return client
+}
+
 // Start implements otlp.ProtocolDriver.
 func (d *driver) Start(ctx context.Context) error {
 	// nothing to do
@@ -204,7 +228,7 @@ func (d *driver) ExportTraces(ctx context.Context, ss []tracesdk.ReadOnlySpan) e
 
 func (d *driver) marshal(msg proto.Message) ([]byte, error) {
 	if d.cfg.Marshaler == otlp.MarshalJSON {
-		return jsonpb.Marshal(msg)
+		return marshalJSON(msg)
 	}
 	return proto.Marshal(msg)
 }
@@ -334,6 +358,23 @@ func (d *signalDriver) prepareBody(rawRequest []byte) (io.ReadCloser, int64, htt
 		headers.Set("Content-Encoding", "gzip")
 		bodyReader = preader
 		contentLength = -1
+	case otlp.ZstdCompression:
+		preader, pwriter := io.Pipe()
+		go func() {
+			zstder, err := zstd.NewWriter(pwriter, zstd.WithEncoderConcurrency(1))
+			if err != nil {
+				_ = pwriter.CloseWithError(err)
+				return
+			}
+			_, err = io.Copy(zstder, requestReader)
+			if err != nil {
+				otel.Handle(fmt.Errorf("otlphttp: failed to zstd request: %v", err))
+			}
+			_ = pwriter.CloseWithError(zstder.Close())
+		}()
+		headers.Set("Content-Encoding", "zstd")
+		bodyReader = preader
+		contentLength = -1
 	}
 	return bodyReader, contentLength, headers
 }
@@ -16,9 +16,15 @@ package otlphttp_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -61,6 +67,12 @@ func TestEndToEnd(t *testing.T) {
 				otlphttp.WithCompression(otlp.GzipCompression),
 			},
 		},
+		{
+			name: "with zstd compression",
+			opts: []otlphttp.Option{
+				otlphttp.WithCompression(otlp.ZstdCompression),
+			},
+		},
 		{
 			name: "with empty paths (forced to defaults)",
 			opts: []otlphttp.Option{
@@ -143,6 +155,143 @@ func TestEndToEnd(t *testing.T) {
 	}
 }
 
+func TestJSONEncoding(t *testing.T) {
+	var (
+		contentType string
+		body        []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	driver := otlphttp.NewDriver(
+		otlphttp.WithEndpoint(strings.TrimPrefix(srv.URL, "http://")),
+		otlphttp.WithInsecure(),
+		otlphttp.WithMarshal(otlp.MarshalJSON),
+	)
+	ctx := context.Background()
+	exporter, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	require.NoError(t, exporter.ExportSpans(ctx, otlptest.SingleReadOnlySpan()))
+
+	assert.Equal(t, "application/json", contentType)
+	var request struct {
+		ResourceSpans []struct {
+			InstrumentationLibrarySpans []struct {
+				Spans []map[string]interface{}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(body, &request))
+	span := request.ResourceSpans[0].InstrumentationLibrarySpans[0].Spans[0]
+	// The OTLP/JSON mapping encodes IDs as hex strings and enums as
+	// integers.
+	assert.Equal(t, "02030405060708090203040506070809", span["traceId"])
+	assert.Equal(t, "0304050607080900", span["spanId"])
+	assert.Equal(t, "0102030405060708", span["parentSpanId"])
+	assert.Equal(t, float64(1), span["kind"])
+}
+
+func TestUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otlphttp")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "otel.sock")
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	var paths []string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.Listener = ln
+	srv.Start()
+	defer srv.Close()
+
+	driver := otlphttp.NewDriver(
+		otlphttp.WithEndpoint("unix://"+socket),
+		otlphttp.WithInsecure(),
+	)
+	ctx := context.Background()
+	exporter, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	require.NoError(t, exporter.ExportSpans(ctx, otlptest.SingleReadOnlySpan()))
+	assert.Equal(t, []string{otlphttp.DefaultTracesPath}, paths)
+}
+
+func TestDialer(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+
+	var dialed []string
+	dialer := func(ctx context.Context, network, address string) (net.Conn, error) {
+		dialed = append(dialed, address)
+		return (&net.Dialer{}).DialContext(ctx, network, mc.Endpoint())
+	}
+	driver := otlphttp.NewDriver(
+		otlphttp.WithEndpoint("collector.invalid:4318"),
+		otlphttp.WithInsecure(),
+		otlphttp.WithDialer(dialer),
+	)
+	ctx := context.Background()
+	exporter, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	require.NoError(t, exporter.ExportSpans(ctx, otlptest.SingleReadOnlySpan()))
+	assert.Equal(t, []string{"collector.invalid:4318"}, dialed)
+	assert.Len(t, mc.GetSpans(), 1)
+}
+
+func TestRoundTripper(t *testing.T) {
+	var signatures []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signatures = append(signatures, r.Header.Get("X-Signature"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var rt roundTripperFunc = func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.Header.Set("X-Signature", "signed")
+		return http.DefaultTransport.RoundTrip(r)
+	}
+	for _, opt := range []otlphttp.Option{
+		otlphttp.WithRoundTripper(rt),
+		otlphttp.WithHTTPClient(&http.Client{Transport: rt}),
+	} {
+		signatures = nil
+		driver := otlphttp.NewDriver(
+			otlphttp.WithEndpoint(strings.TrimPrefix(srv.URL, "http://")),
+			otlphttp.WithInsecure(),
+			opt,
+		)
+		ctx := context.Background()
+		exporter, err := otlp.NewExporter(ctx, driver)
+		require.NoError(t, err)
+		require.NoError(t, exporter.ExportSpans(ctx, otlptest.SingleReadOnlySpan()))
+		assert.NoError(t, exporter.Shutdown(ctx))
+		assert.Equal(t, []string{"signed"}, signatures)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
 func TestRetry(t *testing.T) {
 	statuses := []int{
 		http.StatusTooManyRequests,
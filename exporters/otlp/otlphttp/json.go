@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlphttp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// idFields are the names of the JSON fields holding trace and span IDs,
+// in spans, span links and metric exemplars.
+var idFields = map[string]bool{
+	"traceId":      true,
+	"spanId":       true,
+	"parentSpanId": true,
+}
+
+// marshalJSON encodes msg with the OTLP/JSON mapping of the Protobuf
+// encoding, which differs from the standard mapping in that enum values
+// are encoded as integers and trace and span IDs are encoded as hex
+// strings instead of base64.
+func marshalJSON(msg proto.Message) ([]byte, error) {
+	raw, err := jsonpb.MarshalOptions{UseEnumNumbers: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode numbers as json.Number to preserve them exactly.
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if err := hexEncodeIDs(v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// hexEncodeIDs replaces the base64 encoded values of the ID fields of
+// the decoded JSON value v with their hex encoding.
+func hexEncodeIDs(v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if s, ok := field.(string); ok && idFields[key] {
+				id, err := base64.StdEncoding.DecodeString(s)
+				if err != nil {
+					return err
+				}
+				v[key] = hex.EncodeToString(id)
+				continue
+			}
+			if err := hexEncodeIDs(field); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if err := hexEncodeIDs(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -19,6 +19,9 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -27,6 +30,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	jsonpb "google.golang.org/protobuf/encoding/protojson"
@@ -134,7 +138,7 @@ func (c *mockCollector) serveMetrics(w http.ResponseWriter, r *http.Request) {
 func unmarshalMetricsRequest(rawRequest []byte, contentType string) (*collectormetricpb.ExportMetricsServiceRequest, error) {
 	request := &collectormetricpb.ExportMetricsServiceRequest{}
 	if contentType == "application/json" {
-		err := jsonpb.Unmarshal(rawRequest, request)
+		err := unmarshalJSON(rawRequest, request)
 		return request, err
 	}
 	err := proto.Unmarshal(rawRequest, request)
@@ -184,13 +188,59 @@ func (c *mockCollector) serveTraces(w http.ResponseWriter, r *http.Request) {
 func unmarshalTraceRequest(rawRequest []byte, contentType string) (*collectortracepb.ExportTraceServiceRequest, error) {
 	request := &collectortracepb.ExportTraceServiceRequest{}
 	if contentType == "application/json" {
-		err := jsonpb.Unmarshal(rawRequest, request)
+		err := unmarshalJSON(rawRequest, request)
 		return request, err
 	}
 	err := proto.Unmarshal(rawRequest, request)
 	return request, err
 }
 
+// unmarshalJSON decodes an OTLP/JSON payload, in which trace and span
+// IDs are hex encoded, into msg.
+func unmarshalJSON(rawRequest []byte, msg proto.Message) error {
+	dec := json.NewDecoder(bytes.NewReader(rawRequest))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if err := base64EncodeIDs(v); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return jsonpb.Unmarshal(raw, msg)
+}
+
+func base64EncodeIDs(v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			s, ok := field.(string)
+			if ok && (key == "traceId" || key == "spanId" || key == "parentSpanId") {
+				id, err := hex.DecodeString(s)
+				if err != nil {
+					return err
+				}
+				v[key] = base64.StdEncoding.EncodeToString(id)
+				continue
+			}
+			if err := base64EncodeIDs(field); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if err := base64EncodeIDs(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (c *mockCollector) checkHeaders(r *http.Request) bool {
 	for k, v := range c.expectedHeaders {
 		got := r.Header.Get(k)
@@ -214,12 +264,24 @@ func (c *mockCollector) getInjectHTTPStatus() int {
 }
 
 func readRequest(r *http.Request) ([]byte, error) {
-	if r.Header.Get("Content-Encoding") == "gzip" {
+	switch r.Header.Get("Content-Encoding") {
+	case "gzip":
 		return readGzipBody(r.Body)
+	case "zstd":
+		return readZstdBody(r.Body)
 	}
 	return ioutil.ReadAll(r.Body)
 }
 
+func readZstdBody(body io.Reader) ([]byte, error) {
+	dec, err := zstd.NewReader(body)
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+	return ioutil.ReadAll(dec)
+}
+
 func readGzipBody(body io.Reader) ([]byte, error) {
 	rawRequest := bytes.Buffer{}
 	gunzipper, err := gzip.NewReader(body)
@@ -15,7 +15,10 @@
 package otlphttp
 
 import (
+	"context"
 	"crypto/tls"
+	"net"
+	"net/http"
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp"
@@ -58,7 +61,9 @@ func (w wrappedOption) applyHTTPOption(cfg *otlpconfig.Config) {
 // endpoint that the driver will use to send metrics and spans. If
 // unset, it will instead try to use
 // DefaultCollectorHost:DefaultCollectorPort. Note that the endpoint
-// must not contain any URL path.
+// must not contain any URL path. An endpoint with the unix scheme, like
+// unix:///var/run/otel.sock, sends the payloads through a Unix domain
+// socket.
 func WithEndpoint(endpoint string) Option {
 	return wrappedOption{otlpconfig.WithEndpoint(endpoint)}
 }
@@ -120,6 +125,14 @@ func WithBackoff(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithBackoff(duration)}
 }
 
+// WithDialer sets the function used to establish the connections to
+// the collector, instead of the default dialer of the HTTP transport.
+// The network passed to dialer is "unix" for Unix domain socket
+// endpoints, in which case the address is the path of the socket.
+func WithDialer(dialer func(ctx context.Context, network, address string) (net.Conn, error)) Option {
+	return wrappedOption{otlpconfig.WithDialer(dialer)}
+}
+
 // WithTLSClientConfig can be used to set up a custom TLS
 // configuration for the client used to send payloads to the
 // collector. Use it if you want to use a custom certificate.
@@ -127,6 +140,18 @@ func WithTLSClientConfig(tlsCfg *tls.Config) Option {
 	return wrappedOption{otlpconfig.WithTLSClientConfig(tlsCfg)}
 }
 
+// WithTLSFiles configures the transport security from PEM files, like
+// the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables. The server
+// certificate is verified with the CA certificates of the certificate
+// file, and the clientCertificate and clientKey files are used for
+// mutual TLS. Empty paths are ignored. The files are read again when
+// they are modified, so rotated certificates are picked up by the next
+// connection to the collector.
+func WithTLSFiles(certificate, clientCertificate, clientKey string) Option {
+	return wrappedOption{otlpconfig.WithTLSFiles(certificate, clientCertificate, clientKey)}
+}
+
 // WithTracesTLSClientConfig can be used to set up a custom TLS
 // configuration for the client used to send traces.
 // Use it if you want to use a custom certificate.
@@ -181,7 +206,9 @@ func WithMetricsHeaders(headers map[string]string) Option {
 }
 
 // WithMarshal tells the driver which wire format to use when sending to the
-// collector.  If unset, MarshalProto will be used
+// collector.  If unset, MarshalProto will be used.  MarshalJSON sends
+// application/json payloads following the OTLP/JSON mapping, with trace
+// and span IDs encoded as hex strings and enum values as integers.
 func WithMarshal(m otlp.Marshaler) Option {
 	return wrappedOption{otlpconfig.NewHTTPOption(func(cfg *otlpconfig.Config) {
 		cfg.Marshaler = m
@@ -205,3 +232,27 @@ func WithTracesTimeout(duration time.Duration) Option {
 func WithMetricsTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithMetricsTimeout(duration)}
 }
+
+// WithHTTPClient sets the client used to send the payloads to the
+// collector. The client is used as is: its Timeout is the time limit
+// of the requests, and the TLS and dialer options are
+// ignored. Use it to route the exports through a proxy, sign the
+// requests, or instrument them.
+func WithHTTPClient(client *http.Client) Option {
+	return wrappedOption{otlpconfig.NewHTTPOption(func(cfg *otlpconfig.Config) {
+		cfg.HTTPClient = client
+	})}
+}
+
+// WithRoundTripper sets the transport of the client used to send the
+// payloads to the collector, for instance a wrapper of
+// http.DefaultTransport that signs the requests. The TLS and dialer
+// options are ignored. The default transport uses the
+// proxy configured by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables. WithHTTPClient takes precedence over this
+// option.
+func WithRoundTripper(rt http.RoundTripper) Option {
+	return wrappedOption{otlpconfig.NewHTTPOption(func(cfg *otlpconfig.Config) {
+		cfg.RoundTripper = rt
+	})}
+}
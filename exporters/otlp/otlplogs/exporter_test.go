@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplogs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+	sdklogs "go.opentelemetry.io/otel/sdk/logs"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type recordingClient struct {
+	started int
+	stopped int
+	uploads [][]*logspb.ResourceLogs
+}
+
+var _ otlplogs.Client = (*recordingClient)(nil)
+
+func (c *recordingClient) Start(context.Context) error {
+	c.started++
+	return nil
+}
+
+func (c *recordingClient) Stop(ctx context.Context) error {
+	c.stopped++
+	return ctx.Err()
+}
+
+func (c *recordingClient) UploadLogs(_ context.Context, protoLogs []*logspb.ResourceLogs) error {
+	c.uploads = append(c.uploads, protoLogs)
+	return nil
+}
+
+func TestExporterStartShutdown(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{}
+	exp, err := otlplogs.NewExporter(ctx, client)
+	require.NoError(t, err)
+	assert.Error(t, exp.Start(ctx), "second start must fail")
+	assert.Equal(t, 1, client.started)
+
+	require.NoError(t, exp.Shutdown(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Equal(t, 1, client.stopped)
+}
+
+func TestUnstartedExporterShutdown(t *testing.T) {
+	client := &recordingClient{}
+	exp := otlplogs.NewUnstartedExporter(client)
+	require.NoError(t, exp.Shutdown(context.Background()))
+	assert.Equal(t, 0, client.stopped)
+}
+
+func TestExporterExportLogs(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{}
+	exp, err := otlplogs.NewExporter(ctx, client)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.ExportLogs(ctx, nil))
+	assert.Len(t, client.uploads, 0, "empty batches must not be uploaded")
+
+	require.NoError(t, exp.ExportLogs(ctx, []sdklogs.Record{
+		{Body: attribute.StringValue("hello")},
+	}))
+	require.Len(t, client.uploads, 1)
+	require.Len(t, client.uploads[0], 1)
+	ills := client.uploads[0][0].InstrumentationLibraryLogs
+	require.Len(t, ills, 1)
+	require.Len(t, ills[0].Logs, 1)
+	assert.Equal(t, "hello", ills[0].Logs[0].Body.GetStringValue())
+}
@@ -0,0 +1,102 @@
+module go.opentelemetry.io/otel/exporters/otlp/otlplogs
+
+go 1.15
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.20.0
+	go.opentelemetry.io/otel/log v0.20.0
+	go.opentelemetry.io/otel/sdk v0.20.0
+	go.opentelemetry.io/otel/trace v0.20.0
+	go.opentelemetry.io/proto/otlp v0.8.0
+	google.golang.org/grpc v1.37.1
+	google.golang.org/protobuf v1.26.0
+)
+
+replace go.opentelemetry.io/otel => ../../..
+
+replace go.opentelemetry.io/otel/bridge/opencensus => ../../../bridge/opencensus
+
+replace go.opentelemetry.io/otel/bridge/opentracing => ../../../bridge/opentracing
+
+replace go.opentelemetry.io/otel/example/jaeger => ../../../example/jaeger
+
+replace go.opentelemetry.io/otel/example/namedtracer => ../../../example/namedtracer
+
+replace go.opentelemetry.io/otel/example/opencensus => ../../../example/opencensus
+
+replace go.opentelemetry.io/otel/example/otel-collector => ../../../example/otel-collector
+
+replace go.opentelemetry.io/otel/example/passthrough => ../../../example/passthrough
+
+replace go.opentelemetry.io/otel/example/prom-collector => ../../../example/prom-collector
+
+replace go.opentelemetry.io/otel/example/prometheus => ../../../example/prometheus
+
+replace go.opentelemetry.io/otel/example/zipkin => ../../../example/zipkin
+
+replace go.opentelemetry.io/otel/exporters/metric/prometheus => ../../metric/prometheus
+
+replace go.opentelemetry.io/otel/exporters/otlp => ../
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs => ./
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlptrace => ../otlptrace
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc => ../otlptrace/otlptracegrpc
+
+replace go.opentelemetry.io/otel/exporters/trace/jaeger => ../../trace/jaeger
+
+replace go.opentelemetry.io/otel/exporters/trace/zipkin => ../../trace/zipkin
+
+replace go.opentelemetry.io/otel/internal/tools => ../../../internal/tools
+
+replace go.opentelemetry.io/otel/metric => ../../../metric
+
+replace go.opentelemetry.io/otel/oteltest => ../../../oteltest
+
+replace go.opentelemetry.io/otel/sdk => ../../../sdk
+
+replace go.opentelemetry.io/otel/sdk/export/metric => ../../../sdk/export/metric
+
+replace go.opentelemetry.io/otel/sdk/metric => ../../../sdk/metric
+
+replace go.opentelemetry.io/otel/trace => ../../../trace
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc => ./otlplogsgrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp => ./otlplogshttp
+
+replace go.opentelemetry.io/otel/samplers/jaegerremote => ../../../samplers/jaegerremote
+
+replace go.opentelemetry.io/otel/sdk/config => ../../../sdk/config
+
+replace go.opentelemetry.io/otel/exporters/autoexport => ../../autoexport
+
+replace go.opentelemetry.io/otel/log => ../../../log
+
+replace go.opentelemetry.io/otel/bridge/otelslog => ../../../bridge/otelslog
+
+replace go.opentelemetry.io/otel/propagators/aws => ../../../propagators/aws
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdoutmetric => ../../stdout/stdoutmetric
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdouttrace => ../../stdout/stdouttrace
+
+replace go.opentelemetry.io/otel/exporters/file => ../../file
+
+replace go.opentelemetry.io/otel/schema => ../../../schema
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric => ../otlpmetric
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp => ../otlpmetric/otlpmetrichttp
+
+replace go.opentelemetry.io/otel/zpages => ../../../zpages
+
+replace go.opentelemetry.io/otel/instrumentation/runtime => ../../../instrumentation/runtime
+
+replace go.opentelemetry.io/otel/instrumentation/host => ../../../instrumentation/host
+
+replace go.opentelemetry.io/otel/instrumentation/net/http/otelhttp => ../../../instrumentation/net/http/otelhttp
+
+replace go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc => ../../../instrumentation/google.golang.org/grpc/otelgrpc
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logstransform
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklogs "go.opentelemetry.io/otel/sdk/logs"
+)
+
+// Logs transforms a slice of OpenTelemetry log records into a slice of OTLP
+// ResourceLogs.
+func Logs(records []sdklogs.Record) []*logspb.ResourceLogs {
+	if len(records) == 0 {
+		return nil
+	}
+
+	rlm := make(map[attribute.Distinct]*logspb.ResourceLogs)
+
+	type illKey struct {
+		r  attribute.Distinct
+		il instrumentation.Library
+	}
+	illm := make(map[illKey]*logspb.InstrumentationLibraryLogs)
+
+	var resources int
+	for _, r := range records {
+		rKey := r.Resource.Equivalent()
+		iKey := illKey{
+			r:  rKey,
+			il: r.InstrumentationLibrary,
+		}
+		ill, iOk := illm[iKey]
+		if !iOk {
+			// Either the resource or instrumentation library were unknown.
+			ill = &logspb.InstrumentationLibraryLogs{
+				InstrumentationLibrary: InstrumentationLibrary(r.InstrumentationLibrary),
+				Logs:                   []*logspb.LogRecord{},
+			}
+		}
+		ill.Logs = append(ill.Logs, logRecord(r))
+		illm[iKey] = ill
+
+		rl, rOk := rlm[rKey]
+		if !rOk {
+			resources++
+			// The resource was unknown.
+			rl = &logspb.ResourceLogs{
+				Resource:                   Resource(r.Resource),
+				InstrumentationLibraryLogs: []*logspb.InstrumentationLibraryLogs{ill},
+			}
+			rlm[rKey] = rl
+			continue
+		}
+
+		// The resource has been seen before. Check if the instrumentation
+		// library lookup was unknown because if so we need to add it to the
+		// ResourceLogs. Otherwise, the instrumentation library has already
+		// been seen and the append we did above will be included it in the
+		// InstrumentationLibraryLogs reference.
+		if !iOk {
+			rl.InstrumentationLibraryLogs = append(rl.InstrumentationLibraryLogs, ill)
+		}
+	}
+
+	// Transform the categorized map into a slice
+	rls := make([]*logspb.ResourceLogs, 0, resources)
+	for _, rl := range rlm {
+		rls = append(rls, rl)
+	}
+	return rls
+}
+
+// logRecord transforms a Record into an OTLP LogRecord.
+func logRecord(r sdklogs.Record) *logspb.LogRecord {
+	lr := &logspb.LogRecord{
+		SeverityNumber:         logspb.SeverityNumber(r.Severity),
+		SeverityText:           r.SeverityText,
+		Name:                   r.Name,
+		Body:                   body(r.Body),
+		Attributes:             Attributes(r.Attributes),
+		DroppedAttributesCount: uint32(r.DroppedAttributeCount),
+	}
+	if !r.Timestamp.IsZero() {
+		lr.TimeUnixNano = uint64(r.Timestamp.UnixNano())
+	}
+	if r.SpanContext.IsValid() {
+		tid := r.SpanContext.TraceID()
+		sid := r.SpanContext.SpanID()
+		lr.TraceId = tid[:]
+		lr.SpanId = sid[:]
+		lr.Flags = uint32(r.SpanContext.TraceFlags())
+	}
+	return lr
+}
+
+// body transforms the body of a Record into an OTLP AnyValue. An unset body
+// is not transmitted.
+func body(v attribute.Value) *commonpb.AnyValue {
+	if v.Type() == attribute.INVALID {
+		return nil
+	}
+	return Value(v)
+}
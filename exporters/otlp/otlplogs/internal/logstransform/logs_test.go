@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logstransform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklogs "go.opentelemetry.io/otel/sdk/logs"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestNilRecords(t *testing.T) {
+	assert.Nil(t, Logs(nil))
+}
+
+func TestEmptyRecords(t *testing.T) {
+	assert.Nil(t, Logs([]sdklogs.Record{}))
+}
+
+func TestSeverity(t *testing.T) {
+	for _, test := range []struct {
+		severity log.Severity
+		expected logspb.SeverityNumber
+	}{
+		{log.SeverityUndefined, logspb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED},
+		{log.SeverityTrace, logspb.SeverityNumber_SEVERITY_NUMBER_TRACE},
+		{log.SeverityDebug2, logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG2},
+		{log.SeverityInfo, logspb.SeverityNumber_SEVERITY_NUMBER_INFO},
+		{log.SeverityWarn3, logspb.SeverityNumber_SEVERITY_NUMBER_WARN3},
+		{log.SeverityError, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR},
+		{log.SeverityFatal4, logspb.SeverityNumber_SEVERITY_NUMBER_FATAL4},
+	} {
+		lr := logRecord(sdklogs.Record{Severity: test.severity})
+		assert.Equal(t, test.expected, lr.SeverityNumber)
+	}
+}
+
+func TestRecordNoBodyNoSpanContext(t *testing.T) {
+	lr := logRecord(sdklogs.Record{Name: "empty"})
+	assert.Nil(t, lr.Body)
+	assert.Nil(t, lr.TraceId)
+	assert.Nil(t, lr.SpanId)
+	assert.Zero(t, lr.Flags)
+	assert.Zero(t, lr.TimeUnixNano)
+}
+
+func TestRecord(t *testing.T) {
+	now := time.Now()
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	res := resource.NewWithAttributes(attribute.String("rk", "rv"))
+	il := instrumentation.Library{Name: "logger", Version: "v0.1.0"}
+
+	got := Logs([]sdklogs.Record{
+		{
+			Timestamp:              now,
+			Severity:               log.SeverityWarn,
+			SeverityText:           "WARNING",
+			Name:                   "disk.full",
+			Body:                   attribute.StringValue("disk is full"),
+			Attributes:             []attribute.KeyValue{attribute.Int64("free", 0)},
+			DroppedAttributeCount:  2,
+			SpanContext:            sc,
+			Resource:               res,
+			InstrumentationLibrary: il,
+		},
+	})
+
+	tid := sc.TraceID()
+	sid := sc.SpanID()
+	expected := &logspb.ResourceLogs{
+		Resource: Resource(res),
+		InstrumentationLibraryLogs: []*logspb.InstrumentationLibraryLogs{
+			{
+				InstrumentationLibrary: InstrumentationLibrary(il),
+				Logs: []*logspb.LogRecord{
+					{
+						TimeUnixNano:   uint64(now.UnixNano()),
+						SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+						SeverityText:   "WARNING",
+						Name:           "disk.full",
+						Body: &commonpb.AnyValue{
+							Value: &commonpb.AnyValue_StringValue{StringValue: "disk is full"},
+						},
+						Attributes: []*commonpb.KeyValue{
+							{
+								Key:   "free",
+								Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 0}},
+							},
+						},
+						DroppedAttributesCount: 2,
+						Flags:                  uint32(trace.FlagsSampled),
+						TraceId:                tid[:],
+						SpanId:                 sid[:],
+					},
+				},
+			},
+		},
+	}
+
+	require.Len(t, got, 1)
+	assert.True(t, proto.Equal(expected, got[0]), "got: %v", got[0])
+}
+
+func TestRecordsGroupedByResourceAndLibrary(t *testing.T) {
+	r1 := resource.NewWithAttributes(attribute.String("service.name", "one"))
+	r2 := resource.NewWithAttributes(attribute.String("service.name", "two"))
+	il1 := instrumentation.Library{Name: "a"}
+	il2 := instrumentation.Library{Name: "b"}
+
+	got := Logs([]sdklogs.Record{
+		{Name: "1", Resource: r1, InstrumentationLibrary: il1},
+		{Name: "2", Resource: r1, InstrumentationLibrary: il1},
+		{Name: "3", Resource: r1, InstrumentationLibrary: il2},
+		{Name: "4", Resource: r2, InstrumentationLibrary: il1},
+		{Name: "5"},
+	})
+	require.Len(t, got, 3)
+
+	counts := make(map[string][]int)
+	for _, rl := range got {
+		var key string
+		if rl.Resource != nil && len(rl.Resource.Attributes) > 0 {
+			key = rl.Resource.Attributes[0].Value.GetStringValue()
+		}
+		for _, ill := range rl.InstrumentationLibraryLogs {
+			counts[key] = append(counts[key], len(ill.Logs))
+		}
+	}
+	assert.ElementsMatch(t, []int{2, 1}, counts["one"])
+	assert.Equal(t, []int{1}, counts["two"])
+	assert.Equal(t, []int{1}, counts[""])
+}
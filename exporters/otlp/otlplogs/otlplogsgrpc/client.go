@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplogsgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc"
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs/internal/otlpconfig"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type client struct {
+	cfg      otlpconfig.Config
+	metadata metadata.MD
+
+	// stopCh is closed when the client is stopped, interrupting all
+	// in-flight exports.
+	stopCh chan struct{}
+
+	mu         sync.RWMutex
+	conn       *grpc.ClientConn
+	logsClient collogspb.LogsServiceClient
+}
+
+var _ otlplogs.Client = (*client)(nil)
+
+var (
+	errNoClient = errors.New("no client")
+)
+
+// NewClient creates a new gRPC logs client.
+func NewClient(opts ...Option) otlplogs.Client {
+	cfg := otlpconfig.NewDefaultConfig()
+	for _, opt := range opts {
+		opt.applyGRPCOption(&cfg)
+	}
+
+	c := &client{
+		cfg:    cfg,
+		stopCh: make(chan struct{}),
+	}
+	if len(cfg.Logs.Headers) > 0 {
+		c.metadata = metadata.New(cfg.Logs.Headers)
+	}
+	return c
+}
+
+// Start establishes a connection to the collector.
+func (c *client) Start(ctx context.Context) error {
+	dialOpts := []grpc.DialOption{}
+	if c.cfg.ServiceConfig != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultServiceConfig(c.cfg.ServiceConfig))
+	}
+	if c.cfg.Logs.GRPCCredentials != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(c.cfg.Logs.GRPCCredentials))
+	} else if c.cfg.Logs.Insecure {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	if c.cfg.Logs.Compression == otlpconfig.GzipCompression {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
+	if len(c.cfg.DialOptions) != 0 {
+		dialOpts = append(dialOpts, c.cfg.DialOptions...)
+	}
+
+	conn, err := grpc.DialContext(ctx, c.cfg.Logs.Endpoint, dialOpts...)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.conn = conn
+	c.logsClient = collogspb.NewLogsServiceClient(conn)
+	c.mu.Unlock()
+	return nil
+}
+
+// Stop shuts down the connection to the collector.
+func (c *client) Stop(ctx context.Context) error {
+	close(c.stopCh)
+
+	c.mu.Lock()
+	conn := c.conn
+	c.conn = nil
+	c.logsClient = nil
+	c.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- conn.Close() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// UploadLogs sends a batch of log records to the collector. If the batch
+// is not sent before the configured timeout, or the deadline of ctx if it
+// is earlier, an otlplogs.DeadlineExceeded is returned.
+func (c *client) UploadLogs(ctx context.Context, protoLogs []*logspb.ResourceLogs) error {
+	c.mu.RLock()
+	logsClient := c.logsClient
+	c.mu.RUnlock()
+	if logsClient == nil {
+		return errNoClient
+	}
+
+	ctx, cancel := c.contextWithStop(ctx)
+	defer cancel()
+	if c.cfg.Logs.Timeout > 0 {
+		var tCancel context.CancelFunc
+		ctx, tCancel = context.WithTimeout(ctx, c.cfg.Logs.Timeout)
+		defer tCancel()
+	}
+	if c.metadata.Len() > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, c.metadata)
+	}
+
+	_, err := logsClient.Export(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: protoLogs,
+	})
+	if err != nil && (ctx.Err() == context.DeadlineExceeded || status.Code(err) == codes.DeadlineExceeded) {
+		return otlplogs.DeadlineExceeded{Err: err}
+	}
+	return err
+}
+
+func (c *client) contextWithStop(ctx context.Context) (context.Context, context.CancelFunc) {
+	// Unify the parent context Done signal with the client's stop
+	// channel.
+	ctx, cancel := context.WithCancel(ctx)
+	go func(ctx context.Context, cancel context.CancelFunc) {
+		select {
+		case <-ctx.Done():
+			// Nothing to do, either cancelled or deadline
+			// happened.
+		case <-c.stopCh:
+			cancel()
+		}
+	}(ctx, cancel)
+	return ctx, cancel
+}
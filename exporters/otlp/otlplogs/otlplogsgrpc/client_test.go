@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplogsgrpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc"
+	"go.opentelemetry.io/otel/log"
+	sdklogs "go.opentelemetry.io/otel/sdk/logs"
+	"go.opentelemetry.io/otel/sdk/resource"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type mockLogsService struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	mu       sync.Mutex
+	err      error
+	delay    time.Duration
+	headers  metadata.MD
+	requests int
+	logs     []*logspb.ResourceLogs
+}
+
+func (m *mockLogsService) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	if m.delay > 0 {
+		select {
+		case <-time.After(m.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests++
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.headers, _ = metadata.FromIncomingContext(ctx)
+	m.logs = append(m.logs, req.ResourceLogs...)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (m *mockLogsService) getLogs() []*logspb.ResourceLogs {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.logs
+}
+
+func runMockCollector(t *testing.T, svc *mockLogsService) string {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(srv, svc)
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	return ln.Addr().String()
+}
+
+var records = []sdklogs.Record{
+	{
+		Timestamp:    time.Unix(1000, 0),
+		Severity:     log.SeverityInfo,
+		SeverityText: "INFO",
+		Body:         attribute.StringValue("hello"),
+		Resource:     resource.NewWithAttributes(attribute.String("service.name", "test")),
+	},
+}
+
+func TestExportLogs(t *testing.T) {
+	svc := &mockLogsService{}
+	endpoint := runMockCollector(t, svc)
+
+	ctx := context.Background()
+	exp, err := otlplogsgrpc.NewExporter(ctx,
+		otlplogsgrpc.WithInsecure(),
+		otlplogsgrpc.WithEndpoint(endpoint),
+		otlplogsgrpc.WithHeaders(map[string]string{"header1": "value1"}),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.ExportLogs(ctx, records))
+
+	got := svc.getLogs()
+	require.Len(t, got, 1)
+	require.Len(t, got[0].InstrumentationLibraryLogs, 1)
+	require.Len(t, got[0].InstrumentationLibraryLogs[0].Logs, 1)
+	lr := got[0].InstrumentationLibraryLogs[0].Logs[0]
+	assert.Equal(t, "hello", lr.Body.GetStringValue())
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, lr.SeverityNumber)
+	assert.Equal(t, []string{"value1"}, svc.headers.Get("header1"))
+}
+
+func TestExportLogsError(t *testing.T) {
+	svc := &mockLogsService{err: status.Error(codes.InvalidArgument, "bad request")}
+	endpoint := runMockCollector(t, svc)
+
+	ctx := context.Background()
+	exp, err := otlplogsgrpc.NewExporter(ctx, otlplogsgrpc.WithInsecure(), otlplogsgrpc.WithEndpoint(endpoint))
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	err = exp.ExportLogs(ctx, records)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExportLogsTimeout(t *testing.T) {
+	svc := &mockLogsService{delay: time.Second}
+	endpoint := runMockCollector(t, svc)
+
+	ctx := context.Background()
+	exp, err := otlplogsgrpc.NewExporter(ctx,
+		otlplogsgrpc.WithInsecure(),
+		otlplogsgrpc.WithEndpoint(endpoint),
+		otlplogsgrpc.WithTimeout(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	err = exp.ExportLogs(ctx, records)
+	var de otlplogs.DeadlineExceeded
+	require.True(t, errors.As(err, &de), "error must be a DeadlineExceeded: %v", err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(de.Err))
+}
+
+func TestExportLogsContextDeadline(t *testing.T) {
+	svc := &mockLogsService{delay: time.Second}
+	endpoint := runMockCollector(t, svc)
+
+	ctx := context.Background()
+	exp, err := otlplogsgrpc.NewExporter(ctx,
+		otlplogsgrpc.WithInsecure(),
+		otlplogsgrpc.WithEndpoint(endpoint),
+		otlplogsgrpc.WithTimeout(time.Minute),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	exportCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = exp.ExportLogs(exportCtx, records)
+	var de otlplogs.DeadlineExceeded
+	require.True(t, errors.As(err, &de), "error must be a DeadlineExceeded: %v", err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestExportAfterShutdown(t *testing.T) {
+	svc := &mockLogsService{}
+	endpoint := runMockCollector(t, svc)
+
+	ctx := context.Background()
+	exp, err := otlplogsgrpc.NewExporter(ctx, otlplogsgrpc.WithInsecure(), otlplogsgrpc.WithEndpoint(endpoint))
+	require.NoError(t, err)
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Error(t, exp.ExportLogs(ctx, records))
+	assert.Empty(t, svc.getLogs())
+}
@@ -0,0 +1,294 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplogshttp // import "go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs/internal/otlpconfig"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+const contentTypeProto = "application/x-protobuf"
+
+// Keep it in sync with golang's DefaultTransport from net/http! We
+// have our own copy to avoid handling a situation where the
+// DefaultTransport is overwritten with some different implementation
+// of http.RoundTripper or it's modified by other package.
+var ourTransport = &http.Transport{
+	Proxy: http.ProxyFromEnvironment,
+	DialContext: (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext,
+	ForceAttemptHTTP2:     true,
+	MaxIdleConns:          100,
+	IdleConnTimeout:       90 * time.Second,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ExpectContinueTimeout: 1 * time.Second,
+}
+
+type client struct {
+	cfg    otlpconfig.Config
+	client *http.Client
+	stopCh chan struct{}
+}
+
+var _ otlplogs.Client = (*client)(nil)
+
+// NewClient creates a new HTTP logs client.
+func NewClient(opts ...Option) otlplogs.Client {
+	cfg := otlpconfig.NewDefaultConfig()
+	for _, opt := range opts {
+		opt.applyHTTPOption(&cfg)
+	}
+
+	tmp := strings.TrimSpace(cfg.Logs.URLPath)
+	if tmp == "" {
+		tmp = otlpconfig.DefaultLogsPath
+	} else {
+		tmp = path.Clean(tmp)
+		if !path.IsAbs(tmp) {
+			tmp = fmt.Sprintf("/%s", tmp)
+		}
+	}
+	cfg.Logs.URLPath = tmp
+	if cfg.MaxAttempts <= 0 || cfg.MaxAttempts > otlpconfig.DefaultMaxAttempts {
+		cfg.MaxAttempts = otlpconfig.DefaultMaxAttempts
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = otlpconfig.DefaultBackoff
+	}
+
+	httpClient := &http.Client{
+		Transport: ourTransport,
+		Timeout:   cfg.Logs.Timeout,
+	}
+	if cfg.HTTPClient != nil {
+		httpClient = cfg.HTTPClient
+	} else if cfg.RoundTripper != nil {
+		httpClient.Transport = cfg.RoundTripper
+	} else if cfg.Logs.TLSCfg != nil {
+		transport := ourTransport.Clone()
+		transport.TLSClientConfig = cfg.Logs.TLSCfg
+		httpClient.Transport = transport
+	}
+
+	return &client{
+		cfg:    cfg,
+		client: httpClient,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start does nothing in a HTTP client.
+func (c *client) Start(ctx context.Context) error {
+	// nothing to do
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	return nil
+}
+
+// Stop shuts down the client and interrupts any in-flight request.
+func (c *client) Stop(ctx context.Context) error {
+	close(c.stopCh)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	return nil
+}
+
+// UploadLogs sends a batch of log records to the collector. If the batch
+// is not sent before the configured timeout, or the deadline of ctx if it
+// is earlier, an otlplogs.DeadlineExceeded is returned.
+func (c *client) UploadLogs(ctx context.Context, protoLogs []*logspb.ResourceLogs) error {
+	ctx, cancel := c.contextWithStop(ctx)
+	defer cancel()
+	if c.cfg.Logs.Timeout > 0 {
+		var tCancel context.CancelFunc
+		ctx, tCancel = context.WithTimeout(ctx, c.cfg.Logs.Timeout)
+		defer tCancel()
+	}
+	if err := c.send(ctx, protoLogs); err != nil {
+		if ctx.Err() == context.DeadlineExceeded || isTimeout(err) {
+			return otlplogs.DeadlineExceeded{Err: err}
+		}
+		return err
+	}
+	return nil
+}
+
+// isTimeout returns true if err is returned because a deadline was
+// exceeded, including the Timeout of the HTTP client.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// send sends a request of log records, retrying it while the collector is
+// unavailable.
+func (c *client) send(ctx context.Context, protoLogs []*logspb.ResourceLogs) error {
+	pbRequest := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: protoLogs,
+	}
+	rawRequest, err := proto.Marshal(pbRequest)
+	if err != nil {
+		return err
+	}
+
+	address := fmt.Sprintf("%s://%s%s", c.getScheme(), c.cfg.Logs.Endpoint, c.cfg.Logs.URLPath)
+	for i := 0; i < c.cfg.MaxAttempts; i++ {
+		response, err := c.singleSend(ctx, rawRequest, address)
+		if err != nil {
+			return err
+		}
+		// We don't care about the body, so try to read it
+		// into /dev/null and close it immediately. The
+		// reading part is to facilitate connection reuse.
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		_ = response.Body.Close()
+		switch response.StatusCode {
+		case http.StatusOK:
+			return nil
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			select {
+			case <-time.After(getWaitDuration(c.cfg.Backoff, i)):
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		default:
+			return fmt.Errorf("failed to send logs to %s with HTTP status %s", address, response.Status)
+		}
+	}
+	return fmt.Errorf("failed to send logs to %s after %d tries", address, c.cfg.MaxAttempts)
+}
+
+func (c *client) getScheme() string {
+	if c.cfg.Logs.Insecure {
+		return "http"
+	}
+	return "https"
+}
+
+func getWaitDuration(backoff time.Duration, i int) time.Duration {
+	// Strategy: after nth failed attempt, attempt resending after
+	// k * initialBackoff + jitter, where k is a random number in
+	// range [0, 2^n-1), and jitter is a random percentage of
+	// initialBackoff from [-5%, 5%).
+	//
+	// Based on
+	// https://en.wikipedia.org/wiki/Exponential_backoff#Example_exponential_backoff_algorithm
+	//
+	// Jitter is our addition.
+
+	// There won't be an overflow, since i is capped to
+	// DefaultMaxAttempts (5).
+	upperK := (int64)(1) << (i + 1)
+	jitterPercent := (rand.Float64() - 0.5) / 10.
+	jitter := jitterPercent * (float64)(backoff)
+	k := rand.Int63n(upperK)
+	return (time.Duration)(k)*backoff + (time.Duration)(jitter)
+}
+
+func (c *client) contextWithStop(ctx context.Context) (context.Context, context.CancelFunc) {
+	// Unify the parent context Done signal with the client's stop
+	// channel.
+	ctx, cancel := context.WithCancel(ctx)
+	go func(ctx context.Context, cancel context.CancelFunc) {
+		select {
+		case <-ctx.Done():
+			// Nothing to do, either cancelled or deadline
+			// happened.
+		case <-c.stopCh:
+			cancel()
+		}
+	}(ctx, cancel)
+	return ctx, cancel
+}
+
+func (c *client) singleSend(ctx context.Context, rawRequest []byte, address string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader, contentLength, headers := c.prepareBody(rawRequest)
+	// Not closing bodyReader through defer, the HTTP Client's
+	// Transport will do it for us
+	request.Body = bodyReader
+	request.ContentLength = contentLength
+	for key, values := range headers {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+	return c.client.Do(request)
+}
+
+func (c *client) prepareBody(rawRequest []byte) (io.ReadCloser, int64, http.Header) {
+	var bodyReader io.ReadCloser
+	headers := http.Header{}
+	for k, v := range c.cfg.Logs.Headers {
+		headers.Set(k, v)
+	}
+	contentLength := (int64)(len(rawRequest))
+	headers.Set("Content-Type", contentTypeProto)
+	requestReader := bytes.NewBuffer(rawRequest)
+	switch c.cfg.Logs.Compression {
+	case otlpconfig.NoCompression:
+		bodyReader = ioutil.NopCloser(requestReader)
+	case otlpconfig.GzipCompression:
+		preader, pwriter := io.Pipe()
+		go func() {
+			defer pwriter.Close()
+			gzipper := gzip.NewWriter(pwriter)
+			defer gzipper.Close()
+			_, err := io.Copy(gzipper, requestReader)
+			if err != nil {
+				otel.Handle(fmt.Errorf("otlplogshttp: failed to gzip request: %v", err))
+			}
+		}()
+		headers.Set("Content-Encoding", "gzip")
+		bodyReader = preader
+		contentLength = -1
+	}
+	return bodyReader, contentLength, headers
+}
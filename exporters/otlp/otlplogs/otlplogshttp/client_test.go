@@ -0,0 +1,301 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplogshttp_test
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp"
+	"go.opentelemetry.io/otel/log"
+	sdklogs "go.opentelemetry.io/otel/sdk/logs"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type mockCollector struct {
+	mu        sync.Mutex
+	statuses  []int
+	requests  int
+	paths     []string
+	headers   http.Header
+	logs      []*logspb.ResourceLogs
+	lastError error
+}
+
+func (c *mockCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	idx := c.requests
+	c.requests++
+	c.paths = append(c.paths, r.URL.Path)
+	if idx < len(c.statuses) {
+		w.WriteHeader(c.statuses[idx])
+		return
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			c.lastError = err
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gz
+	}
+	raw, err := ioutil.ReadAll(body)
+	if err != nil {
+		c.lastError = err
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &collogspb.ExportLogsServiceRequest{}
+	if err := proto.Unmarshal(raw, req); err != nil {
+		c.lastError = err
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.headers = r.Header.Clone()
+	c.logs = append(c.logs, req.ResourceLogs...)
+	w.WriteHeader(http.StatusOK)
+}
+
+func runMockCollector(t *testing.T, statuses ...int) (*mockCollector, string) {
+	mc := &mockCollector{statuses: statuses}
+	srv := httptest.NewServer(mc)
+	t.Cleanup(srv.Close)
+	return mc, strings.TrimPrefix(srv.URL, "http://")
+}
+
+var records = []sdklogs.Record{
+	{Severity: log.SeverityError, Body: attribute.StringValue("boom")},
+}
+
+func TestExportLogs(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		compression otlplogshttp.Compression
+	}{
+		{name: "no compression", compression: otlplogshttp.NoCompression},
+		{name: "gzip", compression: otlplogshttp.GzipCompression},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mc, endpoint := runMockCollector(t)
+			ctx := context.Background()
+			exp, err := otlplogshttp.NewExporter(ctx,
+				otlplogshttp.WithInsecure(),
+				otlplogshttp.WithEndpoint(endpoint),
+				otlplogshttp.WithCompression(tt.compression),
+				otlplogshttp.WithHeaders(map[string]string{"X-Test": "yes"}),
+			)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+			require.NoError(t, exp.ExportLogs(ctx, records))
+
+			mc.mu.Lock()
+			defer mc.mu.Unlock()
+			require.NoError(t, mc.lastError)
+			assert.Equal(t, []string{"/v1/logs"}, mc.paths)
+			assert.Equal(t, "yes", mc.headers.Get("X-Test"))
+			assert.Equal(t, "application/x-protobuf", mc.headers.Get("Content-Type"))
+			require.Len(t, mc.logs, 1)
+			lr := mc.logs[0].InstrumentationLibraryLogs[0].Logs[0]
+			assert.Equal(t, "boom", lr.Body.GetStringValue())
+			assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, lr.SeverityNumber)
+		})
+	}
+}
+
+func TestExportLogsURLPath(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlplogshttp.NewExporter(ctx,
+		otlplogshttp.WithInsecure(),
+		otlplogshttp.WithEndpoint(endpoint),
+		otlplogshttp.WithURLPath("custom/logs/"),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, []string{"/custom/logs"}, mc.paths)
+}
+
+func TestExportLogsRetry(t *testing.T) {
+	mc, endpoint := runMockCollector(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	ctx := context.Background()
+	exp, err := otlplogshttp.NewExporter(ctx,
+		otlplogshttp.WithInsecure(),
+		otlplogshttp.WithEndpoint(endpoint),
+		otlplogshttp.WithBackoff(time.Millisecond),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, 3, mc.requests)
+	assert.Len(t, mc.logs, 1)
+}
+
+func TestExportLogsPermanentError(t *testing.T) {
+	mc, endpoint := runMockCollector(t, http.StatusBadRequest)
+	ctx := context.Background()
+	exp, err := otlplogshttp.NewExporter(ctx,
+		otlplogshttp.WithInsecure(),
+		otlplogshttp.WithEndpoint(endpoint),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	assert.Error(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, 1, mc.requests)
+}
+
+// runSlowCollector runs a collector that does not answer before the
+// request is canceled.
+func runSlowCollector(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The cancellation of the request is only noticed once its body
+		// is read.
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+func TestExportLogsTimeout(t *testing.T) {
+	unavailable := []int{
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+	}
+	for _, tt := range []struct {
+		name     string
+		endpoint func(t *testing.T) string
+		opts     []otlplogshttp.Option
+	}{
+		{
+			name:     "slow collector",
+			endpoint: runSlowCollector,
+			opts:     []otlplogshttp.Option{otlplogshttp.WithTimeout(10 * time.Millisecond)},
+		},
+		{
+			name: "retries",
+			endpoint: func(t *testing.T) string {
+				_, endpoint := runMockCollector(t, unavailable...)
+				return endpoint
+			},
+			opts: []otlplogshttp.Option{
+				otlplogshttp.WithTimeout(10 * time.Millisecond),
+				otlplogshttp.WithBackoff(time.Second),
+			},
+		},
+		{
+			name:     "HTTP client timeout",
+			endpoint: runSlowCollector,
+			opts: []otlplogshttp.Option{
+				otlplogshttp.WithTimeout(time.Minute),
+				otlplogshttp.WithHTTPClient(&http.Client{Timeout: 10 * time.Millisecond}),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			opts := append([]otlplogshttp.Option{
+				otlplogshttp.WithInsecure(),
+				otlplogshttp.WithEndpoint(tt.endpoint(t)),
+			}, tt.opts...)
+			exp, err := otlplogshttp.NewExporter(ctx, opts...)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+			err = exp.ExportLogs(ctx, records)
+			var de otlplogs.DeadlineExceeded
+			require.True(t, errors.As(err, &de), "error must be a DeadlineExceeded: %v", err)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// signingTransport adds a signature header to the requests, like the
+// transports of signing proxies do.
+var signingTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("X-Signature", "signed")
+	return http.DefaultTransport.RoundTrip(r)
+})
+
+func TestExportLogsRoundTripper(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlplogshttp.NewExporter(ctx,
+		otlplogshttp.WithInsecure(),
+		otlplogshttp.WithEndpoint(endpoint),
+		otlplogshttp.WithRoundTripper(signingTransport),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, "signed", mc.headers.Get("X-Signature"))
+}
+
+func TestExportLogsHTTPClient(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlplogshttp.NewExporter(ctx,
+		otlplogshttp.WithInsecure(),
+		otlplogshttp.WithEndpoint(endpoint),
+		// The TLS configuration of the default transport is ignored.
+		otlplogshttp.WithTLSClientConfig(&tls.Config{}),
+		otlplogshttp.WithHTTPClient(&http.Client{Transport: signingTransport}),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, "signed", mc.headers.Get("X-Signature"))
+}
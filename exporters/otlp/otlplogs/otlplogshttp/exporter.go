@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplogshttp // import "go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplogs"
+)
+
+// NewExporter constructs a new Exporter and starts it.
+func NewExporter(ctx context.Context, opts ...Option) (*otlplogs.Exporter, error) {
+	return otlplogs.NewExporter(ctx, NewClient(opts...))
+}
+
+// NewUnstartedExporter constructs a new Exporter and does not start it.
+func NewUnstartedExporter(opts ...Option) *otlplogs.Exporter {
+	return otlplogs.NewUnstartedExporter(NewClient(opts...))
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetric // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/metrictransform"
+	"go.opentelemetry.io/otel/internal/exportstatus"
+	"go.opentelemetry.io/otel/metric"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+var (
+	errAlreadyStarted = errors.New("already started")
+)
+
+// Status is the health of an Exporter: the time of its last successful
+// export, the number of exports that failed since, and the error of its
+// most recent export.
+type Status struct {
+	// LastSuccessfulExport is the time the last export accepted by the
+	// endpoint completed. It is the zero time if no export succeeded yet.
+	LastSuccessfulExport time.Time
+	// ConsecutiveFailures is the number of exports that failed since the
+	// last successful one.
+	ConsecutiveFailures int
+	// LastError is the error of the most recent export, or nil if it
+	// succeeded.
+	LastError error
+}
+
+// Exporter exports metrics data in the OTLP wire format.
+type Exporter struct {
+	client             Client
+	exportKindSelector metricsdk.ExportKindSelector
+
+	status exportstatus.Recorder
+
+	mu      sync.RWMutex
+	started bool
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// Export exports a batch of metrics.
+func (e *Exporter) Export(ctx context.Context, checkpointSet metricsdk.CheckpointSet) error {
+	rms, err := metrictransform.CheckpointSet(ctx, e, checkpointSet, 1)
+	if err != nil {
+		return err
+	}
+	if len(rms) == 0 {
+		return nil
+	}
+
+	err = e.client.UploadMetrics(ctx, rms)
+	e.status.Record(err)
+	return err
+}
+
+// Status returns the health of the Exporter based on its recent exports.
+func (e *Exporter) Status() Status {
+	s := e.status.Status()
+	return Status{
+		LastSuccessfulExport: s.LastSuccessfulExport,
+		ConsecutiveFailures:  s.ConsecutiveFailures,
+		LastError:            s.LastError,
+	}
+}
+
+// Start establishes a connection to the receiving endpoint.
+func (e *Exporter) Start(ctx context.Context) error {
+	var err = errAlreadyStarted
+	e.startOnce.Do(func() {
+		e.mu.Lock()
+		e.started = true
+		e.mu.Unlock()
+		err = e.client.Start(ctx)
+	})
+
+	return err
+}
+
+// Shutdown flushes all exports and closes all connections to the receiving endpoint.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	e.mu.RLock()
+	started := e.started
+	e.mu.RUnlock()
+
+	if !started {
+		return nil
+	}
+
+	var err error
+
+	e.stopOnce.Do(func() {
+		err = e.client.Stop(ctx)
+		e.mu.Lock()
+		e.started = false
+		e.mu.Unlock()
+	})
+
+	return err
+}
+
+// ExportKindFor returns the export kind of the instrument described by
+// descriptor, as selected by the ExportKindSelector of the Exporter.
+func (e *Exporter) ExportKindFor(descriptor *metric.Descriptor, aggregatorKind aggregation.Kind) metricsdk.ExportKind {
+	return e.exportKindSelector.ExportKindFor(descriptor, aggregatorKind)
+}
+
+var _ metricsdk.Exporter = (*Exporter)(nil)
+
+// NewExporter constructs a new Exporter and starts it.
+func NewExporter(ctx context.Context, client Client, opts ...Option) (*Exporter, error) {
+	exp := NewUnstartedExporter(client, opts...)
+	if err := exp.Start(ctx); err != nil {
+		return nil, err
+	}
+	return exp, nil
+}
+
+// NewUnstartedExporter constructs a new Exporter and does not start it.
+func NewUnstartedExporter(client Client, opts ...Option) *Exporter {
+	cfg := config{
+		// Note: the default ExportKindSelector is specified
+		// as Cumulative:
+		// https://github.com/open-telemetry/opentelemetry-specification/issues/731
+		exportKindSelector: metricsdk.CumulativeExportKindSelector(),
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	return &Exporter{
+		client:             client,
+		exportKindSelector: cfg.exportKindSelector,
+	}
+}
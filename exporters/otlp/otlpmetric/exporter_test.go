@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetric_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+type recordingClient struct {
+	started int
+	stopped int
+	uploads [][]*metricpb.ResourceMetrics
+	err     error
+}
+
+var _ otlpmetric.Client = (*recordingClient)(nil)
+
+func (c *recordingClient) Start(context.Context) error {
+	c.started++
+	return nil
+}
+
+func (c *recordingClient) Stop(ctx context.Context) error {
+	c.stopped++
+	return ctx.Err()
+}
+
+func (c *recordingClient) UploadMetrics(_ context.Context, protoMetrics []*metricpb.ResourceMetrics) error {
+	c.uploads = append(c.uploads, protoMetrics)
+	return c.err
+}
+
+var counterDesc = metric.NewDescriptor("requests", metric.CounterInstrumentKind, number.Int64Kind)
+
+func counterCheckpointSet(t *testing.T, value int64) *metrictest.CheckpointSet {
+	agg, ckpt := metrictest.Unslice2(sum.New(2))
+	require.NoError(t, agg.Update(context.Background(), number.NewInt64Number(value), &counterDesc))
+	require.NoError(t, agg.SynchronizedMove(ckpt, &counterDesc))
+
+	cps := metrictest.NewCheckpointSet(resource.NewWithAttributes(attribute.String("service.name", "test")))
+	cps.Add(&counterDesc, ckpt, attribute.String("A", "B"))
+	return cps
+}
+
+func TestExporterStartShutdown(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{}
+	exp, err := otlpmetric.NewExporter(ctx, client)
+	require.NoError(t, err)
+	assert.Error(t, exp.Start(ctx), "second start must fail")
+	assert.Equal(t, 1, client.started)
+
+	require.NoError(t, exp.Shutdown(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Equal(t, 1, client.stopped)
+}
+
+func TestUnstartedExporterShutdown(t *testing.T) {
+	client := &recordingClient{}
+	exp := otlpmetric.NewUnstartedExporter(client)
+	require.NoError(t, exp.Shutdown(context.Background()))
+	assert.Equal(t, 0, client.stopped)
+}
+
+func TestExporterExport(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{}
+	exp, err := otlpmetric.NewExporter(ctx, client)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.Export(ctx, metrictest.NewCheckpointSet(resource.Empty())))
+	assert.Len(t, client.uploads, 0, "empty checkpoint sets must not be uploaded")
+
+	require.NoError(t, exp.Export(ctx, counterCheckpointSet(t, 3)))
+	require.Len(t, client.uploads, 1)
+	require.Len(t, client.uploads[0], 1)
+	ilms := client.uploads[0][0].InstrumentationLibraryMetrics
+	require.Len(t, ilms, 1)
+	require.Len(t, ilms[0].Metrics, 1)
+	m := ilms[0].Metrics[0]
+	assert.Equal(t, "requests", m.Name)
+	assert.Equal(t, metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, m.GetSum().AggregationTemporality)
+	require.Len(t, m.GetSum().DataPoints, 1)
+	assert.Equal(t, int64(3), m.GetSum().DataPoints[0].GetAsInt())
+}
+
+func TestExporterExportKindSelector(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{}
+	exp, err := otlpmetric.NewExporter(ctx, client,
+		otlpmetric.WithMetricExportKindSelector(export.DeltaExportKindSelector()),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, export.DeltaExportKind, exp.ExportKindFor(&counterDesc, aggregation.SumKind))
+
+	require.NoError(t, exp.Export(ctx, counterCheckpointSet(t, 3)))
+	require.Len(t, client.uploads, 1)
+	m := client.uploads[0][0].InstrumentationLibraryMetrics[0].Metrics[0]
+	assert.Equal(t, metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA, m.GetSum().AggregationTemporality)
+}
+
+func TestExporterStatus(t *testing.T) {
+	ctx := context.Background()
+	client := &recordingClient{}
+	exp, err := otlpmetric.NewExporter(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, otlpmetric.Status{}, exp.Status())
+
+	before := time.Now()
+	require.NoError(t, exp.Export(ctx, counterCheckpointSet(t, 1)))
+	status := exp.Status()
+	assert.False(t, status.LastSuccessfulExport.Before(before))
+	assert.Equal(t, 0, status.ConsecutiveFailures)
+	assert.NoError(t, status.LastError)
+
+	client.err = errors.New("unavailable")
+	assert.Error(t, exp.Export(ctx, counterCheckpointSet(t, 1)))
+	assert.Error(t, exp.Export(ctx, counterCheckpointSet(t, 1)))
+	assert.Equal(t, otlpmetric.Status{
+		LastSuccessfulExport: status.LastSuccessfulExport,
+		ConsecutiveFailures:  2,
+		LastError:            client.err,
+	}, exp.Status())
+
+	client.err = nil
+	require.NoError(t, exp.Export(ctx, counterCheckpointSet(t, 1)))
+	assert.Equal(t, 0, exp.Status().ConsecutiveFailures)
+	assert.NoError(t, exp.Status().LastError)
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictransform
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// Field numbers of the OTLP ExponentialHistogram messages. The version of
+// the generated OTLP types in use predates these messages, so they are
+// encoded directly and attached to the Metric as unknown fields. Unknown
+// fields are retained by the protobuf binary encoding and decoded by
+// receivers that support exponential histograms.
+const (
+	metricExponentialHistogramField protowire.Number = 10
+
+	expHistDataPointsField  protowire.Number = 1
+	expHistTemporalityField protowire.Number = 2
+
+	expPointAttributesField protowire.Number = 1
+	expPointStartTimeField  protowire.Number = 2
+	expPointTimeField       protowire.Number = 3
+	expPointCountField      protowire.Number = 4
+	expPointSumField        protowire.Number = 5
+	expPointScaleField      protowire.Number = 6
+	expPointZeroCountField  protowire.Number = 7
+	expPointPositiveField   protowire.Number = 8
+	expPointNegativeField   protowire.Number = 9
+
+	bucketsOffsetField protowire.Number = 1
+	bucketsCountsField protowire.Number = 2
+)
+
+// exponentialHistogramPoint transforms an ExponentialHistogram Aggregator
+// into an OTLP Metric.
+func exponentialHistogramPoint(record export.Record, ek export.ExportKind, a aggregation.ExponentialHistogram) (*metricpb.Metric, error) {
+	desc := record.Descriptor()
+	dist, err := a.ExponentialHistogram()
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := a.Count()
+	if err != nil {
+		return nil, err
+	}
+
+	sum, err := a.Sum()
+	if err != nil {
+		return nil, err
+	}
+
+	var point []byte
+	for _, kv := range keyValues(record.Labels().Iter()) {
+		b, err := proto.Marshal(kv)
+		if err != nil {
+			return nil, err
+		}
+		point = protowire.AppendTag(point, expPointAttributesField, protowire.BytesType)
+		point = protowire.AppendBytes(point, b)
+	}
+	point = protowire.AppendTag(point, expPointStartTimeField, protowire.Fixed64Type)
+	point = protowire.AppendFixed64(point, toNanos(record.StartTime()))
+	point = protowire.AppendTag(point, expPointTimeField, protowire.Fixed64Type)
+	point = protowire.AppendFixed64(point, toNanos(record.EndTime()))
+	point = protowire.AppendTag(point, expPointCountField, protowire.Fixed64Type)
+	point = protowire.AppendFixed64(point, count)
+	point = protowire.AppendTag(point, expPointSumField, protowire.Fixed64Type)
+	point = protowire.AppendFixed64(point, math.Float64bits(sum.CoerceToFloat64(desc.NumberKind())))
+	point = protowire.AppendTag(point, expPointScaleField, protowire.VarintType)
+	point = protowire.AppendVarint(point, protowire.EncodeZigZag(int64(dist.Scale)))
+	point = protowire.AppendTag(point, expPointZeroCountField, protowire.Fixed64Type)
+	point = protowire.AppendFixed64(point, dist.ZeroCount)
+	point = appendBuckets(point, expPointPositiveField, dist.Positive)
+	point = appendBuckets(point, expPointNegativeField, dist.Negative)
+
+	var hist []byte
+	hist = protowire.AppendTag(hist, expHistDataPointsField, protowire.BytesType)
+	hist = protowire.AppendBytes(hist, point)
+	hist = protowire.AppendTag(hist, expHistTemporalityField, protowire.VarintType)
+	hist = protowire.AppendVarint(hist, uint64(exportKindToTemporality(ek)))
+
+	var data []byte
+	data = protowire.AppendTag(data, metricExponentialHistogramField, protowire.BytesType)
+	data = protowire.AppendBytes(data, hist)
+
+	m := &metricpb.Metric{
+		Name:        desc.Name(),
+		Description: desc.Description(),
+		Unit:        string(desc.Unit()),
+	}
+	m.ProtoReflect().SetUnknown(data)
+	return m, nil
+}
+
+// appendBuckets appends the OTLP encoding of b as field num to dst.
+func appendBuckets(dst []byte, num protowire.Number, b aggregation.ExponentialBuckets) []byte {
+	var counts []byte
+	for _, c := range b.Counts {
+		counts = protowire.AppendVarint(counts, c)
+	}
+
+	var buckets []byte
+	buckets = protowire.AppendTag(buckets, bucketsOffsetField, protowire.VarintType)
+	buckets = protowire.AppendVarint(buckets, protowire.EncodeZigZag(int64(b.Offset)))
+	if len(counts) > 0 {
+		buckets = protowire.AppendTag(buckets, bucketsCountsField, protowire.BytesType)
+		buckets = protowire.AppendBytes(buckets, counts)
+	}
+
+	dst = protowire.AppendTag(dst, num, protowire.BytesType)
+	return protowire.AppendBytes(dst, buckets)
+}
+
+// isExponentialHistogram returns true if m holds an exponential
+// histogram encoded by exponentialHistogramPoint.
+func isExponentialHistogram(m *metricpb.Metric) bool {
+	return m.Data == nil && len(m.ProtoReflect().GetUnknown()) > 0
+}
+
+// mergeExponentialHistograms appends the data points of src to dst.
+// Repeated occurrences of a message field are merged by protobuf
+// decoders, so appending the encoded field is sufficient.
+func mergeExponentialHistograms(dst, src *metricpb.Metric) {
+	unknown := dst.ProtoReflect().GetUnknown()
+	dst.ProtoReflect().SetUnknown(append(unknown, src.ProtoReflect().GetUnknown()...))
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictransform
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// RequestSize returns the size in bytes of the serialized export request
+// of rms.
+func RequestSize(rms []*metricpb.ResourceMetrics) int {
+	return proto.Size(&colmetricpb.ExportMetricsServiceRequest{ResourceMetrics: rms})
+}
+
+// SplitResourceMetrics splits rms into batches whose serialized export
+// request is at most maxSize bytes, halving the batches that are too large.
+// A batch with a single metric is not split further even if it is too large.
+// rms is returned as a single batch if maxSize is not positive.
+func SplitResourceMetrics(rms []*metricpb.ResourceMetrics, maxSize int) [][]*metricpb.ResourceMetrics {
+	if maxSize <= 0 || RequestSize(rms) <= maxSize {
+		return [][]*metricpb.ResourceMetrics{rms}
+	}
+	first, second, ok := HalveResourceMetrics(rms)
+	if !ok {
+		return [][]*metricpb.ResourceMetrics{rms}
+	}
+	return append(SplitResourceMetrics(first, maxSize), SplitResourceMetrics(second, maxSize)...)
+}
+
+// HalveResourceMetrics splits rms into two batches holding half of its metrics
+// each, keeping the resource and instrumentation library of every metric.
+// The returned ok is false if rms has fewer than two metrics.
+func HalveResourceMetrics(rms []*metricpb.ResourceMetrics) (first, second []*metricpb.ResourceMetrics, ok bool) {
+	var n int
+	for _, rm := range rms {
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			n += len(ilm.Metrics)
+		}
+	}
+	if n < 2 {
+		return nil, nil, false
+	}
+
+	// left is the number of metrics still to be added to the first batch.
+	left := n / 2
+	for _, rm := range rms {
+		var firstILM, secondILM []*metricpb.InstrumentationLibraryMetrics
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			k := len(ilm.Metrics)
+			if k > left {
+				k = left
+			}
+			left -= k
+			if k > 0 {
+				firstILM = append(firstILM, &metricpb.InstrumentationLibraryMetrics{
+					InstrumentationLibrary: ilm.InstrumentationLibrary,
+					Metrics:                ilm.Metrics[:k],
+				})
+			}
+			if k < len(ilm.Metrics) {
+				secondILM = append(secondILM, &metricpb.InstrumentationLibraryMetrics{
+					InstrumentationLibrary: ilm.InstrumentationLibrary,
+					Metrics:                ilm.Metrics[k:],
+				})
+			}
+		}
+		if len(firstILM) > 0 {
+			first = append(first, &metricpb.ResourceMetrics{
+				Resource:                      rm.Resource,
+				InstrumentationLibraryMetrics: firstILM,
+			})
+		}
+		if len(secondILM) > 0 {
+			second = append(second, &metricpb.ResourceMetrics{
+				Resource:                      rm.Resource,
+				InstrumentationLibraryMetrics: secondILM,
+			})
+		}
+	}
+	return first, second, true
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictransform
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// splitTestMetrics returns two resources with two instrumentation
+// libraries of n metrics each.
+func splitTestMetrics(n int) []*metricpb.ResourceMetrics {
+	var rms []*metricpb.ResourceMetrics
+	for r := 0; r < 2; r++ {
+		rm := &metricpb.ResourceMetrics{
+			Resource: &resourcepb.Resource{DroppedAttributesCount: uint32(r)},
+		}
+		for l := 0; l < 2; l++ {
+			ilm := &metricpb.InstrumentationLibraryMetrics{
+				InstrumentationLibrary: &commonpb.InstrumentationLibrary{Name: fmt.Sprintf("lib%d", l)},
+			}
+			for m := 0; m < n; m++ {
+				ilm.Metrics = append(ilm.Metrics, &metricpb.Metric{Name: fmt.Sprintf("metric%d-%d-%d", r, l, m)})
+			}
+			rm.InstrumentationLibraryMetrics = append(rm.InstrumentationLibraryMetrics, ilm)
+		}
+		rms = append(rms, rm)
+	}
+	return rms
+}
+
+// metricNames returns the names of the metrics of rms, checking that each
+// of them is under the resource and library it was created with.
+func metricNames(t *testing.T, rms []*metricpb.ResourceMetrics) []string {
+	var names []string
+	for _, rm := range rms {
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			require.NotEmpty(t, ilm.Metrics)
+			for _, m := range ilm.Metrics {
+				var r, l, i int
+				_, err := fmt.Sscanf(m.Name, "metric%d-%d-%d", &r, &l, &i)
+				require.NoError(t, err)
+				assert.Equal(t, uint32(r), rm.Resource.DroppedAttributesCount)
+				assert.Equal(t, fmt.Sprintf("lib%d", l), ilm.InstrumentationLibrary.Name)
+				names = append(names, m.Name)
+			}
+		}
+	}
+	return names
+}
+
+func TestHalveResourceMetrics(t *testing.T) {
+	rms := splitTestMetrics(3)
+	want := metricNames(t, rms)
+
+	first, second, ok := HalveResourceMetrics(rms)
+	require.True(t, ok)
+	firstNames, secondNames := metricNames(t, first), metricNames(t, second)
+	assert.Len(t, firstNames, 6)
+	assert.Len(t, secondNames, 6)
+	assert.Equal(t, want, append(firstNames, secondNames...))
+
+	_, _, ok = HalveResourceMetrics(splitTestMetrics(0))
+	assert.False(t, ok)
+}
+
+func TestSplitResourceMetrics(t *testing.T) {
+	rms := splitTestMetrics(50)
+	want := metricNames(t, rms)
+	maxSize := RequestSize(rms) / 5
+
+	batches := SplitResourceMetrics(rms, maxSize)
+	require.Greater(t, len(batches), 4)
+	var got []string
+	for _, b := range batches {
+		assert.LessOrEqual(t, RequestSize(b), maxSize)
+		got = append(got, metricNames(t, b)...)
+	}
+	assert.Equal(t, want, got)
+
+	assert.Equal(t, [][]*metricpb.ResourceMetrics{rms}, SplitResourceMetrics(rms, 0))
+}
@@ -0,0 +1,384 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetrichttp_test
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+type mockCollector struct {
+	mu        sync.Mutex
+	statuses  []int
+	requests  int
+	paths     []string
+	headers   http.Header
+	metrics   []*metricpb.ResourceMetrics
+	lastError error
+}
+
+func (c *mockCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	idx := c.requests
+	c.requests++
+	c.paths = append(c.paths, r.URL.Path)
+	if idx < len(c.statuses) {
+		w.WriteHeader(c.statuses[idx])
+		return
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			c.lastError = err
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gz
+	}
+	raw, err := ioutil.ReadAll(body)
+	if err != nil {
+		c.lastError = err
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &colmetricpb.ExportMetricsServiceRequest{}
+	if err := proto.Unmarshal(raw, req); err != nil {
+		c.lastError = err
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.headers = r.Header.Clone()
+	c.metrics = append(c.metrics, req.ResourceMetrics...)
+	w.WriteHeader(http.StatusOK)
+}
+
+func runMockCollector(t *testing.T, statuses ...int) (*mockCollector, string) {
+	mc := &mockCollector{statuses: statuses}
+	srv := httptest.NewServer(mc)
+	t.Cleanup(srv.Close)
+	return mc, strings.TrimPrefix(srv.URL, "http://")
+}
+
+func checkpointSet(t *testing.T) export.CheckpointSet {
+	desc := metric.NewDescriptor("requests", metric.CounterInstrumentKind, number.Int64Kind)
+	agg, ckpt := metrictest.Unslice2(sum.New(2))
+	require.NoError(t, agg.Update(context.Background(), number.NewInt64Number(3), &desc))
+	require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+
+	cps := metrictest.NewCheckpointSet(resource.NewWithAttributes(attribute.String("service.name", "test")))
+	cps.Add(&desc, ckpt, attribute.String("A", "B"))
+	return cps
+}
+
+// checkpointSetN returns a CheckpointSet with n counters.
+func checkpointSetN(t *testing.T, n int) export.CheckpointSet {
+	cps := metrictest.NewCheckpointSet(resource.NewWithAttributes(attribute.String("service.name", "test")))
+	for i := 0; i < n; i++ {
+		desc := metric.NewDescriptor(fmt.Sprintf("requests%d", i), metric.CounterInstrumentKind, number.Int64Kind)
+		agg, ckpt := metrictest.Unslice2(sum.New(2))
+		require.NoError(t, agg.Update(context.Background(), number.NewInt64Number(3), &desc))
+		require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+		cps.Add(&desc, ckpt, attribute.String("A", "B"))
+	}
+	return cps
+}
+
+// metricCount returns the number of metrics in rms.
+func metricCount(rms []*metricpb.ResourceMetrics) int {
+	var n int
+	for _, rm := range rms {
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			n += len(ilm.Metrics)
+		}
+	}
+	return n
+}
+
+func TestExportMetrics(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		compression otlpmetrichttp.Compression
+	}{
+		{name: "no compression", compression: otlpmetrichttp.NoCompression},
+		{name: "gzip", compression: otlpmetrichttp.GzipCompression},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mc, endpoint := runMockCollector(t)
+			ctx := context.Background()
+			exp, err := otlpmetrichttp.NewExporter(ctx,
+				otlpmetrichttp.WithInsecure(),
+				otlpmetrichttp.WithEndpoint(endpoint),
+				otlpmetrichttp.WithCompression(tt.compression),
+				otlpmetrichttp.WithHeaders(map[string]string{"X-Test": "yes"}),
+			)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+			require.NoError(t, exp.Export(ctx, checkpointSet(t)))
+
+			mc.mu.Lock()
+			defer mc.mu.Unlock()
+			require.NoError(t, mc.lastError)
+			assert.Equal(t, []string{"/v1/metrics"}, mc.paths)
+			assert.Equal(t, "yes", mc.headers.Get("X-Test"))
+			assert.Equal(t, "application/x-protobuf", mc.headers.Get("Content-Type"))
+			require.Len(t, mc.metrics, 1)
+			m := mc.metrics[0].InstrumentationLibraryMetrics[0].Metrics[0]
+			assert.Equal(t, "requests", m.Name)
+			require.Len(t, m.GetSum().DataPoints, 1)
+			assert.Equal(t, int64(3), m.GetSum().DataPoints[0].GetAsInt())
+		})
+	}
+}
+
+func TestExportMetricsURLPath(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+		otlpmetrichttp.WithURLPath("custom/metrics/"),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.Export(ctx, checkpointSet(t)))
+	assert.Equal(t, []string{"/custom/metrics"}, mc.paths)
+}
+
+func TestExportMetricsRetry(t *testing.T) {
+	mc, endpoint := runMockCollector(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+		otlpmetrichttp.WithBackoff(time.Millisecond),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.Export(ctx, checkpointSet(t)))
+	assert.Equal(t, 3, mc.requests)
+	assert.Len(t, mc.metrics, 1)
+}
+
+func TestExportMetricsPermanentError(t *testing.T) {
+	mc, endpoint := runMockCollector(t, http.StatusBadRequest)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	assert.Error(t, exp.Export(ctx, checkpointSet(t)))
+	assert.Equal(t, 1, mc.requests)
+}
+
+func TestExportMetricsMaxRequestSize(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+		otlpmetrichttp.WithMaxRequestSize(256),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.Export(ctx, checkpointSetN(t, 20)))
+	assert.Greater(t, mc.requests, 1)
+	assert.Equal(t, 20, metricCount(mc.metrics))
+}
+
+func TestExportMetricsRequestTooLarge(t *testing.T) {
+	mc, endpoint := runMockCollector(t, http.StatusRequestEntityTooLarge)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+		otlpmetrichttp.WithMaxRequestSize(0),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.Export(ctx, checkpointSetN(t, 4)))
+	assert.Equal(t, 3, mc.requests, "collector must receive the rejected request and its two halves")
+	assert.Equal(t, 4, metricCount(mc.metrics))
+}
+
+func TestExportMetricsSingleMetricTooLarge(t *testing.T) {
+	mc, endpoint := runMockCollector(t, http.StatusRequestEntityTooLarge)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	assert.Error(t, exp.Export(ctx, checkpointSet(t)))
+	assert.Equal(t, 1, mc.requests)
+}
+
+// runSlowCollector runs a collector that does not answer before the
+// request is canceled.
+func runSlowCollector(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The cancellation of the request is only noticed once its body
+		// is read.
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+func TestExportMetricsTimeout(t *testing.T) {
+	unavailable := []int{
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+	}
+	for _, tt := range []struct {
+		name     string
+		endpoint func(t *testing.T) string
+		opts     []otlpmetrichttp.Option
+	}{
+		{
+			name:     "slow collector",
+			endpoint: runSlowCollector,
+			opts:     []otlpmetrichttp.Option{otlpmetrichttp.WithTimeout(10 * time.Millisecond)},
+		},
+		{
+			name: "retries",
+			endpoint: func(t *testing.T) string {
+				_, endpoint := runMockCollector(t, unavailable...)
+				return endpoint
+			},
+			opts: []otlpmetrichttp.Option{
+				otlpmetrichttp.WithTimeout(10 * time.Millisecond),
+				otlpmetrichttp.WithBackoff(time.Second),
+			},
+		},
+		{
+			name:     "HTTP client timeout",
+			endpoint: runSlowCollector,
+			opts: []otlpmetrichttp.Option{
+				otlpmetrichttp.WithTimeout(time.Minute),
+				otlpmetrichttp.WithHTTPClient(&http.Client{Timeout: 10 * time.Millisecond}),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			opts := append([]otlpmetrichttp.Option{
+				otlpmetrichttp.WithInsecure(),
+				otlpmetrichttp.WithEndpoint(tt.endpoint(t)),
+			}, tt.opts...)
+			exp, err := otlpmetrichttp.NewExporter(ctx, opts...)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+			err = exp.Export(ctx, checkpointSet(t))
+			var de otlpmetric.DeadlineExceeded
+			require.True(t, errors.As(err, &de), "error must be a DeadlineExceeded: %v", err)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// signingTransport adds a signature header to the requests, like the
+// transports of signing proxies do.
+var signingTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("X-Signature", "signed")
+	return http.DefaultTransport.RoundTrip(r)
+})
+
+func TestExportMetricsRoundTripper(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+		otlpmetrichttp.WithRoundTripper(signingTransport),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.Export(ctx, checkpointSet(t)))
+	assert.Equal(t, "signed", mc.headers.Get("X-Signature"))
+}
+
+func TestExportMetricsHTTPClient(t *testing.T) {
+	mc, endpoint := runMockCollector(t)
+	ctx := context.Background()
+	exp, err := otlpmetrichttp.NewExporter(ctx,
+		otlpmetrichttp.WithInsecure(),
+		otlpmetrichttp.WithEndpoint(endpoint),
+		// The TLS configuration of the default transport is ignored.
+		otlpmetrichttp.WithTLSClientConfig(&tls.Config{}),
+		otlpmetrichttp.WithHTTPClient(&http.Client{Transport: signingTransport}),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.Export(ctx, checkpointSet(t)))
+	assert.Equal(t, "signed", mc.headers.Get("X-Signature"))
+}
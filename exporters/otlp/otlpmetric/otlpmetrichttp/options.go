@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetrichttp // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/otlpconfig"
+)
+
+// Compression describes the compression used for payloads sent to the
+// collector.
+type Compression otlpconfig.Compression
+
+const (
+	// NoCompression tells the client to send payloads without
+	// compression.
+	NoCompression = Compression(otlpconfig.NoCompression)
+	// GzipCompression tells the client to send payloads after
+	// compressing them with gzip.
+	GzipCompression = Compression(otlpconfig.GzipCompression)
+)
+
+// Option applies an option to the HTTP client.
+type Option interface {
+	applyHTTPOption(*otlpconfig.Config)
+}
+
+type wrappedOption struct {
+	otlpconfig.HTTPOption
+}
+
+func (w wrappedOption) applyHTTPOption(cfg *otlpconfig.Config) {
+	w.ApplyHTTPOption(cfg)
+}
+
+// WithEndpoint allows one to set the address of the collector
+// endpoint that the client will use to send metrics. If
+// unset, it will instead try to use
+// DefaultCollectorHost:DefaultCollectorPort. Note that the endpoint
+// must not contain any URL path.
+func WithEndpoint(endpoint string) Option {
+	return wrappedOption{otlpconfig.WithEndpoint(endpoint)}
+}
+
+// WithCompression tells the client to compress the payload.
+func WithCompression(compression Compression) Option {
+	return wrappedOption{otlpconfig.WithCompression(otlpconfig.Compression(compression))}
+}
+
+// WithURLPath allows one to override the default URL path used
+// for sending metrics. If unset, "/v1/metrics" will be used.
+func WithURLPath(urlPath string) Option {
+	return wrappedOption{otlpconfig.WithURLPath(urlPath)}
+}
+
+// WithMaxAttempts allows one to override how many times the client
+// will try to send the payload in case of retryable errors. If unset,
+// 5 attempts will be made. The max attempts is limited to at most 5
+// retries. If zero or a negative value is passed, the default is used.
+func WithMaxAttempts(maxAttempts int) Option {
+	return wrappedOption{otlpconfig.WithMaxAttempts(maxAttempts)}
+}
+
+// WithBackoff tells the client to use the duration as a base of the
+// exponential backoff strategy. If unset, 300ms will be used. If zero
+// or a negative value is passed, the default is used.
+func WithBackoff(duration time.Duration) Option {
+	return wrappedOption{otlpconfig.WithBackoff(duration)}
+}
+
+// WithTLSClientConfig can be used to set up a custom TLS
+// configuration for the client used to send payloads to the
+// collector. Use it if you want to use a custom certificate.
+func WithTLSClientConfig(tlsCfg *tls.Config) Option {
+	return wrappedOption{otlpconfig.WithTLSClientConfig(tlsCfg)}
+}
+
+// WithInsecure tells the client to connect to the collector using the
+// HTTP scheme, instead of HTTPS.
+func WithInsecure() Option {
+	return wrappedOption{otlpconfig.WithInsecure()}
+}
+
+// WithHeaders allows one to tell the client to send additional HTTP
+// headers with the payloads. Specifying headers like Content-Length,
+// Content-Encoding and Content-Type may result in a broken client.
+func WithHeaders(headers map[string]string) Option {
+	return wrappedOption{otlpconfig.WithHeaders(headers)}
+}
+
+// WithTimeout tells the client the max waiting time for the backend to process
+// each batch of metrics. If unset, the default will be 10 seconds.
+//
+// The timeout applies to each batch whether or not the context passed to
+// the exporter has a deadline, and covers all the retries of the batch. A
+// batch that is not sent in time fails with an otlpmetric.DeadlineExceeded
+// error. A timeout that is not positive leaves the deadline of each batch
+// to the context passed to the exporter.
+func WithTimeout(duration time.Duration) Option {
+	return wrappedOption{otlpconfig.WithTimeout(duration)}
+}
+
+// WithMaxRequestSize sets the max size in bytes of the serialized request
+// of a batch of metrics, before compression. Larger batches are split into
+// requests under the limit before they are sent, and a request the
+// collector rejects with the 413 Request Entity Too Large status is split
+// in halves that are sent again, instead of failing the whole batch. A
+// request with a single metric is not split. If unset, the default is 4
+// MiB. A size that is not positive disables the splitting of batches
+// before they are sent.
+func WithMaxRequestSize(size int) Option {
+	return wrappedOption{otlpconfig.NewHTTPOption(func(cfg *otlpconfig.Config) {
+		cfg.MaxRequestSize = size
+	})}
+}
+
+// WithHTTPClient sets the client used to send the payloads to the
+// collector. The client is used as is: its Timeout is the time limit
+// of the requests, and the TLS options are
+// ignored. Use it to route the exports through a proxy, sign the
+// requests, or instrument them.
+func WithHTTPClient(client *http.Client) Option {
+	return wrappedOption{otlpconfig.NewHTTPOption(func(cfg *otlpconfig.Config) {
+		cfg.HTTPClient = client
+	})}
+}
+
+// WithRoundTripper sets the transport of the client used to send the
+// payloads to the collector, for instance a wrapper of
+// http.DefaultTransport that signs the requests. The TLS
+// options are ignored. The default transport uses the
+// proxy configured by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables. WithHTTPClient takes precedence over this
+// option.
+func WithRoundTripper(rt http.RoundTripper) Option {
+	return wrappedOption{otlpconfig.NewHTTPOption(func(cfg *otlpconfig.Config) {
+		cfg.RoundTripper = rt
+	})}
+}
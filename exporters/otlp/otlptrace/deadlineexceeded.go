@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlptrace // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeadlineExceeded is returned by a Client when an export request did not
+// complete before its deadline, either the timeout configured for the
+// Client or the deadline of the context passed to it. Unlike other
+// failures, the spans may have been received by the endpoint, so a retry
+// policy may choose to retry them with a longer deadline, or not at all.
+//
+// errors.Is reports a DeadlineExceeded as context.DeadlineExceeded.
+type DeadlineExceeded struct {
+	// Err is the error returned by the export request.
+	Err error
+}
+
+func (de DeadlineExceeded) Error() string {
+	return fmt.Sprintf("OTLP export deadline exceeded: %v", de.Err)
+}
+
+// Unwrap returns the error returned by the export request.
+func (de DeadlineExceeded) Unwrap() error {
+	return de.Err
+}
+
+// Is returns true if target is context.DeadlineExceeded.
+func (de DeadlineExceeded) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
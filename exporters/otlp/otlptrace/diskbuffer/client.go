@@ -0,0 +1,224 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskbuffer // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/diskbuffer"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/wal"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+var errNotStarted = errors.New("diskbuffer: client not started")
+
+type client struct {
+	client otlptrace.Client
+	dir    string
+	cfg    config
+
+	mu  sync.RWMutex
+	log *wal.Log
+
+	// flushing is a semaphore ensuring buffered requests are sent by a
+	// single goroutine at a time, and therefore in order.
+	flushing chan struct{}
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+var _ otlptrace.Client = (*client)(nil)
+
+// NewClient returns an otlptrace.Client that buffers all spans in dir before
+// they are uploaded with c.
+func NewClient(c otlptrace.Client, dir string, opts ...Option) otlptrace.Client {
+	return &client{
+		client:   c,
+		dir:      dir,
+		cfg:      newConfig(opts),
+		flushing: make(chan struct{}, 1),
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start opens the buffer, starts the wrapped client, and begins sending any
+// spans left in the buffer by a previous process.
+func (c *client) Start(ctx context.Context) error {
+	l, err := wal.Open(c.dir, c.cfg.segmentSize, c.cfg.maxSize)
+	if err != nil {
+		return err
+	}
+	if err := c.client.Start(ctx); err != nil {
+		_ = l.Close()
+		return err
+	}
+
+	c.mu.Lock()
+	c.log = l
+	c.mu.Unlock()
+
+	go c.run()
+	return nil
+}
+
+func (c *client) run() {
+	defer close(c.doneCh)
+
+	ticker := time.NewTicker(c.cfg.flushInterval)
+	defer ticker.Stop()
+	for {
+		c.flushing <- struct{}{}
+		if err := c.flush(c.stopCh); err != nil {
+			otel.Handle(err)
+		}
+		<-c.flushing
+		select {
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the background flushing, closes the buffer, and stops the
+// wrapped client. Spans that have not been sent remain in the buffer.
+func (c *client) Stop(ctx context.Context) error {
+	c.mu.RLock()
+	started := c.log != nil
+	c.mu.RUnlock()
+	if !started {
+		return c.client.Stop(ctx)
+	}
+
+	close(c.stopCh)
+	select {
+	case <-c.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// Wait for any in-flight flush so the log is not closed beneath it.
+	c.flushing <- struct{}{}
+	c.mu.Lock()
+	err := c.log.Close()
+	c.mu.Unlock()
+	<-c.flushing
+
+	if stopErr := c.client.Stop(ctx); stopErr != nil {
+		return stopErr
+	}
+	return err
+}
+
+// UploadTraces persists protoSpans to the buffer and then tries to send all
+// buffered spans. If the spans were persisted, failures to send them are
+// reported to the global error handler and they are retried later instead of
+// being returned. If buffered spans are already being sent by another
+// goroutine, the persisted spans are left to it.
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	c.mu.RLock()
+	l := c.log
+	c.mu.RUnlock()
+	if l == nil {
+		return errNotStarted
+	}
+
+	b, err := proto.Marshal(&coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+	dropped, err := l.Append(b)
+	if dropped > 0 {
+		otel.Handle(fmt.Errorf("diskbuffer: maximum size reached, dropped %d buffered requests", dropped))
+	}
+	if err != nil {
+		return err
+	}
+
+	select {
+	case c.flushing <- struct{}{}:
+	default:
+		return nil
+	}
+	defer func() { <-c.flushing }()
+	if err := c.flush(ctx.Done()); err != nil {
+		otel.Handle(err)
+	}
+	return nil
+}
+
+// flush sends buffered requests in order until the buffer is empty, a
+// request fails, or done is closed. The caller must hold the flushing
+// semaphore.
+func (c *client) flush(done <-chan struct{}) error {
+	c.mu.RLock()
+	l := c.log
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-c.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+		b, err := l.Peek()
+		if err == io.EOF || err == wal.ErrClosed {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		req := &coltracepb.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(b, req); err != nil {
+			// A record that passed its checksum but cannot be decoded will
+			// never succeed, discard it.
+			otel.Handle(fmt.Errorf("diskbuffer: discarding undecodable request: %w", err))
+		} else if err := c.client.UploadTraces(ctx, req.ResourceSpans); err != nil {
+			var ps otlptrace.PartialSuccess
+			if !errors.As(err, &ps) {
+				return fmt.Errorf("diskbuffer: failed to send buffered spans: %w", err)
+			}
+			// The request was accepted, the rejected spans must not be
+			// retried.
+			otel.Handle(err)
+		}
+		if err := l.Advance(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskbuffer_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/diskbuffer"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+type fakeClient struct {
+	mu      sync.Mutex
+	failing bool
+	names   []string
+	stopped bool
+}
+
+var _ otlptrace.Client = (*fakeClient)(nil)
+
+func (c *fakeClient) Start(context.Context) error { return nil }
+
+func (c *fakeClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	return nil
+}
+
+func (c *fakeClient) UploadTraces(_ context.Context, rss []*tracepb.ResourceSpans) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failing {
+		return errors.New("collector unavailable")
+	}
+	for _, rs := range rss {
+		for _, ils := range rs.InstrumentationLibrarySpans {
+			for _, s := range ils.Spans {
+				c.names = append(c.names, s.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *fakeClient) setFailing(f bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failing = f
+}
+
+func (c *fakeClient) received() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.names...)
+}
+
+func spans(name string) []*tracepb.ResourceSpans {
+	return []*tracepb.ResourceSpans{{
+		InstrumentationLibrarySpans: []*tracepb.InstrumentationLibrarySpans{{
+			Spans: []*tracepb.Span{{Name: name}},
+		}},
+	}}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "diskbuffer")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func TestUploadNotStarted(t *testing.T) {
+	c := diskbuffer.NewClient(&fakeClient{}, tempDir(t))
+	assert.Error(t, c.UploadTraces(context.Background(), spans("a")))
+}
+
+func TestUploadSendsImmediately(t *testing.T) {
+	ctx := context.Background()
+	fc := &fakeClient{}
+	c := diskbuffer.NewClient(fc, tempDir(t))
+	require.NoError(t, c.Start(ctx))
+
+	require.NoError(t, c.UploadTraces(ctx, spans("a")))
+	require.NoError(t, c.UploadTraces(ctx, spans("b")))
+	require.NoError(t, c.Stop(ctx))
+
+	assert.Equal(t, []string{"a", "b"}, fc.received())
+	assert.True(t, fc.stopped)
+}
+
+func TestUploadBuffersWhileUnavailable(t *testing.T) {
+	ctx := context.Background()
+	fc := &fakeClient{failing: true}
+	c := diskbuffer.NewClient(fc, tempDir(t), diskbuffer.WithFlushInterval(time.Millisecond))
+	require.NoError(t, c.Start(ctx))
+	defer func() { require.NoError(t, c.Stop(ctx)) }()
+
+	require.NoError(t, c.UploadTraces(ctx, spans("a")))
+	require.NoError(t, c.UploadTraces(ctx, spans("b")))
+	assert.Empty(t, fc.received())
+
+	fc.setFailing(false)
+	assert.Eventually(t, func() bool {
+		return len(fc.received()) == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"a", "b"}, fc.received())
+}
+
+func TestBufferSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := tempDir(t)
+
+	down := &fakeClient{failing: true}
+	c := diskbuffer.NewClient(down, dir)
+	require.NoError(t, c.Start(ctx))
+	require.NoError(t, c.UploadTraces(ctx, spans("a")))
+	require.NoError(t, c.UploadTraces(ctx, spans("b")))
+	require.NoError(t, c.Stop(ctx))
+	assert.Empty(t, down.received())
+
+	up := &fakeClient{}
+	c = diskbuffer.NewClient(up, dir, diskbuffer.WithFlushInterval(time.Millisecond))
+	require.NoError(t, c.Start(ctx))
+	assert.Eventually(t, func() bool {
+		return len(up.received()) == 2
+	}, time.Second, time.Millisecond)
+	require.NoError(t, c.UploadTraces(ctx, spans("c")))
+	require.NoError(t, c.Stop(ctx))
+	assert.Equal(t, []string{"a", "b", "c"}, up.received())
+}
+
+func TestMaxSizeDropsOldest(t *testing.T) {
+	ctx := context.Background()
+	fc := &fakeClient{failing: true}
+	// Each persisted request uses 17 bytes and is stored in its own segment.
+	c := diskbuffer.NewClient(fc, tempDir(t),
+		diskbuffer.WithMaxSize(40),
+		diskbuffer.WithSegmentSize(1),
+		diskbuffer.WithFlushInterval(time.Millisecond),
+	)
+	require.NoError(t, c.Start(ctx))
+	defer func() { require.NoError(t, c.Stop(ctx)) }()
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, c.UploadTraces(ctx, spans(name)))
+	}
+
+	fc.setFailing(false)
+	assert.Eventually(t, func() bool {
+		return len(fc.received()) == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"c", "d"}, fc.received())
+}
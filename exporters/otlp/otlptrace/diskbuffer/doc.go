@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package diskbuffer provides an otlptrace.Client that persists export requests
+on disk before they are sent by another otlptrace.Client.
+
+Spans handed to the client are first appended to a bounded, segmented
+write-ahead log stored in a directory. They are only removed from the log
+once the wrapped client has successfully uploaded them. If the collector is
+unreachable, spans accumulate on disk and are sent in order once the
+connection recovers, including after a restart of the process using the same
+directory. When the log reaches its maximum size the oldest spans are
+dropped and reported to the global error handler.
+
+	client := diskbuffer.NewClient(
+		otlptracegrpc.NewClient(otlptracegrpc.WithInsecure()),
+		"/var/lib/myapp/otlp-traces",
+		diskbuffer.WithMaxSize(256<<20),
+	)
+	exporter, err := otlptrace.NewExporter(ctx, client)
+
+Records are written to the operating system without being synced to stable
+storage on every export. They survive a crash of the process, but recent
+records may be lost if the host itself fails.
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+*/
+package diskbuffer // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/diskbuffer"
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskbuffer // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/diskbuffer"
+
+import "time"
+
+const (
+	// DefaultMaxSize is the default maximum number of bytes used on disk.
+	DefaultMaxSize int64 = 64 << 20
+	// DefaultSegmentSize is the default size of a single log segment file.
+	DefaultSegmentSize int64 = 4 << 20
+	// DefaultFlushInterval is the default period between attempts to send
+	// buffered spans.
+	DefaultFlushInterval = 5 * time.Second
+)
+
+type config struct {
+	maxSize       int64
+	segmentSize   int64
+	flushInterval time.Duration
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		maxSize:       DefaultMaxSize,
+		segmentSize:   DefaultSegmentSize,
+		flushInterval: DefaultFlushInterval,
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+	if cfg.segmentSize > cfg.maxSize {
+		cfg.segmentSize = cfg.maxSize
+	}
+	return cfg
+}
+
+// Option applies an option to the disk buffer client.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(cfg *config) {
+	fn(cfg)
+}
+
+// WithMaxSize sets the maximum number of bytes the buffer may use on disk.
+// Once reached, the oldest buffered spans are dropped. If unset or not
+// positive, DefaultMaxSize is used.
+func WithMaxSize(bytes int64) Option {
+	return optionFunc(func(cfg *config) {
+		if bytes > 0 {
+			cfg.maxSize = bytes
+		}
+	})
+}
+
+// WithSegmentSize sets the size at which a new segment file of the buffer
+// is started. Space is reclaimed one segment at a time. If unset or not
+// positive, DefaultSegmentSize is used.
+func WithSegmentSize(bytes int64) Option {
+	return optionFunc(func(cfg *config) {
+		if bytes > 0 {
+			cfg.segmentSize = bytes
+		}
+	})
+}
+
+// WithFlushInterval sets the period between background attempts to send
+// buffered spans while the collector is unreachable. If unset or not
+// positive, DefaultFlushInterval is used.
+func WithFlushInterval(d time.Duration) Option {
+	return optionFunc(func(cfg *config) {
+		if d > 0 {
+			cfg.flushInterval = d
+		}
+	})
+}
@@ -18,10 +18,13 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/exportstatus"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 )
 
@@ -29,10 +32,53 @@ var (
 	errAlreadyStarted = errors.New("already started")
 )
 
+// Stats are the cumulative counts of spans handled by an Exporter.
+type Stats struct {
+	// ExportedSpans is the number of spans accepted by the endpoint.
+	ExportedSpans int64
+	// RejectedSpans is the number of spans the endpoint reported as
+	// rejected in a partial success response.
+	RejectedSpans int64
+	// FailedSpans is the number of spans that could not be exported
+	// because the Client returned an error.
+	FailedSpans int64
+}
+
+// Status is the health of an Exporter: the time of its last successful
+// export, the number of exports that failed since, and the error of its
+// most recent export.
+type Status struct {
+	// LastSuccessfulExport is the time the last export accepted by the
+	// endpoint completed. It is the zero time if no export succeeded yet.
+	LastSuccessfulExport time.Time
+	// ConsecutiveFailures is the number of exports that failed since the
+	// last successful one.
+	ConsecutiveFailures int
+	// LastError is the error of the most recent export, or nil if it
+	// succeeded.
+	LastError error
+}
+
+// PipelineStatus is the Status of an Exporter along with the length of the
+// queue of the batch span processor exporting spans to it.
+type PipelineStatus struct {
+	Status
+	// QueueLength is the number of spans waiting to be exported.
+	QueueLength int
+}
+
 // Exporter exports trace data in the OTLP wire format.
 type Exporter struct {
+	// The counters are accessed atomically and are kept first to ensure
+	// 64-bit alignment.
+	exported int64
+	rejected int64
+	failed   int64
+
 	client Client
 
+	status exportstatus.Recorder
+
 	mu      sync.RWMutex
 	started bool
 
@@ -41,13 +87,67 @@ type Exporter struct {
 }
 
 // ExportSpans exports a batch of spans.
+//
+// If the Client reports that the endpoint rejected part of the batch with a
+// PartialSuccess, it is passed to the global error handler and nil is
+// returned as the rejected spans must not be retried.
 func (e *Exporter) ExportSpans(ctx context.Context, ss []tracesdk.ReadOnlySpan) error {
 	protoSpans := tracetransform.Spans(ss)
 	if len(protoSpans) == 0 {
 		return nil
 	}
 
-	return e.client.UploadTraces(ctx, protoSpans)
+	n := int64(len(ss))
+	err := e.client.UploadTraces(ctx, protoSpans)
+	var ps PartialSuccess
+	switch {
+	case err == nil:
+		atomic.AddInt64(&e.exported, n)
+	case errors.As(err, &ps):
+		rejected := ps.RejectedSpans
+		if rejected < 0 || rejected > n {
+			rejected = n
+		}
+		atomic.AddInt64(&e.exported, n-rejected)
+		atomic.AddInt64(&e.rejected, rejected)
+		otel.Handle(err)
+		err = nil
+	default:
+		atomic.AddInt64(&e.failed, n)
+	}
+	e.status.Record(err)
+	return err
+}
+
+// Status returns the health of the Exporter based on its recent exports.
+func (e *Exporter) Status() Status {
+	s := e.status.Status()
+	return Status{
+		LastSuccessfulExport: s.LastSuccessfulExport,
+		ConsecutiveFailures:  s.ConsecutiveFailures,
+		LastError:            s.LastError,
+	}
+}
+
+// PipelineStatus returns the Status of the Exporter along with the queue
+// length of bsp, the batch span processor exporting spans to it. The queue
+// length is zero if bsp was not created by NewBatchSpanProcessor of the
+// go.opentelemetry.io/otel/sdk/trace package.
+func (e *Exporter) PipelineStatus(bsp tracesdk.SpanProcessor) PipelineStatus {
+	stats, _ := tracesdk.ReadBatchSpanProcessorStats(bsp)
+	return PipelineStatus{
+		Status:      e.Status(),
+		QueueLength: stats.QueueLength,
+	}
+}
+
+// Stats returns the cumulative counts of spans handled by the Exporter.
+func (e *Exporter) Stats() Stats {
+	return Stats{
+		ExportedSpans: atomic.LoadInt64(&e.exported),
+		RejectedSpans: atomic.LoadInt64(&e.rejected),
+		FailedSpans:   atomic.LoadInt64(&e.failed),
+	}
 }
 
 // Start establishes a connection to the receiving endpoint.
@@ -105,6 +205,9 @@ func NewUnstartedExporter(client Client) *Exporter {
 
 // NewExportPipeline sets up a complete export pipeline
 // with the recommended TracerProvider setup.
+//
+// Deprecated: Use Launch instead, which also configures the resource,
+// sampler, span limits, propagators, and batch span processor.
 func NewExportPipeline(ctx context.Context, client Client) (*Exporter, *tracesdk.TracerProvider, error) {
 	exp, err := NewExporter(ctx, client)
 	if err != nil {
@@ -120,6 +223,9 @@ func NewExportPipeline(ctx context.Context, client Client) (*Exporter, *tracesdk
 
 // InstallNewPipeline instantiates a NewExportPipeline with the
 // recommended configuration and registers it globally.
+//
+// Deprecated: Use Launch instead, which also configures the resource,
+// sampler, span limits, propagators, and batch span processor.
 func InstallNewPipeline(ctx context.Context, client Client) (*Exporter, *tracesdk.TracerProvider, error) {
 	exp, tp, err := NewExportPipeline(ctx, client)
 	if err != nil {
@@ -16,14 +16,18 @@ package otlptrace_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
@@ -64,3 +68,118 @@ func TestNewExportPipeline(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEqual(t, tp, otel.GetTracerProvider())
 }
+
+type errClient struct {
+	noopClient
+	err error
+}
+
+func (c *errClient) UploadTraces(_ context.Context, _ []*tracepb.ResourceSpans) error {
+	return c.err
+}
+
+func TestExporterStats(t *testing.T) {
+	ctx := context.Background()
+	spans := tracetest.SpanStubs{{Name: "a"}, {Name: "b"}, {Name: "c"}}.Snapshots()
+
+	client := &errClient{}
+	exp, err := otlptrace.NewExporter(ctx, client)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	assert.Equal(t, otlptrace.Stats{ExportedSpans: 3}, exp.Stats())
+
+	client.err = otlptrace.PartialSuccess{RejectedSpans: 2, ErrorMessage: "bad"}
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	assert.Equal(t, otlptrace.Stats{ExportedSpans: 4, RejectedSpans: 2}, exp.Stats())
+
+	client.err = errors.New("unavailable")
+	assert.Error(t, exp.ExportSpans(ctx, spans))
+	assert.Equal(t, otlptrace.Stats{ExportedSpans: 4, RejectedSpans: 2, FailedSpans: 3}, exp.Stats())
+
+	assert.NoError(t, exp.Shutdown(ctx))
+}
+
+func TestPartialSuccessError(t *testing.T) {
+	err := otlptrace.PartialSuccess{RejectedSpans: 2, ErrorMessage: "bad"}
+	assert.Equal(t, "OTLP partial success: bad (2 spans rejected)", err.Error())
+	err = otlptrace.PartialSuccess{RejectedSpans: 1}
+	assert.Equal(t, "OTLP partial success: empty message (1 spans rejected)", err.Error())
+}
+
+func TestExporterStatus(t *testing.T) {
+	ctx := context.Background()
+	spans := tracetest.SpanStubs{{Name: "a"}}.Snapshots()
+
+	client := &errClient{}
+	exp, err := otlptrace.NewExporter(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, otlptrace.Status{}, exp.Status())
+
+	before := time.Now()
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	status := exp.Status()
+	assert.False(t, status.LastSuccessfulExport.Before(before))
+	assert.Equal(t, 0, status.ConsecutiveFailures)
+	assert.NoError(t, status.LastError)
+
+	client.err = errors.New("unavailable")
+	assert.Error(t, exp.ExportSpans(ctx, spans))
+	assert.Error(t, exp.ExportSpans(ctx, spans))
+	assert.Equal(t, otlptrace.Status{
+		LastSuccessfulExport: status.LastSuccessfulExport,
+		ConsecutiveFailures:  2,
+		LastError:            client.err,
+	}, exp.Status())
+
+	// A partial success means the endpoint is reachable.
+	client.err = otlptrace.PartialSuccess{RejectedSpans: 1}
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	assert.Equal(t, 0, exp.Status().ConsecutiveFailures)
+	assert.NoError(t, exp.Status().LastError)
+
+	assert.NoError(t, exp.Shutdown(ctx))
+}
+
+type blockingClient struct {
+	noopClient
+	unblock chan struct{}
+}
+
+func (c *blockingClient) UploadTraces(ctx context.Context, _ []*tracepb.ResourceSpans) error {
+	select {
+	case <-c.unblock:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestExporterPipelineStatus(t *testing.T) {
+	ctx := context.Background()
+	client := &blockingClient{unblock: make(chan struct{})}
+	exp, err := otlptrace.NewExporter(ctx, client)
+	require.NoError(t, err)
+
+	bsp := tracesdk.NewBatchSpanProcessor(exp, tracesdk.WithMaxExportBatchSize(1))
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(bsp))
+	for i := 0; i < 5; i++ {
+		_, span := tp.Tracer("TestExporterPipelineStatus").Start(ctx, "span")
+		span.End()
+	}
+
+	// The first span is being exported, the others are queued.
+	assert.Eventually(t, func() bool {
+		return exp.PipelineStatus(bsp).QueueLength == 4
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, otlptrace.Status{}, exp.PipelineStatus(bsp).Status)
+
+	close(client.unblock)
+	require.NoError(t, tp.Shutdown(ctx))
+	status := exp.PipelineStatus(bsp)
+	assert.Equal(t, 0, status.QueueLength)
+	assert.False(t, status.LastSuccessfulExport.IsZero())
+
+	ssp := tracesdk.NewSimpleSpanProcessor(exp)
+	assert.Equal(t, 0, exp.PipelineStatus(ssp).QueueLength)
+}
@@ -5,6 +5,7 @@ go 1.15
 require (
 	github.com/cenkalti/backoff/v4 v4.1.0
 	github.com/google/go-cmp v0.5.5
+	github.com/klauspost/compress v1.13.0
 	github.com/stretchr/testify v1.7.0
 	go.opentelemetry.io/otel v0.20.0
 	go.opentelemetry.io/otel/oteltest v0.20.0
@@ -52,8 +53,6 @@ replace go.opentelemetry.io/otel/exporters/otlp/otlptrace => ./
 
 replace go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc => ./otlptracegrpc
 
-replace go.opentelemetry.io/otel/exporters/stdout => ../../stdout
-
 replace go.opentelemetry.io/otel/exporters/trace/jaeger => ../../trace/jaeger
 
 replace go.opentelemetry.io/otel/exporters/trace/zipkin => ../../trace/zipkin
@@ -65,3 +64,43 @@ replace go.opentelemetry.io/otel/sdk/export/metric => ../../../sdk/export/metric
 replace go.opentelemetry.io/otel/sdk/metric => ../../../sdk/metric
 
 replace go.opentelemetry.io/otel/example/passthrough => ../../../example/passthrough
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs => ../otlplogs
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogsgrpc => ../otlplogs/otlplogsgrpc
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlplogs/otlplogshttp => ../otlplogs/otlplogshttp
+
+replace go.opentelemetry.io/otel/samplers/jaegerremote => ../../../samplers/jaegerremote
+
+replace go.opentelemetry.io/otel/sdk/config => ../../../sdk/config
+
+replace go.opentelemetry.io/otel/exporters/autoexport => ../../autoexport
+
+replace go.opentelemetry.io/otel/log => ../../../log
+
+replace go.opentelemetry.io/otel/bridge/otelslog => ../../../bridge/otelslog
+
+replace go.opentelemetry.io/otel/propagators/aws => ../../../propagators/aws
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdoutmetric => ../../stdout/stdoutmetric
+
+replace go.opentelemetry.io/otel/exporters/stdout/stdouttrace => ../../stdout/stdouttrace
+
+replace go.opentelemetry.io/otel/exporters/file => ../../file
+
+replace go.opentelemetry.io/otel/schema => ../../../schema
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric => ../otlpmetric
+
+replace go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp => ../otlpmetric/otlpmetrichttp
+
+replace go.opentelemetry.io/otel/zpages => ../../../zpages
+
+replace go.opentelemetry.io/otel/instrumentation/runtime => ../../../instrumentation/runtime
+
+replace go.opentelemetry.io/otel/instrumentation/host => ../../../instrumentation/host
+
+replace go.opentelemetry.io/otel/instrumentation/net/http/otelhttp => ../../../instrumentation/net/http/otelhttp
+
+replace go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc => ../../../instrumentation/google.golang.org/grpc/otelgrpc
@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -31,6 +33,8 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/zstd"
+	"go.opentelemetry.io/otel/internal/global"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -110,6 +114,9 @@ func (c *Connection) saveLastConnectError(err error) {
 }
 
 func (c *Connection) SetStateDisconnected(err error) {
+	if c.Connected() {
+		global.Info("disconnected from collector", "endpoint", c.SCfg.Endpoint, "reason", err)
+	}
 	c.saveLastConnectError(err)
 	select {
 	case c.disconnectedCh <- true:
@@ -119,6 +126,9 @@ func (c *Connection) SetStateDisconnected(err error) {
 }
 
 func (c *Connection) setStateConnected() {
+	if !c.Connected() {
+		global.Info("connected to collector", "endpoint", c.SCfg.Endpoint)
+	}
 	c.saveLastConnectError(nil)
 }
 
@@ -189,9 +199,15 @@ func (c *Connection) indefiniteBackgroundConnection() {
 }
 
 func (c *Connection) connect(ctx context.Context) error {
-	cc, err := c.dialToCollector(ctx)
-	if err != nil {
-		return err
+	// A connection provided by the user is used as is, it is not
+	// dialed or closed.
+	cc := c.cfg.GRPCConn
+	if cc == nil {
+		var err error
+		cc, err = c.dialToCollector(ctx)
+		if err != nil {
+			return err
+		}
 	}
 	c.setConnection(cc)
 	c.newConnectionHandler(cc)
@@ -212,7 +228,7 @@ func (c *Connection) setConnection(cc *grpc.ClientConn) bool {
 	}
 
 	// If the previous clientConn was non-nil, close it
-	if c.cc != nil {
+	if c.cc != nil && c.cc != c.cfg.GRPCConn {
 		_ = c.cc.Close()
 	}
 	c.cc = cc
@@ -229,8 +245,14 @@ func (c *Connection) dialToCollector(ctx context.Context) (*grpc.ClientConn, err
 	} else if c.SCfg.Insecure {
 		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
-	if c.SCfg.Compression == otlpconfig.GzipCompression {
+	switch c.SCfg.Compression {
+	case otlpconfig.GzipCompression:
 		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	case otlpconfig.ZstdCompression:
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(zstd.Name)))
+	}
+	if c.cfg.Dialer != nil {
+		dialOpts = append(dialOpts, grpc.WithContextDialer(c.contextDialer()))
 	}
 	if len(c.cfg.DialOptions) != 0 {
 		dialOpts = append(dialOpts, c.cfg.DialOptions...)
@@ -242,6 +264,19 @@ func (c *Connection) dialToCollector(ctx context.Context) (*grpc.ClientConn, err
 	return grpc.DialContext(ctx, c.SCfg.Endpoint, dialOpts...)
 }
 
+// contextDialer adapts the configured Dialer to gRPC, which only passes
+// the address to dial. Unix socket endpoints are resolved by gRPC into
+// the socket path.
+func (c *Connection) contextDialer() func(context.Context, string) (net.Conn, error) {
+	network := "tcp"
+	if _, ok := otlpconfig.UnixSocketPath(c.SCfg.Endpoint); ok {
+		network = "unix"
+	}
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		return c.cfg.Dialer(ctx, network, address)
+	}
+}
+
 func (c *Connection) ContextWithMetadata(ctx context.Context) context.Context {
 	if c.metadata.Len() > 0 {
 		return metadata.NewOutgoingContext(ctx, c.metadata)
@@ -263,7 +298,7 @@ func (c *Connection) Shutdown(ctx context.Context) error {
 	c.cc = nil
 	c.mu.Unlock()
 
-	if cc != nil {
+	if cc != nil && cc != c.cfg.GRPCConn {
 		return cc.Close()
 	}
 
@@ -309,7 +344,7 @@ func (c *Connection) DoRequest(ctx context.Context, fn func(context.Context) err
 
 		// Now, this is this a real error.
 
-		if !shouldRetry(st.Code()) {
+		if !shouldRetry(st.Code()) || IsMessageTooLarge(err) {
 			// It is not a retryable error, we should not retry.
 			return err
 		}
@@ -339,6 +374,8 @@ func (c *Connection) DoRequest(ctx context.Context, fn func(context.Context) err
 			delay = throttle
 		}
 
+		global.Debug("retrying export", "code", st.Code(), "delay", delay)
+
 		// back-off, but get interrupted when shutting down or request is cancelled or timed out.
 		err = func() error {
 			dt := time.NewTimer(delay)
@@ -397,6 +434,49 @@ func shouldRetry(code codes.Code) bool {
 	}
 }
 
+// messageTooLargeTexts are lowercased parts of the messages the gRPC
+// implementations return when a message is larger than the max message
+// size: grpc-go, gRPC C-core and grpc-js use the first one, grpc-java the
+// second one, and grpc-dotnet the third one.
+var messageTooLargeTexts = []string{
+	"larger than max",
+	"exceeds maximum size",
+	"exceeds the maximum configured message size",
+}
+
+// IsMessageTooLarge returns true if err is the error returned by gRPC when
+// a message is larger than the max message size of the client or of the
+// server. Sending the same message again cannot succeed.
+//
+// gRPC has no status detail for this error, it is recognized by the
+// messages of the main gRPC implementations. A ResourceExhausted error
+// with another message, for example from a proxy, or with a RetryInfo
+// detail is handled as throttling.
+func IsMessageTooLarge(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted || hasRetryInfo(st) {
+		return false
+	}
+	msg := strings.ToLower(st.Message())
+	for _, text := range messageTooLargeTexts {
+		if strings.Contains(msg, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRetryInfo returns true if the server sent a RetryInfo detail with
+// status, asking for the request to be retried later.
+func hasRetryInfo(status *status.Status) bool {
+	for _, detail := range status.Details() {
+		if _, ok := detail.(*errdetails.RetryInfo); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func getThrottleDuration(status *status.Status) time.Duration {
 	// See if throttling information is available.
 	for _, detail := range status.Details() {
@@ -414,9 +494,16 @@ func getThrottleDuration(status *status.Status) time.Duration {
 func newExponentialBackoff(rs otlpconfig.RetrySettings) *backoff.ExponentialBackOff {
 	// Do not use NewExponentialBackOff since it calls Reset and the code here must
 	// call Reset after changing the InitialInterval (this saves an unnecessary call to Now).
+	jitter := backoff.DefaultRandomizationFactor
+	if rs.JitterDisabled {
+		jitter = 0
+	} else if rs.Jitter > 0 && rs.Jitter <= 1 {
+		jitter = rs.Jitter
+	}
+
 	expBackoff := &backoff.ExponentialBackOff{
 		InitialInterval:     rs.InitialInterval,
-		RandomizationFactor: backoff.DefaultRandomizationFactor,
+		RandomizationFactor: jitter,
 		Multiplier:          backoff.DefaultMultiplier,
 		MaxInterval:         rs.MaxInterval,
 		MaxElapsedTime:      rs.MaxElapsedTime,
@@ -18,12 +18,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
 )
 
 func TestGetThrottleDuration(t *testing.T) {
@@ -88,3 +91,60 @@ func TestGetThrottleDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMessageTooLarge(t *testing.T) {
+	require.True(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "grpc: received message larger than max (5000 vs. 4096)")))
+	require.True(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "grpc: trying to send message larger than max (5000 vs. 4096)")))
+	// grpc-java, gRPC C-core, and grpc-dotnet messages.
+	require.True(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "gRPC message exceeds maximum size 4194304: 5000000")))
+	require.True(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "Received message larger than max (5000 vs. 4096)")))
+	require.True(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "Received message exceeds the maximum configured message size.")))
+	require.False(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "quota exceeded")))
+	// Unknown messages, such as the ones of proxies, are handled as
+	// throttling.
+	require.False(t, IsMessageTooLarge(status.Error(codes.ResourceExhausted, "request entity too large")))
+
+	throttled, err := status.New(codes.ResourceExhausted, "grpc: received message larger than max (5000 vs. 4096)").WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)},
+	)
+	require.NoError(t, err)
+	require.False(t, IsMessageTooLarge(throttled.Err()), "a RetryInfo detail marks throttling")
+	require.False(t, IsMessageTooLarge(status.Error(codes.InvalidArgument, "message larger than max")))
+	require.False(t, IsMessageTooLarge(nil))
+}
+
+func TestNewExponentialBackoffJitter(t *testing.T) {
+	tts := []struct {
+		name     string
+		jitter   float64
+		disabled bool
+		want     float64
+	}{
+		{name: "unset uses default", want: backoff.DefaultRandomizationFactor},
+		{name: "disabled", disabled: true, want: 0},
+		{name: "disabled ignores jitter", jitter: 0.2, disabled: true, want: 0},
+		{name: "negative uses default", jitter: -1, want: backoff.DefaultRandomizationFactor},
+		{name: "too large uses default", jitter: 1.5, want: backoff.DefaultRandomizationFactor},
+		{name: "valid value is used", jitter: 0.2, want: 0.2},
+		{name: "upper bound is used", jitter: 1, want: 1},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := otlpconfig.RetrySettings{
+				Enabled:         true,
+				InitialInterval: time.Second,
+				MaxInterval:     time.Minute,
+				MaxElapsedTime:  time.Hour,
+				Jitter:          tt.jitter,
+				JitterDisabled:  tt.disabled,
+			}
+			b := newExponentialBackoff(rs)
+			require.Equal(t, tt.want, b.RandomizationFactor)
+
+			delay := b.NextBackOff()
+			require.GreaterOrEqual(t, int64(delay), int64(float64(time.Second)*(1-tt.want)))
+			require.LessOrEqual(t, int64(delay), int64(float64(time.Second)*(1+tt.want)))
+		})
+	}
+}
@@ -15,8 +15,11 @@
 package otlpconfig // import "go.opentelemetry.io/otel/exporters/otlp/internal/otlpconfig"
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
@@ -37,6 +40,9 @@ const (
 	// DefaultTimeout is a default max waiting time for the backend to process
 	// each span batch.
 	DefaultTimeout time.Duration = 10 * time.Second
+	// DefaultMaxRequestSize is a default max size in bytes of an export
+	// request, the default max message size of gRPC servers.
+	DefaultMaxRequestSize int = 4 * 1024 * 1024
 )
 
 var (
@@ -67,6 +73,10 @@ type (
 		// Signal specific configurations
 		Traces SignalConfig
 
+		// Dialer establishes the connections to the collector,
+		// instead of the default dialer of the transport.
+		Dialer func(ctx context.Context, network, address string) (net.Conn, error)
+
 		// HTTP configurations
 		Marshaler   Marshaler
 		MaxAttempts int
@@ -76,7 +86,19 @@ type (
 		ReconnectionPeriod time.Duration
 		ServiceConfig      string
 		DialOptions        []grpc.DialOption
+		GRPCConn           *grpc.ClientConn
 		RetrySettings      RetrySettings
+
+		// Endpoints are the collector endpoints requests are load
+		// balanced across with LoadBalancing, instead of sending
+		// them to the endpoint of the signal.
+		Endpoints     []string
+		LoadBalancing LoadBalancingPolicy
+
+		// MaxRequestSize is the max size in bytes of an export
+		// request, larger batches are split. It is not applied if not
+		// positive.
+		MaxRequestSize int
 	}
 )
 
@@ -88,9 +110,10 @@ func NewDefaultConfig() Config {
 			Compression: NoCompression,
 			Timeout:     DefaultTimeout,
 		},
-		MaxAttempts:   DefaultMaxAttempts,
-		Backoff:       DefaultBackoff,
-		RetrySettings: defaultRetrySettings,
+		MaxAttempts:    DefaultMaxAttempts,
+		Backoff:        DefaultBackoff,
+		RetrySettings:  defaultRetrySettings,
+		MaxRequestSize: DefaultMaxRequestSize,
 	}
 
 	return c
@@ -214,6 +237,12 @@ func WithTracesEndpoint(endpoint string) GenericOption {
 	})
 }
 
+func WithDialer(dialer func(ctx context.Context, network, address string) (net.Conn, error)) GenericOption {
+	return newGenericOption(func(cfg *Config) {
+		cfg.Dialer = dialer
+	})
+}
+
 func WithCompression(compression Compression) GenericOption {
 	return newGenericOption(func(cfg *Config) {
 		cfg.Traces.Compression = compression
@@ -246,6 +275,10 @@ func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	})
 }
 
+func WithTLSFiles(certificate, clientCertificate, clientKey string) GenericOption {
+	return WithTLSClientConfig(NewFileTLSConfig(certificate, clientCertificate, clientKey))
+}
+
 func WithTracesTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg *Config) {
 		cfg.Traces.TLSCfg = tlsCfg.Clone()
@@ -313,3 +346,17 @@ func WithBackoff(duration time.Duration) GenericOption {
 		cfg.Backoff = duration
 	})
 }
+
+// UnixSocketPath returns the path of the Unix domain socket endpoint
+// refers to, and whether endpoint uses the unix scheme. Both the
+// unix:relative/path and unix:///absolute/path forms are accepted.
+func UnixSocketPath(endpoint string) (string, bool) {
+	if !strings.HasPrefix(endpoint, "unix:") {
+		return "", false
+	}
+	socket := strings.TrimPrefix(endpoint, "unix:")
+	if strings.HasPrefix(socket, "//") {
+		socket = socket[2:]
+	}
+	return socket, true
+}
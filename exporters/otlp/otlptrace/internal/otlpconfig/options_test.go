@@ -281,6 +281,122 @@ func TestConfigs(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Test Environment Endpoint URL",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "https://env_endpoint:4318/base/",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, "env_endpoint:4318", c.Traces.Endpoint)
+				assert.Equal(t, "/base/v1/traces", c.Traces.URLPath)
+				assert.False(t, c.Traces.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Endpoint URL",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":        "https://env_endpoint:4318/base",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://env_traces_endpoint:4318/custom/traces",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, "env_traces_endpoint:4318", c.Traces.Endpoint)
+				assert.Equal(t, "/custom/traces", c.Traces.URLPath)
+				assert.True(t, c.Traces.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Endpoint Without Path",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":        "https://env_endpoint:4318/base",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "https://env_traces_endpoint:4318",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, "env_traces_endpoint:4318", c.Traces.Endpoint)
+				assert.Equal(t, otlpconfig.DefaultTracesPath, c.Traces.URLPath)
+			},
+		},
+		{
+			name: "Test Environment Insecure",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "env_endpoint:4317",
+				"OTEL_EXPORTER_OTLP_INSECURE": "true",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, "env_endpoint:4317", c.Traces.Endpoint)
+				assert.True(t, c.Traces.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Endpoint Scheme Overrides Insecure",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":        "https://env_endpoint:4317",
+				"OTEL_EXPORTER_OTLP_TRACES_INSECURE": "true",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.False(t, c.Traces.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Client Certificate",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CERTIFICATE":               "cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE":        "overrode_by_signal_specific",
+				"OTEL_EXPORTER_OTLP_TRACES_CLIENT_CERTIFICATE": "client_cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":                "client_key_path",
+			},
+			fileReader: fileReader{
+				"cert_path":        []byte(WeakCertificate),
+				"client_cert_path": []byte(WeakCertificate),
+				"client_key_path":  []byte(WeakPrivateKey),
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if grpcOption {
+					assert.NotNil(t, c.Traces.GRPCCredentials)
+				} else {
+					assert.Equal(t, tlsCert.RootCAs.Subjects(), c.Traces.TLSCfg.RootCAs.Subjects())
+					assert.Len(t, c.Traces.TLSCfg.Certificates, 1)
+				}
+			},
+		},
+		{
+			name: "Test Environment Client Certificate Without Key",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "client_cert_path",
+			},
+			fileReader: fileReader{
+				"client_cert_path": []byte(WeakCertificate),
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Nil(t, c.Traces.TLSCfg)
+				assert.Nil(t, c.Traces.GRPCCredentials)
+			},
+		},
+
+		// Protocol tests
+		{
+			name: "Test Environment Protocol",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_PROTOCOL": "http/json",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if grpcOption {
+					assert.Equal(t, otlpconfig.MarshalProto, c.Marshaler)
+				} else {
+					assert.Equal(t, otlpconfig.MarshalJSON, c.Marshaler)
+				}
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Protocol",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_PROTOCOL":        "http/json",
+				"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL": "http/protobuf",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, otlpconfig.MarshalProto, c.Marshaler)
+			},
+		},
+
 		// Headers tests
 		{
 			name: "Test With Headers",
@@ -365,6 +481,15 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, otlpconfig.GzipCompression, c.Traces.Compression)
 			},
 		},
+		{
+			name: "Test Environment Zstd Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_TRACES_COMPRESSION": "zstd",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, otlpconfig.ZstdCompression, c.Traces.Compression)
+			},
+		},
 		{
 			name: "Test Mixed Environment and With Compression",
 			opts: []otlpconfig.GenericOption{
@@ -36,6 +36,22 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	ZstdCompression
+)
+
+// LoadBalancingPolicy selects the collector endpoint each request is sent
+// to when several endpoints are configured.
+type LoadBalancingPolicy int
+
+const (
+	// RoundRobinLoadBalancing tells the driver to send requests to the
+	// connected endpoints in turn.
+	RoundRobinLoadBalancing LoadBalancingPolicy = iota
+	// LeastPendingLoadBalancing tells the driver to send each request to
+	// the connected endpoint with the fewest requests in flight.
+	LeastPendingLoadBalancing
 )
 
 // Marshaler describes the kind of message format sent to the collector
@@ -61,4 +77,13 @@ type RetrySettings struct {
 	// MaxElapsedTime is the maximum amount of time (including retries) spent trying to send a request/batch.
 	// Once this value is reached, the data is discarded.
 	MaxElapsedTime time.Duration
+	// Jitter is the randomization factor applied to each backoff interval. The actual delay
+	// is randomly chosen from [interval*(1-Jitter), interval*(1+Jitter)] to avoid lockstep
+	// retries from many exporters. Valid values are in the range (0, 1]. If zero, or set to
+	// a value outside of the range, a randomization factor of 0.5 is used, so the zero value
+	// of RetrySettings keeps the default randomization. Set JitterDisabled to disable it.
+	Jitter float64
+	// JitterDisabled disables the randomization of the backoff intervals, Jitter is then
+	// ignored.
+	JitterDisabled bool
 }
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"otel-go"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns the PEM certificate and key of a leaf certificate
+// signed by the CA.
+func (ca *testCA) issue(t *testing.T, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{Organization: []string{"otel-go"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeFile writes data to path and moves its modification time
+// forward, so that the change is seen on file systems with a coarse
+// time resolution.
+func writeFile(t *testing.T, path string, data []byte, mod time.Time) {
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+	require.NoError(t, os.Chtimes(path, mod, mod))
+}
+
+func TestFileTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otlpconfig")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+
+	serverCA, clientCA := newTestCA(t), newTestCA(t)
+	serverCert, serverKey := serverCA.issue(t, x509.ExtKeyUsageServerAuth)
+	keyPair, err := tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCA.cert)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	srv.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   NewFileTLSConfig(caFile, certFile, keyFile),
+			DisableKeepAlives: true,
+		},
+	}
+	get := func() error {
+		resp, err := client.Get(srv.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	// The files are read on the handshake.
+	assert.Error(t, get())
+
+	mod := time.Now().Add(-time.Minute)
+	writeFile(t, caFile, serverCA.pem, mod)
+	clientCert, clientKey := clientCA.issue(t, x509.ExtKeyUsageClientAuth)
+	writeFile(t, certFile, clientCert, mod)
+	writeFile(t, keyFile, clientKey, mod)
+	assert.NoError(t, get())
+
+	// A client certificate the server does not trust.
+	otherCert, otherKey := newTestCA(t).issue(t, x509.ExtKeyUsageClientAuth)
+	mod = mod.Add(time.Second)
+	writeFile(t, certFile, otherCert, mod)
+	writeFile(t, keyFile, otherKey, mod)
+	assert.Error(t, get())
+
+	writeFile(t, certFile, clientCert, mod.Add(time.Second))
+	writeFile(t, keyFile, clientKey, mod.Add(time.Second))
+	assert.NoError(t, get())
+
+	// A CA bundle that does not contain the server CA.
+	writeFile(t, caFile, clientCA.pem, mod.Add(time.Second))
+	assert.Error(t, get())
+}
+
+func TestFileTLSConfigMissingClientKey(t *testing.T) {
+	cfg := NewFileTLSConfig("", "client.pem", "")
+	_, err := cfg.GetClientCertificate(&tls.CertificateRequestInfo{})
+	assert.EqualError(t, err, "client certificate and client key must be set together")
+}
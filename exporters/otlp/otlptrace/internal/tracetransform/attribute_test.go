@@ -254,3 +254,49 @@ func newOTelArray(key string, arrayValues []*commonpb.AnyValue) *commonpb.KeyVal
 		},
 	}
 }
+
+func TestMapAttributes(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.Map("map",
+			attribute.String("b", "foo"),
+			attribute.Map("a", attribute.Int("c", 1)),
+		),
+	}
+	expected := []*commonpb.KeyValue{
+		{
+			Key: "map",
+			Value: &commonpb.AnyValue{
+				Value: &commonpb.AnyValue_KvlistValue{
+					KvlistValue: &commonpb.KeyValueList{
+						Values: []*commonpb.KeyValue{
+							{
+								Key: "a",
+								Value: &commonpb.AnyValue{
+									Value: &commonpb.AnyValue_KvlistValue{
+										KvlistValue: &commonpb.KeyValueList{
+											Values: []*commonpb.KeyValue{
+												{
+													Key: "c",
+													Value: &commonpb.AnyValue{
+														Value: &commonpb.AnyValue_IntValue{IntValue: 1},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Key: "b",
+								Value: &commonpb.AnyValue{
+									Value: &commonpb.AnyValue_StringValue{StringValue: "foo"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, Attributes(attrs))
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetransform
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+// Field numbers of ExportTraceServiceResponse.partial_success and of the
+// ExportTracePartialSuccess message. These are decoded from unknown fields
+// as the generated types do not yet include them.
+const (
+	responsePartialSuccessField = 1
+	partialSuccessRejectedField = 1
+	partialSuccessMessageField  = 2
+)
+
+// PartialSuccess returns the number of rejected spans and the error message
+// of the partial success contained in resp. The returned ok is false if resp
+// does not contain a partial success, or if it reports no rejected spans and
+// no message, which the OTLP specification defines as full success.
+func PartialSuccess(resp *coltracepb.ExportTraceServiceResponse) (rejected int64, msg string, ok bool) {
+	if resp == nil {
+		return 0, "", false
+	}
+	b := resp.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0, "", false
+		}
+		b = b[n:]
+		if num == responsePartialSuccessField && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, "", false
+			}
+			b = b[n:]
+			// Repeated occurrences of a message field are merged.
+			r, m, valid := parsePartialSuccess(v)
+			if !valid {
+				return 0, "", false
+			}
+			if r != 0 {
+				rejected = r
+			}
+			if m != "" {
+				msg = m
+			}
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return 0, "", false
+		}
+		b = b[n:]
+	}
+	return rejected, msg, rejected != 0 || msg != ""
+}
+
+func parsePartialSuccess(b []byte) (rejected int64, msg string, ok bool) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0, "", false
+		}
+		b = b[n:]
+		switch {
+		case num == partialSuccessRejectedField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return 0, "", false
+			}
+			rejected = int64(v)
+			b = b[n:]
+		case num == partialSuccessMessageField && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			if n < 0 {
+				return 0, "", false
+			}
+			msg = v
+			b = b[n:]
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return 0, "", false
+			}
+			b = b[n:]
+		}
+	}
+	return rejected, msg, true
+}
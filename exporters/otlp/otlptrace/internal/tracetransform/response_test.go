@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetransform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+func partialSuccessResponse(rejected int64, msg string) *coltracepb.ExportTraceServiceResponse {
+	var ps []byte
+	if rejected != 0 {
+		ps = protowire.AppendTag(ps, partialSuccessRejectedField, protowire.VarintType)
+		ps = protowire.AppendVarint(ps, uint64(rejected))
+	}
+	if msg != "" {
+		ps = protowire.AppendTag(ps, partialSuccessMessageField, protowire.BytesType)
+		ps = protowire.AppendString(ps, msg)
+	}
+	var b []byte
+	b = protowire.AppendTag(b, responsePartialSuccessField, protowire.BytesType)
+	b = protowire.AppendBytes(b, ps)
+
+	resp := &coltracepb.ExportTraceServiceResponse{}
+	resp.ProtoReflect().SetUnknown(b)
+	return resp
+}
+
+func TestPartialSuccess(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *coltracepb.ExportTraceServiceResponse
+		rejected int64
+		msg      string
+		ok       bool
+	}{
+		{
+			name: "nil response",
+		},
+		{
+			name: "empty response",
+			resp: &coltracepb.ExportTraceServiceResponse{},
+		},
+		{
+			name: "empty partial success",
+			resp: partialSuccessResponse(0, ""),
+		},
+		{
+			name:     "rejected spans",
+			resp:     partialSuccessResponse(3, "invalid span"),
+			rejected: 3,
+			msg:      "invalid span",
+			ok:       true,
+		},
+		{
+			name: "warning only",
+			resp: partialSuccessResponse(0, "deprecated attribute"),
+			msg:  "deprecated attribute",
+			ok:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rejected, msg, ok := PartialSuccess(test.resp)
+			assert.Equal(t, test.rejected, rejected)
+			assert.Equal(t, test.msg, msg)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func TestPartialSuccessMalformed(t *testing.T) {
+	resp := &coltracepb.ExportTraceServiceResponse{}
+	resp.ProtoReflect().SetUnknown([]byte{0x0a, 0x05, 0x08})
+	_, _, ok := PartialSuccess(resp)
+	assert.False(t, ok)
+}
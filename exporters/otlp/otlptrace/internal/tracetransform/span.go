@@ -62,7 +62,7 @@ func Spans(sdl []tracesdk.ReadOnlySpan) []*tracepb.ResourceSpans {
 				Spans:                  []*tracepb.Span{},
 			}
 		}
-		ils.Spans = append(ils.Spans, span(sd))
+		ils.Spans = append(ils.Spans, Span(sd))
 		ilsm[iKey] = ils
 
 		rs, rOk := rsm[rKey]
@@ -95,8 +95,8 @@ func Spans(sdl []tracesdk.ReadOnlySpan) []*tracepb.ResourceSpans {
 	return rss
 }
 
-// span transforms a Span into an OTLP span.
-func span(sd tracesdk.ReadOnlySpan) *tracepb.Span {
+// Span transforms a Span into an OTLP span.
+func Span(sd tracesdk.ReadOnlySpan) *tracepb.Span {
 	if sd == nil {
 		return nil
 	}
@@ -184,7 +184,7 @@ func TestStatus(t *testing.T) {
 }
 
 func TestNilSpan(t *testing.T) {
-	assert.Nil(t, span(nil))
+	assert.Nil(t, Span(nil))
 }
 
 func TestNilSpanData(t *testing.T) {
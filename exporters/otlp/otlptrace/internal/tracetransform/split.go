@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetransform
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// RequestSize returns the size in bytes of the serialized export request
+// of rss.
+func RequestSize(rss []*tracepb.ResourceSpans) int {
+	return proto.Size(&coltracepb.ExportTraceServiceRequest{ResourceSpans: rss})
+}
+
+// SplitResourceSpans splits rss into batches whose serialized export
+// request is at most maxSize bytes, halving the batches that are too large.
+// A batch with a single span is not split further even if it is too large.
+// rss is returned as a single batch if maxSize is not positive.
+func SplitResourceSpans(rss []*tracepb.ResourceSpans, maxSize int) [][]*tracepb.ResourceSpans {
+	if maxSize <= 0 || RequestSize(rss) <= maxSize {
+		return [][]*tracepb.ResourceSpans{rss}
+	}
+	first, second, ok := HalveResourceSpans(rss)
+	if !ok {
+		return [][]*tracepb.ResourceSpans{rss}
+	}
+	return append(SplitResourceSpans(first, maxSize), SplitResourceSpans(second, maxSize)...)
+}
+
+// HalveResourceSpans splits rss into two batches holding half of its spans
+// each, keeping the resource and instrumentation library of every span.
+// The returned ok is false if rss has fewer than two spans.
+func HalveResourceSpans(rss []*tracepb.ResourceSpans) (first, second []*tracepb.ResourceSpans, ok bool) {
+	var n int
+	for _, rs := range rss {
+		for _, ils := range rs.InstrumentationLibrarySpans {
+			n += len(ils.Spans)
+		}
+	}
+	if n < 2 {
+		return nil, nil, false
+	}
+
+	// left is the number of spans still to be added to the first batch.
+	left := n / 2
+	for _, rs := range rss {
+		var firstILS, secondILS []*tracepb.InstrumentationLibrarySpans
+		for _, ils := range rs.InstrumentationLibrarySpans {
+			k := len(ils.Spans)
+			if k > left {
+				k = left
+			}
+			left -= k
+			if k > 0 {
+				firstILS = append(firstILS, &tracepb.InstrumentationLibrarySpans{
+					InstrumentationLibrary: ils.InstrumentationLibrary,
+					Spans:                  ils.Spans[:k],
+				})
+			}
+			if k < len(ils.Spans) {
+				secondILS = append(secondILS, &tracepb.InstrumentationLibrarySpans{
+					InstrumentationLibrary: ils.InstrumentationLibrary,
+					Spans:                  ils.Spans[k:],
+				})
+			}
+		}
+		if len(firstILS) > 0 {
+			first = append(first, &tracepb.ResourceSpans{
+				Resource:                    rs.Resource,
+				InstrumentationLibrarySpans: firstILS,
+			})
+		}
+		if len(secondILS) > 0 {
+			second = append(second, &tracepb.ResourceSpans{
+				Resource:                    rs.Resource,
+				InstrumentationLibrarySpans: secondILS,
+			})
+		}
+	}
+	return first, second, true
+}
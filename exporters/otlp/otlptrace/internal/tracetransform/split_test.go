@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetransform
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// splitTestSpans returns two resources with two instrumentation libraries
+// of n spans each.
+func splitTestSpans(n int) []*tracepb.ResourceSpans {
+	var rss []*tracepb.ResourceSpans
+	for r := 0; r < 2; r++ {
+		rs := &tracepb.ResourceSpans{
+			Resource: &resourcepb.Resource{DroppedAttributesCount: uint32(r)},
+		}
+		for l := 0; l < 2; l++ {
+			ils := &tracepb.InstrumentationLibrarySpans{
+				InstrumentationLibrary: &commonpb.InstrumentationLibrary{Name: fmt.Sprintf("lib%d", l)},
+			}
+			for s := 0; s < n; s++ {
+				ils.Spans = append(ils.Spans, &tracepb.Span{Name: fmt.Sprintf("span%d-%d-%d", r, l, s)})
+			}
+			rs.InstrumentationLibrarySpans = append(rs.InstrumentationLibrarySpans, ils)
+		}
+		rss = append(rss, rs)
+	}
+	return rss
+}
+
+// spanNames returns the names of the spans of rss, checking that each
+// of them is under the resource and library it was created with.
+func spanNames(t *testing.T, rss []*tracepb.ResourceSpans) []string {
+	var names []string
+	for _, rs := range rss {
+		for _, ils := range rs.InstrumentationLibrarySpans {
+			require.NotEmpty(t, ils.Spans)
+			for _, s := range ils.Spans {
+				var r, l, i int
+				_, err := fmt.Sscanf(s.Name, "span%d-%d-%d", &r, &l, &i)
+				require.NoError(t, err)
+				assert.Equal(t, uint32(r), rs.Resource.DroppedAttributesCount)
+				assert.Equal(t, fmt.Sprintf("lib%d", l), ils.InstrumentationLibrary.Name)
+				names = append(names, s.Name)
+			}
+		}
+	}
+	return names
+}
+
+func TestHalveResourceSpans(t *testing.T) {
+	rss := splitTestSpans(3)
+	want := spanNames(t, rss)
+
+	first, second, ok := HalveResourceSpans(rss)
+	require.True(t, ok)
+	firstNames, secondNames := spanNames(t, first), spanNames(t, second)
+	assert.Len(t, firstNames, 6)
+	assert.Len(t, secondNames, 6)
+	assert.Equal(t, want, append(firstNames, secondNames...))
+
+	_, _, ok = HalveResourceSpans(splitTestSpans(0))
+	assert.False(t, ok)
+	_, _, ok = HalveResourceSpans(nil)
+	assert.False(t, ok)
+}
+
+func TestSplitResourceSpans(t *testing.T) {
+	rss := splitTestSpans(50)
+	want := spanNames(t, rss)
+	maxSize := RequestSize(rss) / 5
+
+	batches := SplitResourceSpans(rss, maxSize)
+	require.Greater(t, len(batches), 4)
+	var got []string
+	for _, b := range batches {
+		assert.LessOrEqual(t, RequestSize(b), maxSize)
+		got = append(got, spanNames(t, b)...)
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestSplitResourceSpansUnlimited(t *testing.T) {
+	rss := splitTestSpans(50)
+	assert.Equal(t, [][]*tracepb.ResourceSpans{rss}, SplitResourceSpans(rss, 0))
+	assert.Equal(t, [][]*tracepb.ResourceSpans{rss}, SplitResourceSpans(rss, RequestSize(rss)))
+}
+
+func TestSplitResourceSpansSingleSpan(t *testing.T) {
+	rss := []*tracepb.ResourceSpans{{
+		InstrumentationLibrarySpans: []*tracepb.InstrumentationLibrarySpans{{
+			Spans: []*tracepb.Span{{Name: "too large"}},
+		}},
+	}}
+	assert.Equal(t, [][]*tracepb.ResourceSpans{rss}, SplitResourceSpans(rss, 1))
+}
@@ -0,0 +1,423 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wal provides a bounded, segmented write-ahead log used to persist
+// export requests on disk until they are acknowledged.
+//
+// Records are appended to segment files as a 4 byte big-endian length, a 4
+// byte CRC-32 (IEEE) checksum of the payload, and the payload itself. The
+// position of the oldest unacknowledged record is stored in a cursor file
+// that is atomically replaced each time a record is acknowledged. Segments
+// that have been fully acknowledged are removed.
+package wal // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/wal"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	headerSize    = 8
+	segmentSuffix = ".wal"
+	cursorFile    = "cursor"
+)
+
+var (
+	// ErrRecordTooLarge is returned by Append if a record can never fit
+	// within the maximum size of the log.
+	ErrRecordTooLarge = errors.New("wal: record exceeds maximum log size")
+	// ErrClosed is returned when operating on a closed log.
+	ErrClosed = errors.New("wal: log is closed")
+)
+
+type segment struct {
+	id   uint64
+	size int64
+}
+
+// Log is a segmented write-ahead log. It is safe for concurrent use.
+type Log struct {
+	dir         string
+	segmentSize int64
+	maxSize     int64
+
+	mu       sync.Mutex
+	closed   bool
+	segments []segment
+	// w is the file of the last (active) segment that records are appended to.
+	w *os.File
+
+	// readOff is the offset of the oldest unacknowledged record in the
+	// first segment.
+	readOff int64
+	// r is the file of the first segment.
+	r *os.File
+	// pending is the encoded length of the last record returned by Peek.
+	pending int64
+}
+
+// Open opens the log stored in dir, creating it if it does not exist.
+// Segments are rotated once they reach segmentSize bytes and the oldest
+// segments are discarded when the total size of the log would exceed maxSize
+// bytes.
+func Open(dir string, segmentSize, maxSize int64) (*Log, error) {
+	if segmentSize <= 0 || maxSize <= 0 {
+		return nil, fmt.Errorf("wal: invalid sizes (segment: %d, max: %d)", segmentSize, maxSize)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	l := &Log{
+		dir:         dir,
+		segmentSize: segmentSize,
+		maxSize:     maxSize,
+	}
+	if err := l.load(); err != nil {
+		return nil, err
+	}
+	// Never append to a segment left by a previous process, its tail could
+	// have been torn by a crash.
+	if err := l.rotate(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Log) load() error {
+	entries, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 16, 64)
+		if err != nil {
+			continue
+		}
+		l.segments = append(l.segments, segment{id: id, size: e.Size()})
+	}
+	sort.Slice(l.segments, func(i, j int) bool { return l.segments[i].id < l.segments[j].id })
+
+	id, off, err := l.readCursor()
+	if err != nil {
+		return err
+	}
+	// Remove every segment the cursor has moved past.
+	for len(l.segments) > 0 && l.segments[0].id < id {
+		if err := os.Remove(l.segmentPath(l.segments[0].id)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		l.segments = l.segments[1:]
+	}
+	if len(l.segments) > 0 && l.segments[0].id == id {
+		l.readOff = off
+	}
+	return nil
+}
+
+func (l *Log) segmentPath(id uint64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%016x%s", id, segmentSuffix))
+}
+
+func (l *Log) readCursor() (uint64, int64, error) {
+	b, err := ioutil.ReadFile(filepath.Join(l.dir, cursorFile))
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(b) != 16 {
+		otel.Handle(fmt.Errorf("wal: ignoring corrupt cursor in %s", l.dir))
+		return 0, 0, nil
+	}
+	return binary.BigEndian.Uint64(b[:8]), int64(binary.BigEndian.Uint64(b[8:])), nil
+}
+
+func (l *Log) writeCursor() error {
+	var b [16]byte
+	if len(l.segments) > 0 {
+		binary.BigEndian.PutUint64(b[:8], l.segments[0].id)
+		binary.BigEndian.PutUint64(b[8:], uint64(l.readOff))
+	}
+	tmp := filepath.Join(l.dir, cursorFile+".tmp")
+	if err := ioutil.WriteFile(tmp, b[:], 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(l.dir, cursorFile))
+}
+
+// rotate closes the active segment and starts a new one.
+func (l *Log) rotate() error {
+	var id uint64 = 1
+	if n := len(l.segments); n > 0 {
+		id = l.segments[n-1].id + 1
+	}
+	f, err := os.OpenFile(l.segmentPath(id), os.O_CREATE|os.O_WRONLY|os.O_APPEND|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if l.w != nil {
+		if err := l.w.Sync(); err != nil {
+			otel.Handle(err)
+		}
+		if err := l.w.Close(); err != nil {
+			otel.Handle(err)
+		}
+	}
+	l.w = f
+	l.segments = append(l.segments, segment{id: id})
+	return nil
+}
+
+func (l *Log) totalSize() int64 {
+	var n int64
+	for _, s := range l.segments {
+		n += s.size
+	}
+	return n
+}
+
+// Append writes p as a new record at the end of the log. If the log would
+// grow beyond its maximum size, the oldest segments are discarded and the
+// number of unacknowledged records lost is returned.
+func (l *Log) Append(p []byte) (dropped int, err error) {
+	size := int64(headerSize + len(p))
+	if size > l.maxSize {
+		return 0, ErrRecordTooLarge
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return 0, ErrClosed
+	}
+
+	if active := l.segments[len(l.segments)-1]; active.size > 0 && active.size+size > l.segmentSize {
+		if err := l.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	for l.totalSize()+size > l.maxSize {
+		if len(l.segments) == 1 {
+			// Only the active segment remains, make room by rotating it
+			// so it can be discarded as well.
+			if err := l.rotate(); err != nil {
+				return dropped, err
+			}
+		}
+		n, err := l.dropOldest()
+		dropped += n
+		if err != nil {
+			return dropped, err
+		}
+	}
+
+	var hdr [headerSize]byte
+	binary.BigEndian.PutUint32(hdr[:4], uint32(len(p)))
+	binary.BigEndian.PutUint32(hdr[4:], crc32.ChecksumIEEE(p))
+	if _, err := l.w.Write(append(hdr[:], p...)); err != nil {
+		return dropped, err
+	}
+	l.segments[len(l.segments)-1].size += size
+	return dropped, nil
+}
+
+// dropOldest discards the oldest segment, returning the number of
+// unacknowledged records it held.
+func (l *Log) dropOldest() (int, error) {
+	n := l.countRecords(l.segments[0], l.readOff)
+	if err := l.removeFirst(); err != nil {
+		return n, err
+	}
+	return n, l.writeCursor()
+}
+
+// removeFirst deletes the oldest segment and moves the read cursor to the
+// start of the next one.
+func (l *Log) removeFirst() error {
+	if l.r != nil {
+		_ = l.r.Close()
+		l.r = nil
+	}
+	l.readOff = 0
+	l.pending = 0
+	s := l.segments[0]
+	l.segments = l.segments[1:]
+	if err := os.Remove(l.segmentPath(s.id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (l *Log) countRecords(s segment, off int64) int {
+	f, err := os.Open(l.segmentPath(s.id))
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	var n int
+	var hdr [headerSize]byte
+	for off+headerSize <= s.size {
+		if _, err := f.ReadAt(hdr[:], off); err != nil {
+			break
+		}
+		off += headerSize + int64(binary.BigEndian.Uint32(hdr[:4]))
+		if off > s.size {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// Peek returns the oldest unacknowledged record. It returns io.EOF if there
+// are no such records. The record remains in the log until Advance is
+// called.
+func (l *Log) Peek() ([]byte, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil, ErrClosed
+	}
+
+	for {
+		seg := l.segments[0]
+		last := len(l.segments) == 1
+		if l.readOff >= seg.size {
+			if last {
+				return nil, io.EOF
+			}
+			if err := l.finishSegment(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		p, err := l.readRecord(seg)
+		if err != nil {
+			otel.Handle(fmt.Errorf("wal: skipping corrupt segment %s: %w", l.segmentPath(seg.id), err))
+			l.readOff = seg.size
+			if last {
+				// Keep appending after the corrupt data in a fresh segment.
+				if err := l.rotate(); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		l.pending = int64(headerSize + len(p))
+		return p, nil
+	}
+}
+
+func (l *Log) readRecord(seg segment) ([]byte, error) {
+	if l.r == nil {
+		f, err := os.Open(l.segmentPath(seg.id))
+		if err != nil {
+			return nil, err
+		}
+		l.r = f
+	}
+
+	var hdr [headerSize]byte
+	if l.readOff+headerSize > seg.size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if _, err := l.r.ReadAt(hdr[:], l.readOff); err != nil {
+		return nil, err
+	}
+	n := int64(binary.BigEndian.Uint32(hdr[:4]))
+	if l.readOff+headerSize+n > seg.size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	p := make([]byte, n)
+	if _, err := l.r.ReadAt(p, l.readOff+headerSize); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(p) != binary.BigEndian.Uint32(hdr[4:]) {
+		return nil, errors.New("checksum mismatch")
+	}
+	return p, nil
+}
+
+// finishSegment removes the fully acknowledged segment at the read cursor
+// and moves the cursor to the start of the next one.
+func (l *Log) finishSegment() error {
+	if err := l.removeFirst(); err != nil {
+		return err
+	}
+	return l.writeCursor()
+}
+
+// Advance acknowledges the record returned by the last call to Peek.
+func (l *Log) Advance() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return ErrClosed
+	}
+	if l.pending == 0 {
+		return nil
+	}
+	l.readOff += l.pending
+	l.pending = 0
+	if len(l.segments) > 1 && l.readOff >= l.segments[0].size {
+		return l.finishSegment()
+	}
+	return l.writeCursor()
+}
+
+// Size returns the number of bytes used on disk by unacknowledged records.
+func (l *Log) Size() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.totalSize() - l.readOff
+}
+
+// Close flushes and closes all files held by the log.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return ErrClosed
+	}
+	l.closed = true
+
+	if l.r != nil {
+		_ = l.r.Close()
+	}
+	if err := l.w.Sync(); err != nil {
+		_ = l.w.Close()
+		return err
+	}
+	return l.w.Close()
+}
@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "wal")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	m, err := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	require.NoError(t, err)
+	return m
+}
+
+func consume(t *testing.T, l *Log) []string {
+	var out []string
+	for {
+		p, err := l.Peek()
+		if err == io.EOF {
+			return out
+		}
+		require.NoError(t, err)
+		out = append(out, string(p))
+		require.NoError(t, l.Advance())
+	}
+}
+
+func TestOpenInvalidSizes(t *testing.T) {
+	_, err := Open(tempDir(t), 0, 10)
+	assert.Error(t, err)
+	_, err = Open(tempDir(t), 10, 0)
+	assert.Error(t, err)
+}
+
+func TestEmptyLog(t *testing.T) {
+	l, err := Open(tempDir(t), 1024, 4096)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.Peek()
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, l.Advance())
+	assert.Equal(t, int64(0), l.Size())
+}
+
+func TestAppendPeekAdvance(t *testing.T) {
+	l, err := Open(tempDir(t), 1024, 4096)
+	require.NoError(t, err)
+	defer l.Close()
+
+	for _, r := range []string{"one", "two", "three"} {
+		dropped, err := l.Append([]byte(r))
+		require.NoError(t, err)
+		assert.Equal(t, 0, dropped)
+	}
+
+	p, err := l.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "one", string(p))
+	// Peek without Advance returns the same record.
+	p, err = l.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "one", string(p))
+
+	assert.Equal(t, []string{"one", "two", "three"}, consume(t, l))
+	assert.Equal(t, int64(0), l.Size())
+}
+
+func TestPersistAcrossReopen(t *testing.T) {
+	dir := tempDir(t)
+	l, err := Open(dir, 1024, 4096)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err := l.Append([]byte(fmt.Sprint(i)))
+		require.NoError(t, err)
+	}
+	_, err = l.Peek()
+	require.NoError(t, err)
+	require.NoError(t, l.Advance())
+	require.NoError(t, l.Close())
+
+	l, err = Open(dir, 1024, 4096)
+	require.NoError(t, err)
+	defer l.Close()
+	_, err = l.Append([]byte("5"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, consume(t, l))
+}
+
+func TestSegmentRotationAndRemoval(t *testing.T) {
+	dir := tempDir(t)
+	// Each record is 8 bytes of header plus 2 bytes of payload.
+	l, err := Open(dir, 20, 1000)
+	require.NoError(t, err)
+	defer l.Close()
+
+	for i := 10; i < 16; i++ {
+		_, err := l.Append([]byte(fmt.Sprint(i)))
+		require.NoError(t, err)
+	}
+	assert.Len(t, segmentFiles(t, dir), 3)
+
+	assert.Equal(t, []string{"10", "11", "12", "13", "14", "15"}, consume(t, l))
+	// Only the active segment is kept once everything is acknowledged.
+	assert.Len(t, segmentFiles(t, dir), 1)
+}
+
+func TestMaxSizeDropsOldest(t *testing.T) {
+	dir := tempDir(t)
+	l, err := Open(dir, 20, 40)
+	require.NoError(t, err)
+	defer l.Close()
+
+	var dropped int
+	for i := 10; i < 16; i++ {
+		n, err := l.Append([]byte(fmt.Sprint(i)))
+		require.NoError(t, err)
+		dropped += n
+	}
+	assert.Equal(t, 2, dropped)
+	assert.LessOrEqual(t, l.Size(), int64(40))
+	assert.Equal(t, []string{"12", "13", "14", "15"}, consume(t, l))
+}
+
+func TestMaxSizeWithSingleSegment(t *testing.T) {
+	l, err := Open(tempDir(t), 1000, 20)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.Append([]byte("10"))
+	require.NoError(t, err)
+	_, err = l.Append([]byte("11"))
+	require.NoError(t, err)
+	dropped, err := l.Append([]byte("12"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, []string{"12"}, consume(t, l))
+}
+
+func TestRecordTooLarge(t *testing.T) {
+	l, err := Open(tempDir(t), 1000, 10)
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = l.Append([]byte("too large"))
+	assert.Equal(t, ErrRecordTooLarge, err)
+}
+
+func TestCorruptSegmentIsSkipped(t *testing.T) {
+	dir := tempDir(t)
+	l, err := Open(dir, 1000, 4096)
+	require.NoError(t, err)
+	_, err = l.Append([]byte("lost"))
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	// Flip a payload byte of the persisted record.
+	files := segmentFiles(t, dir)
+	require.NotEmpty(t, files)
+	var corrupted bool
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		require.NoError(t, err)
+		if len(b) == 0 {
+			continue
+		}
+		b[len(b)-1] ^= 0xff
+		require.NoError(t, ioutil.WriteFile(f, b, 0o600))
+		corrupted = true
+	}
+	require.True(t, corrupted)
+
+	l, err = Open(dir, 1000, 4096)
+	require.NoError(t, err)
+	defer l.Close()
+	_, err = l.Append([]byte("kept"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kept"}, consume(t, l))
+}
+
+func TestClosed(t *testing.T) {
+	l, err := Open(tempDir(t), 1000, 4096)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	_, err = l.Append([]byte("x"))
+	assert.Equal(t, ErrClosed, err)
+	_, err = l.Peek()
+	assert.Equal(t, ErrClosed, err)
+	assert.Equal(t, ErrClosed, l.Advance())
+	assert.Equal(t, ErrClosed, l.Close())
+}
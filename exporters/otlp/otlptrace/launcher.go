@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlptrace // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// launchConfig contains the options for configuring the pipeline started
+// by Launch.
+type launchConfig struct {
+	providerOpts []tracesdk.TracerProviderOption
+	batchOpts    []tracesdk.BatchSpanProcessorOption
+	propagator   propagation.TextMapPropagator
+}
+
+// LaunchOption applies an option to the pipeline started by Launch.
+type LaunchOption interface {
+	apply(*launchConfig)
+}
+
+type launchOptionFunc func(*launchConfig)
+
+func (fn launchOptionFunc) apply(cfg *launchConfig) {
+	fn(cfg)
+}
+
+// WithResource sets the Resource of the TracerProvider. If not set, the
+// resource.Default() Resource is used.
+func WithResource(r *resource.Resource) LaunchOption {
+	return launchOptionFunc(func(cfg *launchConfig) {
+		cfg.providerOpts = append(cfg.providerOpts, tracesdk.WithResource(r))
+	})
+}
+
+// WithSampler sets the Sampler of the TracerProvider. If not set, spans
+// are sampled based on their parent and root spans are always sampled.
+func WithSampler(s tracesdk.Sampler) LaunchOption {
+	return launchOptionFunc(func(cfg *launchConfig) {
+		cfg.providerOpts = append(cfg.providerOpts, tracesdk.WithSampler(s))
+	})
+}
+
+// WithSpanLimits sets the SpanLimits of the TracerProvider. If not set,
+// the default limits of the TracerProvider are used.
+func WithSpanLimits(sl tracesdk.SpanLimits) LaunchOption {
+	return launchOptionFunc(func(cfg *launchConfig) {
+		cfg.providerOpts = append(cfg.providerOpts, tracesdk.WithSpanLimits(sl))
+	})
+}
+
+// WithPropagators sets the TextMapPropagator registered globally. If not
+// set, a composite of the TraceContext and Baggage propagators is used.
+func WithPropagators(p propagation.TextMapPropagator) LaunchOption {
+	return launchOptionFunc(func(cfg *launchConfig) {
+		cfg.propagator = p
+	})
+}
+
+// WithBatchOptions sets the options of the batch span processor exporting
+// spans with the Exporter.
+func WithBatchOptions(opts ...tracesdk.BatchSpanProcessorOption) LaunchOption {
+	return launchOptionFunc(func(cfg *launchConfig) {
+		cfg.batchOpts = append(cfg.batchOpts, opts...)
+	})
+}
+
+// Launch starts an Exporter using client and registers a TracerProvider
+// exporting spans with it through a batch span processor as the global
+// TracerProvider, along with the global TextMapPropagator.
+//
+// The returned function flushes and shuts down the TracerProvider and the
+// Exporter. It must be called before the application exits so buffered
+// spans are not lost.
+func Launch(ctx context.Context, client Client, opts ...LaunchOption) (func(context.Context) error, error) {
+	cfg := launchConfig{
+		propagator: propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		),
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	exp, err := NewExporter(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	providerOpts := append(cfg.providerOpts, tracesdk.WithBatcher(exp, cfg.batchOpts...))
+	tp := tracesdk.NewTracerProvider(providerOpts...)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(cfg.propagator)
+	return tp.Shutdown, nil
+}
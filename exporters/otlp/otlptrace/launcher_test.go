@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlptrace_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+type uploadingClient struct {
+	noopClient
+
+	mu      sync.Mutex
+	spans   []*tracepb.ResourceSpans
+	stopped bool
+}
+
+func (c *uploadingClient) UploadTraces(_ context.Context, rss []*tracepb.ResourceSpans) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.spans = append(c.spans, rss...)
+	return nil
+}
+
+func (c *uploadingClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	return nil
+}
+
+func TestLaunch(t *testing.T) {
+	origTP, origProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	defer func() {
+		otel.SetTracerProvider(origTP)
+		otel.SetTextMapPropagator(origProp)
+	}()
+
+	ctx := context.Background()
+	client := &uploadingClient{}
+	shutdown, err := otlptrace.Launch(ctx, client,
+		otlptrace.WithResource(resource.NewWithAttributes(attribute.String("service.name", "launched"))),
+		otlptrace.WithSampler(tracesdk.AlwaysSample()),
+		otlptrace.WithSpanLimits(tracesdk.SpanLimits{AttributeCountLimit: 1}),
+		otlptrace.WithPropagators(propagation.TraceContext{}),
+		otlptrace.WithBatchOptions(tracesdk.WithMaxExportBatchSize(1)),
+	)
+	require.NoError(t, err)
+	assert.IsType(t, &tracesdk.TracerProvider{}, otel.GetTracerProvider())
+	assert.Equal(t, propagation.TraceContext{}.Fields(), otel.GetTextMapPropagator().Fields())
+
+	_, span := otel.Tracer("test").Start(ctx, "span")
+	span.SetAttributes(attribute.Int("a", 1), attribute.Int("b", 2))
+	span.End()
+
+	require.NoError(t, shutdown(ctx))
+	assert.True(t, client.stopped, "exporter not shut down")
+	require.Len(t, client.spans, 1)
+	rs := client.spans[0]
+	assert.Equal(t, "launched", rs.Resource.Attributes[0].Value.GetStringValue())
+	s := rs.InstrumentationLibrarySpans[0].Spans[0]
+	assert.Equal(t, "span", s.Name)
+	assert.Len(t, s.Attributes, 1, "span limits not applied")
+}
+
+func TestLaunchDefaultPropagators(t *testing.T) {
+	origTP, origProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	defer func() {
+		otel.SetTracerProvider(origTP)
+		otel.SetTextMapPropagator(origProp)
+	}()
+
+	ctx := context.Background()
+	shutdown, err := otlptrace.Launch(ctx, &noopClient{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"traceparent", "tracestate", "baggage"}, otel.GetTextMapPropagator().Fields())
+	assert.NoError(t, shutdown(ctx))
+}
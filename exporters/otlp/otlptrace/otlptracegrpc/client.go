@@ -18,20 +18,55 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/connection"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
 type client struct {
+	endpoints     []*endpoint
+	loadBalancing otlpconfig.LoadBalancingPolicy
+	// failurePeriod is the time an endpoint is tried last after a
+	// request sent to it failed.
+	failurePeriod time.Duration
+	// timeout is the deadline of each batch of spans, across all the
+	// endpoints it is sent to. It is not applied if not positive.
+	timeout time.Duration
+	// maxRequestSize is the max size of a request, larger batches are
+	// split. It is not applied if not positive.
+	maxRequestSize int
+	// retry bounds the time a request is retried across all the
+	// endpoints.
+	retry otlpconfig.RetrySettings
+
+	// next is the index of the endpoint the next request is sent to
+	// with the RoundRobin policy.
+	next uint32
+}
+
+// endpoint is a collector endpoint with its own connection.
+type endpoint struct {
+	// pending is the number of requests in flight, and failedUntil the
+	// Unix time in nanoseconds until which the endpoint is tried last
+	// because a request failed. They are first to be 64-bit aligned for
+	// atomic operations on 32 bit machines.
+	pending     int64
+	failedUntil int64
+
 	connection *connection.Connection
 
 	lock         sync.Mutex
@@ -44,6 +79,10 @@ var (
 	errNoClient = errors.New("no client")
 )
 
+// defaultFailurePeriod is the time an endpoint is tried last after a
+// request sent to it failed, if no reconnection period is configured.
+const defaultFailurePeriod = 10 * time.Second
+
 // NewClient creates a new gRPC trace client.
 func NewClient(opts ...Option) otlptrace.Client {
 	cfg := otlpconfig.NewDefaultConfig()
@@ -52,59 +91,273 @@ func NewClient(opts ...Option) otlptrace.Client {
 		opt.applyGRPCOption(&cfg)
 	}
 
-	c := &client{}
-	c.connection = connection.NewConnection(cfg, cfg.Traces, c.handleNewConnection)
+	addrs := cfg.Endpoints
+	if len(addrs) == 0 || cfg.GRPCConn != nil {
+		addrs = []string{cfg.Traces.Endpoint}
+	}
+	c := &client{
+		loadBalancing:  cfg.LoadBalancing,
+		failurePeriod:  cfg.ReconnectionPeriod,
+		timeout:        cfg.Traces.Timeout,
+		maxRequestSize: cfg.MaxRequestSize,
+		retry:          cfg.RetrySettings,
+	}
+	if c.failurePeriod <= 0 {
+		c.failurePeriod = defaultFailurePeriod
+	}
+	for _, addr := range addrs {
+		sCfg := cfg.Traces
+		sCfg.Endpoint = addr
+		e := &endpoint{}
+		e.connection = connection.NewConnection(cfg, sCfg, e.handleNewConnection)
+		c.endpoints = append(c.endpoints, e)
+	}
 
 	return c
 }
 
-func (c *client) handleNewConnection(cc *grpc.ClientConn) {
-	c.lock.Lock()
-	defer c.lock.Unlock()
+func (e *endpoint) handleNewConnection(cc *grpc.ClientConn) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
 	if cc != nil {
-		c.tracesClient = coltracepb.NewTraceServiceClient(cc)
+		e.tracesClient = coltracepb.NewTraceServiceClient(cc)
 	} else {
-		c.tracesClient = nil
+		e.tracesClient = nil
 	}
 }
 
 // Start establishes a connection to the collector.
 func (c *client) Start(ctx context.Context) error {
-	return c.connection.StartConnection(ctx)
+	for _, e := range c.endpoints {
+		if err := e.connection.StartConnection(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Stop shuts down the connection to the collector.
 func (c *client) Stop(ctx context.Context) error {
-	return c.connection.Shutdown(ctx)
+	var err error
+	for _, e := range c.endpoints {
+		if serr := e.connection.Shutdown(ctx); serr != nil && err == nil {
+			err = serr
+		}
+	}
+	return err
 }
 
-// UploadTraces sends a batch of spans to the collector.
+// UploadTraces sends a batch of spans to the collector. If the collector
+// rejects some of the spans, an otlptrace.PartialSuccess is returned. If
+// the batch is not sent before the configured timeout, or the deadline of
+// ctx if it is earlier, an otlptrace.DeadlineExceeded is returned.
+//
+// The batch is split into requests under the max request size, and the
+// requests rejected as too large are split in halves.
+//
+// With several endpoints, each request is sent to the endpoint selected by
+// the load balancing policy, and to the following ones if it is not
+// delivered. Errors returned by the collector are returned right away.
 func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
-	if !c.connection.Connected() {
-		return fmt.Errorf("traces exporter is disconnected from the server %s: %w", c.connection.SCfg.Endpoint, c.connection.LastConnectError())
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	var ps otlptrace.PartialSuccess
+	for _, batch := range tracetransform.SplitResourceSpans(protoSpans, c.maxRequestSize) {
+		if err := c.uploadBatch(ctx, batch, &ps); err != nil {
+			if ctx.Err() == context.DeadlineExceeded || isDeadlineExceeded(err) {
+				return otlptrace.DeadlineExceeded{Err: err}
+			}
+			return err
+		}
+	}
+	if ps.RejectedSpans != 0 || ps.ErrorMessage != "" {
+		return ps
+	}
+	return nil
+}
+
+// uploadBatch sends a request of spans, and sends its halves instead if it
+// is rejected as larger than the max message size. Other ResourceExhausted
+// errors, such as the ones of a throttling collector, are returned without
+// splitting the request. The spans rejected in partial successes are added
+// to ps.
+func (c *client) uploadBatch(ctx context.Context, protoSpans []*tracepb.ResourceSpans, ps *otlptrace.PartialSuccess) error {
+	err := c.send(ctx, protoSpans)
+	if connection.IsMessageTooLarge(err) && ctx.Err() == nil {
+		if first, second, ok := tracetransform.HalveResourceSpans(protoSpans); ok {
+			if err := c.uploadBatch(ctx, first, ps); err != nil {
+				return err
+			}
+			return c.uploadBatch(ctx, second, ps)
+		}
+	}
+	if p, ok := err.(otlptrace.PartialSuccess); ok {
+		ps.RejectedSpans += p.RejectedSpans
+		if ps.ErrorMessage == "" {
+			ps.ErrorMessage = p.ErrorMessage
+		}
+		return nil
+	}
+	return err
+}
+
+// send sends a request of spans to the endpoints in the order of the load
+// balancing policy until one of them accepts it. The request is sent to the
+// next endpoint only if it failed to be delivered, any other error is
+// returned right away.
+//
+// With several endpoints, the request is retried for at most the max
+// elapsed time of the retry settings across all of them, and each endpoint
+// is given an equal share of the time left.
+func (c *client) send(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	endpoints := c.order()
+	if len(endpoints) > 1 && c.retry.Enabled && c.retry.MaxElapsedTime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.retry.MaxElapsedTime)
+		defer cancel()
+	}
+
+	var err error
+	for i, e := range endpoints {
+		eCtx, cancel := shareDeadline(ctx, len(endpoints)-i)
+		err = e.uploadTraces(eCtx, protoSpans)
+		cancel()
+		if err == nil || ctx.Err() != nil || !shouldFailover(err) {
+			return err
+		}
+		if isTransportError(err) && e.connection.Connected() {
+			e.connection.SetStateDisconnected(err)
+		}
+		atomic.StoreInt64(&e.failedUntil, time.Now().Add(c.failurePeriod).UnixNano())
+	}
+	return err
+}
+
+// shareDeadline returns a context whose deadline is 1/n of the time left
+// before the deadline of ctx. It returns ctx if it has no deadline.
+func shareDeadline(ctx context.Context, n int) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok || n <= 1 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, time.Until(deadline)/time.Duration(n))
+}
+
+// grpcCode returns the gRPC status code of err, which may be wrapped, and
+// false if err has no status.
+func grpcCode(err error) (codes.Code, bool) {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Code(), true
+	}
+	return codes.Unknown, false
+}
+
+// isTransportError returns true if err is returned because the connection
+// to the collector is unusable, rather than by the collector.
+func isTransportError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	code, ok := grpcCode(err)
+	return !ok || code == codes.Unavailable
+}
+
+// shouldFailover returns true if a request that failed with err may be
+// delivered by another endpoint: the endpoint is unreachable or did not
+// answer in time. Errors returned by the collector, such as a request
+// rejected as invalid or unauthenticated, are returned by all of them.
+func shouldFailover(err error) bool {
+	if _, ok := err.(otlptrace.PartialSuccess); ok {
+		return false
+	}
+	return isTransportError(err) || isDeadlineExceeded(err)
+}
+
+// isDeadlineExceeded returns true if err is returned because a deadline
+// was reached before the request completed.
+func isDeadlineExceeded(err error) bool {
+	code, _ := grpcCode(err)
+	return errors.Is(err, context.DeadlineExceeded) || code == codes.DeadlineExceeded
+}
+
+// order returns the endpoints in the order a request is sent to them: the
+// healthy endpoints in the order of the load balancing policy, then the
+// endpoints that are disconnected or recently failed.
+func (c *client) order() []*endpoint {
+	n := len(c.endpoints)
+	if n == 1 {
+		return c.endpoints
+	}
+
+	// Rotate the endpoints so ties are broken differently for each
+	// request.
+	start := int(atomic.AddUint32(&c.next, 1)-1) % n
+	now := time.Now().UnixNano()
+	order := make([]*endpoint, 0, n)
+	for i := 0; i < n; i++ {
+		if e := c.endpoints[(start+i)%n]; e.healthy(now) {
+			order = append(order, e)
+		}
+	}
+	healthy := len(order)
+	for i := 0; i < n; i++ {
+		if e := c.endpoints[(start+i)%n]; !e.healthy(now) {
+			order = append(order, e)
+		}
+	}
+
+	if c.loadBalancing == otlpconfig.LeastPendingLoadBalancing {
+		sort.SliceStable(order[:healthy], func(i, j int) bool {
+			return atomic.LoadInt64(&order[i].pending) < atomic.LoadInt64(&order[j].pending)
+		})
 	}
+	return order
+}
 
-	ctx, cancel := c.connection.ContextWithStop(ctx)
+// healthy returns true if the endpoint is connected and no request sent
+// to it failed recently.
+func (e *endpoint) healthy(now int64) bool {
+	return e.connection.Connected() && now >= atomic.LoadInt64(&e.failedUntil)
+}
+
+// uploadTraces sends a batch of spans to the collector of the endpoint.
+func (e *endpoint) uploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	if !e.connection.Connected() {
+		return fmt.Errorf("traces exporter is disconnected from the server %s: %w", e.connection.SCfg.Endpoint, e.connection.LastConnectError())
+	}
+
+	atomic.AddInt64(&e.pending, 1)
+	defer atomic.AddInt64(&e.pending, -1)
+
+	ctx, cancel := e.connection.ContextWithStop(ctx)
 	defer cancel()
-	ctx, tCancel := context.WithTimeout(ctx, c.connection.SCfg.Timeout)
-	defer tCancel()
 
-	ctx = c.connection.ContextWithMetadata(ctx)
+	ctx = e.connection.ContextWithMetadata(ctx)
+	var resp *coltracepb.ExportTraceServiceResponse
 	err := func() error {
-		c.lock.Lock()
-		defer c.lock.Unlock()
-		if c.tracesClient == nil {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		if e.tracesClient == nil {
 			return errNoClient
 		}
-		return c.connection.DoRequest(ctx, func(ctx context.Context) error {
-			_, err := c.tracesClient.Export(ctx, &coltracepb.ExportTraceServiceRequest{
+		return e.connection.DoRequest(ctx, func(ctx context.Context) error {
+			var err error
+			resp, err = e.tracesClient.Export(ctx, &coltracepb.ExportTraceServiceRequest{
 				ResourceSpans: protoSpans,
 			})
 			return err
 		})
 	}()
 	if err != nil {
-		c.connection.SetStateDisconnected(err)
+		return err
 	}
-	return err
+	if rejected, msg, ok := tracetransform.PartialSuccess(resp); ok {
+		return otlptrace.PartialSuccess{RejectedSpans: rejected, ErrorMessage: msg}
+	}
+	return nil
 }
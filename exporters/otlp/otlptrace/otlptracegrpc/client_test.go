@@ -15,9 +15,14 @@ package otlptracegrpc_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,7 +32,9 @@ import (
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/stretchr/testify/assert"
@@ -58,6 +65,12 @@ func TestNewExporter_endToEnd(t *testing.T) {
 				otlptracegrpc.WithCompressor(gzip.Name),
 			},
 		},
+		{
+			name: "WithZstdCompressor",
+			additionalOpts: []otlptracegrpc.Option{
+				otlptracegrpc.WithCompressor("zstd"),
+			},
+		},
 		{
 			name: "WithServiceConfig",
 			additionalOpts: []otlptracegrpc.Option{
@@ -340,7 +353,7 @@ func TestExporterExportFailureAndRecoveryModes(t *testing.T) {
 			fn: func(t *testing.T, ctx context.Context, exp *otlptrace.Exporter, mc *mockCollector) {
 				err := exp.ExportSpans(ctx, roSpans)
 				require.Error(t, err)
-				require.Equal(t, "context deadline exceeded", err.Error())
+				require.Equal(t, "OTLP export deadline exceeded: context deadline exceeded", err.Error())
 
 				span := mc.getSpans()
 
@@ -472,6 +485,7 @@ func TestPermanentErrorsShouldNotBeRetried(t *testing.T) {
 
 			err := exp.ExportSpans(ctx, roSpans)
 			require.Error(t, err)
+			require.False(t, errors.Is(err, context.DeadlineExceeded))
 			require.Len(t, mc.getSpans(), 0)
 			require.Equal(t, 1, mc.traceSvc.requests, "trace service must receive 1 permanent error requests.")
 
@@ -614,6 +628,28 @@ func TestNewExporter_WithTimeout(t *testing.T) {
 			delay:   true,
 		},
 
+		{
+			name: "Context Deadline Spans",
+			fn: func(exp *otlptrace.Exporter) error {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+				defer cancel()
+				return exp.ExportSpans(ctx, roSpans)
+			},
+			timeout: time.Minute,
+			code:    codes.DeadlineExceeded,
+			delay:   true,
+		},
+
+		{
+			name: "Non Positive Timeout Spans",
+			fn: func(exp *otlptrace.Exporter) error {
+				return exp.ExportSpans(context.Background(), roSpans)
+			},
+			timeout: 0,
+			spans:   1,
+			code:    codes.OK,
+		},
+
 		{
 			name: "No Timeout Spans",
 			fn: func(exp *otlptrace.Exporter) error {
@@ -647,12 +683,12 @@ func TestNewExporter_WithTimeout(t *testing.T) {
 			if tt.code == codes.OK {
 				require.NoError(t, err)
 			} else {
-				require.Error(t, err)
+				var de otlptrace.DeadlineExceeded
+				require.True(t, errors.As(err, &de), "error must be a DeadlineExceeded: %v", err)
+				assert.True(t, errors.Is(err, context.DeadlineExceeded))
+				require.Equal(t, tt.code, status.Code(de.Err))
 			}
 
-			s := status.Convert(err)
-			require.Equal(t, tt.code, s.Code())
-
 			require.Len(t, mc.getSpans(), tt.spans)
 		})
 	}
@@ -850,3 +886,308 @@ func TestEmptyData(t *testing.T) {
 
 	assert.NoError(t, exp.ExportSpans(ctx, nil))
 }
+
+func TestPartialSuccess(t *testing.T) {
+	var partial []byte
+	partial = protowire.AppendTag(partial, 1, protowire.VarintType)
+	partial = protowire.AppendVarint(partial, 1)
+	partial = protowire.AppendTag(partial, 2, protowire.BytesType)
+	partial = protowire.AppendString(partial, "span too large")
+	var resp []byte
+	resp = protowire.AppendTag(resp, 1, protowire.BytesType)
+	resp = protowire.AppendBytes(resp, partial)
+
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		endpoint:       "localhost:0",
+		partialSuccess: resp,
+	})
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(ctx))
+	}()
+
+	spans := tracetest.SpanStubs{{Name: "Span 0"}, {Name: "Span 1"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	assert.Equal(t, otlptrace.Stats{ExportedSpans: 1, RejectedSpans: 1}, exp.Stats())
+}
+
+func TestNewExporter_WithGRPCConn(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	conn, err := grpc.Dial(mc.endpoint, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+
+	ctx := context.Background()
+	// The endpoint is ignored, the passed connection is used instead.
+	exp := newGRPCExporter(t, ctx, "invalid", otlptracegrpc.WithGRPCConn(conn))
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Len(t, mc.getSpans(), 1)
+	assert.NotEqual(t, connectivity.Shutdown, conn.GetState(), "shared connection closed by the client")
+}
+
+func TestNewExporter_withUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otlptracegrpc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	mc := runMockCollectorAtEndpoint(t, "unix://"+filepath.Join(dir, "otel.sock"))
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Len(t, mc.getSpans(), 1)
+}
+
+func TestNewExporter_withDialer(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	var (
+		mu     sync.Mutex
+		dialed []string
+	)
+	dialer := func(ctx context.Context, network, address string) (net.Conn, error) {
+		mu.Lock()
+		dialed = append(dialed, network+" "+address)
+		mu.Unlock()
+		return (&net.Dialer{}).DialContext(ctx, network, mc.endpoint)
+	}
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "collector.invalid:4317", otlptracegrpc.WithDialer(dialer))
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"tcp collector.invalid:4317"}, dialed)
+	assert.Len(t, mc.getSpans(), 1)
+}
+
+func TestLoadBalancingRoundRobin(t *testing.T) {
+	mc1 := runMockCollector(t)
+	mc2 := runMockCollector(t)
+	defer func() {
+		_ = mc1.stop()
+		_ = mc2.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "",
+		otlptracegrpc.WithEndpoints(mc1.endpoint, mc2.endpoint),
+		otlptracegrpc.WithLoadBalancing(otlptracegrpc.RoundRobin),
+	)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(ctx))
+	}()
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	}
+	assert.Len(t, mc1.getSpans(), 2)
+	assert.Len(t, mc2.getSpans(), 2)
+}
+
+func TestLoadBalancingFailover(t *testing.T) {
+	for _, policy := range []otlptracegrpc.LoadBalancingPolicy{
+		otlptracegrpc.RoundRobin,
+		otlptracegrpc.LeastPending,
+	} {
+		failing := runMockCollectorWithConfig(t, &mockConfig{
+			errors: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+		})
+		mc := runMockCollector(t)
+
+		ctx := context.Background()
+		exp := newGRPCExporter(t, ctx, "",
+			otlptracegrpc.WithEndpoints(failing.endpoint, mc.endpoint),
+			otlptracegrpc.WithLoadBalancing(policy),
+			otlptracegrpc.WithRetry(otlptracegrpc.RetrySettings{Enabled: false}),
+			otlptracegrpc.WithReconnectionPeriod(time.Hour),
+		)
+
+		// The failing endpoint is disconnected after its first
+		// error, the batches are sent to the other endpoint.
+		for i := 0; i < 4; i++ {
+			require.NoError(t, exp.ExportSpans(ctx, roSpans))
+		}
+		assert.Len(t, failing.getSpans(), 0)
+		assert.Len(t, mc.getSpans(), 4)
+		assert.Equal(t, 1, failing.traceSvc.requests)
+
+		assert.NoError(t, exp.Shutdown(ctx))
+		_ = failing.stop()
+		_ = mc.stop()
+	}
+}
+
+func TestLoadBalancingPermanentErrorDoesNotFailover(t *testing.T) {
+	rejecting := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.InvalidArgument, "invalid")},
+	})
+	mc := runMockCollector(t)
+	defer func() {
+		_ = rejecting.stop()
+		_ = mc.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "",
+		otlptracegrpc.WithEndpoints(rejecting.endpoint, mc.endpoint),
+		otlptracegrpc.WithLoadBalancing(otlptracegrpc.RoundRobin),
+		otlptracegrpc.WithRetry(otlptracegrpc.RetrySettings{Enabled: false}),
+		otlptracegrpc.WithReconnectionPeriod(time.Hour),
+	)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(ctx))
+	}()
+
+	// The rejected batch is not sent to the other endpoint.
+	err := exp.ExportSpans(ctx, roSpans)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, mc.getSpans(), 0)
+
+	// The rejecting endpoint is still connected and healthy, it
+	// receives every other batch.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	}
+	assert.Len(t, mc.getSpans(), 1)
+	assert.Len(t, rejecting.getSpans(), 1)
+	assert.Equal(t, 2, rejecting.traceSvc.requests)
+}
+
+func TestLoadBalancingRetriesBounded(t *testing.T) {
+	unavailable := make([]error, 1000)
+	for i := range unavailable {
+		unavailable[i] = status.Error(codes.Unavailable, "unavailable")
+	}
+	mc1 := runMockCollectorWithConfig(t, &mockConfig{errors: unavailable})
+	mc2 := runMockCollectorWithConfig(t, &mockConfig{errors: unavailable})
+	defer func() {
+		_ = mc1.stop()
+		_ = mc2.stop()
+	}()
+
+	const maxElapsedTime = 500 * time.Millisecond
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "",
+		otlptracegrpc.WithEndpoints(mc1.endpoint, mc2.endpoint),
+		otlptracegrpc.WithRetry(otlptracegrpc.RetrySettings{
+			Enabled:         true,
+			InitialInterval: 10 * time.Millisecond,
+			MaxInterval:     50 * time.Millisecond,
+			MaxElapsedTime:  maxElapsedTime,
+		}),
+		otlptracegrpc.WithReconnectionPeriod(time.Hour),
+	)
+	defer func() {
+		assert.NoError(t, exp.Shutdown(ctx))
+	}()
+
+	start := time.Now()
+	assert.Error(t, exp.ExportSpans(ctx, roSpans))
+	// Both endpoints are tried, within a single max elapsed time.
+	assert.Less(t, int64(time.Since(start)), int64(maxElapsedTime+maxElapsedTime/2))
+	assert.Greater(t, mc1.traceSvc.requests, 0)
+	assert.Greater(t, mc2.traceSvc.requests, 0)
+}
+
+func TestMaxRequestSize(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	var stubs tracetest.SpanStubs
+	for i := 0; i < 20; i++ {
+		stubs = append(stubs, tracetest.SpanStub{Name: fmt.Sprintf("Span %d", i)})
+	}
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithMaxRequestSize(256))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(ctx))
+	}()
+
+	require.NoError(t, exp.ExportSpans(ctx, stubs.Snapshots()))
+	assert.Len(t, mc.getSpans(), 20)
+	assert.Greater(t, mc.traceSvc.requests, 1)
+}
+
+func TestResourceExhaustedSplitsRequest(t *testing.T) {
+	for _, msg := range []string{
+		"grpc: received message larger than max (5000 vs. 4096)",
+		// grpc-java collectors.
+		"gRPC message exceeds maximum size 4096: 5000",
+	} {
+		t.Run(msg, func(t *testing.T) {
+			mc := runMockCollectorWithConfig(t, &mockConfig{
+				errors: []error{status.Error(codes.ResourceExhausted, msg)},
+			})
+			defer func() {
+				_ = mc.stop()
+			}()
+
+			stubs := tracetest.SpanStubs{{Name: "Span 0"}, {Name: "Span 1"}, {Name: "Span 2"}, {Name: "Span 3"}}
+
+			ctx := context.Background()
+			exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithMaxRequestSize(0))
+			defer func() {
+				assert.NoError(t, exp.Shutdown(ctx))
+			}()
+
+			require.NoError(t, exp.ExportSpans(ctx, stubs.Snapshots()))
+			assert.Len(t, mc.getSpans(), 4)
+			assert.Equal(t, 3, mc.traceSvc.requests, "trace service must receive the rejected request and its two halves")
+		})
+	}
+}
+
+func TestThrottlingResourceExhaustedDoesNotSplitRequest(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{
+			newThrottlingError(codes.ResourceExhausted, time.Second),
+		},
+	})
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	stubs := tracetest.SpanStubs{{Name: "Span 0"}, {Name: "Span 1"}, {Name: "Span 2"}, {Name: "Span 3"}}
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithMaxRequestSize(0),
+		otlptracegrpc.WithRetry(otlptracegrpc.RetrySettings{Enabled: false}))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(ctx))
+	}()
+
+	err := exp.ExportSpans(ctx, stubs.Snapshots())
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Len(t, mc.getSpans(), 0)
+	assert.Equal(t, 1, mc.traceSvc.requests, "a throttled request must not be split")
+}
@@ -33,12 +33,18 @@ func NewUnstartedExporter(opts ...Option) *otlptrace.Exporter {
 
 // NewExportPipeline sets up a complete export pipeline
 // with the recommended TracerProvider setup.
+//
+// Deprecated: Use otlptrace.Launch with a Client returned by NewClient
+// instead.
 func NewExportPipeline(ctx context.Context, opts ...Option) (*otlptrace.Exporter, *tracesdk.TracerProvider, error) {
 	return otlptrace.NewExportPipeline(ctx, NewClient(opts...))
 }
 
 // InstallNewPipeline instantiates a NewExportPipeline with the
 // recommended configuration and registers it globally.
+//
+// Deprecated: Use otlptrace.Launch with a Client returned by NewClient
+// instead.
 func InstallNewPipeline(ctx context.Context, opts ...Option) (*otlptrace.Exporter, *tracesdk.TracerProvider, error) {
 	return otlptrace.InstallNewPipeline(ctx, NewClient(opts...))
 }
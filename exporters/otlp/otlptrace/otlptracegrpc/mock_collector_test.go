@@ -39,6 +39,7 @@ func makeMockCollector(t *testing.T, mockConfig *mockConfig) *mockCollector {
 		traceSvc: &mockTraceService{
 			storage: otlptracetest.NewSpansStorage(),
 			errors:  mockConfig.errors,
+			partial: mockConfig.partialSuccess,
 		},
 	}
 }
@@ -52,6 +53,7 @@ type mockTraceService struct {
 	storage  otlptracetest.SpansStorage
 	headers  metadata.MD
 	delay    time.Duration
+	partial  []byte
 }
 
 func (mts *mockTraceService) getHeaders() metadata.MD {
@@ -91,6 +93,9 @@ func (mts *mockTraceService) Export(ctx context.Context, exp *collectortracepb.E
 
 	mts.headers, _ = metadata.FromIncomingContext(ctx)
 	mts.storage.AddSpans(exp)
+	if mts.partial != nil {
+		reply.ProtoReflect().SetUnknown(mts.partial)
+	}
 	return reply, nil
 }
 
@@ -106,8 +111,9 @@ type mockCollector struct {
 }
 
 type mockConfig struct {
-	errors   []error
-	endpoint string
+	errors         []error
+	endpoint       string
+	partialSuccess []byte
 }
 
 var _ collectortracepb.TraceServiceServer = (*mockTraceService)(nil)
@@ -162,7 +168,11 @@ func runMockCollectorAtEndpoint(t *testing.T, endpoint string) *mockCollector {
 }
 
 func runMockCollectorWithConfig(t *testing.T, mockConfig *mockConfig) *mockCollector {
-	ln, err := net.Listen("tcp", mockConfig.endpoint)
+	network, address := "tcp", mockConfig.endpoint
+	if strings.HasPrefix(address, "unix://") {
+		network, address = "unix", strings.TrimPrefix(address, "unix://")
+	}
+	ln, err := net.Listen(network, address)
 	if err != nil {
 		t.Fatalf("Failed to get an endpoint: %v", err)
 	}
@@ -176,6 +186,9 @@ func runMockCollectorWithConfig(t *testing.T, mockConfig *mockConfig) *mockColle
 	}()
 
 	mc.endpoint = ln.Addr().String()
+	if network == "unix" {
+		mc.endpoint = "unix://" + mc.endpoint
+	}
 	// srv.Stop calls Close on mc.ln.
 	mc.stopFunc = srv.Stop
 
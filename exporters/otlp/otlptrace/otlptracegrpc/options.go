@@ -15,7 +15,9 @@
 package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -58,7 +60,9 @@ func WithTracesInsecure() Option {
 
 // WithEndpoint allows one to set the endpoint that the exporter will
 // connect to the collector on. If unset, it will instead try to use
-// connect to DefaultCollectorHost:DefaultCollectorPort.
+// connect to DefaultCollectorHost:DefaultCollectorPort. An endpoint with
+// the unix scheme, like unix:///var/run/otel.sock, connects to the
+// collector through a Unix domain socket.
 func WithEndpoint(endpoint string) Option {
 	return wrappedOption{otlpconfig.WithEndpoint(endpoint)}
 }
@@ -70,6 +74,71 @@ func WithTracesEndpoint(endpoint string) Option {
 	return wrappedOption{otlpconfig.WithTracesEndpoint(endpoint)}
 }
 
+// WithEndpoints sets several collector endpoints the exporter connects to
+// and spreads the batches of spans across, according to the
+// LoadBalancingPolicy set with WithLoadBalancing.  Each endpoint has its
+// own connection, so no external load balancer is needed in front of the
+// collectors.  A batch that cannot be delivered to an endpoint, because it
+// is unavailable or does not answer in time, is sent to the next endpoint.
+// Other errors returned by a collector, such as InvalidArgument or
+// Unauthenticated, are returned without trying the other endpoints.
+// Disconnected endpoints, and for the reconnection period endpoints a batch
+// failed to be delivered to, are tried last.  Retries across all the
+// endpoints are bounded by the MaxElapsedTime of the RetrySettings.
+//
+// The endpoints replace the endpoint set with WithEndpoint or
+// WithTracesEndpoint.  They are ignored if a connection is set with
+// WithGRPCConn.
+func WithEndpoints(endpoints ...string) Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg *otlpconfig.Config) {
+		cfg.Endpoints = endpoints
+	})}
+}
+
+// LoadBalancingPolicy selects the collector endpoint each batch of spans
+// is sent to when several endpoints are set with WithEndpoints.
+type LoadBalancingPolicy otlpconfig.LoadBalancingPolicy
+
+const (
+	// RoundRobin sends the batches of spans to the connected endpoints
+	// in turn.  It is the default LoadBalancingPolicy.
+	RoundRobin = LoadBalancingPolicy(otlpconfig.RoundRobinLoadBalancing)
+	// LeastPending sends each batch of spans to the connected endpoint
+	// with the fewest batches in flight, favoring the collectors that
+	// respond the fastest.
+	LeastPending = LoadBalancingPolicy(otlpconfig.LeastPendingLoadBalancing)
+)
+
+// WithLoadBalancing sets the LoadBalancingPolicy used to select the
+// endpoint each batch of spans is sent to when several endpoints are set
+// with WithEndpoints.
+func WithLoadBalancing(policy LoadBalancingPolicy) Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg *otlpconfig.Config) {
+		cfg.LoadBalancing = otlpconfig.LoadBalancingPolicy(policy)
+	})}
+}
+
+// WithMaxRequestSize sets the max size in bytes of the serialized request
+// of a batch of spans. Larger batches are split into requests under the
+// limit before they are sent, and a request the collector rejects as larger
+// than its max message size is split in halves that are sent again, instead
+// of failing the whole batch. A request with a single span is not split. If
+// unset, the default is 4 MiB, the default max message size of gRPC servers.
+// A size that is not positive disables the splitting of batches before they
+// are sent.
+//
+// gRPC reports a message too large with a ResourceExhausted status and no
+// structured detail, a rejected request is recognized by the error messages
+// of the grpc-go, gRPC C-core, grpc-js, grpc-java, and grpc-dotnet servers.
+// A request rejected by a proxy or a server with another message is handled
+// as throttled and is not split, the max request size must then be set
+// under its limit.
+func WithMaxRequestSize(size int) Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg *otlpconfig.Config) {
+		cfg.MaxRequestSize = size
+	})}
+}
+
 // WithReconnectionPeriod allows one to set the delay between next connection attempt
 // after failing to connect with the collector.
 func WithReconnectionPeriod(rp time.Duration) Option {
@@ -82,6 +151,8 @@ func compressorToCompression(compressor string) otlpconfig.Compression {
 	switch compressor {
 	case "gzip":
 		return otlpconfig.GzipCompression
+	case "zstd":
+		return otlpconfig.ZstdCompression
 	}
 
 	otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
@@ -92,7 +163,8 @@ func compressorToCompression(compressor string) otlpconfig.Compression {
 // It is the responsibility of the caller to ensure that the compressor set has been registered
 // with google.golang.org/grpc/encoding. This can be done by encoding.RegisterCompressor. Some
 // compressors auto-register on import, such as gzip, which can be registered by calling
-// `import _ "google.golang.org/grpc/encoding/gzip"`.
+// `import _ "google.golang.org/grpc/encoding/gzip"`. The zstd compressor
+// is registered by this package.
 func WithCompressor(compressor string) Option {
 	return wrappedOption{otlpconfig.WithCompression(compressorToCompression(compressor))}
 }
@@ -101,7 +173,8 @@ func WithCompressor(compressor string) Option {
 // It is the responsibility of the caller to ensure that the compressor set has been registered
 // with google.golang.org/grpc/encoding. This can be done by encoding.RegisterCompressor. Some
 // compressors auto-register on import, such as gzip, which can be registered by calling
-// `import _ "google.golang.org/grpc/encoding/gzip"`.
+// `import _ "google.golang.org/grpc/encoding/gzip"`. The zstd compressor
+// is registered by this package.
 func WithTracesCompression(compressor string) Option {
 	return wrappedOption{otlpconfig.WithTracesCompression(compressorToCompression(compressor))}
 }
@@ -127,6 +200,18 @@ func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 	})}
 }
 
+// WithTLSFiles configures the transport security from PEM files, like
+// the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables. The server
+// certificate is verified with the CA certificates of the certificate
+// file, and the clientCertificate and clientKey files are used for
+// mutual TLS. Empty paths are ignored. The files are read again when
+// they are modified, so rotated certificates are picked up by the next
+// connection to the collector.
+func WithTLSFiles(certificate, clientCertificate, clientKey string) Option {
+	return wrappedOption{otlpconfig.WithTLSFiles(certificate, clientCertificate, clientKey)}
+}
+
 // WithTracesTLSCredentials allows the connection to use TLS credentials
 // when talking to the traces server. It takes in grpc.TransportCredentials instead
 // of say a Certificate file or a tls.Certificate, because the retrieving of
@@ -154,14 +239,41 @@ func WithDialOption(opts ...grpc.DialOption) Option {
 	})}
 }
 
+// WithDialer sets the function used to establish the connection to the
+// collector, instead of the default dialer of gRPC. The network passed
+// to dialer is "unix" for Unix domain socket endpoints and "tcp"
+// otherwise. Options passed with WithDialOption take precedence.
+func WithDialer(dialer func(ctx context.Context, network, address string) (net.Conn, error)) Option {
+	return wrappedOption{otlpconfig.WithDialer(dialer)}
+}
+
+// WithGRPCConn sets conn as the gRPC ClientConn used for all
+// communication with the collector, so that it can be shared with other
+// exporters.  When set, the endpoint, credentials, compression, service
+// config and dial options are ignored, as conn is not dialed by
+// the client.  It is not closed on shutdown either, the caller remains
+// responsible for closing it.
+func WithGRPCConn(conn *grpc.ClientConn) Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg *otlpconfig.Config) {
+		cfg.GRPCConn = conn
+	})}
+}
+
 // WithTimeout tells the driver the max waiting time for the backend to process
 // each spans batch. If unset, the default will be 10 seconds.
+//
+// The timeout applies to each batch whether or not the context passed to
+// the exporter has a deadline, and covers the retries of the batch and the
+// other endpoints it is sent to. A batch that is not sent in time fails
+// with an otlptrace.DeadlineExceeded error. A timeout that is not positive
+// leaves the deadline of each batch to the context passed to the exporter.
 func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithTimeout(duration)}
 }
 
 // WithTracesTimeout tells the driver the max waiting time for the backend to process
-// each spans batch. If unset, the default will be 10 seconds.
+// each spans batch. If unset, the default will be 10 seconds. See WithTimeout
+// for how it is applied.
 func WithTracesTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithTracesTimeout(duration)}
 }
@@ -169,9 +281,10 @@ func WithTracesTimeout(duration time.Duration) Option {
 // WithRetry configures the retry policy for transient errors that may occurs when
 // exporting traces. An exponential back-off algorithm is used to
 // ensure endpoints are not overwhelmed with retries. If unset, the default
-// ensure endpoints are not overwhelmed with retries. If unset, the default
 // retry policy will retry after 5 seconds and increase exponentially after each
-// error for a total of 1 minute.
+// error for a total of 1 minute. Each delay is randomized by the configured
+// Jitter. If the server responds with a RetryInfo detail the requested delay
+// is honored instead when it is longer than the computed back-off.
 func WithRetry(settings RetrySettings) Option {
 	return wrappedOption{otlpconfig.WithRetry(otlpconfig.RetrySettings(settings))}
 }
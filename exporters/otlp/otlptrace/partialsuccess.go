@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlptrace // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+
+import "fmt"
+
+// PartialSuccess is returned by a Client when the receiving endpoint
+// accepted an export request but rejected some of the spans it contained.
+// The rejected spans must not be retried.
+type PartialSuccess struct {
+	// RejectedSpans is the number of spans the endpoint rejected.
+	RejectedSpans int64
+	// ErrorMessage is the reason given by the endpoint, it may be empty.
+	ErrorMessage string
+}
+
+func (ps PartialSuccess) Error() string {
+	msg := ps.ErrorMessage
+	if msg == "" {
+		msg = "empty message"
+	}
+	return fmt.Sprintf("OTLP partial success: %s (%d spans rejected)", msg, ps.RejectedSpans)
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transform provides the conversion of the spans of the
+// OpenTelemetry SDK into their OTLP protobuf representation. It is the
+// conversion used by the otlptrace Exporter, and can be used by exporters
+// that send OTLP spans over a transport not provided by an otlptrace
+// Client.
+package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/transform"
+
+import (
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// Spans transforms spans into OTLP ResourceSpans. The spans are grouped
+// by their Resource and InstrumentationLibrary. Nil spans are skipped.
+func Spans(spans []tracesdk.ReadOnlySpan) []*tracepb.ResourceSpans {
+	return tracetransform.Spans(spans)
+}
+
+// Span transforms span into an OTLP span, including its attributes,
+// events, links, status and parent span ID. The Resource and
+// InstrumentationLibrary of span are not part of an OTLP span, Spans has to
+// be used to export them. It returns nil if span is nil.
+func Span(span tracesdk.ReadOnlySpan) *tracepb.Span {
+	return tracetransform.Span(span)
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/transform"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSpan(t *testing.T) {
+	start := time.Unix(0, 0)
+	stub := tracetest.SpanStub{
+		Name: "span",
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{2},
+		}),
+		Parent: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{3},
+		}),
+		StartTime:  start,
+		EndTime:    start.Add(time.Second),
+		Attributes: []attribute.KeyValue{attribute.String("key", "value")},
+		Links: []trace.Link{{
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{4},
+				SpanID:  trace.SpanID{5},
+			}),
+		}},
+	}
+
+	span := transform.Span(stub.Snapshot())
+	require.NotNil(t, span)
+	assert.Equal(t, "span", span.Name)
+	assert.Equal(t, []byte{3, 0, 0, 0, 0, 0, 0, 0}, span.ParentSpanId)
+	assert.Equal(t, uint64(time.Second), span.EndTimeUnixNano-span.StartTimeUnixNano)
+	require.Len(t, span.Attributes, 1)
+	assert.Equal(t, "key", span.Attributes[0].Key)
+	require.Len(t, span.Links, 1)
+	assert.Equal(t, []byte{5, 0, 0, 0, 0, 0, 0, 0}, span.Links[0].SpanId)
+
+	assert.Nil(t, transform.Span(nil))
+}
+
+func TestSpans(t *testing.T) {
+	res := resource.NewWithAttributes(attribute.String("service.name", "test"))
+	stubs := tracetest.SpanStubs{
+		{Name: "a", Resource: res, InstrumentationLibrary: instrumentation.Library{Name: "lib"}},
+		{Name: "b", Resource: res, InstrumentationLibrary: instrumentation.Library{Name: "lib"}},
+	}
+
+	rss := transform.Spans(stubs.Snapshots())
+	require.Len(t, rss, 1)
+	require.Len(t, rss[0].InstrumentationLibrarySpans, 1)
+	ils := rss[0].InstrumentationLibrarySpans[0]
+	assert.Equal(t, "lib", ils.InstrumentationLibrary.Name)
+	require.Len(t, ils.Spans, 2)
+	assert.Equal(t, "a", ils.Spans[0].Name)
+	assert.Equal(t, "b", ils.Spans[1].Name)
+}
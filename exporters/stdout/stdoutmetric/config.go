@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package stdout // import "go.opentelemetry.io/otel/exporters/stdout"
+package stdoutmetric // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 
 import (
 	"io"
@@ -22,15 +22,13 @@ import (
 )
 
 var (
-	defaultWriter              = os.Stdout
-	defaultPrettyPrint         = false
-	defaultTimestamps          = true
-	defaultLabelEncoder        = attribute.DefaultEncoder()
-	defaultDisableTraceExport  = false
-	defaultDisableMetricExport = false
+	defaultWriter       = os.Stdout
+	defaultPrettyPrint  = false
+	defaultTimestamps   = true
+	defaultLabelEncoder = attribute.DefaultEncoder()
 )
 
-// config contains options for the STDOUT exporter.
+// config contains options for the STDOUT metric exporter.
 type config struct {
 	// Writer is the destination.  If not set, os.Stdout is used.
 	Writer io.Writer
@@ -39,38 +37,34 @@ type config struct {
 	// false.
 	PrettyPrint bool
 
-	// Timestamps specifies if timestamps should be pritted. Default is
+	// Timestamps specifies if timestamps should be printed. Default is
 	// true.
 	Timestamps bool
 
 	// LabelEncoder encodes the labels.
 	LabelEncoder attribute.Encoder
 
-	// DisableTraceExport prevents any export of trace telemetry.
-	DisableTraceExport bool
-
-	// DisableMetricExport prevents any export of metric telemetry.
-	DisableMetricExport bool
+	// JSONLines writes one JSON object per line for each metric record
+	// using the versioned JSON-lines schema. PrettyPrint and LabelEncoder
+	// are ignored when it is set.
+	JSONLines bool
 }
 
-// newConfig creates a validated Config configured with options.
+// newConfig creates a validated config configured with options.
 func newConfig(options ...Option) (config, error) {
 	cfg := config{
-		Writer:              defaultWriter,
-		PrettyPrint:         defaultPrettyPrint,
-		Timestamps:          defaultTimestamps,
-		LabelEncoder:        defaultLabelEncoder,
-		DisableTraceExport:  defaultDisableTraceExport,
-		DisableMetricExport: defaultDisableMetricExport,
+		Writer:       defaultWriter,
+		PrettyPrint:  defaultPrettyPrint,
+		Timestamps:   defaultTimestamps,
+		LabelEncoder: defaultLabelEncoder,
 	}
 	for _, opt := range options {
 		opt.apply(&cfg)
-
 	}
 	return cfg, nil
 }
 
-// Option sets the value of an option for a Config.
+// Option sets the value of an option for a config.
 type Option interface {
 	apply(*config)
 }
@@ -123,24 +117,17 @@ func (o labelEncoderOption) apply(cfg *config) {
 	cfg.LabelEncoder = o.LabelEncoder
 }
 
-// WithoutTraceExport disables all trace exporting.
-func WithoutTraceExport() Option {
-	return disableTraceExportOption(true)
-}
-
-type disableTraceExportOption bool
-
-func (o disableTraceExportOption) apply(cfg *config) {
-	cfg.DisableTraceExport = bool(o)
-}
-
-// WithoutMetricExport disables all metric exporting.
-func WithoutMetricExport() Option {
-	return disableMetricExportOption(true)
+// WithJSONLines sets the export stream to write one JSON object per line for
+// each metric record. The objects follow a stable schema close to the OTLP
+// JSON encoding, identified by a schemaVersion field with the value of
+// JSONLinesSchemaVersion, and are suitable for processing with tools like jq
+// or log shippers.
+func WithJSONLines() Option {
+	return jsonLinesOption(true)
 }
 
-type disableMetricExportOption bool
+type jsonLinesOption bool
 
-func (o disableMetricExportOption) apply(cfg *config) {
-	cfg.DisableMetricExport = bool(o)
+func (o jsonLinesOption) apply(cfg *config) {
+	cfg.JSONLines = bool(o)
 }
@@ -12,18 +12,19 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package stdout_test
+package stdoutmetric_test
 
 import (
 	"context"
 	"log"
 
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/global"
-	"go.opentelemetry.io/otel/trace"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
 )
 
 const (
@@ -32,12 +33,6 @@ const (
 )
 
 var (
-	tracer = otel.GetTracerProvider().Tracer(
-		instrumentationName,
-		trace.WithInstrumentationVersion(instrumentationVersion),
-		trace.WithSchemaURL("https://opentelemetry.io/schemas/1.2.0"),
-	)
-
 	meter = global.GetMeterProvider().Meter(
 		instrumentationName,
 		metric.WithInstrumentationVersion(instrumentationVersion),
@@ -52,10 +47,6 @@ var (
 func add(ctx context.Context, x, y int64) int64 {
 	nameKV := nameKey.String("add")
 
-	var span trace.Span
-	ctx, span = tracer.Start(ctx, "Addition")
-	defer span.End()
-
 	loopCounter.Add(ctx, 1, nameKV)
 	paramValue.Record(ctx, x, nameKV)
 	paramValue.Record(ctx, y, nameKV)
@@ -66,10 +57,6 @@ func add(ctx context.Context, x, y int64) int64 {
 func multiply(ctx context.Context, x, y int64) int64 {
 	nameKV := nameKey.String("multiply")
 
-	var span trace.Span
-	ctx, span = tracer.Start(ctx, "Multiplication")
-	defer span.End()
-
 	loopCounter.Add(ctx, 1, nameKV)
 	paramValue.Record(ctx, x, nameKV)
 	paramValue.Record(ctx, y, nameKV)
@@ -78,22 +65,27 @@ func multiply(ctx context.Context, x, y int64) int64 {
 }
 
 func Example() {
-	exportOpts := []stdout.Option{
-		stdout.WithPrettyPrint(),
-	}
-	// Registers both a trace and meter Provider globally.
-	tracerProvider, pusher, err := stdout.InstallNewPipeline(exportOpts, nil)
+	exporter, err := stdoutmetric.NewExporter(stdoutmetric.WithPrettyPrint())
 	if err != nil {
-		log.Fatal("Could not initialize stdout exporter:", err)
+		log.Fatal("Could not initialize stdoutmetric exporter:", err)
 	}
+	pusher := controller.New(
+		processor.New(
+			simple.NewWithInexpensiveDistribution(),
+			exporter,
+		),
+		controller.WithExporter(exporter),
+	)
 	ctx := context.Background()
+	if err := pusher.Start(ctx); err != nil {
+		log.Fatal("Could not start stdoutmetric controller:", err)
+	}
+	// Registers the meter Provider globally.
+	global.SetMeterProvider(pusher.MeterProvider())
 
 	log.Println("the answer is", add(ctx, multiply(ctx, multiply(ctx, 2, 2), 10), 2))
 
 	if err := pusher.Stop(ctx); err != nil {
-		log.Fatal("Could not stop stdout exporter:", err)
-	}
-	if err := tracerProvider.Shutdown(ctx); err != nil {
-		log.Fatal("Could not stop stdout tracer:", err)
+		log.Fatal("Could not stop stdoutmetric controller:", err)
 	}
 }
@@ -0,0 +1,339 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdoutmetric // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/number"
+	exportmetric "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// JSONLinesSchemaVersion is the version of the schema of the objects
+// written in JSON-lines mode. It is incremented for any change that is not
+// backwards compatible; fields may be added without changing it.
+const JSONLinesSchemaVersion = 1
+
+// The JSON-lines schema follows the OTLP JSON encoding: field names are
+// lowerCamelCase, 64-bit integers and timestamps in nanoseconds since the
+// Unix epoch are encoded as strings, and enumerations use their OTLP names.
+// Each object is self-contained and carries its resource and
+// instrumentation library.
+
+// jsonResource is the resource of a metric.
+type jsonResource struct {
+	Attributes []jsonKeyValue `json:"attributes,omitempty"`
+}
+
+// jsonInstrumentationLibrary is the instrumentation library of a metric.
+type jsonInstrumentationLibrary struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// jsonKeyValue is an attribute.
+type jsonKeyValue struct {
+	Key   string       `json:"key"`
+	Value jsonAnyValue `json:"value"`
+}
+
+// jsonAnyValue is an attribute value. Exactly one field is set.
+type jsonAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *int64          `json:"intValue,string,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *jsonArrayValue `json:"arrayValue,omitempty"`
+}
+
+// jsonArrayValue is an array attribute value.
+type jsonArrayValue struct {
+	Values []jsonAnyValue `json:"values"`
+}
+
+// jsonMetric is the object written for a metric record. Its type is
+// "metric" and exactly one of Sum, Gauge, Histogram and Summary is set.
+type jsonMetric struct {
+	SchemaVersion          int                        `json:"schemaVersion"`
+	Type                   string                     `json:"type"`
+	Resource               jsonResource               `json:"resource"`
+	InstrumentationLibrary jsonInstrumentationLibrary `json:"instrumentationLibrary"`
+
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+
+	Sum       *jsonSum       `json:"sum,omitempty"`
+	Gauge     *jsonGauge     `json:"gauge,omitempty"`
+	Histogram *jsonHistogram `json:"histogram,omitempty"`
+	Summary   *jsonSummary   `json:"summary,omitempty"`
+}
+
+// jsonSum is a sum metric.
+type jsonSum struct {
+	DataPoints             []jsonNumberDataPoint `json:"dataPoints"`
+	AggregationTemporality string                `json:"aggregationTemporality"`
+	IsMonotonic            bool                  `json:"isMonotonic"`
+}
+
+// jsonGauge is a gauge metric.
+type jsonGauge struct {
+	DataPoints []jsonNumberDataPoint `json:"dataPoints"`
+}
+
+// jsonNumberDataPoint is a data point of a sum or gauge. Exactly one of
+// AsInt and AsDouble is set.
+type jsonNumberDataPoint struct {
+	Attributes        []jsonKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano uint64         `json:"startTimeUnixNano,string,omitempty"`
+	TimeUnixNano      uint64         `json:"timeUnixNano,string"`
+	AsInt             *int64         `json:"asInt,string,omitempty"`
+	AsDouble          *float64       `json:"asDouble,omitempty"`
+}
+
+// jsonHistogram is a histogram metric.
+type jsonHistogram struct {
+	DataPoints             []jsonHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality string                   `json:"aggregationTemporality"`
+}
+
+// jsonHistogramDataPoint is a data point of a histogram.
+type jsonHistogramDataPoint struct {
+	Attributes        []jsonKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano uint64         `json:"startTimeUnixNano,string"`
+	TimeUnixNano      uint64         `json:"timeUnixNano,string"`
+	Count             uint64         `json:"count,string"`
+	Sum               float64        `json:"sum"`
+	BucketCounts      []string       `json:"bucketCounts"`
+	ExplicitBounds    []float64      `json:"explicitBounds"`
+}
+
+// jsonSummary is a summary metric of the minimum, maximum, sum and count of
+// the values recorded.
+type jsonSummary struct {
+	DataPoints []jsonSummaryDataPoint `json:"dataPoints"`
+}
+
+// jsonSummaryDataPoint is a data point of a summary.
+type jsonSummaryDataPoint struct {
+	Attributes        []jsonKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano uint64         `json:"startTimeUnixNano,string"`
+	TimeUnixNano      uint64         `json:"timeUnixNano,string"`
+	Count             uint64         `json:"count,string"`
+	Sum               float64        `json:"sum"`
+	Min               float64        `json:"min"`
+	Max               float64        `json:"max"`
+}
+
+func unixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+func toJSONResource(r *resource.Resource) jsonResource {
+	if r == nil {
+		return jsonResource{}
+	}
+	return jsonResource{Attributes: toJSONKeyValues(r.Attributes())}
+}
+
+func toJSONKeyValues(attrs []attribute.KeyValue) []jsonKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]jsonKeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		out = append(out, jsonKeyValue{Key: string(kv.Key), Value: toJSONAnyValue(kv.Value)})
+	}
+	return out
+}
+
+func toJSONAnyValue(v attribute.Value) jsonAnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return jsonAnyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := v.AsInt64()
+		return jsonAnyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return jsonAnyValue{DoubleValue: &f}
+	case attribute.ARRAY:
+		return jsonAnyValue{ArrayValue: toJSONArrayValue(v.AsArray())}
+	default:
+		s := v.Emit()
+		return jsonAnyValue{StringValue: &s}
+	}
+}
+
+func toJSONArrayValue(a interface{}) *jsonArrayValue {
+	rv := reflect.ValueOf(a)
+	values := make([]jsonAnyValue, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		e := rv.Index(i)
+		switch e.Kind() {
+		case reflect.Bool:
+			b := e.Bool()
+			values = append(values, jsonAnyValue{BoolValue: &b})
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n := e.Int()
+			values = append(values, jsonAnyValue{IntValue: &n})
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			n := int64(e.Uint())
+			values = append(values, jsonAnyValue{IntValue: &n})
+		case reflect.Float32, reflect.Float64:
+			f := e.Float()
+			values = append(values, jsonAnyValue{DoubleValue: &f})
+		default:
+			s := fmt.Sprint(e.Interface())
+			values = append(values, jsonAnyValue{StringValue: &s})
+		}
+	}
+	return &jsonArrayValue{Values: values}
+}
+
+// toJSONMetric returns the object for record and false if the aggregation
+// of the record is not supported. The times of data points are left zero
+// unless timestamps is true.
+func toJSONMetric(record exportmetric.Record, temporality exportmetric.ExportKind, timestamps bool) (jsonMetric, bool, error) {
+	desc := record.Descriptor()
+	jm := jsonMetric{
+		SchemaVersion: JSONLinesSchemaVersion,
+		Type:          "metric",
+		Resource:      toJSONResource(record.Resource()),
+		InstrumentationLibrary: jsonInstrumentationLibrary{
+			Name:    desc.InstrumentationName(),
+			Version: desc.InstrumentationVersion(),
+		},
+		Name:        desc.Name(),
+		Description: desc.Description(),
+		Unit:        string(desc.Unit()),
+	}
+
+	attrs := toJSONKeyValues(record.Labels().ToSlice())
+	var start, end uint64
+	if timestamps {
+		start, end = unixNano(record.StartTime()), unixNano(record.EndTime())
+	}
+	kind := desc.NumberKind()
+
+	switch agg := record.Aggregation().(type) {
+	case aggregation.Histogram:
+		buckets, err := agg.Histogram()
+		if err != nil {
+			return jm, false, err
+		}
+		count, err := agg.Count()
+		if err != nil {
+			return jm, false, err
+		}
+		sum, err := agg.Sum()
+		if err != nil {
+			return jm, false, err
+		}
+		counts := make([]string, len(buckets.Counts))
+		for i, c := range buckets.Counts {
+			counts[i] = fmt.Sprint(uint64(c))
+		}
+		jm.Histogram = &jsonHistogram{
+			AggregationTemporality: aggregationTemporality(temporality),
+			DataPoints: []jsonHistogramDataPoint{{
+				Attributes:        attrs,
+				StartTimeUnixNano: start,
+				TimeUnixNano:      end,
+				Count:             count,
+				Sum:               sum.CoerceToFloat64(kind),
+				BucketCounts:      counts,
+				ExplicitBounds:    buckets.Boundaries,
+			}},
+		}
+	case aggregation.MinMaxSumCount:
+		dp := jsonSummaryDataPoint{
+			Attributes:        attrs,
+			StartTimeUnixNano: start,
+			TimeUnixNano:      end,
+		}
+		var err error
+		if dp.Count, err = agg.Count(); err != nil {
+			return jm, false, err
+		}
+		for _, v := range []struct {
+			dst *float64
+			get func() (number.Number, error)
+		}{{&dp.Sum, agg.Sum}, {&dp.Min, agg.Min}, {&dp.Max, agg.Max}} {
+			n, err := v.get()
+			if err != nil {
+				return jm, false, err
+			}
+			*v.dst = n.CoerceToFloat64(kind)
+		}
+		jm.Summary = &jsonSummary{DataPoints: []jsonSummaryDataPoint{dp}}
+	case aggregation.Sum:
+		sum, err := agg.Sum()
+		if err != nil {
+			return jm, false, err
+		}
+		jm.Sum = &jsonSum{
+			AggregationTemporality: aggregationTemporality(temporality),
+			IsMonotonic:            desc.InstrumentKind().Monotonic(),
+			DataPoints:             []jsonNumberDataPoint{numberDataPoint(attrs, start, end, sum, kind)},
+		}
+	case aggregation.LastValue:
+		value, timestamp, err := agg.LastValue()
+		if err != nil {
+			return jm, false, err
+		}
+		if !timestamps {
+			timestamp = time.Time{}
+		}
+		jm.Gauge = &jsonGauge{
+			DataPoints: []jsonNumberDataPoint{numberDataPoint(attrs, 0, unixNano(timestamp), value, kind)},
+		}
+	default:
+		return jm, false, nil
+	}
+	return jm, true, nil
+}
+
+func numberDataPoint(attrs []jsonKeyValue, start, end uint64, n number.Number, kind number.Kind) jsonNumberDataPoint {
+	dp := jsonNumberDataPoint{
+		Attributes:        attrs,
+		StartTimeUnixNano: start,
+		TimeUnixNano:      end,
+	}
+	if kind == number.Int64Kind {
+		i := n.AsInt64()
+		dp.AsInt = &i
+	} else {
+		f := n.CoerceToFloat64(kind)
+		dp.AsDouble = &f
+	}
+	return dp
+}
+
+func aggregationTemporality(kind exportmetric.ExportKind) string {
+	if kind.Includes(exportmetric.CumulativeExportKind) {
+		return "AGGREGATION_TEMPORALITY_CUMULATIVE"
+	}
+	return "AGGREGATION_TEMPORALITY_DELTA"
+}
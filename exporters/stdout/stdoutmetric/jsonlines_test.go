@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdoutmetric_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+)
+
+func TestJSONLinesMetrics(t *testing.T) {
+	fix := newFixture(t, stdoutmetric.WithJSONLines())
+
+	checkpointSet := metrictest.NewCheckpointSet(testResource)
+
+	counter := metric.NewDescriptor(
+		"test.counter",
+		metric.CounterInstrumentKind,
+		number.Int64Kind,
+		metric.WithInstrumentationName("lib"),
+		metric.WithUnit("By"),
+	)
+	cagg, ckpt := metrictest.Unslice2(sum.New(2))
+	aggregatortest.CheckedUpdate(fix.t, cagg, number.NewInt64Number(123), &counter)
+	require.NoError(t, cagg.SynchronizedMove(ckpt, &counter))
+	checkpointSet.Add(&counter, ckpt, attribute.String("A", "B"))
+
+	gauge := metric.NewDescriptor("test.gauge", metric.ValueObserverInstrumentKind, number.Float64Kind)
+	lvagg, lvckpt := metrictest.Unslice2(lastvalue.New(2))
+	aggregatortest.CheckedUpdate(fix.t, lvagg, number.NewFloat64Number(1.5), &gauge)
+	require.NoError(t, lvagg.SynchronizedMove(lvckpt, &gauge))
+	checkpointSet.Add(&gauge, lvckpt)
+
+	fix.Export(checkpointSet)
+
+	lines := strings.Split(fix.Output(), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"schemaVersion": 1,
+		"type": "metric",
+		"resource": {"attributes": [{"key": "R", "value": {"stringValue": "V"}}]},
+		"instrumentationLibrary": {"name": "lib"},
+		"name": "test.counter",
+		"unit": "By",
+		"sum": {
+			"dataPoints": [{
+				"attributes": [{"key": "A", "value": {"stringValue": "B"}}],
+				"timeUnixNano": "0",
+				"asInt": "123"
+			}],
+			"aggregationTemporality": "AGGREGATION_TEMPORALITY_DELTA",
+			"isMonotonic": true
+		}
+	}`, lines[0])
+
+	var gaugeLine map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &gaugeLine))
+	assert.Equal(t, "test.gauge", gaugeLine["name"])
+	dps := gaugeLine["gauge"].(map[string]interface{})["dataPoints"].([]interface{})
+	require.Len(t, dps, 1)
+	assert.Equal(t, 1.5, dps[0].(map[string]interface{})["asDouble"])
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package stdout // import "go.opentelemetry.io/otel/exporters/stdout"
+package stdoutmetric // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 
 import (
 	"context"
@@ -27,11 +27,22 @@ import (
 	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
 )
 
-type metricExporter struct {
+// Exporter is an implementation of metric.Exporter that writes metric
+// records to an output destination as JSON.
+type Exporter struct {
 	config config
 }
 
-var _ exportmetric.Exporter = &metricExporter{}
+var _ exportmetric.Exporter = &Exporter{}
+
+// NewExporter creates an Exporter with the passed options.
+func NewExporter(options ...Option) (*Exporter, error) {
+	cfg, err := newConfig(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{config: cfg}, nil
+}
 
 type line struct {
 	Name      string      `json:"Name"`
@@ -45,13 +56,16 @@ type line struct {
 	Timestamp *time.Time `json:"Timestamp,omitempty"`
 }
 
-func (e *metricExporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) exportmetric.ExportKind {
+// ExportKindFor returns the stateless export kind for every instrument.
+func (e *Exporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) exportmetric.ExportKind {
 	return exportmetric.StatelessExportKindSelector().ExportKindFor(desc, kind)
 }
 
-func (e *metricExporter) Export(_ context.Context, checkpointSet exportmetric.CheckpointSet) error {
-	if e.config.DisableMetricExport {
-		return nil
+// Export writes the records of checkpointSet in json format to the
+// configured writer.
+func (e *Exporter) Export(_ context.Context, checkpointSet exportmetric.CheckpointSet) error {
+	if e.config.JSONLines {
+		return e.exportJSONLines(checkpointSet)
 	}
 	var aggError error
 	var batch []line
@@ -153,8 +167,32 @@ func (e *metricExporter) Export(_ context.Context, checkpointSet exportmetric.Ch
 	return aggError
 }
 
+// Shutdown is called to stop the exporter, it preforms no action.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	return nil
+}
+
+// exportJSONLines writes each record of checkpointSet as a JSON object on
+// its own line.
+func (e *Exporter) exportJSONLines(checkpointSet exportmetric.CheckpointSet) error {
+	enc := json.NewEncoder(e.config.Writer)
+	return checkpointSet.ForEach(e, func(record exportmetric.Record) error {
+		desc := record.Descriptor()
+		jm, ok, err := toJSONMetric(record, e.ExportKindFor(desc, record.Aggregation().Kind()), e.config.Timestamps)
+		if err != nil || !ok {
+			return err
+		}
+		return enc.Encode(jm)
+	})
+}
+
 // marshal v with approriate indentation.
-func (e *metricExporter) marshal(v interface{}) ([]byte, error) {
+func (e *Exporter) marshal(v interface{}) ([]byte, error) {
 	if e.config.PrettyPrint {
 		return json.MarshalIndent(v, "", "\t")
 	}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package stdout_test
+package stdoutmetric_test
 
 import (
 	"bytes"
@@ -27,7 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/number"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
@@ -42,17 +42,17 @@ import (
 type testFixture struct {
 	t        *testing.T
 	ctx      context.Context
-	exporter *stdout.Exporter
+	exporter *stdoutmetric.Exporter
 	output   *bytes.Buffer
 }
 
 var testResource = resource.NewWithAttributes(attribute.String("R", "V"))
 
-func newFixture(t *testing.T, opts ...stdout.Option) testFixture {
+func newFixture(t *testing.T, opts ...stdoutmetric.Option) testFixture {
 	buf := &bytes.Buffer{}
-	opts = append(opts, stdout.WithWriter(buf))
-	opts = append(opts, stdout.WithoutTimestamps())
-	exp, err := stdout.NewExporter(opts...)
+	opts = append(opts, stdoutmetric.WithWriter(buf))
+	opts = append(opts, stdoutmetric.WithoutTimestamps())
+	exp, err := stdoutmetric.NewExporter(opts...)
 	if err != nil {
 		t.Fatal("Error building fixture: ", err)
 	}
@@ -77,8 +77,8 @@ func (fix testFixture) Export(checkpointSet export.CheckpointSet) {
 
 func TestStdoutTimestamp(t *testing.T) {
 	var buf bytes.Buffer
-	exporter, err := stdout.NewExporter(
-		stdout.WithWriter(&buf),
+	exporter, err := stdoutmetric.NewExporter(
+		stdoutmetric.WithWriter(&buf),
 	)
 	if err != nil {
 		t.Fatal("Invalid config: ", err)
@@ -185,7 +185,7 @@ func TestStdoutMinMaxSumCount(t *testing.T) {
 }
 
 func TestStdoutValueRecorderFormat(t *testing.T) {
-	fix := newFixture(t, stdout.WithPrettyPrint())
+	fix := newFixture(t, stdoutmetric.WithPrettyPrint())
 
 	checkpointSet := metrictest.NewCheckpointSet(testResource)
 
@@ -307,3 +307,12 @@ func TestStdoutResource(t *testing.T) {
 		require.Equal(t, `[{"Name":"test.name{`+tc.expect+`}","Last":123.456}]`, fix.Output())
 	}
 }
+
+func TestStdoutShutdownHonorsCancel(t *testing.T) {
+	fix := newFixture(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, fix.exporter.Shutdown(ctx), context.Canceled)
+	assert.NoError(t, fix.exporter.Shutdown(context.Background()))
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdouttrace_test
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName    = "github.com/instrumentron"
+	instrumentationVersion = "v0.1.0"
+)
+
+var (
+	tracer = otel.GetTracerProvider().Tracer(
+		instrumentationName,
+		trace.WithInstrumentationVersion(instrumentationVersion),
+		trace.WithSchemaURL("https://opentelemetry.io/schemas/1.2.0"),
+	)
+
+	nameKey = attribute.Key("function.name")
+)
+
+func add(ctx context.Context, x, y int64) int64 {
+	var span trace.Span
+	_, span = tracer.Start(ctx, "Addition", trace.WithAttributes(nameKey.String("add")))
+	defer span.End()
+
+	return x + y
+}
+
+func multiply(ctx context.Context, x, y int64) int64 {
+	var span trace.Span
+	_, span = tracer.Start(ctx, "Multiplication", trace.WithAttributes(nameKey.String("multiply")))
+	defer span.End()
+
+	return x * y
+}
+
+func Example() {
+	exporter, err := stdouttrace.NewExporter(stdouttrace.WithPrettyPrint())
+	if err != nil {
+		log.Fatal("Could not initialize stdouttrace exporter:", err)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+	// Registers the trace Provider globally.
+	otel.SetTracerProvider(tracerProvider)
+	ctx := context.Background()
+
+	log.Println("the answer is", add(ctx, multiply(ctx, multiply(ctx, 2, 2), 10), 2))
+
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		log.Fatal("Could not stop stdouttrace tracer:", err)
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdouttrace // import "go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// JSONLinesSchemaVersion is the version of the schema of the objects
+// written in JSON-lines mode. It is incremented for any change that is not
+// backwards compatible; fields may be added without changing it.
+const JSONLinesSchemaVersion = 1
+
+// The JSON-lines schema follows the OTLP JSON encoding: field names are
+// lowerCamelCase, 64-bit integers and timestamps in nanoseconds since the
+// Unix epoch are encoded as strings, and enumerations use their OTLP names.
+// Each object is self-contained and carries its resource and
+// instrumentation library.
+
+// jsonResource is the resource of a span.
+type jsonResource struct {
+	Attributes []jsonKeyValue `json:"attributes,omitempty"`
+}
+
+// jsonInstrumentationLibrary is the instrumentation library of a span.
+type jsonInstrumentationLibrary struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// jsonKeyValue is an attribute.
+type jsonKeyValue struct {
+	Key   string       `json:"key"`
+	Value jsonAnyValue `json:"value"`
+}
+
+// jsonAnyValue is an attribute value. Exactly one field is set.
+type jsonAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *int64          `json:"intValue,string,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *jsonArrayValue `json:"arrayValue,omitempty"`
+}
+
+// jsonArrayValue is an array attribute value.
+type jsonArrayValue struct {
+	Values []jsonAnyValue `json:"values"`
+}
+
+// jsonSpan is the object written for a span. Its type is "span".
+type jsonSpan struct {
+	SchemaVersion          int                        `json:"schemaVersion"`
+	Type                   string                     `json:"type"`
+	Resource               jsonResource               `json:"resource"`
+	InstrumentationLibrary jsonInstrumentationLibrary `json:"instrumentationLibrary"`
+
+	TraceID                string         `json:"traceId"`
+	SpanID                 string         `json:"spanId"`
+	TraceState             string         `json:"traceState,omitempty"`
+	ParentSpanID           string         `json:"parentSpanId,omitempty"`
+	Name                   string         `json:"name"`
+	Kind                   string         `json:"kind"`
+	StartTimeUnixNano      uint64         `json:"startTimeUnixNano,string"`
+	EndTimeUnixNano        uint64         `json:"endTimeUnixNano,string"`
+	Attributes             []jsonKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+	Events                 []jsonEvent    `json:"events,omitempty"`
+	DroppedEventsCount     int            `json:"droppedEventsCount,omitempty"`
+	Links                  []jsonLink     `json:"links,omitempty"`
+	DroppedLinksCount      int            `json:"droppedLinksCount,omitempty"`
+	Status                 jsonStatus     `json:"status"`
+}
+
+// jsonEvent is an event of a span.
+type jsonEvent struct {
+	TimeUnixNano           uint64         `json:"timeUnixNano,string"`
+	Name                   string         `json:"name"`
+	Attributes             []jsonKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+}
+
+// jsonLink is a link of a span.
+type jsonLink struct {
+	TraceID                string         `json:"traceId"`
+	SpanID                 string         `json:"spanId"`
+	TraceState             string         `json:"traceState,omitempty"`
+	Attributes             []jsonKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+}
+
+// jsonStatus is the status of a span.
+type jsonStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func unixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+func toJSONResource(r *resource.Resource) jsonResource {
+	if r == nil {
+		return jsonResource{}
+	}
+	return jsonResource{Attributes: toJSONKeyValues(r.Attributes())}
+}
+
+func toJSONInstrumentationLibrary(il instrumentation.Library) jsonInstrumentationLibrary {
+	return jsonInstrumentationLibrary{Name: il.Name, Version: il.Version}
+}
+
+func toJSONKeyValues(attrs []attribute.KeyValue) []jsonKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]jsonKeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		out = append(out, jsonKeyValue{Key: string(kv.Key), Value: toJSONAnyValue(kv.Value)})
+	}
+	return out
+}
+
+func toJSONAnyValue(v attribute.Value) jsonAnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return jsonAnyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := v.AsInt64()
+		return jsonAnyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return jsonAnyValue{DoubleValue: &f}
+	case attribute.ARRAY:
+		return jsonAnyValue{ArrayValue: toJSONArrayValue(v.AsArray())}
+	default:
+		s := v.Emit()
+		return jsonAnyValue{StringValue: &s}
+	}
+}
+
+func toJSONArrayValue(a interface{}) *jsonArrayValue {
+	rv := reflect.ValueOf(a)
+	values := make([]jsonAnyValue, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		e := rv.Index(i)
+		switch e.Kind() {
+		case reflect.Bool:
+			b := e.Bool()
+			values = append(values, jsonAnyValue{BoolValue: &b})
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n := e.Int()
+			values = append(values, jsonAnyValue{IntValue: &n})
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			n := int64(e.Uint())
+			values = append(values, jsonAnyValue{IntValue: &n})
+		case reflect.Float32, reflect.Float64:
+			f := e.Float()
+			values = append(values, jsonAnyValue{DoubleValue: &f})
+		default:
+			s := fmt.Sprint(e.Interface())
+			values = append(values, jsonAnyValue{StringValue: &s})
+		}
+	}
+	return &jsonArrayValue{Values: values}
+}
+
+var statusCodes = map[codes.Code]string{
+	codes.Unset: "STATUS_CODE_UNSET",
+	codes.Ok:    "STATUS_CODE_OK",
+	codes.Error: "STATUS_CODE_ERROR",
+}
+
+func toJSONSpan(s trace.ReadOnlySpan) jsonSpan {
+	js := jsonSpan{
+		SchemaVersion:          JSONLinesSchemaVersion,
+		Type:                   "span",
+		Resource:               toJSONResource(s.Resource()),
+		InstrumentationLibrary: toJSONInstrumentationLibrary(s.InstrumentationLibrary()),
+		TraceID:                s.SpanContext().TraceID().String(),
+		SpanID:                 s.SpanContext().SpanID().String(),
+		TraceState:             s.SpanContext().TraceState().String(),
+		Name:                   s.Name(),
+		Kind:                   "SPAN_KIND_" + strings.ToUpper(s.SpanKind().String()),
+		StartTimeUnixNano:      unixNano(s.StartTime()),
+		EndTimeUnixNano:        unixNano(s.EndTime()),
+		Attributes:             toJSONKeyValues(s.Attributes()),
+		DroppedAttributesCount: s.DroppedAttributes(),
+		DroppedEventsCount:     s.DroppedEvents(),
+		DroppedLinksCount:      s.DroppedLinks(),
+		Status: jsonStatus{
+			Code:    statusCodes[s.Status().Code],
+			Message: s.Status().Description,
+		},
+	}
+	if parent := s.Parent(); parent.HasSpanID() {
+		js.ParentSpanID = parent.SpanID().String()
+	}
+	for _, e := range s.Events() {
+		js.Events = append(js.Events, jsonEvent{
+			TimeUnixNano:           unixNano(e.Time),
+			Name:                   e.Name,
+			Attributes:             toJSONKeyValues(e.Attributes),
+			DroppedAttributesCount: e.DroppedAttributeCount,
+		})
+	}
+	for _, l := range s.Links() {
+		js.Links = append(js.Links, jsonLink{
+			TraceID:                l.SpanContext.TraceID().String(),
+			SpanID:                 l.SpanContext.SpanID().String(),
+			TraceState:             l.SpanContext.TraceState().String(),
+			Attributes:             toJSONKeyValues(l.Attributes),
+			DroppedAttributesCount: l.DroppedAttributeCount,
+		})
+	}
+	return js
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdouttrace_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestJSONLinesSpans(t *testing.T) {
+	var b bytes.Buffer
+	ex, err := stdouttrace.NewExporter(stdouttrace.WithWriter(&b), stdouttrace.WithJSONLines())
+	require.NoError(t, err)
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	parentID, _ := trace.SpanIDFromHex("0807060504030201")
+	start := time.Unix(1600000000, 0)
+	end := start.Add(time.Second)
+
+	spans := tracetest.SpanStubs{
+		{
+			Name: "/foo",
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+			}),
+			Parent: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  parentID,
+			}),
+			SpanKind:  trace.SpanKindServer,
+			StartTime: start,
+			EndTime:   end,
+			Attributes: []attribute.KeyValue{
+				attribute.String("key", "value"),
+				attribute.Int64("count", 42),
+				attribute.Array("list", []bool{true, false}),
+			},
+			Events: []tracesdk.Event{
+				{Name: "event", Time: start},
+			},
+			Status:                 tracesdk.Status{Code: codes.Error, Description: "failed"},
+			Resource:               resource.NewWithAttributes(attribute.String("service.name", "test")),
+			InstrumentationLibrary: instrumentation.Library{Name: "lib", Version: "v1"},
+		},
+		{
+			Name: "/bar",
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  parentID,
+			}),
+			StartTime: start,
+			EndTime:   end,
+		},
+	}.Snapshots()
+	require.NoError(t, ex.ExportSpans(context.Background(), spans))
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"schemaVersion": 1,
+		"type": "span",
+		"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "test"}}]},
+		"instrumentationLibrary": {"name": "lib", "version": "v1"},
+		"traceId": "0102030405060708090a0b0c0d0e0f10",
+		"spanId": "0102030405060708",
+		"parentSpanId": "0807060504030201",
+		"name": "/foo",
+		"kind": "SPAN_KIND_SERVER",
+		"startTimeUnixNano": "1600000000000000000",
+		"endTimeUnixNano": "1600000001000000000",
+		"attributes": [
+			{"key": "key", "value": {"stringValue": "value"}},
+			{"key": "count", "value": {"intValue": "42"}},
+			{"key": "list", "value": {"arrayValue": {"values": [{"boolValue": true}, {"boolValue": false}]}}}
+		],
+		"events": [{"timeUnixNano": "1600000000000000000", "name": "event"}],
+		"status": {"code": "STATUS_CODE_ERROR", "message": "failed"}
+	}`, lines[0])
+	assert.JSONEq(t, `{
+		"schemaVersion": 1,
+		"type": "span",
+		"resource": {},
+		"instrumentationLibrary": {},
+		"traceId": "0102030405060708090a0b0c0d0e0f10",
+		"spanId": "0807060504030201",
+		"name": "/bar",
+		"kind": "SPAN_KIND_UNSPECIFIED",
+		"startTimeUnixNano": "1600000000000000000",
+		"endTimeUnixNano": "1600000001000000000",
+		"status": {"code": "STATUS_CODE_UNSET"}
+	}`, lines[1])
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdouttrace // import "go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var zeroTime time.Time
+
+// Exporter is an implementation of trace.SpanExporter that writes spans to
+// an output destination as JSON.
+type Exporter struct {
+	config config
+
+	stoppedMu sync.RWMutex
+	stopped   bool
+}
+
+var _ trace.SpanExporter = &Exporter{}
+
+// NewExporter creates an Exporter with the passed options.
+func NewExporter(options ...Option) (*Exporter, error) {
+	cfg, err := newConfig(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{config: cfg}, nil
+}
+
+// ExportSpans writes spans in json format to the configured writer.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan) error {
+	e.stoppedMu.RLock()
+	stopped := e.stopped
+	e.stoppedMu.RUnlock()
+	if stopped {
+		return nil
+	}
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	stubs := tracetest.SpanStubsFromReadOnlySpans(spans)
+	if !e.config.Timestamps {
+		for i := range stubs {
+			stub := &stubs[i]
+			// Remove timestamps
+			stub.StartTime = zeroTime
+			stub.EndTime = zeroTime
+			if len(stub.Events) == 0 {
+				continue
+			}
+			events := make([]trace.Event, len(stub.Events))
+			for j, event := range stub.Events {
+				event.Time = zeroTime
+				events[j] = event
+			}
+			stub.Events = events
+		}
+	}
+
+	if e.config.JSONLines {
+		enc := json.NewEncoder(e.config.Writer)
+		for _, s := range stubs.Snapshots() {
+			if err := enc.Encode(toJSONSpan(s)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	out, err := e.marshal(stubs)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(e.config.Writer, string(out))
+	return err
+}
+
+// Shutdown is called to stop the exporter, it preforms no action.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	e.stoppedMu.Lock()
+	e.stopped = true
+	e.stoppedMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	return nil
+}
+
+// marshal v with approriate indentation.
+func (e *Exporter) marshal(v interface{}) ([]byte, error) {
+	if e.config.PrettyPrint {
+		return json.MarshalIndent(v, "", "\t")
+	}
+	return json.Marshal(v)
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package stdout_test
+package stdouttrace_test
 
 import (
 	"bytes"
@@ -26,7 +26,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
-	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/oteltest"
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
@@ -37,7 +37,7 @@ import (
 func TestExporter_ExportSpan(t *testing.T) {
 	// write to buffer for testing
 	var b bytes.Buffer
-	ex, err := stdout.NewExporter(stdout.WithWriter(&b), stdout.WithPrettyPrint())
+	ex, err := stdouttrace.NewExporter(stdouttrace.WithWriter(&b), stdouttrace.WithPrettyPrint())
 	if err != nil {
 		t.Errorf("Error constructing stdout exporter %s", err)
 	}
@@ -158,6 +158,7 @@ func TestExporter_ExportSpan(t *testing.T) {
 		"DroppedAttributes": 0,
 		"DroppedEvents": 0,
 		"DroppedLinks": 0,
+		"TruncatedAttributes": 0,
 		"ChildSpanCount": 0,
 		"Resource": [
 			{
@@ -183,7 +184,7 @@ func TestExporterShutdownHonorsTimeout(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
 
-	e, err := stdout.NewExporter()
+	e, err := stdouttrace.NewExporter()
 	if err != nil {
 		t.Fatalf("failed to create exporter: %v", err)
 	}
@@ -202,7 +203,7 @@ func TestExporterShutdownHonorsCancel(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
 
-	e, err := stdout.NewExporter()
+	e, err := stdouttrace.NewExporter()
 	if err != nil {
 		t.Fatalf("failed to create exporter: %v", err)
 	}
@@ -217,7 +218,7 @@ func TestExporterShutdownHonorsCancel(t *testing.T) {
 }
 
 func TestExporterShutdownNoError(t *testing.T) {
-	e, err := stdout.NewExporter()
+	e, err := stdouttrace.NewExporter()
 	if err != nil {
 		t.Fatalf("failed to create exporter: %v", err)
 	}
@@ -226,3 +227,44 @@ func TestExporterShutdownNoError(t *testing.T) {
 		t.Errorf("shutdown errored: expected nil, got %v", err)
 	}
 }
+
+func TestExporterExportSpanWithoutTimestamps(t *testing.T) {
+	var b bytes.Buffer
+	ex, err := stdouttrace.NewExporter(stdouttrace.WithWriter(&b), stdouttrace.WithoutTimestamps())
+	if err != nil {
+		t.Fatalf("failed to create exporter: %v", err)
+	}
+
+	now := time.Now()
+	events := []tracesdk.Event{{Name: "foo", Time: now}}
+	ro := tracetest.SpanStubs{
+		{
+			Name:      "/foo",
+			StartTime: now,
+			EndTime:   now,
+			Events:    events,
+		},
+	}.Snapshots()
+	if err := ex.ExportSpans(context.Background(), ro); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []struct {
+		StartTime time.Time
+		EndTime   time.Time
+		Events    []struct{ Time time.Time }
+	}
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, got, 1) {
+		assert.True(t, got[0].StartTime.IsZero())
+		assert.True(t, got[0].EndTime.IsZero())
+		if assert.Len(t, got[0].Events, 1) {
+			assert.True(t, got[0].Events[0].Time.IsZero())
+		}
+	}
+	// The exported spans are not modified.
+	assert.Equal(t, now, events[0].Time)
+	assert.Equal(t, now, ro[0].StartTime())
+}
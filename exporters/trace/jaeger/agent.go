@@ -21,6 +21,7 @@ import (
 	"log"
 	"net"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/trace/jaeger/internal/third_party/thrift/lib/go/thrift"
@@ -34,6 +35,11 @@ const udpPacketMaxLength = 65000
 
 // agentClientUDP is a UDP client to Jaeger agent that implements gen.Agent interface.
 type agentClientUDP struct {
+	// oversizedSpans is the number of spans dropped because they do not fit
+	// in a packet. It is accessed atomically and kept first in the struct
+	// to guarantee 64-bit alignment.
+	oversizedSpans uint64
+
 	genAgent.Agent
 	io.Closer
 
@@ -132,6 +138,7 @@ func (a *agentClientUDP) EmitBatch(ctx context.Context, batch *gen.Batch) error
 		}
 		if spanSize+processSize >= a.maxPacketSize {
 			// drop the span that exceeds the limit.
+			atomic.AddUint64(&a.oversizedSpans, 1)
 			errs = append(errs, fmt.Errorf("span too large to send: %v", span))
 			continue
 		}
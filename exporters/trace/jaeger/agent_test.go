@@ -148,7 +148,9 @@ func TestSpanExceedsMaxPacketLimit(t *testing.T) {
 
 	ctx := context.Background()
 	assert.Error(t, exp.ExportSpans(ctx, largeSpans))
+	assert.Equal(t, uint64(1), exp.OversizedSpans())
 	assert.NoError(t, exp.ExportSpans(ctx, normalSpans))
+	assert.Equal(t, uint64(1), exp.OversizedSpans())
 	assert.NoError(t, exp.Shutdown(ctx))
 }
 
@@ -168,4 +170,47 @@ func TestEmitBatchWithMultipleErrors(t *testing.T) {
 	err = exp.ExportSpans(ctx, largeSpans)
 	assert.Error(t, err)
 	require.Contains(t, err.Error(), "multiple errors")
+	assert.Equal(t, uint64(2), exp.OversizedSpans())
+}
+
+type recordingUDPConn struct {
+	packets [][]byte
+}
+
+func (c *recordingUDPConn) Write(b []byte) (int, error) {
+	c.packets = append(c.packets, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+func (c *recordingUDPConn) SetWriteBuffer(int) error { return nil }
+func (c *recordingUDPConn) Close() error             { return nil }
+
+func TestEmitBatchSplitsIntoPackets(t *testing.T) {
+	mockServer, err := newUDPListener()
+	require.NoError(t, err)
+	defer mockServer.Close()
+	host, port, err := net.SplitHostPort(mockServer.LocalAddr().String())
+	require.NoError(t, err)
+
+	maxPacketSize := 1000
+	agentClient, err := newAgentClientUDP(agentClientUDPParams{
+		Host:                host,
+		Port:                port,
+		MaxPacketSize:       maxPacketSize,
+		AttemptReconnecting: false,
+	})
+	require.NoError(t, err)
+	conn := &recordingUDPConn{}
+	agentClient.connUDP = conn
+
+	spans := make(tracetest.SpanStubs, 100).Snapshots()
+	batches := jaegerBatchList(spans, "test")
+	require.Len(t, batches, 1)
+	require.NoError(t, agentClient.EmitBatch(context.Background(), batches[0]))
+
+	assert.Greater(t, len(conn.packets), 1)
+	for _, p := range conn.packets {
+		assert.LessOrEqual(t, len(p), maxPacketSize)
+	}
+	assert.Equal(t, uint64(0), agentClient.oversizedSpans)
 }
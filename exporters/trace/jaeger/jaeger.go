@@ -148,6 +148,17 @@ func (e *Exporter) Shutdown(ctx context.Context) error {
 	return e.uploader.shutdown(ctx)
 }
 
+// OversizedSpans returns the number of spans dropped because they were too
+// large to fit in a UDP packet of the maximum packet size when exporting to
+// a Jaeger agent. Batches of spans are otherwise split into as many packets
+// as needed. It is always zero when exporting to a Jaeger collector.
+func (e *Exporter) OversizedSpans() uint64 {
+	if u, ok := e.uploader.(interface{ oversizedSpans() uint64 }); ok {
+		return u.oversizedSpans()
+	}
+	return 0
+}
+
 func spanToThrift(ss sdktrace.ReadOnlySpan) *gen.Span {
 	attr := ss.Attributes()
 	tags := make([]*gen.Tag, 0, len(attr))
@@ -281,6 +292,14 @@ func keyValueToTag(keyValue attribute.KeyValue) *gen.Tag {
 			VStr:  &a,
 			VType: gen.TagType_STRING,
 		}
+	case attribute.MAP:
+		json, _ := json.Marshal(keyValue.Value.AsInterface())
+		m := (string)(json)
+		tag = &gen.Tag{
+			Key:   string(keyValue.Key),
+			VStr:  &m,
+			VType: gen.TagType_STRING,
+		}
 	}
 	return tag
 }
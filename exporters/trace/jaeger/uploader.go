@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/trace/jaeger/internal/third_party/thrift/lib/go/thrift"
@@ -259,6 +260,10 @@ func (a *agentUploader) upload(ctx context.Context, batch *gen.Batch) error {
 	return a.client.EmitBatch(ctx, batch)
 }
 
+func (a *agentUploader) oversizedSpans() uint64 {
+	return atomic.LoadUint64(&a.client.oversizedSpans)
+}
+
 // collectorUploader implements batchUploader interface sending batches to
 // Jaeger through the collector http endpoint.
 type collectorUploader struct {
@@ -183,6 +183,10 @@ func toZipkinTags(data tracesdk.ReadOnlySpan) map[string]string {
 		case attribute.ARRAY:
 			json, _ := json.Marshal(kv.Value.AsArray())
 			m[(string)(kv.Key)] = (string)(json)
+		// For map attributes, serialize as JSON object string.
+		case attribute.MAP:
+			json, _ := json.Marshal(kv.Value.AsInterface())
+			m[(string)(kv.Key)] = (string)(json)
 		default:
 			m[(string)(kv.Key)] = kv.Value.Emit()
 		}
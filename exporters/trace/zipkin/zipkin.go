@@ -17,7 +17,6 @@ package zipkin // import "go.opentelemetry.io/otel/exporters/trace/zipkin"
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -27,6 +26,10 @@ import (
 	"net/url"
 	"sync"
 
+	zkmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"github.com/openzipkin/zipkin-go/reporter"
+
 	"go.opentelemetry.io/otel"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -48,9 +51,10 @@ var (
 
 // Options contains configuration for the exporter.
 type config struct {
-	client *http.Client
-	logger *log.Logger
-	tpOpts []sdktrace.TracerProviderOption
+	client     *http.Client
+	logger     *log.Logger
+	serializer reporter.SpanSerializer
+	tpOpts     []sdktrace.TracerProviderOption
 }
 
 // Option defines a function that configures the exporter.
@@ -78,6 +82,22 @@ func WithClient(client *http.Client) Option {
 	})
 }
 
+// WithSerializer configures the exporter to encode spans with the passed
+// serializer. The Content-Type of requests is the one of the serializer.
+// By default spans are encoded as JSON using reporter.JSONSerializer.
+func WithSerializer(serializer reporter.SpanSerializer) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.serializer = serializer
+	})
+}
+
+// WithProtobuf configures the exporter to encode spans using the Zipkin
+// protobuf (proto3) encoding instead of JSON. This significantly reduces
+// the size of requests.
+func WithProtobuf() Option {
+	return WithSerializer(zipkin_proto3.SpanSerializer{})
+}
+
 // WithSDKOptions configures options passed to the created TracerProvider.
 func WithSDKOptions(tpOpts ...sdktrace.TracerProviderOption) Option {
 	return optionFunc(func(cfg *config) {
@@ -105,6 +125,9 @@ func NewRawExporter(collectorURL string, opts ...Option) (*Exporter, error) {
 	if cfg.client == nil {
 		cfg.client = http.DefaultClient
 	}
+	if cfg.serializer == nil {
+		cfg.serializer = reporter.JSONSerializer{}
+	}
 	return &Exporter{
 		url:    collectorURL,
 		client: cfg.client,
@@ -154,16 +177,25 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpa
 		return nil
 	}
 	models := toZipkinSpanModels(spans)
-	body, err := json.Marshal(models)
+	ptrs := make([]*zkmodel.SpanModel, len(models))
+	for i := range models {
+		ptrs[i] = &models[i]
+	}
+	serializer := e.config.serializer
+	body, err := serializer.Serialize(ptrs)
 	if err != nil {
-		return e.errf("failed to serialize zipkin models to JSON: %v", err)
+		return e.errf("failed to serialize zipkin models: %v", err)
+	}
+	if _, ok := serializer.(reporter.JSONSerializer); ok {
+		e.logf("about to send a POST request to %s with body %s", e.url, body)
+	} else {
+		e.logf("about to send a POST request to %s with %d bytes of %s", e.url, len(body), serializer.ContentType())
 	}
-	e.logf("about to send a POST request to %s with body %s", e.url, body)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewBuffer(body))
 	if err != nil {
 		return e.errf("failed to create request to %s: %v", e.url, err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", serializer.ContentType())
 	resp, err := e.client.Do(req)
 	if err != nil {
 		return e.errf("request to %s failed: %v", e.url, err)
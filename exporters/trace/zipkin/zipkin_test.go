@@ -27,10 +27,12 @@ import (
 	"time"
 
 	zkmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -167,11 +169,22 @@ func startMockZipkinCollector(t *testing.T) *mockZipkinCollector {
 }
 
 func (c *mockZipkinCollector) handler(w http.ResponseWriter, r *http.Request) {
-	jsonBytes, err := ioutil.ReadAll(r.Body)
+	body, err := ioutil.ReadAll(r.Body)
 	require.NoError(c.t, err)
 	var models []zkmodel.SpanModel
-	err = json.Unmarshal(jsonBytes, &models)
-	require.NoError(c.t, err)
+	switch ct := r.Header.Get("Content-Type"); ct {
+	case "application/json":
+		err = json.Unmarshal(body, &models)
+		require.NoError(c.t, err)
+	case "application/x-protobuf":
+		ptrs, err := zipkin_proto3.ParseSpans(body, false)
+		require.NoError(c.t, err)
+		for _, m := range ptrs {
+			models = append(models, *m)
+		}
+	default:
+		c.t.Errorf("unexpected Content-Type: %s", ct)
+	}
 	// for some reason we may get the nonUTC timestamps in models,
 	// fix that
 	for midx := range models {
@@ -422,3 +435,72 @@ func TestNewExportPipelineWithOptions(t *testing.T) {
 	model := collector.StealModels()[0]
 	require.Equal(t, len(model.Annotations), eventCountLimit)
 }
+
+func TestExportSpansProtobuf(t *testing.T) {
+	spans := tracetest.SpanStubs{
+		{
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{0x01},
+				SpanID:  trace.SpanID{0xFF},
+			}),
+			SpanKind:  trace.SpanKindServer,
+			Name:      "foo",
+			StartTime: time.Date(2020, time.March, 11, 19, 24, 0, 0, time.UTC),
+			EndTime:   time.Date(2020, time.March, 11, 19, 25, 0, 0, time.UTC),
+			Attributes: []attribute.KeyValue{
+				attribute.String("key", "value"),
+			},
+			Resource: resource.NewWithAttributes(
+				semconv.ServiceNameKey.String("exporter-test"),
+			),
+		},
+	}.Snapshots()
+
+	collector := startMockZipkinCollector(t)
+	defer collector.Close()
+	exporter, err := NewRawExporter(collector.url, WithProtobuf())
+	require.NoError(t, err)
+	require.NoError(t, exporter.ExportSpans(context.Background(), spans))
+
+	require.Eventually(t, func() bool {
+		return collector.ModelsLen() == 1
+	}, time.Second, 10*time.Millisecond)
+	got := collector.StealModels()[0]
+	assert.Equal(t, zkmodel.TraceID{High: 0x0100000000000000}, got.TraceID)
+	assert.Equal(t, zkmodel.ID(0xFF00000000000000), got.ID)
+	assert.Equal(t, "foo", got.Name)
+	assert.Equal(t, zkmodel.Server, got.Kind)
+	assert.Equal(t, time.Minute, got.Duration)
+	assert.Equal(t, "exporter-test", got.LocalEndpoint.ServiceName)
+	assert.Equal(t, "value", got.Tags["key"])
+}
+
+type recordingSerializer struct {
+	spans []*zkmodel.SpanModel
+}
+
+func (s *recordingSerializer) Serialize(spans []*zkmodel.SpanModel) ([]byte, error) {
+	s.spans = append(s.spans, spans...)
+	return json.Marshal(spans)
+}
+
+func (s *recordingSerializer) ContentType() string { return "application/json" }
+
+func TestWithSerializer(t *testing.T) {
+	collector := startMockZipkinCollector(t)
+	defer collector.Close()
+	serializer := &recordingSerializer{}
+	exporter, err := NewRawExporter(collector.url, WithSerializer(serializer))
+	require.NoError(t, err)
+
+	spans := tracetest.SpanStubs{{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{0x01},
+			SpanID:  trace.SpanID{0xFF},
+		}),
+		Name: "foo",
+	}}.Snapshots()
+	require.NoError(t, exporter.ExportSpans(context.Background(), spans))
+	require.Len(t, serializer.spans, 1)
+	assert.Equal(t, "foo", serializer.spans[0].Name)
+}
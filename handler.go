@@ -34,8 +34,9 @@ var (
 	// only ever registered once.
 	delegateErrorHandlerOnce sync.Once
 
-	// Comiple time check that loggingErrorHandler implements ErrorHandler.
-	_ ErrorHandler = (*loggingErrorHandler)(nil)
+	// Comiple time check that loggingErrorHandler implements
+	// ClassifiedErrorHandler.
+	_ ClassifiedErrorHandler = (*loggingErrorHandler)(nil)
 )
 
 // loggingErrorHandler logs all errors to STDERR.
@@ -63,6 +64,19 @@ func (h *loggingErrorHandler) Handle(err error) {
 	h.l.Print(err)
 }
 
+// HandleClassified implements ClassifiedErrorHandler.
+func (h *loggingErrorHandler) HandleClassified(component string, sev Severity, err error) {
+	if d := h.delegate.Load(); d != nil {
+		if c, ok := d.(ClassifiedErrorHandler); ok {
+			c.HandleClassified(component, sev, err)
+		} else {
+			d.(ErrorHandler).Handle(err)
+		}
+		return
+	}
+	h.l.Printf("%s %s: %v", component, sev, err)
+}
+
 // GetErrorHandler returns the global ErrorHandler instance. If no ErrorHandler
 // instance has been set (`SetErrorHandler`), the default ErrorHandler which
 // logs errors to STDERR is returned.
@@ -87,3 +101,15 @@ func SetErrorHandler(h ErrorHandler) {
 func Handle(err error) {
 	GetErrorHandler().Handle(err)
 }
+
+// HandleClassified passes err, reported by component with severity sev, to
+// the global ErrorHandler. If the registered ErrorHandler is not a
+// ClassifiedErrorHandler only err is passed to its Handle method.
+func HandleClassified(component string, sev Severity, err error) {
+	h := GetErrorHandler()
+	if c, ok := h.(ClassifiedErrorHandler); ok {
+		c.HandleClassified(component, sev, err)
+		return
+	}
+	h.Handle(err)
+}
@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelgrpc provides gRPC stats.Handlers instrumenting the client and
+// server side of gRPC connections.
+//
+// The handlers start a span for each RPC, add an event to it for each message
+// sent and received, including the messages of streaming RPCs, and record
+// the rpc.* metrics of the RPCs: their duration, the sizes of their messages
+// and the number of messages per RPC.
+//
+//	conn, err := grpc.Dial(target, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+//	srv := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package otelgrpc // import "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc // import "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
+
+import (
+	"google.golang.org/grpc/metadata"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// MetadataCarrier adapts gRPC metadata to satisfy the
+// propagation.TextMapCarrier and propagation.ValuesGetter interfaces, so
+// propagators read every value of keys sent several times.
+type MetadataCarrier metadata.MD
+
+var (
+	_ propagation.TextMapCarrier = MetadataCarrier{}
+	_ propagation.ValuesGetter   = MetadataCarrier{}
+)
+
+// Get returns the first value associated with the passed key.
+func (c MetadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Values returns all the values associated with the passed key.
+func (c MetadataCarrier) Values(key string) []string {
+	return metadata.MD(c).Get(key)
+}
+
+// Set stores the key-value pair, replacing any values of the key.
+func (c MetadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys lists the keys stored in this carrier.
+func (c MetadataCarrier) Keys() []string {
+	out := make([]string, 0, len(c))
+	for key := range c {
+		out = append(out, key)
+	}
+	return out
+}
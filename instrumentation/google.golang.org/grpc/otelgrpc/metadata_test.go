@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMetadataCarrier(t *testing.T) {
+	md := metadata.MD{}
+	c := otelgrpc.MetadataCarrier(md)
+	assert.Equal(t, "", c.Get("key"))
+
+	c.Set("Key", "one")
+	assert.Equal(t, []string{"one"}, md.Get("key"))
+	md.Append("key", "two")
+	assert.Equal(t, "one", c.Get("key"))
+	assert.Equal(t, []string{"one", "two"}, c.Values("key"))
+	assert.Equal(t, []string{"key"}, c.Keys())
+}
+
+func TestMetadataCarrierMultipleTraceState(t *testing.T) {
+	md := metadata.Pairs(
+		"traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"tracestate", "a=1",
+		"tracestate", "b=2",
+	)
+	ctx := propagation.TraceContext{}.Extract(context.Background(), otelgrpc.MetadataCarrier(md))
+	ts := trace.SpanContextFromContext(ctx).TraceState()
+	assert.Equal(t, "1", ts.Get("a"))
+	assert.Equal(t, "2", ts.Get("b"))
+}
@@ -0,0 +1,277 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc // import "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attributes of the message events added to the spans.
+const (
+	// MessageTypeKey is the type of the message, SENT or RECEIVED.
+	MessageTypeKey = attribute.Key("message.type")
+	// MessageIDKey is the sequence number of the message in its direction,
+	// starting at 1.
+	MessageIDKey = attribute.Key("message.id")
+	// MessageUncompressedSizeKey is the size of the message before
+	// compression.
+	MessageUncompressedSizeKey = attribute.Key("message.uncompressed_size")
+)
+
+var (
+	messageSent     = MessageTypeKey.String("SENT")
+	messageReceived = MessageTypeKey.String("RECEIVED")
+)
+
+// gRPCContextKey is the key of the gRPCContext of an RPC.
+type gRPCContextKey struct{}
+
+// gRPCContext holds the state of an RPC between the calls to the stats
+// handler.
+type gRPCContext struct {
+	messagesReceived int64
+	messagesSent     int64
+	labels           []attribute.KeyValue
+}
+
+// instruments are the rpc.* metric instruments of one side of the RPCs.
+type instruments struct {
+	duration     metric.Float64ValueRecorder
+	requestSize  metric.Int64ValueRecorder
+	responseSize metric.Int64ValueRecorder
+	requests     metric.Int64ValueRecorder
+	responses    metric.Int64ValueRecorder
+}
+
+func newInstruments(meter metric.Meter, side string) instruments {
+	m := metric.Must(meter)
+	return instruments{
+		duration: m.NewFloat64ValueRecorder(
+			"rpc."+side+".duration",
+			metric.WithUnit(unit.Milliseconds),
+			metric.WithDescription("Duration of the RPCs"),
+		),
+		requestSize: m.NewInt64ValueRecorder(
+			"rpc."+side+".request.size",
+			metric.WithUnit(unit.Bytes),
+			metric.WithDescription("Uncompressed size of the request messages"),
+		),
+		responseSize: m.NewInt64ValueRecorder(
+			"rpc."+side+".response.size",
+			metric.WithUnit(unit.Bytes),
+			metric.WithDescription("Uncompressed size of the response messages"),
+		),
+		requests: m.NewInt64ValueRecorder(
+			"rpc."+side+".requests_per_rpc",
+			metric.WithUnit(unit.Dimensionless),
+			metric.WithDescription("Number of request messages per RPC"),
+		),
+		responses: m.NewInt64ValueRecorder(
+			"rpc."+side+".responses_per_rpc",
+			metric.WithUnit(unit.Dimensionless),
+			metric.WithDescription("Number of response messages per RPC"),
+		),
+	}
+}
+
+type handler struct {
+	tracer      trace.Tracer
+	propagators propagation.TextMapPropagator
+	instruments instruments
+}
+
+func newHandler(side string, opts []Option) handler {
+	c := newConfig(opts)
+	return handler{
+		tracer: c.tracerProvider.Tracer(
+			instrumentationName,
+			trace.WithInstrumentationVersion(otel.Version()),
+		),
+		propagators: c.propagators,
+		instruments: newInstruments(c.meterProvider.Meter(
+			instrumentationName,
+			metric.WithInstrumentationVersion(otel.Version()),
+		), side),
+	}
+}
+
+// TagConn does nothing.
+func (h *handler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn does nothing.
+func (h *handler) HandleConn(context.Context, stats.ConnStats) {}
+
+type serverHandler struct {
+	handler
+}
+
+// NewServerHandler returns a stats.Handler for gRPC servers, to set with the
+// grpc.StatsHandler server option. It extracts the span context propagated
+// with the incoming RPCs, and starts a server span for each of them.
+func NewServerHandler(opts ...Option) stats.Handler {
+	return &serverHandler{handler: newHandler("server", opts)}
+}
+
+// TagRPC starts the server span of the RPC.
+func (h *serverHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = h.propagators.Extract(ctx, MetadataCarrier(md))
+
+	name, attrs := spanInfo(info.FullMethodName)
+	ctx, _ = h.tracer.Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+	return context.WithValue(ctx, gRPCContextKey{}, &gRPCContext{labels: attrs})
+}
+
+// HandleRPC records the events and metrics of the RPC.
+func (h *serverHandler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	h.handleRPC(ctx, rs, false)
+}
+
+type clientHandler struct {
+	handler
+}
+
+// NewClientHandler returns a stats.Handler for gRPC clients, to set with the
+// grpc.WithStatsHandler dial option. It starts a client span for each RPC and
+// propagates its span context with the RPC.
+func NewClientHandler(opts ...Option) stats.Handler {
+	return &clientHandler{handler: newHandler("client", opts)}
+}
+
+// TagRPC starts the client span of the RPC and injects its span context into
+// the outgoing metadata.
+func (h *clientHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	name, attrs := spanInfo(info.FullMethodName)
+	ctx, _ = h.tracer.Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	h.propagators.Inject(ctx, MetadataCarrier(md))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return context.WithValue(ctx, gRPCContextKey{}, &gRPCContext{labels: attrs})
+}
+
+// HandleRPC records the events and metrics of the RPC.
+func (h *clientHandler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	h.handleRPC(ctx, rs, true)
+}
+
+func (h *handler) handleRPC(ctx context.Context, rs stats.RPCStats, isClient bool) {
+	span := trace.SpanFromContext(ctx)
+	gctx, _ := ctx.Value(gRPCContextKey{}).(*gRPCContext)
+	if gctx == nil {
+		return
+	}
+
+	switch rs := rs.(type) {
+	case *stats.InPayload:
+		id := atomic.AddInt64(&gctx.messagesReceived, 1)
+		if isClient {
+			h.instruments.responseSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		} else {
+			h.instruments.requestSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		}
+		span.AddEvent("message", trace.WithAttributes(
+			messageReceived,
+			MessageIDKey.Int64(id),
+			MessageUncompressedSizeKey.Int(rs.Length),
+		))
+	case *stats.OutPayload:
+		id := atomic.AddInt64(&gctx.messagesSent, 1)
+		if isClient {
+			h.instruments.requestSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		} else {
+			h.instruments.responseSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		}
+		span.AddEvent("message", trace.WithAttributes(
+			messageSent,
+			MessageIDKey.Int64(id),
+			MessageUncompressedSizeKey.Int(rs.Length),
+		))
+	case *stats.End:
+		code := codes.OK
+		if rs.Error != nil {
+			s, _ := status.FromError(rs.Error)
+			code = s.Code()
+			span.SetStatus(otelcodes.Error, s.Message())
+		}
+		statusCode := semconv.RPCGRPCStatusCodeKey.Int(int(code))
+		span.SetAttributes(statusCode)
+
+		labels := append(append([]attribute.KeyValue(nil), gctx.labels...), statusCode)
+		elapsed := float64(rs.EndTime.Sub(rs.BeginTime)) / float64(time.Millisecond)
+		h.instruments.duration.Record(ctx, elapsed, labels...)
+		received := atomic.LoadInt64(&gctx.messagesReceived)
+		sent := atomic.LoadInt64(&gctx.messagesSent)
+		if isClient {
+			h.instruments.requests.Record(ctx, sent, labels...)
+			h.instruments.responses.Record(ctx, received, labels...)
+		} else {
+			h.instruments.requests.Record(ctx, received, labels...)
+			h.instruments.responses.Record(ctx, sent, labels...)
+		}
+		span.End(trace.WithTimestamp(rs.EndTime))
+	}
+}
+
+// spanInfo returns the span name and the attributes of the RPC with the
+// gRPC full method name, formatted as "/package.service/method".
+func spanInfo(fullMethod string) (string, []attribute.KeyValue) {
+	name := strings.TrimLeft(fullMethod, "/")
+	attrs := []attribute.KeyValue{semconv.RPCSystemKey.String("grpc")}
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		if service := name[:i]; service != "" {
+			attrs = append(attrs, semconv.RPCServiceKey.String(service))
+		}
+		if method := name[i+1:]; method != "" {
+			attrs = append(attrs, semconv.RPCMethodKey.String(method))
+		}
+	}
+	return name, attrs
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type testEnv struct {
+	client      healthpb.HealthClient
+	clientSpans *oteltest.SpanRecorder
+	serverSpans *oteltest.SpanRecorder
+	clientMeter *oteltest.MeterImpl
+	serverMeter *oteltest.MeterImpl
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	env := &testEnv{
+		clientSpans: new(oteltest.SpanRecorder),
+		serverSpans: new(oteltest.SpanRecorder),
+	}
+	clientMeter, clientMP := oteltest.NewMeterProvider()
+	serverMeter, serverMP := oteltest.NewMeterProvider()
+	env.clientMeter, env.serverMeter = clientMeter, serverMeter
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.StatsHandler(NewServerHandler(
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(env.serverSpans))),
+		WithMeterProvider(serverMP),
+		WithPropagators(propagation.TraceContext{}),
+	)))
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("known", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+		grpc.WithStatsHandler(NewClientHandler(
+			WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(env.clientSpans))),
+			WithMeterProvider(clientMP),
+			WithPropagators(propagation.TraceContext{}),
+		)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	env.client = healthpb.NewHealthClient(conn)
+	return env
+}
+
+// measured returns the values recorded by the instrument with name.
+func measured(impl *oteltest.MeterImpl, name string) []oteltest.Measured {
+	var out []oteltest.Measured
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		if m.Name == name {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func messageTypes(span *oteltest.Span) []string {
+	var types []string
+	for _, e := range span.Events() {
+		if e.Name == "message" {
+			types = append(types, e.Attributes[MessageTypeKey].AsString())
+		}
+	}
+	return types
+}
+
+func TestUnaryRPC(t *testing.T) {
+	env := newTestEnv(t)
+	_, err := env.client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "known"})
+	require.NoError(t, err)
+
+	clientSpans := env.clientSpans.Completed()
+	require.Len(t, clientSpans, 1)
+	client := clientSpans[0]
+	assert.Equal(t, "grpc.health.v1.Health/Check", client.Name())
+	assert.Equal(t, trace.SpanKindClient, client.SpanKind())
+	assert.Equal(t, codes.Unset, client.StatusCode())
+	attrs := client.Attributes()
+	assert.Equal(t, attribute.StringValue("grpc"), attrs[semconv.RPCSystemKey])
+	assert.Equal(t, attribute.StringValue("grpc.health.v1.Health"), attrs[semconv.RPCServiceKey])
+	assert.Equal(t, attribute.StringValue("Check"), attrs[semconv.RPCMethodKey])
+	assert.Equal(t, attribute.IntValue(0), attrs[semconv.RPCGRPCStatusCodeKey])
+	assert.Equal(t, []string{"SENT", "RECEIVED"}, messageTypes(client))
+
+	require.Eventually(t, func() bool { return len(env.serverSpans.Completed()) == 1 }, time.Second, 10*time.Millisecond)
+	server := env.serverSpans.Completed()[0]
+	assert.Equal(t, "grpc.health.v1.Health/Check", server.Name())
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+	assert.Equal(t, client.SpanContext().TraceID(), server.SpanContext().TraceID())
+	assert.Equal(t, client.SpanContext().SpanID(), server.ParentSpanID())
+	assert.Equal(t, []string{"RECEIVED", "SENT"}, messageTypes(server))
+
+	for _, name := range []string{
+		"rpc.client.duration",
+		"rpc.client.request.size",
+		"rpc.client.response.size",
+	} {
+		assert.Len(t, measured(env.clientMeter, name), 1, name)
+	}
+	requests := measured(env.clientMeter, "rpc.client.requests_per_rpc")
+	require.Len(t, requests, 1)
+	assert.Equal(t, int64(1), requests[0].Number.AsInt64())
+	assert.Equal(t, attribute.IntValue(0), requests[0].Labels[semconv.RPCGRPCStatusCodeKey])
+	assert.Len(t, measured(env.serverMeter, "rpc.server.duration"), 1)
+	assert.Len(t, measured(env.serverMeter, "rpc.server.responses_per_rpc"), 1)
+}
+
+func TestUnaryRPCError(t *testing.T) {
+	env := newTestEnv(t)
+	_, err := env.client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	require.Equal(t, grpccodes.NotFound, status.Code(err))
+
+	clientSpans := env.clientSpans.Completed()
+	require.Len(t, clientSpans, 1)
+	assert.Equal(t, codes.Error, clientSpans[0].StatusCode())
+	assert.Equal(t, "unknown service", clientSpans[0].StatusMessage())
+	assert.Equal(t, attribute.IntValue(int(grpccodes.NotFound)), clientSpans[0].Attributes()[semconv.RPCGRPCStatusCodeKey])
+}
+
+func TestStreamingRPC(t *testing.T) {
+	env := newTestEnv(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := env.client.Watch(ctx, &healthpb.HealthCheckRequest{Service: "known"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	require.Equal(t, grpccodes.Canceled, status.Code(err))
+
+	require.Eventually(t, func() bool { return len(env.clientSpans.Completed()) == 1 }, time.Second, 10*time.Millisecond)
+	client := env.clientSpans.Completed()[0]
+	assert.Equal(t, "grpc.health.v1.Health/Watch", client.Name())
+	assert.Equal(t, []string{"SENT", "RECEIVED"}, messageTypes(client))
+	assert.Equal(t, attribute.IntValue(int(grpccodes.Canceled)), client.Attributes()[semconv.RPCGRPCStatusCodeKey])
+
+	require.Eventually(t, func() bool { return len(env.serverSpans.Completed()) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestSpanInfo(t *testing.T) {
+	name, attrs := spanInfo("/foo.Service/Method")
+	assert.Equal(t, "foo.Service/Method", name)
+	assert.Equal(t, []attribute.KeyValue{
+		semconv.RPCSystemKey.String("grpc"),
+		semconv.RPCServiceKey.String("foo.Service"),
+		semconv.RPCMethodKey.String("Method"),
+	}, attrs)
+
+	name, attrs = spanInfo("invalid")
+	assert.Equal(t, "invalid", name)
+	assert.Equal(t, []attribute.KeyValue{semconv.RPCSystemKey.String("grpc")}, attrs)
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package host provides the conventional host metrics of the system a
+// process runs on: the CPU time spent in each state, the memory usage and
+// the network I/O.
+//
+// All metrics are recorded by asynchronous instruments registered with a
+// single call to Start:
+//
+//	if err := host.Start(host.WithMeterProvider(provider)); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// The metrics are read from the proc filesystem and are only supported on
+// Linux, Start returns an error on other systems.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package host // import "go.opentelemetry.io/otel/instrumentation/host"
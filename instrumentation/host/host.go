@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host // import "go.opentelemetry.io/otel/instrumentation/host"
+
+import (
+	"context"
+
+	"github.com/prometheus/procfs"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+// instrumentationName is the name of this instrumentation package.
+const instrumentationName = "go.opentelemetry.io/otel/instrumentation/host"
+
+var (
+	stateKey     = attribute.Key("state")
+	directionKey = attribute.Key("direction")
+
+	cpuStateUser   = []attribute.KeyValue{stateKey.String("user")}
+	cpuStateSystem = []attribute.KeyValue{stateKey.String("system")}
+	cpuStateIdle   = []attribute.KeyValue{stateKey.String("idle")}
+	cpuStateOther  = []attribute.KeyValue{stateKey.String("other")}
+
+	memoryStateUsed      = []attribute.KeyValue{stateKey.String("used")}
+	memoryStateAvailable = []attribute.KeyValue{stateKey.String("available")}
+
+	networkDirectionReceive  = []attribute.KeyValue{directionKey.String("receive")}
+	networkDirectionTransmit = []attribute.KeyValue{directionKey.String("transmit")}
+)
+
+// config contains the options of the host instrumentation.
+type config struct {
+	meterProvider metric.MeterProvider
+	procPath      string
+}
+
+// Option configures the host instrumentation.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(c *config) {
+	fn(c)
+}
+
+// WithMeterProvider sets the MeterProvider the instruments are created with.
+// The default value is the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return optionFunc(func(c *config) {
+		if mp != nil {
+			c.meterProvider = mp
+		}
+	})
+}
+
+// withProcPath sets the mount point of the proc filesystem.
+func withProcPath(path string) Option {
+	return optionFunc(func(c *config) {
+		c.procPath = path
+	})
+}
+
+func newConfig(opts ...Option) config {
+	c := config{
+		meterProvider: global.GetMeterProvider(),
+		procPath:      procfs.DefaultMountPoint,
+	}
+	for _, o := range opts {
+		o.apply(&c)
+	}
+	return c
+}
+
+// host reports the host metrics.
+type host struct {
+	meter metric.Meter
+	fs    procfs.FS
+}
+
+// Start registers the instruments of the host metrics with the MeterProvider
+// of the options. The metrics are observed each time the MeterProvider
+// collects them until the process ends. It returns an error if the proc
+// filesystem cannot be read.
+func Start(opts ...Option) error {
+	c := newConfig(opts...)
+	fs, err := procfs.NewFS(c.procPath)
+	if err != nil {
+		return err
+	}
+	h := &host{
+		meter: c.meterProvider.Meter(
+			instrumentationName,
+			metric.WithInstrumentationVersion(otel.Version()),
+		),
+		fs: fs,
+	}
+	return h.register()
+}
+
+func (h *host) register() error {
+	var (
+		err error
+
+		cpuTime           metric.Float64SumObserver
+		memoryUsage       metric.Int64UpDownSumObserver
+		memoryUtilization metric.Float64ValueObserver
+		networkIO         metric.Int64SumObserver
+	)
+
+	batchObserver := h.meter.NewBatchObserver(func(ctx context.Context, result metric.BatchObserverResult) {
+		if stat, err := h.fs.Stat(); err != nil {
+			otel.Handle(err)
+		} else {
+			cpu := stat.CPUTotal
+			result.Observe(cpuStateUser, cpuTime.Observation(cpu.User+cpu.Nice))
+			result.Observe(cpuStateSystem, cpuTime.Observation(cpu.System))
+			result.Observe(cpuStateIdle, cpuTime.Observation(cpu.Idle))
+			result.Observe(cpuStateOther, cpuTime.Observation(cpu.Iowait+cpu.IRQ+cpu.SoftIRQ+cpu.Steal))
+		}
+
+		if mem, err := h.fs.Meminfo(); err != nil {
+			otel.Handle(err)
+		} else if mem.MemTotal != nil && mem.MemAvailable != nil {
+			// The values of /proc/meminfo are in kibibytes.
+			total := int64(*mem.MemTotal) * 1024
+			available := int64(*mem.MemAvailable) * 1024
+			result.Observe(memoryStateUsed,
+				memoryUsage.Observation(total-available),
+				memoryUtilization.Observation(float64(total-available)/float64(total)),
+			)
+			result.Observe(memoryStateAvailable,
+				memoryUsage.Observation(available),
+				memoryUtilization.Observation(float64(available)/float64(total)),
+			)
+		}
+
+		if dev, err := h.fs.NetDev(); err != nil {
+			otel.Handle(err)
+		} else {
+			total := dev.Total()
+			result.Observe(networkDirectionReceive, networkIO.Observation(int64(total.RxBytes)))
+			result.Observe(networkDirectionTransmit, networkIO.Observation(int64(total.TxBytes)))
+		}
+	})
+
+	if cpuTime, err = batchObserver.NewFloat64SumObserver(
+		"system.cpu.time",
+		metric.WithUnit("s"),
+		metric.WithDescription("Accumulated CPU time spent by the host in each state"),
+	); err != nil {
+		return err
+	}
+	if memoryUsage, err = batchObserver.NewInt64UpDownSumObserver(
+		"system.memory.usage",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Memory of the host used and available"),
+	); err != nil {
+		return err
+	}
+	if memoryUtilization, err = batchObserver.NewFloat64ValueObserver(
+		"system.memory.utilization",
+		metric.WithUnit(unit.Dimensionless),
+		metric.WithDescription("Fraction of the memory of the host used and available"),
+	); err != nil {
+		return err
+	}
+	networkIO, err = batchObserver.NewInt64SumObserver(
+		"system.network.io",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Bytes transferred over all the network interfaces of the host"),
+	)
+	return err
+}
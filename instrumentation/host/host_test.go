@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+func TestStart(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	require.NoError(t, Start(WithMeterProvider(provider), withProcPath("testdata/proc")))
+	impl.RunAsyncInstruments()
+
+	type key struct {
+		name  string
+		label attribute.KeyValue
+	}
+	got := make(map[key]number.Number)
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		for k, v := range m.Labels {
+			got[key{m.Name, k.String(v.AsString())}] = m.Number
+		}
+	}
+
+	// /proc/stat counts CPU time in hundredths of seconds.
+	assert.Equal(t, number.NewFloat64Number(12), got[key{"system.cpu.time", stateKey.String("user")}])
+	assert.Equal(t, number.NewFloat64Number(3), got[key{"system.cpu.time", stateKey.String("system")}])
+	assert.Equal(t, number.NewFloat64Number(40), got[key{"system.cpu.time", stateKey.String("idle")}])
+	other := got[key{"system.cpu.time", stateKey.String("other")}]
+	assert.InDelta(t, 0.71, other.AsFloat64(), 1e-9)
+
+	assert.Equal(t, number.NewInt64Number(1000000*1024), got[key{"system.memory.usage", stateKey.String("used")}])
+	assert.Equal(t, number.NewInt64Number(3000000*1024), got[key{"system.memory.usage", stateKey.String("available")}])
+	assert.Equal(t, number.NewFloat64Number(0.25), got[key{"system.memory.utilization", stateKey.String("used")}])
+	assert.Equal(t, number.NewFloat64Number(0.75), got[key{"system.memory.utilization", stateKey.String("available")}])
+
+	assert.Equal(t, number.NewInt64Number(3000), got[key{"system.network.io", directionKey.String("receive")}])
+	assert.Equal(t, number.NewInt64Number(4000), got[key{"system.network.io", directionKey.String("transmit")}])
+}
+
+func TestStartWithoutProcFS(t *testing.T) {
+	_, provider := oteltest.NewMeterProvider()
+	assert.Error(t, Start(WithMeterProvider(provider), withProcPath("testdata/missing")))
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelhttp provides an http.Handler and an http.RoundTripper
+// instrumenting the server and client side of net/http.
+//
+// The handler returned by NewHandler extracts the span context propagated
+// with the incoming request, starts a server span with the semantic
+// conventions HTTP attributes, and records the duration and the request and
+// response sizes of the requests. The Transport injects the span context
+// into outgoing requests, starts client spans and records the same metrics
+// for the client side.
+//
+//	http.Handle("/users", otelhttp.NewHandler(usersHandler, "users"))
+//	client := http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package otelhttp // import "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func measured(impl *oteltest.MeterImpl, name string) []oteltest.Measured {
+	var out []oteltest.Measured
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		if m.Name == name {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func TestHandler(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	meterImpl, mp := oteltest.NewMeterProvider()
+
+	mux := http.NewServeMux()
+	mux.Handle("/users/", WithRouteTag("/users/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "request", string(body))
+		assert.True(t, trace.SpanContextFromContext(r.Context()).IsValid())
+
+		l, ok := LabelerFromContext(r.Context())
+		assert.True(t, ok)
+		l.Add(attribute.String("test", "label"))
+
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = io.WriteString(w, "response")
+	})))
+	h := NewHandler(mux, "server",
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithPropagators(propagation.TraceContext{}),
+		WithServerName("example.com"),
+	)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users/42", strings.NewReader("request")))
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("traceparent"), "span context injected into the response")
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "server", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, codes.Error, span.StatusCode())
+	attrs := span.Attributes()
+	assert.Equal(t, attribute.StringValue("/users/{id}"), attrs[semconv.HTTPRouteKey])
+	assert.Equal(t, attribute.StringValue("POST"), attrs[semconv.HTTPMethodKey])
+	assert.Equal(t, attribute.IntValue(http.StatusTeapot), attrs[semconv.HTTPStatusCodeKey])
+	assert.Equal(t, attribute.Int64Value(7), attrs[ReadBytesKey])
+	assert.Equal(t, attribute.Int64Value(8), attrs[WroteBytesKey])
+
+	requestSize := measured(meterImpl, RequestContentLength)
+	require.Len(t, requestSize, 1)
+	assert.Equal(t, int64(7), requestSize[0].Number.AsInt64())
+	assert.Equal(t, attribute.StringValue("label"), requestSize[0].Labels["test"])
+	assert.Equal(t, attribute.StringValue("/users/{id}"), requestSize[0].Labels[semconv.HTTPRouteKey])
+	assert.Equal(t, attribute.IntValue(http.StatusTeapot), requestSize[0].Labels[semconv.HTTPStatusCodeKey])
+
+	responseSize := measured(meterImpl, ResponseContentLength)
+	require.Len(t, responseSize, 1)
+	assert.Equal(t, int64(8), responseSize[0].Number.AsInt64())
+	assert.Len(t, measured(meterImpl, ServerLatency), 1)
+}
+
+func TestHandlerExtractsParent(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	_, mp := oteltest.NewMeterProvider()
+	props := propagation.TraceContext{}
+
+	h := NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), "server",
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithPropagators(props),
+	)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	props.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	parent.End()
+
+	spans := sr.Completed()
+	require.Len(t, spans, 2)
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].ParentSpanID())
+}
+
+func TestHandlerOptions(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	_, mp := oteltest.NewMeterProvider()
+
+	h := NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		_, _ = io.WriteString(w, "ok")
+	}), "server",
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithFilter(func(r *http.Request) bool { return r.URL.Path != "/health" }),
+		WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return operation + " " + r.URL.Path
+		}),
+		WithMessageEvents(ReadEvents, WriteEvents),
+	)
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Empty(t, sr.Completed(), "filtered request traced")
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/items", strings.NewReader("body")))
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "server /items", spans[0].Name())
+
+	var names []string
+	for _, e := range spans[0].Events() {
+		names = append(names, e.Name)
+	}
+	assert.Contains(t, names, "read")
+	assert.Contains(t, names, "write")
+}
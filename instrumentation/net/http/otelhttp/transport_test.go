@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTransport(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	meterImpl, mp := oteltest.NewMeterProvider()
+	props := propagation.TraceContext{}
+
+	var serverSpanContext trace.SpanContext
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := props.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		serverSpanContext = trace.SpanContextFromContext(ctx)
+		_, _ = io.WriteString(w, "response")
+	}))
+	defer srv.Close()
+
+	client := http.Client{Transport: NewTransport(http.DefaultTransport,
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithPropagators(props),
+	)}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, srv.URL, strings.NewReader("request"))
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err)
+
+	assert.Empty(t, sr.Completed(), "span ended before the body is read")
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "response", string(body))
+	require.NoError(t, res.Body.Close())
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "HTTP POST", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Unset, span.StatusCode())
+	assert.Equal(t, span.SpanContext().SpanID(), serverSpanContext.SpanID())
+	attrs := span.Attributes()
+	assert.Equal(t, attribute.IntValue(http.StatusOK), attrs[semconv.HTTPStatusCodeKey])
+	assert.Equal(t, attribute.StringValue(srv.URL), attrs[semconv.HTTPURLKey])
+	assert.Equal(t, attribute.Int64Value(8), attrs[ReadBytesKey])
+
+	requestSize := measured(meterImpl, ClientRequestContentLength)
+	require.Len(t, requestSize, 1)
+	assert.Equal(t, int64(7), requestSize[0].Number.AsInt64())
+	responseSize := measured(meterImpl, ClientResponseContentLength)
+	require.Len(t, responseSize, 1)
+	assert.Equal(t, int64(8), responseSize[0].Number.AsInt64())
+	assert.Equal(t, attribute.IntValue(http.StatusOK), responseSize[0].Labels[semconv.HTTPStatusCodeKey])
+	assert.Len(t, measured(meterImpl, ClientLatency), 1)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+func TestTransportError(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	_, mp := oteltest.NewMeterProvider()
+	errRoundTrip := errors.New("connection refused")
+
+	tr := NewTransport(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errRoundTrip
+	}), WithTracerProvider(tp), WithMeterProvider(mp))
+	_, err := tr.RoundTrip(httptest.NewRequest(http.MethodGet, "http://localhost/", nil))
+	assert.Equal(t, errRoundTrip, err)
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].StatusCode())
+	assert.Equal(t, "connection refused", spans[0].StatusMessage())
+}
+
+func TestTransportFilter(t *testing.T) {
+	sr := new(oteltest.SpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	_, mp := oteltest.NewMeterProvider()
+
+	tr := NewTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		assert.Empty(t, r.Header.Get("traceparent"))
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	}), WithTracerProvider(tp), WithMeterProvider(mp), WithFilter(func(*http.Request) bool { return false }))
+	res, err := tr.RoundTrip(httptest.NewRequest(http.MethodGet, "http://localhost/", nil))
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	assert.Empty(t, sr.Started())
+}
+
+func TestWrappedBodyKeepsWriter(t *testing.T) {
+	rwc := struct {
+		io.ReadCloser
+		io.Writer
+	}{ioutil.NopCloser(strings.NewReader("")), ioutil.Discard}
+	_, span := oteltest.NewTracerProvider().Tracer("test").Start(context.Background(), "span")
+
+	_, ok := newWrappedBody(span, rwc, func(int64) {}).(io.ReadWriteCloser)
+	assert.True(t, ok)
+	_, ok = newWrappedBody(span, ioutil.NopCloser(strings.NewReader("")), func(int64) {}).(io.ReadWriteCloser)
+	assert.False(t, ok)
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtime provides the conventional runtime metrics of Go
+// applications: the memory and garbage collection statistics of the Go
+// runtime, the number of goroutines and cgo calls, and the uptime.
+//
+// All metrics are recorded by asynchronous instruments registered with a
+// single call to Start:
+//
+//	if err := runtime.Start(runtime.WithMeterProvider(provider)); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// The memory statistics are read with runtime.ReadMemStats, which stops the
+// world. They are read at most once every WithMinimumReadMemStatsInterval,
+// whatever the collection interval of the MeterProvider.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
@@ -0,0 +1,302 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
+
+import (
+	"context"
+	goruntime "runtime"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+const (
+	// instrumentationName is the name of this instrumentation package.
+	instrumentationName = "go.opentelemetry.io/otel/instrumentation/runtime"
+
+	// DefaultMinimumReadMemStatsInterval is the default minimum interval
+	// between calls to runtime.ReadMemStats.
+	DefaultMinimumReadMemStatsInterval = 15 * time.Second
+)
+
+// config contains the options of the runtime instrumentation.
+type config struct {
+	minimumReadMemStatsInterval time.Duration
+	meterProvider               metric.MeterProvider
+}
+
+// Option configures the runtime instrumentation.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(c *config) {
+	fn(c)
+}
+
+// WithMinimumReadMemStatsInterval sets the minimum interval between calls to
+// runtime.ReadMemStats, a stop-the-world operation. The memory statistics
+// observed between two reads are the ones of the previous read.
+// The default value is DefaultMinimumReadMemStatsInterval.
+func WithMinimumReadMemStatsInterval(d time.Duration) Option {
+	return optionFunc(func(c *config) {
+		if d >= 0 {
+			c.minimumReadMemStatsInterval = d
+		}
+	})
+}
+
+// WithMeterProvider sets the MeterProvider the instruments are created with.
+// The default value is the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return optionFunc(func(c *config) {
+		if mp != nil {
+			c.meterProvider = mp
+		}
+	})
+}
+
+func newConfig(opts ...Option) config {
+	c := config{
+		minimumReadMemStatsInterval: DefaultMinimumReadMemStatsInterval,
+		meterProvider:               global.GetMeterProvider(),
+	}
+	for _, o := range opts {
+		o.apply(&c)
+	}
+	return c
+}
+
+// runtime reports the runtime metrics.
+type runtime struct {
+	config config
+	meter  metric.Meter
+	now    func() time.Time
+
+	mu       sync.Mutex
+	lastRead time.Time
+	memStats goruntime.MemStats
+	// lastNumGC is the number of garbage collections of which the pause
+	// durations were recorded.
+	lastNumGC uint32
+}
+
+// Start registers the instruments of the runtime metrics with the
+// MeterProvider of the options. The metrics are observed each time the
+// MeterProvider collects them until the process ends.
+func Start(opts ...Option) error {
+	c := newConfig(opts...)
+	r := &runtime{
+		config: c,
+		meter: c.meterProvider.Meter(
+			instrumentationName,
+			metric.WithInstrumentationVersion(otel.Version()),
+		),
+		now: time.Now,
+	}
+	if err := r.registerProcess(); err != nil {
+		return err
+	}
+	return r.registerMemStats()
+}
+
+func (r *runtime) registerProcess() error {
+	startTime := r.now()
+	if _, err := r.meter.NewInt64SumObserver(
+		"runtime.uptime",
+		func(_ context.Context, result metric.Int64ObserverResult) {
+			result.Observe(r.now().Sub(startTime).Milliseconds())
+		},
+		metric.WithUnit(unit.Milliseconds),
+		metric.WithDescription("Milliseconds since application was initialized"),
+	); err != nil {
+		return err
+	}
+
+	if _, err := r.meter.NewInt64UpDownSumObserver(
+		"runtime.go.goroutines",
+		func(_ context.Context, result metric.Int64ObserverResult) {
+			result.Observe(int64(goruntime.NumGoroutine()))
+		},
+		metric.WithDescription("Number of goroutines that currently exist"),
+	); err != nil {
+		return err
+	}
+
+	_, err := r.meter.NewInt64SumObserver(
+		"runtime.go.cgo.calls",
+		func(_ context.Context, result metric.Int64ObserverResult) {
+			result.Observe(goruntime.NumCgoCall())
+		},
+		metric.WithDescription("Number of cgo calls made by the current process"),
+	)
+	return err
+}
+
+// readMemStats reads the memory statistics if they were not read for the
+// minimum interval. It must be called with r.mu held.
+func (r *runtime) readMemStats() {
+	now := r.now()
+	if !r.lastRead.IsZero() && now.Sub(r.lastRead) < r.config.minimumReadMemStatsInterval {
+		return
+	}
+	goruntime.ReadMemStats(&r.memStats)
+	r.lastRead = now
+}
+
+func (r *runtime) registerMemStats() error {
+	var (
+		err error
+
+		heapAlloc    metric.Int64UpDownSumObserver
+		heapIdle     metric.Int64UpDownSumObserver
+		heapInuse    metric.Int64UpDownSumObserver
+		heapObjects  metric.Int64UpDownSumObserver
+		heapReleased metric.Int64UpDownSumObserver
+		heapSys      metric.Int64UpDownSumObserver
+		liveObjects  metric.Int64UpDownSumObserver
+
+		lookups   metric.Int64SumObserver
+		mallocs   metric.Int64SumObserver
+		frees     metric.Int64SumObserver
+		gcCount   metric.Int64SumObserver
+		pauseTime metric.Int64SumObserver
+
+		gcPause metric.Int64ValueRecorder
+	)
+
+	batchObserver := r.meter.NewBatchObserver(func(ctx context.Context, result metric.BatchObserverResult) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.readMemStats()
+		ms := &r.memStats
+
+		result.Observe(nil,
+			heapAlloc.Observation(int64(ms.HeapAlloc)),
+			heapIdle.Observation(int64(ms.HeapIdle)),
+			heapInuse.Observation(int64(ms.HeapInuse)),
+			heapObjects.Observation(int64(ms.HeapObjects)),
+			heapReleased.Observation(int64(ms.HeapReleased)),
+			heapSys.Observation(int64(ms.HeapSys)),
+			liveObjects.Observation(int64(ms.Mallocs-ms.Frees)),
+
+			lookups.Observation(int64(ms.Lookups)),
+			mallocs.Observation(int64(ms.Mallocs)),
+			frees.Observation(int64(ms.Frees)),
+			gcCount.Observation(int64(ms.NumGC)),
+			pauseTime.Observation(int64(ms.PauseTotalNs)),
+		)
+
+		// PauseNs holds the durations of the last 256 garbage collections,
+		// older pauses not yet recorded are lost.
+		n := ms.NumGC - r.lastNumGC
+		if n > uint32(len(ms.PauseNs)) {
+			n = uint32(len(ms.PauseNs))
+		}
+		for i := ms.NumGC - n; i < ms.NumGC; i++ {
+			gcPause.Record(ctx, int64(ms.PauseNs[i%uint32(len(ms.PauseNs))]))
+		}
+		r.lastNumGC = ms.NumGC
+	})
+
+	if heapAlloc, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.heap_alloc",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Bytes of allocated heap objects"),
+	); err != nil {
+		return err
+	}
+	if heapIdle, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.heap_idle",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Bytes in idle (unused) spans"),
+	); err != nil {
+		return err
+	}
+	if heapInuse, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.heap_inuse",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Bytes in in-use spans"),
+	); err != nil {
+		return err
+	}
+	if heapObjects, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.heap_objects",
+		metric.WithDescription("Number of allocated heap objects"),
+	); err != nil {
+		return err
+	}
+	if heapReleased, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.heap_released",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Bytes of idle spans whose physical memory has been returned to the OS"),
+	); err != nil {
+		return err
+	}
+	if heapSys, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.heap_sys",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Bytes of heap memory obtained from the OS"),
+	); err != nil {
+		return err
+	}
+	if liveObjects, err = batchObserver.NewInt64UpDownSumObserver(
+		"runtime.go.mem.live_objects",
+		metric.WithDescription("Number of live objects is the number of cumulative Mallocs - Frees"),
+	); err != nil {
+		return err
+	}
+	if lookups, err = batchObserver.NewInt64SumObserver(
+		"runtime.go.mem.lookups",
+		metric.WithDescription("Number of pointer lookups performed by the runtime"),
+	); err != nil {
+		return err
+	}
+	if mallocs, err = batchObserver.NewInt64SumObserver(
+		"runtime.go.mem.mallocs",
+		metric.WithDescription("Cumulative count of heap objects allocated"),
+	); err != nil {
+		return err
+	}
+	if frees, err = batchObserver.NewInt64SumObserver(
+		"runtime.go.mem.frees",
+		metric.WithDescription("Cumulative count of heap objects freed"),
+	); err != nil {
+		return err
+	}
+	if gcCount, err = batchObserver.NewInt64SumObserver(
+		"runtime.go.gc.count",
+		metric.WithDescription("Number of completed garbage collection cycles"),
+	); err != nil {
+		return err
+	}
+	if pauseTime, err = batchObserver.NewInt64SumObserver(
+		"runtime.go.gc.pause_total_ns",
+		metric.WithDescription("Cumulative nanoseconds in GC stop-the-world pauses since the program started"),
+	); err != nil {
+		return err
+	}
+	gcPause, err = r.meter.NewInt64ValueRecorder(
+		"runtime.go.gc.pause_ns",
+		metric.WithDescription("Amount of nanoseconds in GC stop-the-world pauses"),
+	)
+	return err
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	goruntime "runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+// observed returns the last observed value of each instrument.
+func observed(impl *oteltest.MeterImpl) map[string]int64 {
+	values := make(map[string]int64)
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		values[m.Name] = m.Number.AsInt64()
+	}
+	return values
+}
+
+func TestStart(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	require.NoError(t, Start(WithMeterProvider(provider)))
+
+	goruntime.GC()
+	impl.RunAsyncInstruments()
+
+	values := observed(impl)
+	for _, name := range []string{
+		"runtime.uptime",
+		"runtime.go.goroutines",
+		"runtime.go.cgo.calls",
+		"runtime.go.mem.heap_alloc",
+		"runtime.go.mem.heap_idle",
+		"runtime.go.mem.heap_inuse",
+		"runtime.go.mem.heap_objects",
+		"runtime.go.mem.heap_released",
+		"runtime.go.mem.heap_sys",
+		"runtime.go.mem.live_objects",
+		"runtime.go.mem.lookups",
+		"runtime.go.mem.mallocs",
+		"runtime.go.mem.frees",
+		"runtime.go.gc.count",
+		"runtime.go.gc.pause_total_ns",
+		"runtime.go.gc.pause_ns",
+	} {
+		assert.Contains(t, values, name)
+	}
+	assert.GreaterOrEqual(t, values["runtime.go.goroutines"], int64(1))
+	assert.Greater(t, values["runtime.go.mem.heap_sys"], int64(0))
+	assert.GreaterOrEqual(t, values["runtime.go.gc.count"], int64(1))
+}
+
+func TestMinimumReadMemStatsInterval(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	r := &runtime{
+		config: newConfig(WithMeterProvider(provider), WithMinimumReadMemStatsInterval(time.Minute)),
+		meter:  provider.Meter(instrumentationName),
+	}
+	now := time.Unix(0, 0)
+	r.now = func() time.Time { return now }
+	require.NoError(t, r.registerMemStats())
+
+	impl.RunAsyncInstruments()
+	firstCount := observed(impl)["runtime.go.gc.count"]
+	goruntime.GC()
+
+	impl.MeasurementBatches = nil
+	impl.RunAsyncInstruments()
+	assert.Equal(t, firstCount, observed(impl)["runtime.go.gc.count"], "memory statistics read again before the interval")
+
+	now = now.Add(time.Minute)
+	impl.MeasurementBatches = nil
+	impl.RunAsyncInstruments()
+	values := observed(impl)
+	assert.Greater(t, values["runtime.go.gc.count"], firstCount)
+	assert.Contains(t, values, "runtime.go.gc.pause_ns", "pause of the new garbage collection")
+}
+
+func TestConfig(t *testing.T) {
+	c := newConfig()
+	assert.Equal(t, DefaultMinimumReadMemStatsInterval, c.minimumReadMemStatsInterval)
+	assert.NotNil(t, c.meterProvider)
+
+	c = newConfig(WithMinimumReadMemStatsInterval(-time.Second), WithMeterProvider(nil))
+	assert.Equal(t, DefaultMinimumReadMemStatsInterval, c.minimumReadMemStatsInterval)
+	assert.NotNil(t, c.meterProvider)
+}
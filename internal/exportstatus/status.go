@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exportstatus records the health of an exporter from the outcome
+// of its exports. It is shared by the OTLP exporters.
+package exportstatus // import "go.opentelemetry.io/otel/internal/exportstatus"
+
+import (
+	"sync"
+	"time"
+)
+
+// Status is the health of an exporter, derived from the outcome of its
+// most recent exports. It can be used to report the health of a telemetry
+// pipeline, for example from a readiness probe.
+type Status struct {
+	// LastSuccessfulExport is the time the last export accepted by the
+	// endpoint completed. It is the zero time if no export succeeded yet.
+	LastSuccessfulExport time.Time
+	// ConsecutiveFailures is the number of exports that failed since the
+	// last successful one.
+	ConsecutiveFailures int
+	// LastError is the error of the most recent export, or nil if it
+	// succeeded.
+	LastError error
+}
+
+// Recorder updates a Status with the outcome of exports. Its zero value
+// is ready to use and it is safe for concurrent use.
+type Recorder struct {
+	mu     sync.Mutex
+	status Status
+}
+
+// Record updates the Status with the result of an export, err is nil if
+// the export succeeded.
+func (r *Recorder) Record(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status.LastError = err
+	if err != nil {
+		r.status.ConsecutiveFailures++
+		return
+	}
+	r.status.LastSuccessfulExport = time.Now()
+	r.status.ConsecutiveFailures = 0
+}
+
+// Status returns the current Status.
+func (r *Recorder) Status() Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
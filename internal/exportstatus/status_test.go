@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportstatus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	var r Recorder
+	assert.Equal(t, Status{}, r.Status())
+
+	before := time.Now()
+	r.Record(nil)
+	status := r.Status()
+	assert.False(t, status.LastSuccessfulExport.Before(before))
+	assert.Equal(t, 0, status.ConsecutiveFailures)
+	assert.NoError(t, status.LastError)
+
+	err := errors.New("unavailable")
+	r.Record(err)
+	r.Record(err)
+	assert.Equal(t, Status{
+		LastSuccessfulExport: status.LastSuccessfulExport,
+		ConsecutiveFailures:  2,
+		LastError:            err,
+	}, r.Status())
+
+	r.Record(nil)
+	assert.Equal(t, 0, r.Status().ConsecutiveFailures)
+	assert.NoError(t, r.Status().LastError)
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerVerbosity(t *testing.T) {
+	orig := getLogger()
+	defer SetLogger(orig)
+
+	tests := []struct {
+		verbosity int
+		want      []string
+	}{
+		{0, []string{"error"}},
+		{1, []string{"info", "error"}},
+		{5, []string{"info", "error", "debug"}},
+	}
+	for _, test := range tests {
+		buf := new(bytes.Buffer)
+		prev := stdr.SetVerbosity(test.verbosity)
+		SetLogger(stdr.New(log.New(buf, "", 0)))
+
+		Info("info")
+		Error(errors.New("failed"), "error")
+		Debug("debug")
+
+		stdr.SetVerbosity(prev)
+		for _, msg := range []string{"info", "error", "debug"} {
+			logged := bytes.Contains(buf.Bytes(), []byte(`"msg"="`+msg+`"`))
+			assert.Equal(t, contains(test.want, msg), logged, "verbosity %d, message %q", test.verbosity, msg)
+		}
+	}
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
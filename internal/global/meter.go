@@ -116,6 +116,7 @@ type syncHandle struct {
 
 var _ metric.MeterProvider = &meterProvider{}
 var _ metric.MeterImpl = &meterImpl{}
+var _ metric.AsyncUnregisterImpl = &meterImpl{}
 var _ metric.InstrumentImpl = &syncImpl{}
 var _ metric.BoundSyncImpl = &syncHandle{}
 var _ metric.AsyncImpl = &asyncImpl{}
@@ -173,7 +174,8 @@ func (m *meterImpl) setDelegate(name, version string, provider metric.MeterProvi
 
 	d := new(metric.MeterImpl)
 	*d = provider.Meter(name, metric.WithInstrumentationVersion(version)).MeterImpl()
-	m.delegate = unsafe.Pointer(d)
+	// The delegate is read without the lock when recording a batch.
+	atomic.StorePointer(&m.delegate, unsafe.Pointer(d))
 
 	for _, inst := range m.syncInsts {
 		inst.setDelegate(*d)
@@ -274,6 +276,28 @@ func (m *meterImpl) NewAsyncInstrument(
 	return inst, nil
 }
 
+func (m *meterImpl) UnregisterAsync(runner metric.AsyncRunner) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if meterPtr := (*metric.MeterImpl)(atomic.LoadPointer(&m.delegate)); meterPtr != nil {
+		impl, ok := (*meterPtr).(metric.AsyncUnregisterImpl)
+		if !ok {
+			return metric.ErrUnregisterUnsupported
+		}
+		return impl.UnregisterAsync(runner)
+	}
+
+	insts := m.asyncInsts[:0]
+	for _, inst := range m.asyncInsts {
+		if inst.runner != runner {
+			insts = append(insts, inst)
+		}
+	}
+	m.asyncInsts = insts
+	return nil
+}
+
 func (obs *asyncImpl) Implementation() interface{} {
 	if implPtr := (*metric.AsyncImpl)(atomic.LoadPointer(&obs.delegate)); implPtr != nil {
 		return (*implPtr).Implementation()
@@ -17,6 +17,8 @@ package global_test
 import (
 	"context"
 	"errors"
+	"runtime"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -132,6 +134,48 @@ func TestDirect(t *testing.T) {
 	)
 }
 
+func TestUnregisterAsync(t *testing.T) {
+	global.ResetForTest()
+
+	meter := metricglobal.Meter("test")
+
+	var before, after metric.Int64ValueObserver
+	beforeBatch := Must(meter).NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		result.Observe(nil, before.Observation(1))
+	})
+	before = beforeBatch.NewInt64ValueObserver("test.before")
+	afterBatch := Must(meter).NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		result.Observe(nil, after.Observation(2))
+	})
+	after = afterBatch.NewInt64ValueObserver("test.after")
+
+	// Unregistered before the delegate is set.
+	beforeBatch.Unregister()
+
+	mock, provider := oteltest.NewMeterProvider()
+	metricglobal.SetMeterProvider(provider)
+
+	mock.RunAsyncInstruments()
+	require.EqualValues(t,
+		[]oteltest.Measured{
+			{
+				Name:                "test.after",
+				InstrumentationName: "test",
+				Labels:              oteltest.LabelsToMap(),
+				Number:              asInt(2),
+			},
+		},
+		oteltest.AsStructs(mock.MeasurementBatches),
+	)
+
+	// Unregistered after the delegate is set.
+	afterBatch.Unregister()
+	mock.MeasurementBatches = nil
+
+	mock.RunAsyncInstruments()
+	require.Empty(t, mock.MeasurementBatches)
+}
+
 func TestBound(t *testing.T) {
 	global.ResetForTest()
 
@@ -325,3 +369,44 @@ func TestRecordBatchMock(t *testing.T) {
 		},
 		oteltest.AsStructs(mock.MeasurementBatches))
 }
+
+func TestRecordDuringSetMeterProvider(t *testing.T) {
+	global.ResetForTest()
+
+	ctx := context.Background()
+	meter := metricglobal.Meter("test")
+	counter := Must(meter).NewInt64Counter("test.counter")
+	bound := counter.Bind(attribute.String("A", "B"))
+	defer bound.Unbind()
+
+	var iterations int64
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			counter.Add(ctx, 1)
+			bound.Add(ctx, 1)
+			meter.RecordBatch(ctx, nil, counter.Measurement(1))
+			atomic.AddInt64(&iterations, 1)
+		}
+	}()
+
+	mock, provider := oteltest.NewMeterProvider()
+	metricglobal.SetMeterProvider(provider)
+
+	// Let measurements be made after the delegate is set.
+	after := atomic.LoadInt64(&iterations) + 10
+	for atomic.LoadInt64(&iterations) < after {
+		runtime.Gosched()
+	}
+	close(stop)
+	<-done
+
+	require.NotEmpty(t, mock.MeasurementBatches)
+}
@@ -61,6 +61,10 @@ type AsyncInstrumentState struct {
 	// instruments maintains the set of instruments in the order
 	// they were registered.
 	instruments []metric.AsyncImpl
+
+	// instrumentRunners maintains the runner each instrument in
+	// instruments was registered with, at the same index.
+	instrumentRunners []metric.AsyncRunner
 }
 
 // asyncRunnerPair is a map entry for Observer callback runners.
@@ -101,6 +105,7 @@ func (a *AsyncInstrumentState) Register(inst metric.AsyncImpl, runner metric.Asy
 	defer a.lock.Unlock()
 
 	a.instruments = append(a.instruments, inst)
+	a.instrumentRunners = append(a.instrumentRunners, runner)
 
 	// asyncRunnerPair reflects this callback in the asyncRunners
 	// list.  If this is a batch runner, the instrument is nil.
@@ -120,6 +125,46 @@ func (a *AsyncInstrumentState) Register(inst metric.AsyncImpl, runner metric.Asy
 	}
 }
 
+// Unregister removes runner and the asynchronous instruments that were
+// registered with it from the managed set, and returns the removed
+// instruments.  The runner is not executed by subsequent calls to Run.
+//
+// The slices of runners and instruments are replaced rather than
+// modified, as Run and the callers of Instruments use them without
+// holding the lock.
+func (a *AsyncInstrumentState) Unregister(runner metric.AsyncRunner) []metric.AsyncImpl {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	var removed []metric.AsyncImpl
+	instruments := make([]metric.AsyncImpl, 0, len(a.instruments))
+	instrumentRunners := make([]metric.AsyncRunner, 0, len(a.instrumentRunners))
+	for i, inst := range a.instruments {
+		if a.instrumentRunners[i] == runner {
+			removed = append(removed, inst)
+			continue
+		}
+		instruments = append(instruments, inst)
+		instrumentRunners = append(instrumentRunners, a.instrumentRunners[i])
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	a.instruments = instruments
+	a.instrumentRunners = instrumentRunners
+
+	runners := make([]asyncRunnerPair, 0, len(a.runners))
+	for _, rp := range a.runners {
+		if rp.runner == runner {
+			delete(a.runnerMap, rp)
+			continue
+		}
+		runners = append(runners, rp)
+	}
+	a.runners = runners
+	return removed
+}
+
 // Run executes the complete set of observer callbacks.
 func (a *AsyncInstrumentState) Run(ctx context.Context, collector AsyncCollector) {
 	a.lock.Lock()
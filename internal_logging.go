@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel // import "go.opentelemetry.io/otel"
+
+import (
+	"github.com/go-logr/logr"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// SetLogger configures the logger used internally to OpenTelemetry to
+// report diagnostics like connection state changes, exported batch sizes,
+// and retries.
+//
+// By default only errors are logged to STDERR. Info messages are logged
+// when `logger.V(1).Enabled()` is true, and debug messages when
+// `logger.V(5).Enabled()` is true.
+func SetLogger(logger logr.Logger) {
+	global.SetLogger(logger)
+}
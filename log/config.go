@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+// LoggerConfig is a group of options for a Logger.
+type LoggerConfig struct {
+	instrumentationVersion string
+	// Schema URL of the telemetry emitted by the Logger.
+	schemaURL string
+}
+
+// InstrumentationVersion returns the version of the library providing
+// instrumentation.
+func (cfg *LoggerConfig) InstrumentationVersion() string {
+	return cfg.instrumentationVersion
+}
+
+// SchemaURL returns the Schema URL of the telemetry emitted by the Logger.
+func (cfg *LoggerConfig) SchemaURL() string {
+	return cfg.schemaURL
+}
+
+// NewLoggerConfig applies all the options to a returned LoggerConfig.
+func NewLoggerConfig(options ...LoggerOption) *LoggerConfig {
+	config := new(LoggerConfig)
+	for _, option := range options {
+		option.apply(config)
+	}
+	return config
+}
+
+// LoggerOption applies an option to a LoggerConfig.
+type LoggerOption interface {
+	apply(*LoggerConfig)
+}
+
+type loggerOptionFunc func(*LoggerConfig)
+
+func (fn loggerOptionFunc) apply(cfg *LoggerConfig) {
+	fn(cfg)
+}
+
+// WithInstrumentationVersion sets the instrumentation version.
+func WithInstrumentationVersion(version string) LoggerOption {
+	return loggerOptionFunc(func(cfg *LoggerConfig) {
+		cfg.instrumentationVersion = version
+	})
+}
+
+// WithSchemaURL sets the schema URL for the Logger.
+func WithSchemaURL(schemaURL string) LoggerOption {
+	return loggerOptionFunc(func(cfg *LoggerConfig) {
+		cfg.schemaURL = schemaURL
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestNewLoggerConfig(t *testing.T) {
+	cfg := log.NewLoggerConfig()
+	assert.Equal(t, "", cfg.InstrumentationVersion())
+	assert.Equal(t, "", cfg.SchemaURL())
+
+	cfg = log.NewLoggerConfig(
+		log.WithInstrumentationVersion("v0.1.0"),
+		log.WithSchemaURL("https://opentelemetry.io/schemas/1.4.0"),
+	)
+	assert.Equal(t, "v0.1.0", cfg.InstrumentationVersion())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.4.0", cfg.SchemaURL())
+}
+
+func TestNoopLoggerProvider(t *testing.T) {
+	logger := log.NewNoopLoggerProvider().Logger("test")
+	assert.False(t, logger.Enabled(context.Background(), log.SeverityFatal))
+	assert.NotPanics(t, func() {
+		logger.Emit(context.Background(), log.Record{Severity: log.SeverityInfo})
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package log provides the OpenTelemetry Logs Bridge API.
+
+This API is not intended to be called directly by application code. It is
+used to build bridges between existing logging libraries, such as log/slog,
+zap or logrus, and OpenTelemetry. The records emitted through a Logger are
+correlated with the span active in the context they are emitted with, and
+exported by the SDK alongside traces and metrics.
+
+To participate in logging, a bridge obtains a Logger from a LoggerProvider:
+
+	logger := loggerProvider.Logger("github.com/example/bridge")
+	logger.Emit(ctx, log.Record{
+		Severity: log.SeverityInfo,
+		Body:     attribute.StringValue("hello"),
+	})
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+*/
+package log // import "go.opentelemetry.io/otel/log"
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// LoggerProvider provides access to named Logger instances.
+type LoggerProvider interface {
+	// Logger creates an implementation of the Logger interface.
+	// The instrumentationName must be the name of the library providing
+	// instrumentation. This name may be the same as the instrumented code
+	// only if that code provides built-in instrumentation. If the
+	// instrumentationName is empty, then a implementation defined default
+	// name will be used instead.
+	Logger(instrumentationName string, opts ...LoggerOption) Logger
+}
+
+// Logger emits log records.
+type Logger interface {
+	// Emit emits a log record. The record is associated with the span
+	// active in ctx, if any.
+	Emit(ctx context.Context, record Record)
+
+	// Enabled reports whether a record with the given severity would be
+	// emitted. Bridges can use it to avoid the cost of building records
+	// that would be dropped.
+	Enabled(ctx context.Context, severity Severity) bool
+}
+
+// Record is a log record emitted by a Logger.
+type Record struct {
+	// Timestamp is the time when the event occurred. If zero, the time the
+	// record is emitted is used.
+	Timestamp time.Time
+
+	// Severity is the normalized severity of the record.
+	Severity Severity
+
+	// SeverityText is the original string representation of the severity
+	// as it is known at the source (also known as log level).
+	SeverityText string
+
+	// Name is a short event identifier that does not contain varying parts.
+	Name string
+
+	// Body is the body of the log record, for example a human-readable
+	// message.
+	Body attribute.Value
+
+	// Attributes describe the specific event occurrence.
+	Attributes []attribute.KeyValue
+}
+
+// Severity is the normalized severity of a log record as defined by the
+// OpenTelemetry Log Data Model.
+type Severity int32
+
+// Severity values defined by the OpenTelemetry Log Data Model. Each range
+// (e.g. SeverityInfo through SeverityInfo4) represents a single severity
+// level with increasing granularity.
+const (
+	// SeverityUndefined is the default value of a Severity and indicates the
+	// severity is unknown.
+	SeverityUndefined Severity = iota
+
+	SeverityTrace
+	SeverityTrace2
+	SeverityTrace3
+	SeverityTrace4
+
+	SeverityDebug
+	SeverityDebug2
+	SeverityDebug3
+	SeverityDebug4
+
+	SeverityInfo
+	SeverityInfo2
+	SeverityInfo3
+	SeverityInfo4
+
+	SeverityWarn
+	SeverityWarn2
+	SeverityWarn3
+	SeverityWarn4
+
+	SeverityError
+	SeverityError2
+	SeverityError3
+	SeverityError4
+
+	SeverityFatal
+	SeverityFatal2
+	SeverityFatal3
+	SeverityFatal4
+)
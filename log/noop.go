@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import "context"
+
+// NewNoopLoggerProvider returns an implementation of LoggerProvider that
+// performs no operations. The Loggers created from the returned
+// LoggerProvider also perform no operations.
+func NewNoopLoggerProvider() LoggerProvider {
+	return noopLoggerProvider{}
+}
+
+type noopLoggerProvider struct{}
+
+var _ LoggerProvider = noopLoggerProvider{}
+
+// Logger returns noop implementation of Logger.
+func (noopLoggerProvider) Logger(string, ...LoggerOption) Logger {
+	return noopLogger{}
+}
+
+// noopLogger is an implementation of Logger that preforms no operations.
+type noopLogger struct{}
+
+var _ Logger = noopLogger{}
+
+// Emit does nothing.
+func (noopLogger) Emit(context.Context, Record) {}
+
+// Enabled always returns false.
+func (noopLogger) Enabled(context.Context, Severity) bool { return false }
@@ -24,6 +24,9 @@ type InstrumentConfig struct {
 	unit                   unit.Unit
 	instrumentationName    string
 	instrumentationVersion string
+	// explicitBucketBoundaries is held by pointer so InstrumentConfig,
+	// and so Descriptor, remain comparable.
+	explicitBucketBoundaries *[]float64
 }
 
 // Description describes the instrument in human-readable terms.
@@ -48,6 +51,16 @@ func (cfg InstrumentConfig) InstrumentationVersion() string {
 	return cfg.instrumentationVersion
 }
 
+// ExplicitBucketBoundaries is the advised bucket boundaries of a
+// histogram aggregating the instrument. It is nil if no boundaries were
+// advised.
+func (cfg InstrumentConfig) ExplicitBucketBoundaries() []float64 {
+	if cfg.explicitBucketBoundaries == nil {
+		return nil
+	}
+	return *cfg.explicitBucketBoundaries
+}
+
 // InstrumentOption is an interface for applying metric instrument options.
 type InstrumentOption interface {
 	// ApplyMeter is used to set a InstrumentOption value of a
@@ -92,6 +105,21 @@ func WithInstrumentationName(name string) InstrumentOption {
 	})
 }
 
+// WithExplicitBucketBoundaries advises the SDK to use boundaries as the
+// bucket boundaries of a histogram aggregating the instrument. This is
+// advice only: the SDK uses the boundaries in place of its defaults, but
+// a View that configures boundaries takes precedence.
+func WithExplicitBucketBoundaries(boundaries ...float64) InstrumentOption {
+	var b *[]float64
+	if len(boundaries) > 0 {
+		c := append([]float64(nil), boundaries...)
+		b = &c
+	}
+	return instrumentOptionFunc(func(cfg *InstrumentConfig) {
+		cfg.explicitBucketBoundaries = b
+	})
+}
+
 // MeterConfig contains options for Meters.
 type MeterConfig struct {
 	instrumentationVersion string
@@ -42,6 +42,7 @@ The synchronous instrument names are:
   Counter:           additive, monotonic
   UpDownCounter:     additive
   ValueRecorder:     grouping
+  Gauge:             grouping, last value
 
 and the asynchronous instruments are:
 
@@ -52,6 +53,11 @@ and the asynchronous instruments are:
 All instruments are provided with support for either float64 or int64 input
 values.
 
+Synchronous instruments can be bound to a set of labels with Bind. The labels
+of a bound instrument are sorted and de-duplicated once rather than on every
+call, so it is preferred on hot paths that repeatedly record with the same
+labels. Unbind releases a bound instrument that is no longer used.
+
 An instrument is created using a Meter. Additionally, a Meter is used to
 record batches of synchronous measurements or asynchronous observations. A
 Meter is obtained using a MeterProvider. A Meter, like a Tracer, is unique to
@@ -44,6 +44,14 @@ func GetMeterProvider() metric.MeterProvider {
 }
 
 // SetMeterProvider registers `mp` as the global meter provider.
+//
+// Meters and instruments obtained from the global meter provider before
+// SetMeterProvider is first called are delegated to `mp`: each instrument
+// is created with the meter of the same name and version from `mp`,
+// asynchronous instrument callbacks are registered with it, and bound
+// instruments are bound again when next used. As with spans started with
+// the global tracer provider before it is set, measurements made before
+// the delegation are dropped.
 func SetMeterProvider(mp metric.MeterProvider) {
 	global.SetMeterProvider(mp)
 }
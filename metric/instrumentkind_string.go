@@ -14,11 +14,12 @@ func _() {
 	_ = x[UpDownCounterInstrumentKind-3]
 	_ = x[SumObserverInstrumentKind-4]
 	_ = x[UpDownSumObserverInstrumentKind-5]
+	_ = x[GaugeInstrumentKind-6]
 }
 
-const _InstrumentKind_name = "ValueRecorderInstrumentKindValueObserverInstrumentKindCounterInstrumentKindUpDownCounterInstrumentKindSumObserverInstrumentKindUpDownSumObserverInstrumentKind"
+const _InstrumentKind_name = "ValueRecorderInstrumentKindValueObserverInstrumentKindCounterInstrumentKindUpDownCounterInstrumentKindSumObserverInstrumentKindUpDownSumObserverInstrumentKindGaugeInstrumentKind"
 
-var _InstrumentKind_index = [...]uint8{0, 27, 54, 75, 102, 127, 158}
+var _InstrumentKind_index = [...]uint8{0, 27, 54, 75, 102, 127, 158, 177}
 
 func (i InstrumentKind) String() string {
 	if i < 0 || i >= InstrumentKind(len(_InstrumentKind_index)-1) {
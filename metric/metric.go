@@ -50,7 +50,10 @@ func (m Meter) RecordBatch(ctx context.Context, ls []attribute.KeyValue, ms ...M
 }
 
 // NewBatchObserver creates a new BatchObserver that supports
-// making batches of observations for multiple instruments.
+// making batches of observations for multiple instruments.  The
+// callback is registered when the first instrument is created with
+// the BatchObserver, and is called once per collection until the
+// BatchObserver is unregistered.
 func (m Meter) NewBatchObserver(callback BatchObserverFunc) BatchObserver {
 	return BatchObserver{
 		meter:  m,
@@ -112,6 +115,24 @@ func (m Meter) NewFloat64ValueRecorder(name string, opts ...InstrumentOption) (F
 		m.newSync(name, ValueRecorderInstrumentKind, number.Float64Kind, opts))
 }
 
+// NewInt64Gauge creates a new integer Gauge instrument with the
+// given name, customized with options.  May return an error if the
+// name is invalid (e.g., empty) or improperly registered (e.g.,
+// duplicate registration).
+func (m Meter) NewInt64Gauge(name string, opts ...InstrumentOption) (Int64Gauge, error) {
+	return wrapInt64GaugeInstrument(
+		m.newSync(name, GaugeInstrumentKind, number.Int64Kind, opts))
+}
+
+// NewFloat64Gauge creates a new floating point Gauge with the
+// given name, customized with options.  May return an error if the
+// name is invalid (e.g., empty) or improperly registered (e.g.,
+// duplicate registration).
+func (m Meter) NewFloat64Gauge(name string, opts ...InstrumentOption) (Float64Gauge, error) {
+	return wrapFloat64GaugeInstrument(
+		m.newSync(name, GaugeInstrumentKind, number.Float64Kind, opts))
+}
+
 // NewInt64ValueObserver creates a new integer ValueObserver instrument
 // with the given name, running a given callback, and customized with
 // options.  May return an error if the name is invalid (e.g., empty)
@@ -315,6 +336,22 @@ type MeterMust struct {
 	meter Meter
 }
 
+// Unregister stops calling the callback of the BatchObserver and removes
+// the instruments created with it, so that no more observations are
+// reported for them.  A collection that is in progress may still call the
+// callback.  Unregister returns ErrUnregisterUnsupported if the SDK does
+// not support unregistering callbacks.
+func (b BatchObserver) Unregister() error {
+	if b.runner == nil || b.meter.impl == nil {
+		return nil
+	}
+	impl, ok := b.meter.impl.(AsyncUnregisterImpl)
+	if !ok {
+		return ErrUnregisterUnsupported
+	}
+	return impl.UnregisterAsync(b.runner)
+}
+
 // BatchObserverMust is a wrapper for BatchObserver that panics when
 // any instrument constructor encounters an error.
 type BatchObserverMust struct {
@@ -388,6 +425,26 @@ func (mm MeterMust) NewFloat64ValueRecorder(name string, mos ...InstrumentOption
 	}
 }
 
+// NewInt64Gauge calls `Meter.NewInt64Gauge` and returns the
+// instrument, panicking if it encounters an error.
+func (mm MeterMust) NewInt64Gauge(name string, mos ...InstrumentOption) Int64Gauge {
+	if inst, err := mm.meter.NewInt64Gauge(name, mos...); err != nil {
+		panic(err)
+	} else {
+		return inst
+	}
+}
+
+// NewFloat64Gauge calls `Meter.NewFloat64Gauge` and returns the
+// instrument, panicking if it encounters an error.
+func (mm MeterMust) NewFloat64Gauge(name string, mos ...InstrumentOption) Float64Gauge {
+	if inst, err := mm.meter.NewFloat64Gauge(name, mos...); err != nil {
+		panic(err)
+	} else {
+		return inst
+	}
+}
+
 // NewInt64ValueObserver calls `Meter.NewInt64ValueObserver` and
 // returns the instrument, panicking if it encounters an error.
 func (mm MeterMust) NewInt64ValueObserver(name string, callback Int64ObserverFunc, oos ...InstrumentOption) Int64ValueObserver {
@@ -456,6 +513,14 @@ func (mm MeterMust) NewBatchObserver(callback BatchObserverFunc) BatchObserverMu
 	}
 }
 
+// Unregister calls `BatchObserver.Unregister` and panics if it returns
+// an error.
+func (bm BatchObserverMust) Unregister() {
+	if err := bm.batch.Unregister(); err != nil {
+		panic(err)
+	}
+}
+
 // NewInt64ValueObserver calls `BatchObserver.NewInt64ValueObserver` and
 // returns the instrument, panicking if it encounters an error.
 func (bm BatchObserverMust) NewInt64ValueObserver(name string, oos ...InstrumentOption) Int64ValueObserver {
@@ -575,3 +640,9 @@ func (d Descriptor) InstrumentationName() string {
 func (d Descriptor) InstrumentationVersion() string {
 	return d.config.InstrumentationVersion()
 }
+
+// ExplicitBucketBoundaries returns the histogram bucket boundaries
+// advised for this instrument, or nil if none were advised.
+func (d Descriptor) ExplicitBucketBoundaries() []float64 {
+	return d.config.ExplicitBucketBoundaries()
+}
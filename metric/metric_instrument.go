@@ -27,6 +27,10 @@ import (
 // ErrSDKReturnedNilImpl is returned when a new `MeterImpl` returns nil.
 var ErrSDKReturnedNilImpl = errors.New("SDK returned a nil implementation")
 
+// ErrUnregisterUnsupported is returned when unregistering an asynchronous
+// callback from a `MeterImpl` that does not implement `AsyncUnregisterImpl`.
+var ErrUnregisterUnsupported = errors.New("SDK does not support unregistering callbacks")
+
 // InstrumentKind describes the kind of instrument.
 type InstrumentKind int8
 
@@ -46,12 +50,15 @@ const (
 	// UpDownSumObserverInstrumentKind indicates a UpDownSumObserver
 	// instrument.
 	UpDownSumObserverInstrumentKind
+
+	// GaugeInstrumentKind indicates a Gauge instrument.
+	GaugeInstrumentKind
 )
 
 // Synchronous returns whether this is a synchronous kind of instrument.
 func (k InstrumentKind) Synchronous() bool {
 	switch k {
-	case CounterInstrumentKind, UpDownCounterInstrumentKind, ValueRecorderInstrumentKind:
+	case CounterInstrumentKind, UpDownCounterInstrumentKind, ValueRecorderInstrumentKind, GaugeInstrumentKind:
 		return true
 	}
 	return false
@@ -551,6 +558,18 @@ func wrapFloat64ValueRecorderInstrument(syncInst SyncImpl, err error) (Float64Va
 	return Float64ValueRecorder{syncInstrument: common}, err
 }
 
+// wrapInt64GaugeInstrument converts a SyncImpl into Int64Gauge.
+func wrapInt64GaugeInstrument(syncInst SyncImpl, err error) (Int64Gauge, error) {
+	common, err := checkNewSync(syncInst, err)
+	return Int64Gauge{syncInstrument: common}, err
+}
+
+// wrapFloat64GaugeInstrument converts a SyncImpl into Float64Gauge.
+func wrapFloat64GaugeInstrument(syncInst SyncImpl, err error) (Float64Gauge, error) {
+	common, err := checkNewSync(syncInst, err)
+	return Float64Gauge{syncInstrument: common}, err
+}
+
 // Float64Counter is a metric that accumulates float64 values.
 type Float64Counter struct {
 	syncInstrument
@@ -775,3 +794,77 @@ func (b BoundFloat64ValueRecorder) Record(ctx context.Context, value float64) {
 func (b BoundInt64ValueRecorder) Record(ctx context.Context, value int64) {
 	b.directRecord(ctx, number.NewInt64Number(value))
 }
+
+// Float64Gauge is a metric that sets the current float64 value.
+type Float64Gauge struct {
+	syncInstrument
+}
+
+// Int64Gauge is a metric that sets the current int64 value.
+type Int64Gauge struct {
+	syncInstrument
+}
+
+// BoundFloat64Gauge is a bound instrument for Float64Gauge.
+//
+// It inherits the Unbind function from syncBoundInstrument.
+type BoundFloat64Gauge struct {
+	syncBoundInstrument
+}
+
+// BoundInt64Gauge is a bound instrument for Int64Gauge.
+//
+// It inherits the Unbind function from syncBoundInstrument.
+type BoundInt64Gauge struct {
+	syncBoundInstrument
+}
+
+// Bind creates a bound instrument for this Gauge. The labels are
+// associated with values set via subsequent calls to Set.
+func (c Float64Gauge) Bind(labels ...attribute.KeyValue) (h BoundFloat64Gauge) {
+	h.syncBoundInstrument = c.bind(labels)
+	return
+}
+
+// Bind creates a bound instrument for this Gauge. The labels are
+// associated with values set via subsequent calls to Set.
+func (c Int64Gauge) Bind(labels ...attribute.KeyValue) (h BoundInt64Gauge) {
+	h.syncBoundInstrument = c.bind(labels)
+	return
+}
+
+// Measurement creates a Measurement object to use with batch
+// recording.
+func (c Float64Gauge) Measurement(value float64) Measurement {
+	return c.float64Measurement(value)
+}
+
+// Measurement creates a Measurement object to use with batch
+// recording.
+func (c Int64Gauge) Measurement(value int64) Measurement {
+	return c.int64Measurement(value)
+}
+
+// Set replaces the Gauge's current value. The labels should contain
+// the keys and values to be associated with this value.
+func (c Float64Gauge) Set(ctx context.Context, value float64, labels ...attribute.KeyValue) {
+	c.directRecord(ctx, number.NewFloat64Number(value), labels)
+}
+
+// Set replaces the Gauge's current value. The labels should contain
+// the keys and values to be associated with this value.
+func (c Int64Gauge) Set(ctx context.Context, value int64, labels ...attribute.KeyValue) {
+	c.directRecord(ctx, number.NewInt64Number(value), labels)
+}
+
+// Set replaces the Gauge's current value using the labels previously
+// bound to the Gauge via Bind().
+func (b BoundFloat64Gauge) Set(ctx context.Context, value float64) {
+	b.directRecord(ctx, number.NewFloat64Number(value))
+}
+
+// Set replaces the Gauge's current value using the labels previously
+// bound to the Gauge via Bind().
+func (b BoundInt64Gauge) Set(ctx context.Context, value int64) {
+	b.directRecord(ctx, number.NewInt64Number(value))
+}
@@ -41,6 +41,16 @@ type MeterImpl interface {
 	) (AsyncImpl, error)
 }
 
+// AsyncUnregisterImpl is an optional interface of a MeterImpl that
+// supports unregistering asynchronous callbacks.
+type AsyncUnregisterImpl interface {
+	// UnregisterAsync stops running the callback of runner in
+	// subsequent collections and removes the asynchronous
+	// instruments that were created with it.  Unregistering a
+	// runner that is not registered does nothing.
+	UnregisterAsync(runner AsyncRunner) error
+}
+
 // InstrumentImpl is a common interface for synchronous and
 // asynchronous instruments.
 type InstrumentImpl interface {
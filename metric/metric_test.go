@@ -37,6 +37,7 @@ var (
 		metric.ValueRecorderInstrumentKind,
 		metric.CounterInstrumentKind,
 		metric.UpDownCounterInstrumentKind,
+		metric.GaugeInstrumentKind,
 	}
 	asyncKinds = []metric.InstrumentKind{
 		metric.ValueObserverInstrumentKind,
@@ -52,6 +53,7 @@ var (
 	groupingKinds = []metric.InstrumentKind{
 		metric.ValueRecorderInstrumentKind,
 		metric.ValueObserverInstrumentKind,
+		metric.GaugeInstrumentKind,
 	}
 
 	monotonicKinds = []metric.InstrumentKind{
@@ -64,6 +66,7 @@ var (
 		metric.UpDownSumObserverInstrumentKind,
 		metric.ValueRecorderInstrumentKind,
 		metric.ValueObserverInstrumentKind,
+		metric.GaugeInstrumentKind,
 	}
 
 	precomputedSumKinds = []metric.InstrumentKind{
@@ -76,6 +79,7 @@ var (
 		metric.UpDownCounterInstrumentKind,
 		metric.ValueRecorderInstrumentKind,
 		metric.ValueObserverInstrumentKind,
+		metric.GaugeInstrumentKind,
 	}
 )
 
@@ -304,6 +308,21 @@ func TestOptions(t *testing.T) {
 	}
 }
 
+func TestExplicitBucketBoundaries(t *testing.T) {
+	cfg := metric.NewInstrumentConfig()
+	require.Nil(t, cfg.ExplicitBucketBoundaries())
+
+	bounds := []float64{1, 5, 10}
+	opt := metric.WithExplicitBucketBoundaries(bounds...)
+	bounds[0] = 0 // The option holds a copy.
+
+	cfg = metric.NewInstrumentConfig(opt)
+	require.Equal(t, []float64{1, 5, 10}, cfg.ExplicitBucketBoundaries())
+
+	desc := metric.NewDescriptor("latency", metric.ValueRecorderInstrumentKind, number.Float64Kind, opt)
+	require.Equal(t, []float64{1, 5, 10}, desc.ExplicitBucketBoundaries())
+}
+
 func TestCounter(t *testing.T) {
 	// N.B. the API does not check for negative
 	// values, that's the SDK's responsibility.
@@ -391,6 +410,35 @@ func TestValueRecorder(t *testing.T) {
 	})
 }
 
+func TestGauge(t *testing.T) {
+	t.Run("float64 gauge", func(t *testing.T) {
+		mockSDK, meter := oteltest.NewMeter()
+		m := Must(meter).NewFloat64Gauge("test.gauge.float")
+		ctx := context.Background()
+		labels := []attribute.KeyValue{attribute.String("A", "B")}
+		m.Set(ctx, 42.5, labels...)
+		boundInstrument := m.Bind(labels...)
+		boundInstrument.Set(ctx, -1)
+		meter.RecordBatch(ctx, labels, m.Measurement(0))
+		checkSyncBatches(ctx, t, labels, mockSDK, number.Float64Kind, metric.GaugeInstrumentKind, m.SyncImpl(),
+			42.5, -1, 0,
+		)
+	})
+	t.Run("int64 gauge", func(t *testing.T) {
+		mockSDK, meter := oteltest.NewMeter()
+		m := Must(meter).NewInt64Gauge("test.gauge.int")
+		ctx := context.Background()
+		labels := []attribute.KeyValue{attribute.Int("I", 1)}
+		m.Set(ctx, 1024, labels...)
+		boundInstrument := m.Bind(labels...)
+		boundInstrument.Set(ctx, 512)
+		meter.RecordBatch(ctx, labels, m.Measurement(-3))
+		checkSyncBatches(ctx, t, labels, mockSDK, number.Int64Kind, metric.GaugeInstrumentKind, m.SyncImpl(),
+			1024, 512, -3,
+		)
+	})
+}
+
 func TestObserverInstruments(t *testing.T) {
 	t.Run("float valueobserver", func(t *testing.T) {
 		labels := []attribute.KeyValue{attribute.String("O", "P")}
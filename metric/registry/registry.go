@@ -34,12 +34,22 @@ var _ metric.MeterProvider = (*MeterProvider)(nil)
 // uniqueness checking for instrument descriptors.  Use NewUniqueInstrumentMeter
 // to wrap an implementation with uniqueness checking.
 type uniqueInstrumentMeterImpl struct {
-	lock  sync.Mutex
-	impl  metric.MeterImpl
-	state map[key]metric.InstrumentImpl
+	lock   sync.Mutex
+	impl   metric.MeterImpl
+	config config
+
+	// state holds the instruments registered by key. It holds more
+	// than one instrument only for conflicting registrations that
+	// were allowed by a conflict handler.
+	state map[key][]metric.InstrumentImpl
+
+	// runners maintains the runner each asynchronous instrument
+	// in state was created with.
+	runners map[metric.AsyncImpl]metric.AsyncRunner
 }
 
 var _ metric.MeterImpl = (*uniqueInstrumentMeterImpl)(nil)
+var _ metric.AsyncUnregisterImpl = (*uniqueInstrumentMeterImpl)(nil)
 
 type key struct {
 	instrumentName         string
@@ -47,11 +57,38 @@ type key struct {
 	InstrumentationVersion string
 }
 
+// config contains the configuration of the uniqueness checking.
+type config struct {
+	conflictHandler func(error)
+}
+
+// Option configures the uniqueness checking of a MeterImpl.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(cfg *config) {
+	fn(cfg)
+}
+
+// WithConflictHandler configures the uniqueness checking to report a
+// registration that conflicts with an instrument already registered by
+// the same name to handler, rather than to return an error. A new
+// instrument is then registered with the wrapped MeterImpl and returned,
+// so data from both instruments is passed through.
+func WithConflictHandler(handler func(error)) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.conflictHandler = handler
+	})
+}
+
 // NewMeterProvider returns a new provider that implements instrument
 // name-uniqueness checking.
-func NewMeterProvider(impl metric.MeterImpl) *MeterProvider {
+func NewMeterProvider(impl metric.MeterImpl, opts ...Option) *MeterProvider {
 	return &MeterProvider{
-		impl: NewUniqueInstrumentMeterImpl(impl),
+		impl: NewUniqueInstrumentMeterImpl(impl, opts...),
 	}
 }
 
@@ -63,15 +100,20 @@ func (p *MeterProvider) Meter(instrumentationName string, opts ...metric.MeterOp
 // ErrMetricKindMismatch is the standard error for mismatched metric
 // instrument definitions.
 var ErrMetricKindMismatch = fmt.Errorf(
-	"a metric was already registered by this name with another kind or number type")
+	"a metric was already registered by this name with another kind, number type or unit")
 
 // NewUniqueInstrumentMeterImpl returns a wrapped metric.MeterImpl with
 // the addition of uniqueness checking.
-func NewUniqueInstrumentMeterImpl(impl metric.MeterImpl) metric.MeterImpl {
-	return &uniqueInstrumentMeterImpl{
-		impl:  impl,
-		state: map[key]metric.InstrumentImpl{},
+func NewUniqueInstrumentMeterImpl(impl metric.MeterImpl, opts ...Option) metric.MeterImpl {
+	u := &uniqueInstrumentMeterImpl{
+		impl:    impl,
+		state:   map[key][]metric.InstrumentImpl{},
+		runners: map[metric.AsyncImpl]metric.AsyncRunner{},
 	}
+	for _, o := range opts {
+		o.apply(&u.config)
+	}
+	return u
 }
 
 // RecordBatch implements metric.MeterImpl.
@@ -99,11 +141,29 @@ func NewMetricKindMismatchError(desc metric.Descriptor) error {
 		ErrMetricKindMismatch)
 }
 
+// newDuplicateRegistrationError formats an error that describes the
+// registration of candidate conflicting with the existing instrument.
+func newDuplicateRegistrationError(candidate, existing metric.Descriptor) error {
+	return fmt.Errorf("duplicate registration of metric %s (%s %s) as a %s %s with unit %q, "+
+		"already registered as a %s %s with unit %q: %w",
+		candidate.Name(),
+		candidate.InstrumentationName(),
+		candidate.InstrumentationVersion(),
+		candidate.NumberKind(),
+		candidate.InstrumentKind(),
+		candidate.Unit(),
+		existing.NumberKind(),
+		existing.InstrumentKind(),
+		existing.Unit(),
+		ErrMetricKindMismatch)
+}
+
 // Compatible determines whether two metric.Descriptors are considered
 // the same for the purpose of uniqueness checking.
 func Compatible(candidate, existing metric.Descriptor) bool {
 	return candidate.InstrumentKind() == existing.InstrumentKind() &&
-		candidate.NumberKind() == existing.NumberKind()
+		candidate.NumberKind() == existing.NumberKind() &&
+		candidate.Unit() == existing.Unit()
 }
 
 // checkUniqueness returns an ErrMetricKindMismatch error if there is
@@ -111,17 +171,26 @@ func Compatible(candidate, existing metric.Descriptor) bool {
 // `descriptor` argument.  If there is an existing compatible
 // registration, this returns the already-registered instrument.  If
 // there is no conflict and no prior registration, returns (nil, nil).
+//
+// If a conflict handler is configured the conflict is reported to it
+// instead, and (nil, nil) is returned so a new instrument is registered.
 func (u *uniqueInstrumentMeterImpl) checkUniqueness(descriptor metric.Descriptor) (metric.InstrumentImpl, error) {
-	impl, ok := u.state[keyOf(descriptor)]
+	impls, ok := u.state[keyOf(descriptor)]
 	if !ok {
 		return nil, nil
 	}
 
-	if !Compatible(descriptor, impl.Descriptor()) {
-		return nil, NewMetricKindMismatchError(impl.Descriptor())
+	for _, impl := range impls {
+		if Compatible(descriptor, impl.Descriptor()) {
+			return impl, nil
+		}
 	}
 
-	return impl, nil
+	if u.config.conflictHandler == nil {
+		return nil, NewMetricKindMismatchError(impls[0].Descriptor())
+	}
+	u.config.conflictHandler(newDuplicateRegistrationError(descriptor, impls[0].Descriptor()))
+	return nil, nil
 }
 
 // NewSyncInstrument implements metric.MeterImpl.
@@ -141,7 +210,8 @@ func (u *uniqueInstrumentMeterImpl) NewSyncInstrument(descriptor metric.Descript
 	if err != nil {
 		return nil, err
 	}
-	u.state[keyOf(descriptor)] = syncInst
+	k := keyOf(descriptor)
+	u.state[k] = append(u.state[k], syncInst)
 	return syncInst, nil
 }
 
@@ -165,6 +235,44 @@ func (u *uniqueInstrumentMeterImpl) NewAsyncInstrument(
 	if err != nil {
 		return nil, err
 	}
-	u.state[keyOf(descriptor)] = asyncInst
+	k := keyOf(descriptor)
+	u.state[k] = append(u.state[k], asyncInst)
+	u.runners[asyncInst] = runner
 	return asyncInst, nil
 }
+
+// UnregisterAsync implements metric.AsyncUnregisterImpl.  The
+// instruments created with runner are forgotten, so that their names
+// may be registered again.
+func (u *uniqueInstrumentMeterImpl) UnregisterAsync(runner metric.AsyncRunner) error {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	impl, ok := u.impl.(metric.AsyncUnregisterImpl)
+	if !ok {
+		return metric.ErrUnregisterUnsupported
+	}
+	if err := impl.UnregisterAsync(runner); err != nil {
+		return err
+	}
+	for inst, r := range u.runners {
+		if r != runner {
+			continue
+		}
+		delete(u.runners, inst)
+
+		k := keyOf(inst.Descriptor())
+		impls := u.state[k][:0]
+		for _, impl := range u.state[k] {
+			if impl != metric.InstrumentImpl(inst) {
+				impls = append(impls, impl)
+			}
+		}
+		if len(impls) == 0 {
+			delete(u.state, k)
+		} else {
+			u.state[k] = impls
+		}
+	}
+	return nil
+}
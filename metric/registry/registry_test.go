@@ -23,6 +23,7 @@ import (
 
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/registry"
+	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/oteltest"
 )
 
@@ -120,6 +121,84 @@ func TestRegistryDiffInstruments(t *testing.T) {
 	}
 }
 
+func TestRegistryDiffUnits(t *testing.T) {
+	_, provider := oteltest.NewMeterProvider()
+	meter := provider.Meter("meter")
+
+	_, err := meter.NewInt64Counter("this", metric.WithUnit(unit.Bytes))
+	require.NoError(t, err)
+
+	_, err = meter.NewInt64Counter("this", metric.WithUnit(unit.Milliseconds))
+	require.True(t, errors.Is(err, registry.ErrMetricKindMismatch))
+}
+
+func TestRegistryConflictHandler(t *testing.T) {
+	impl, _ := oteltest.NewMeter()
+	var conflicts []error
+	provider := registry.NewMeterProvider(impl, registry.WithConflictHandler(func(err error) {
+		conflicts = append(conflicts, err)
+	}))
+	meter := provider.Meter("meter")
+
+	inst1, err := meter.NewInt64Counter("this", metric.WithUnit(unit.Bytes))
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+
+	inst2, err := meter.NewFloat64ValueRecorder("this")
+	require.NoError(t, err)
+	require.NotNil(t, inst2.SyncImpl())
+	require.NotEqual(t, inst1.SyncImpl(), inst2.SyncImpl())
+	require.Len(t, conflicts, 1)
+	require.True(t, errors.Is(conflicts[0], registry.ErrMetricKindMismatch))
+	require.Contains(t, conflicts[0].Error(), "Float64Kind ValueRecorderInstrumentKind")
+	require.Contains(t, conflicts[0].Error(), "Int64Kind CounterInstrumentKind")
+
+	inst3, err := meter.NewInt64Counter("this", metric.WithUnit(unit.Milliseconds))
+	require.NoError(t, err)
+	require.NotEqual(t, inst1.SyncImpl(), inst3.SyncImpl())
+	require.Len(t, conflicts, 2)
+	require.Contains(t, conflicts[1].Error(), `unit "ms"`)
+
+	// Identical registrations return the identical instrument, even
+	// the ones that conflicted.
+	inst4, err := meter.NewInt64Counter("this", metric.WithUnit(unit.Bytes))
+	require.NoError(t, err)
+	require.Equal(t, inst1.SyncImpl(), inst4.SyncImpl())
+	inst5, err := meter.NewFloat64ValueRecorder("this")
+	require.NoError(t, err)
+	require.Equal(t, inst2.SyncImpl(), inst5.SyncImpl())
+	require.Len(t, conflicts, 2)
+
+	// Both instruments record.
+	ctx := context.Background()
+	inst1.Add(ctx, 1)
+	inst2.Record(ctx, 2)
+	require.Len(t, impl.MeasurementBatches, 2)
+}
+
+func TestRegistryUnregisterConflicting(t *testing.T) {
+	impl, _ := oteltest.NewMeter()
+	provider := registry.NewMeterProvider(impl, registry.WithConflictHandler(func(error) {}))
+	meter := provider.Meter("meter")
+
+	batch1 := meter.NewBatchObserver(func(context.Context, metric.BatchObserverResult) {})
+	inst1, err := batch1.NewInt64ValueObserver("this")
+	require.NoError(t, err)
+	batch2 := meter.NewBatchObserver(func(context.Context, metric.BatchObserverResult) {})
+	inst2, err := batch2.NewFloat64SumObserver("this")
+	require.NoError(t, err)
+
+	require.NoError(t, batch1.Unregister())
+
+	// Only the instrument of the unregistered runner is forgotten.
+	inst3, err := meter.NewFloat64SumObserver("this", func(context.Context, metric.Float64ObserverResult) {})
+	require.NoError(t, err)
+	require.Equal(t, inst2.AsyncImpl(), inst3.AsyncImpl())
+	inst4, err := meter.NewInt64ValueObserver("this", func(context.Context, metric.Int64ObserverResult) {})
+	require.NoError(t, err)
+	require.NotEqual(t, inst1.AsyncImpl(), inst4.AsyncImpl())
+}
+
 func TestMeterProvider(t *testing.T) {
 	impl, _ := oteltest.NewMeter()
 	p := registry.NewMeterProvider(impl)
@@ -130,3 +209,42 @@ func TestMeterProvider(t *testing.T) {
 	require.Equal(t, m1, m1p)
 	require.NotEqual(t, m1, m2)
 }
+
+func TestRegistryUnregisterAsync(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	meter := provider.Meter("meter")
+
+	var calls int
+	batch := meter.NewBatchObserver(
+		func(context.Context, metric.BatchObserverResult) { calls++ },
+	)
+	inst1, err := batch.NewInt64ValueObserver("this")
+	require.NoError(t, err)
+
+	impl.RunAsyncInstruments()
+	require.Equal(t, 1, calls)
+
+	require.NoError(t, batch.Unregister())
+	impl.RunAsyncInstruments()
+	require.Equal(t, 1, calls)
+
+	// The name is available again, with another kind.
+	inst2, err := meter.NewFloat64SumObserver("this", func(context.Context, metric.Float64ObserverResult) {})
+	require.NoError(t, err)
+	require.NotEqual(t, inst1.AsyncImpl(), inst2.AsyncImpl())
+}
+
+func TestRegistryUnregisterUnsupported(t *testing.T) {
+	impl, _ := oteltest.NewMeter()
+	// Embedding hides the UnregisterAsync method of impl.
+	provider := registry.NewMeterProvider(struct{ metric.MeterImpl }{impl})
+	meter := provider.Meter("meter")
+
+	batch := meter.NewBatchObserver(
+		func(context.Context, metric.BatchObserverResult) {},
+	)
+	_, err := batch.NewInt64ValueObserver("this")
+	require.NoError(t, err)
+
+	require.True(t, errors.Is(batch.Unregister(), metric.ErrUnregisterUnsupported))
+}
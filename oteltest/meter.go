@@ -72,10 +72,11 @@ type (
 )
 
 var (
-	_ metric.SyncImpl      = &Sync{}
-	_ metric.BoundSyncImpl = &Handle{}
-	_ metric.MeterImpl     = &MeterImpl{}
-	_ metric.AsyncImpl     = &Async{}
+	_ metric.SyncImpl            = &Sync{}
+	_ metric.BoundSyncImpl       = &Handle{}
+	_ metric.MeterImpl           = &MeterImpl{}
+	_ metric.AsyncUnregisterImpl = &MeterImpl{}
+	_ metric.AsyncImpl           = &Async{}
 )
 
 func (i Instrument) Descriptor() metric.Descriptor {
@@ -154,6 +155,11 @@ func (m *MeterImpl) NewAsyncInstrument(descriptor metric.Descriptor, runner metr
 	return a, nil
 }
 
+func (m *MeterImpl) UnregisterAsync(runner metric.AsyncRunner) error {
+	m.asyncInstruments.Unregister(runner)
+	return nil
+}
+
 func (m *MeterImpl) RecordBatch(ctx context.Context, labels []attribute.KeyValue, measurements ...metric.Measurement) {
 	mm := make([]Measurement, len(measurements))
 	for i := 0; i < len(measurements); i++ {
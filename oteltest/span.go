@@ -17,6 +17,7 @@ package oteltest // import "go.opentelemetry.io/otel/oteltest"
 import (
 	"fmt"
 	"reflect"
+	"runtime/debug"
 	"sync"
 	"time"
 
@@ -85,10 +86,33 @@ func (s *Span) RecordError(err error, opts ...trace.EventOption) {
 		semconv.ExceptionTypeKey.String(errTypeString),
 		semconv.ExceptionMessageKey.String(err.Error()),
 	))
+	c := trace.NewEventConfig(opts...)
+	if c.StackTrace() {
+		opts = append(opts, trace.WithAttributes(
+			semconv.ExceptionStacktraceKey.String(string(debug.Stack())),
+		))
+	}
+	if c.Escaped() {
+		opts = append(opts, trace.WithAttributes(
+			semconv.ExceptionEscapedKey.Bool(true),
+		))
+	}
 
 	s.AddEvent(semconv.ExceptionEventName, opts...)
 }
 
+// AddLink adds link to s. Links cannot be added after End has been called
+// on s.
+func (s *Span) AddLink(link trace.Link) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ended {
+		return
+	}
+	s.links = append(s.links, link)
+}
+
 // AddEvent adds an event to s.
 func (s *Span) AddEvent(name string, o ...trace.EventOption) {
 	s.lock.Lock()
@@ -193,8 +217,9 @@ func (s *Span) Attributes() map[attribute.Key]attribute.Value {
 // been called on s.
 func (s *Span) Events() []Event { return s.events }
 
-// Links returns the links set on s at creation time. If multiple links for
-// the same SpanContext were set, the last link will be used.
+// Links returns the links set on s at creation time and with AddLink. If
+// multiple links for the same SpanContext were set at creation time, the
+// last link will be used.
 func (s *Span) Links() []trace.Link { return s.links }
 
 // StartTime returns the time at which s was started. This will be the
@@ -387,6 +387,30 @@ func TestSpan(t *testing.T) {
 
 			e.Expect(len(subject.Links())).ToEqual(0)
 		})
+
+		t.Run("returns the links added after start", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+
+			tracer := tp.Tracer(t.Name())
+			_, linked := tracer.Start(context.Background(), "linked")
+			_, span := tracer.Start(context.Background(), "test")
+
+			subject, ok := span.(*oteltest.Span)
+			e.Expect(ok).ToBeTrue()
+
+			link := trace.Link{
+				SpanContext: linked.SpanContext(),
+				Attributes:  []attribute.KeyValue{attribute.String("a", "1")},
+			}
+			subject.AddLink(link)
+			subject.End()
+			// Links cannot be added after the span has been ended.
+			subject.AddLink(link)
+
+			e.Expect(subject.Links()).ToEqual([]trace.Link{link})
+		})
 	})
 
 	t.Run("#Events", func(t *testing.T) {
@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// B3 header names.
+const (
+	b3ContextHeader   = "b3"
+	b3TraceIDHeader   = "x-b3-traceid"
+	b3SpanIDHeader    = "x-b3-spanid"
+	b3SampledHeader   = "x-b3-sampled"
+	b3DebugFlagHeader = "x-b3-flags"
+)
+
+var errInvalidB3TraceID = errors.New("invalid B3 trace ID")
+
+// B3Encoding is a bitmask representation of the B3 encoding type.
+type B3Encoding uint8
+
+// supports returns if e has o bit(s) set.
+func (e B3Encoding) supports(o B3Encoding) bool {
+	return e&o == o
+}
+
+const (
+	// B3Unspecified is an unspecified B3 encoding. The B3 propagator
+	// injects using B3MultipleHeader when it is used.
+	B3Unspecified B3Encoding = 0
+	// B3MultipleHeader is a B3 encoding that uses multiple headers to
+	// transmit tracing information all prefixed with `x-b3-`.
+	B3MultipleHeader B3Encoding = 1 << iota
+	// B3SingleHeader is a B3 encoding that uses a single header named `b3`
+	// to transmit tracing information.
+	B3SingleHeader
+)
+
+type b3KeyType int
+
+const (
+	// b3DebugKey is the context key of the B3 debug flag.
+	b3DebugKey b3KeyType = iota
+	// b3DeferredKey is the context key of a deferred B3 sampling decision.
+	b3DeferredKey
+)
+
+// B3 is a propagator that supports the B3 format used by Zipkin
+// (https://github.com/openzipkin/b3-propagation).
+//
+// Both the single header and the multiple header encodings are extracted,
+// the single header being preferred when both are present. InjectEncoding
+// selects the encodings injected, B3Unspecified meaning B3MultipleHeader.
+//
+// The debug flag ("d" in the single header, "x-b3-flags: 1" in the multiple
+// headers) implies the span context is sampled and is propagated on
+// injection. When no sampling decision is extracted the decision is
+// deferred: the span context is not sampled and no decision is injected.
+// A sampling-only single header ("b3: 0") carries no span context and
+// leaves the extraction context unchanged.
+type B3 struct {
+	// InjectEncoding are the B3 encodings used when injecting.
+	InjectEncoding B3Encoding
+}
+
+var _ TextMapPropagator = B3{}
+
+// Inject injects the span context of ctx into the carrier using the
+// configured InjectEncoding.
+func (b3 B3) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	debug, _ := ctx.Value(b3DebugKey).(bool)
+	deferred, _ := ctx.Value(b3DeferredKey).(bool)
+
+	if b3.InjectEncoding.supports(B3SingleHeader) {
+		header := []string{sc.TraceID().String(), sc.SpanID().String()}
+		switch {
+		case debug:
+			header = append(header, "d")
+		case !deferred:
+			if sc.IsSampled() {
+				header = append(header, "1")
+			} else {
+				header = append(header, "0")
+			}
+		}
+		carrier.Set(b3ContextHeader, strings.Join(header, "-"))
+	}
+
+	if b3.InjectEncoding.supports(B3MultipleHeader) || b3.InjectEncoding == B3Unspecified {
+		carrier.Set(b3TraceIDHeader, sc.TraceID().String())
+		carrier.Set(b3SpanIDHeader, sc.SpanID().String())
+		switch {
+		case debug:
+			// Debug implies sampled and the sampled header must not be
+			// sent alongside the debug flag.
+			carrier.Set(b3DebugFlagHeader, "1")
+		case !deferred:
+			if sc.IsSampled() {
+				carrier.Set(b3SampledHeader, "1")
+			} else {
+				carrier.Set(b3SampledHeader, "0")
+			}
+		}
+	}
+}
+
+// Extract extracts a B3 span context from the carrier into a returned
+// Context. If no valid span context is found the passed ctx is returned.
+func (b3 B3) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	sc, debug, deferred, ok := extractB3Single(carrier.Get(b3ContextHeader))
+	if !ok {
+		sc, debug, deferred, ok = extractB3Multiple(
+			carrier.Get(b3TraceIDHeader),
+			carrier.Get(b3SpanIDHeader),
+			carrier.Get(b3SampledHeader),
+			carrier.Get(b3DebugFlagHeader),
+		)
+	}
+	if !ok {
+		return ctx
+	}
+
+	if debug {
+		ctx = context.WithValue(ctx, b3DebugKey, true)
+	}
+	if deferred {
+		ctx = context.WithValue(ctx, b3DeferredKey, true)
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject.
+func (b3 B3) Fields() []string {
+	var fields []string
+	if b3.InjectEncoding.supports(B3SingleHeader) {
+		fields = append(fields, b3ContextHeader)
+	}
+	if b3.InjectEncoding.supports(B3MultipleHeader) || b3.InjectEncoding == B3Unspecified {
+		fields = append(fields, b3TraceIDHeader, b3SpanIDHeader, b3SampledHeader, b3DebugFlagHeader)
+	}
+	return fields
+}
+
+// extractB3Multiple parses the B3 multiple headers. It reports whether a
+// valid span context was extracted.
+func extractB3Multiple(traceID, spanID, sampled, flags string) (sc trace.SpanContext, debug, deferred, ok bool) {
+	scc := trace.SpanContextConfig{Remote: true}
+
+	var err error
+	if scc.TraceID, err = b3TraceID(traceID); err != nil {
+		return trace.SpanContext{}, false, false, false
+	}
+	if len(spanID) != 16 {
+		return trace.SpanContext{}, false, false, false
+	}
+	if scc.SpanID, err = trace.SpanIDFromHex(spanID); err != nil {
+		return trace.SpanContext{}, false, false, false
+	}
+
+	switch flags {
+	case "", "0":
+	case "1":
+		debug = true
+	default:
+		return trace.SpanContext{}, false, false, false
+	}
+
+	switch strings.ToLower(sampled) {
+	case "":
+		deferred = !debug
+	case "1", "true":
+		scc.TraceFlags = trace.FlagsSampled
+	case "0", "false":
+	default:
+		return trace.SpanContext{}, false, false, false
+	}
+	if debug {
+		scc.TraceFlags = trace.FlagsSampled
+	}
+
+	sc = trace.NewSpanContext(scc)
+	return sc, debug, deferred, sc.IsValid()
+}
+
+// extractB3Single parses the B3 single header,
+// {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}, where the sampling
+// state and parent span ID are optional. It reports whether a valid span
+// context was extracted.
+func extractB3Single(header string) (sc trace.SpanContext, debug, deferred, ok bool) {
+	parts := strings.Split(header, "-")
+	// A sampling state alone carries no span context.
+	if len(parts) < 2 || len(parts) > 4 {
+		return trace.SpanContext{}, false, false, false
+	}
+
+	scc := trace.SpanContextConfig{Remote: true}
+
+	var err error
+	if scc.TraceID, err = b3TraceID(parts[0]); err != nil {
+		return trace.SpanContext{}, false, false, false
+	}
+	if len(parts[1]) != 16 {
+		return trace.SpanContext{}, false, false, false
+	}
+	if scc.SpanID, err = trace.SpanIDFromHex(parts[1]); err != nil {
+		return trace.SpanContext{}, false, false, false
+	}
+
+	if len(parts) == 2 {
+		deferred = true
+	} else {
+		switch parts[2] {
+		case "d":
+			debug = true
+			scc.TraceFlags = trace.FlagsSampled
+		case "1":
+			scc.TraceFlags = trace.FlagsSampled
+		case "0":
+		default:
+			return trace.SpanContext{}, false, false, false
+		}
+	}
+
+	if len(parts) == 4 {
+		if _, err := trace.SpanIDFromHex(parts[3]); len(parts[3]) != 16 || err != nil {
+			return trace.SpanContext{}, false, false, false
+		}
+	}
+
+	sc = trace.NewSpanContext(scc)
+	return sc, debug, deferred, sc.IsValid()
+}
+
+// b3TraceID parses a 64 or 128 bit B3 trace ID. 64 bit IDs are left padded
+// with zeros.
+func b3TraceID(id string) (trace.TraceID, error) {
+	switch len(id) {
+	case 16:
+		id = strings.Repeat("0", 16) + id
+	case 32:
+	default:
+		return trace.TraceID{}, errInvalidB3TraceID
+	}
+	return trace.TraceIDFromHex(id)
+}
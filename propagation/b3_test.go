@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func b3SpanContext(flags trace.TraceFlags) trace.SpanContext {
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+}
+
+func TestB3Extract(t *testing.T) {
+	shortTraceID, _ := trace.TraceIDFromHex("0000000000000000a3ce929d0e0e4736")
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    trace.SpanContext
+	}{
+		{
+			name:    "single sampled",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-1"},
+			want:    b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name:    "single not sampled with parent",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-0-0000000000000001"},
+			want:    b3SpanContext(0),
+		},
+		{
+			name:    "single debug",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-d"},
+			want:    b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name:    "single deferred",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr},
+			want:    b3SpanContext(0),
+		},
+		{
+			name:    "single 64 bit trace ID",
+			headers: map[string]string{"b3": "a3ce929d0e0e4736-" + spanIDStr + "-1"},
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    shortTraceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled,
+				Remote:     true,
+			}),
+		},
+		{
+			name:    "single sampling only",
+			headers: map[string]string{"b3": "0"},
+		},
+		{
+			name:    "single invalid sampling state",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-x"},
+		},
+		{
+			name: "single preferred over multiple",
+			headers: map[string]string{
+				"b3":           traceIDStr + "-" + spanIDStr + "-1",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+			want: b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name: "invalid single falls back to multiple",
+			headers: map[string]string{
+				"b3":           "0",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "true",
+			},
+			want: b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name: "multiple not sampled",
+			headers: map[string]string{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+			want: b3SpanContext(0),
+		},
+		{
+			name: "multiple debug",
+			headers: map[string]string{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-flags":   "1",
+			},
+			want: b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name: "multiple invalid span ID",
+			headers: map[string]string{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  "00f067aa",
+			},
+		},
+		{
+			name: "multiple invalid sampled",
+			headers: map[string]string{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "yes",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			ctx := propagation.B3{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestB3Inject(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding propagation.B3Encoding
+		sc       trace.SpanContext
+		want     map[string]string
+	}{
+		{
+			name: "unspecified uses multiple",
+			sc:   b3SpanContext(trace.FlagsSampled),
+			want: map[string]string{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+		},
+		{
+			name:     "single",
+			encoding: propagation.B3SingleHeader,
+			sc:       b3SpanContext(0),
+			want: map[string]string{
+				"b3": traceIDStr + "-" + spanIDStr + "-0",
+			},
+		},
+		{
+			name:     "both",
+			encoding: propagation.B3SingleHeader | propagation.B3MultipleHeader,
+			sc:       b3SpanContext(trace.FlagsSampled),
+			want: map[string]string{
+				"b3":           traceIDStr + "-" + spanIDStr + "-1",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+		},
+		{
+			name:     "invalid span context",
+			encoding: propagation.B3SingleHeader,
+			want:     map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			ctx := trace.ContextWithSpanContext(context.Background(), tt.sc)
+			propagation.B3{InjectEncoding: tt.encoding}.Inject(ctx, propagation.HeaderCarrier(header))
+			assert.Equal(t, len(tt.want), len(header))
+			for k, v := range tt.want {
+				assert.Equal(t, v, header.Get(k), k)
+			}
+		})
+	}
+}
+
+func TestB3RoundTripFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		extract     map[string]string
+		wantSingle  string
+		wantSampled string
+		wantFlags   string
+	}{
+		{
+			name:       "debug",
+			extract:    map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-d"},
+			wantSingle: traceIDStr + "-" + spanIDStr + "-d",
+			wantFlags:  "1",
+		},
+		{
+			name: "deferred",
+			extract: map[string]string{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+			},
+			wantSingle: traceIDStr + "-" + spanIDStr,
+		},
+	}
+
+	b3 := propagation.B3{InjectEncoding: propagation.B3SingleHeader | propagation.B3MultipleHeader}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := http.Header{}
+			for k, v := range tt.extract {
+				in.Set(k, v)
+			}
+			ctx := b3.Extract(context.Background(), propagation.HeaderCarrier(in))
+
+			out := http.Header{}
+			b3.Inject(ctx, propagation.HeaderCarrier(out))
+			assert.Equal(t, tt.wantSingle, out.Get("b3"))
+			assert.Equal(t, tt.wantSampled, out.Get("x-b3-sampled"))
+			assert.Equal(t, tt.wantFlags, out.Get("x-b3-flags"))
+		})
+	}
+}
+
+func TestB3Fields(t *testing.T) {
+	assert.Equal(t,
+		[]string{"x-b3-traceid", "x-b3-spanid", "x-b3-sampled", "x-b3-flags"},
+		propagation.B3{}.Fields(),
+	)
+	assert.Equal(t, []string{"b3"}, propagation.B3{InjectEncoding: propagation.B3SingleHeader}.Fields())
+}
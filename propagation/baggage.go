@@ -56,7 +56,7 @@ func (b Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
 
 // Extract returns a copy of parent with the baggage from the carrier added.
 func (b Baggage) Extract(parent context.Context, carrier TextMapCarrier) context.Context {
-	bVal := carrier.Get(baggageHeader)
+	bVal := getJoined(carrier, baggageHeader)
 	if bVal == "" {
 		return parent
 	}
@@ -109,3 +109,37 @@ func (b Baggage) Extract(parent context.Context, carrier TextMapCarrier) context
 func (b Baggage) Fields() []string {
 	return []string{baggageHeader}
 }
+
+// injectPrefixedBaggage sets each baggage key-value of ctx into the carrier
+// with the key prefixed by prefix and the value URL encoded.
+func injectPrefixedBaggage(ctx context.Context, carrier TextMapCarrier, prefix string) {
+	baggage.MapFromContext(ctx).Foreach(func(kv attribute.KeyValue) bool {
+		carrier.Set(prefix+string(kv.Key), url.QueryEscape(kv.Value.Emit()))
+		return true
+	})
+}
+
+// extractPrefixedBaggage returns a copy of ctx with the baggage of the keys
+// of the carrier starting with prefix, compared case-insensitively, added.
+// The baggage key is the lower case rest of the carrier key. Values that are
+// not valid URL encodings are ignored.
+func extractPrefixedBaggage(ctx context.Context, carrier TextMapCarrier, prefix string) context.Context {
+	var keyValues []attribute.KeyValue
+	for _, key := range carrier.Keys() {
+		lower := strings.ToLower(key)
+		if !strings.HasPrefix(lower, prefix) || len(lower) == len(prefix) {
+			continue
+		}
+		value, err := url.QueryUnescape(carrier.Get(key))
+		if err != nil {
+			continue
+		}
+		keyValues = append(keyValues, attribute.String(lower[len(prefix):], value))
+	}
+	if len(keyValues) == 0 {
+		return ctx
+	}
+	return baggage.ContextWithMap(ctx, baggage.MapFromContext(ctx).Apply(baggage.MapUpdate{
+		MultiKV: keyValues,
+	}))
+}
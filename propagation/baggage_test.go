@@ -246,3 +246,21 @@ func TestBaggagePropagatorGetAllKeys(t *testing.T) {
 		t.Errorf("GetAllKeys: -got +want %s", diff)
 	}
 }
+
+func TestExtractBaggageFromMultipleHeaders(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	req.Header.Add("baggage", "key1=val1")
+	req.Header.Add("baggage", "key2=val2")
+
+	ctx := propagation.Baggage{}.Extract(context.Background(), propagation.HeaderCarrier(req.Header))
+	m := baggage.MapFromContext(ctx)
+	for _, kv := range []attribute.KeyValue{
+		attribute.String("key1", "val1"),
+		attribute.String("key2", "val2"),
+	} {
+		got, ok := m.Value(kv.Key)
+		if !ok || got != kv.Value {
+			t.Errorf("baggage %s: got %v, want %v", kv.Key, got.Emit(), kv.Value.Emit())
+		}
+	}
+}
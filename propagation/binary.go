@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Binary format versions and field IDs.
+const (
+	binaryVersion        = 0
+	binaryTraceIDField   = 0
+	binarySpanIDField    = 1
+	binaryTraceFlagField = 2
+
+	// binaryLength is the length of an encoded span context: the version,
+	// and the ID and value of each field.
+	binaryLength = 1 + 1 + len(trace.TraceID{}) + 1 + len(trace.SpanID{}) + 1 + 1
+)
+
+var (
+	errBinaryVersion = errors.New("unsupported binary span context version")
+	errBinaryFormat  = errors.New("malformed binary span context")
+	errBinaryInvalid = errors.New("invalid binary span context")
+)
+
+// Binary encodes span contexts in the binary format of OpenCensus
+// (https://github.com/census-instrumentation/opencensus-specs/blob/master/encodings/BinaryEncoding.md).
+// It is intended for carriers where text headers are wasteful, like Kafka
+// record headers or custom RPC protocols.
+//
+// The trace ID, span ID and trace flags are encoded in 29 bytes. The trace
+// state is not encoded.
+type Binary struct{}
+
+// Marshal returns the binary encoding of sc. Nil is returned if sc is not
+// valid.
+func (Binary) Marshal(sc trace.SpanContext) []byte {
+	if !sc.IsValid() {
+		return nil
+	}
+
+	b := make([]byte, 0, binaryLength)
+	b = append(b, binaryVersion)
+
+	traceID := sc.TraceID()
+	b = append(b, binaryTraceIDField)
+	b = append(b, traceID[:]...)
+
+	spanID := sc.SpanID()
+	b = append(b, binarySpanIDField)
+	b = append(b, spanID[:]...)
+
+	b = append(b, binaryTraceFlagField, byte(sc.TraceFlags()))
+	return b
+}
+
+// Unmarshal decodes the span context encoded in b by Marshal. The returned
+// span context is remote.
+//
+// Fields following the known ones, added by later revisions of the format,
+// are ignored. The trace flags are optional and default to not sampled.
+func (Binary) Unmarshal(b []byte) (trace.SpanContext, error) {
+	if len(b) == 0 {
+		return trace.SpanContext{}, errBinaryFormat
+	}
+	if b[0] != binaryVersion {
+		return trace.SpanContext{}, errBinaryVersion
+	}
+	b = b[1:]
+
+	scc := trace.SpanContextConfig{Remote: true}
+	if len(b) < 1+len(scc.TraceID) || b[0] != binaryTraceIDField {
+		return trace.SpanContext{}, errBinaryFormat
+	}
+	copy(scc.TraceID[:], b[1:])
+	b = b[1+len(scc.TraceID):]
+
+	if len(b) < 1+len(scc.SpanID) || b[0] != binarySpanIDField {
+		return trace.SpanContext{}, errBinaryFormat
+	}
+	copy(scc.SpanID[:], b[1:])
+	b = b[1+len(scc.SpanID):]
+
+	if len(b) > 0 && b[0] == binaryTraceFlagField {
+		if len(b) < 2 {
+			return trace.SpanContext{}, errBinaryFormat
+		}
+		scc.TraceFlags = trace.TraceFlags(b[1]) & trace.FlagsSampled
+	}
+
+	sc := trace.NewSpanContext(scc)
+	if !sc.IsValid() {
+		return trace.SpanContext{}, errBinaryInvalid
+	}
+	return sc, nil
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var binarySampled = []byte{
+	0,
+	0, 0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36,
+	1, 0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7,
+	2, 1,
+}
+
+func TestBinaryMarshal(t *testing.T) {
+	assert.Equal(t, binarySampled, propagation.Binary{}.Marshal(b3SpanContext(trace.FlagsSampled)))
+	assert.Nil(t, propagation.Binary{}.Marshal(trace.SpanContext{}))
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, flags := range []trace.TraceFlags{0, trace.FlagsSampled} {
+		sc := b3SpanContext(flags)
+		got, err := propagation.Binary{}.Unmarshal(propagation.Binary{}.Marshal(sc))
+		require.NoError(t, err)
+		assert.Equal(t, sc, got)
+	}
+}
+
+func TestBinaryUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		b       []byte
+		want    trace.SpanContext
+		wantErr bool
+	}{
+		{
+			name: "sampled",
+			b:    binarySampled,
+			want: b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name: "no trace flags",
+			b:    binarySampled[:len(binarySampled)-2],
+			want: b3SpanContext(0),
+		},
+		{
+			name: "unknown trailing field",
+			b:    append(append([]byte{}, binarySampled...), 3, 0xff),
+			want: b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported version",
+			b:       append([]byte{1}, binarySampled[1:]...),
+			wantErr: true,
+		},
+		{
+			name:    "truncated span ID",
+			b:       binarySampled[:20],
+			wantErr: true,
+		},
+		{
+			name:    "missing trace flag value",
+			b:       binarySampled[:len(binarySampled)-1],
+			wantErr: true,
+		},
+		{
+			name:    "zero trace ID",
+			b:       append([]byte{0, 0}, append(make([]byte, 16), binarySampled[18:]...)...),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := propagation.Binary{}.Unmarshal(tt.b)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// ErrExtractFailed is reported by a composite TextMapPropagator when one
+	// of its propagators extracts nothing from a carrier that holds one of
+	// the fields it injects, which usually means the field is malformed.
+	ErrExtractFailed = errors.New("propagator failed to extract")
+	// ErrSpanContextConflict is reported by a composite TextMapPropagator
+	// when its propagators extract different span contexts.
+	ErrSpanContextConflict = errors.New("propagators extracted conflicting span contexts")
+)
+
+// SpanContextPrecedence selects the span context kept by a composite
+// TextMapPropagator when several of its propagators extract one.
+type SpanContextPrecedence int
+
+const (
+	// LastSpanContext keeps the span context extracted by the last of the
+	// propagators to extract one. This is the behavior of
+	// NewCompositeTextMapPropagator.
+	LastSpanContext SpanContextPrecedence = iota
+	// FirstSpanContext keeps the span context extracted by the first of
+	// the propagators to extract one.
+	FirstSpanContext
+)
+
+// CompositeOption configures a composite TextMapPropagator.
+type CompositeOption interface {
+	apply(*compositeConfig)
+}
+
+type compositeConfig struct {
+	precedence   SpanContextPrecedence
+	errorHandler func(error)
+}
+
+type compositeOptionFunc func(*compositeConfig)
+
+func (fn compositeOptionFunc) apply(cfg *compositeConfig) {
+	fn(cfg)
+}
+
+// WithSpanContextPrecedence configures which of the span contexts
+// extracted by the propagators is kept when more than one extracts a span
+// context. The default is LastSpanContext.
+func WithSpanContextPrecedence(precedence SpanContextPrecedence) CompositeOption {
+	return compositeOptionFunc(func(cfg *compositeConfig) {
+		cfg.precedence = precedence
+	})
+}
+
+// WithErrorHandler configures the extraction failures and span context
+// conflicts found while extracting to be reported to handler, wrapping
+// ErrExtractFailed and ErrSpanContextConflict. Passing otel.Handle reports
+// them to the global error handler.
+func WithErrorHandler(handler func(error)) CompositeOption {
+	return compositeOptionFunc(func(cfg *compositeConfig) {
+		cfg.errorHandler = handler
+	})
+}
+
+// NewCompositeTextMapPropagatorWithOptions returns a unified
+// TextMapPropagator from the group of passed TextMapPropagator, like
+// NewCompositeTextMapPropagator, configured with opts.
+func NewCompositeTextMapPropagatorWithOptions(p []TextMapPropagator, opts ...CompositeOption) TextMapPropagator {
+	var cfg compositeConfig
+	for _, o := range opts {
+		o.apply(&cfg)
+	}
+	if cfg.precedence == LastSpanContext && cfg.errorHandler == nil {
+		return compositeTextMapPropagator(p)
+	}
+	return configuredCompositeTextMapPropagator{
+		compositeTextMapPropagator: compositeTextMapPropagator(p),
+		cfg:                        cfg,
+	}
+}
+
+// configuredCompositeTextMapPropagator is a compositeTextMapPropagator that
+// checks the result of each extraction.
+type configuredCompositeTextMapPropagator struct {
+	compositeTextMapPropagator
+
+	cfg compositeConfig
+}
+
+func (p configuredCompositeTextMapPropagator) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	kept := trace.SpanContextFromContext(ctx)
+	for _, i := range p.compositeTextMapPropagator {
+		extracted := i.Extract(ctx, carrier)
+		if extracted == ctx {
+			if hasField(carrier, i.Fields()) {
+				p.handle(fmt.Errorf("%w: %T", ErrExtractFailed, i))
+			}
+			continue
+		}
+		ctx = extracted
+
+		sc := trace.SpanContextFromContext(ctx)
+		if !sc.IsValid() || sc.Equal(kept) {
+			continue
+		}
+		if !kept.IsValid() {
+			kept = sc
+			continue
+		}
+		p.handle(fmt.Errorf("%w: %T extracted %s-%s, previously extracted %s-%s",
+			ErrSpanContextConflict, i, sc.TraceID(), sc.SpanID(), kept.TraceID(), kept.SpanID()))
+		if p.cfg.precedence == FirstSpanContext {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, kept)
+		} else {
+			kept = sc
+		}
+	}
+	return ctx
+}
+
+// handle reports err to the configured error handler, if any.
+func (p configuredCompositeTextMapPropagator) handle(err error) {
+	if p.cfg.errorHandler != nil {
+		p.cfg.errorHandler(err)
+	}
+}
+
+// hasField returns if the carrier holds a value for any of fields.
+func hasField(carrier TextMapCarrier, fields []string) bool {
+	for _, f := range fields {
+		if carrier.Get(f) != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const otherSpanIDStr = "00f067aa0ba902b8"
+
+func conflictingHeaders() propagation.HeaderCarrier {
+	header := http.Header{}
+	header.Set("traceparent", "00-"+traceIDStr+"-"+spanIDStr+"-01")
+	header.Set("b3", traceIDStr+"-"+otherSpanIDStr+"-1")
+	return propagation.HeaderCarrier(header)
+}
+
+func TestCompositeSpanContextPrecedence(t *testing.T) {
+	propagators := []propagation.TextMapPropagator{propagation.TraceContext{}, propagation.B3{}}
+
+	tests := []struct {
+		name       string
+		opts       []propagation.CompositeOption
+		wantSpanID string
+	}{
+		{
+			name:       "default",
+			wantSpanID: otherSpanIDStr,
+		},
+		{
+			name:       "last",
+			opts:       []propagation.CompositeOption{propagation.WithSpanContextPrecedence(propagation.LastSpanContext)},
+			wantSpanID: otherSpanIDStr,
+		},
+		{
+			name:       "first",
+			opts:       []propagation.CompositeOption{propagation.WithSpanContextPrecedence(propagation.FirstSpanContext)},
+			wantSpanID: spanIDStr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := propagation.NewCompositeTextMapPropagatorWithOptions(propagators, tt.opts...)
+			ctx := p.Extract(context.Background(), conflictingHeaders())
+			sc := trace.SpanContextFromContext(ctx)
+			assert.Equal(t, traceIDStr, sc.TraceID().String())
+			assert.Equal(t, tt.wantSpanID, sc.SpanID().String())
+			assert.True(t, sc.IsRemote())
+		})
+	}
+}
+
+func TestCompositeErrorHandler(t *testing.T) {
+	var errs []error
+	p := propagation.NewCompositeTextMapPropagatorWithOptions(
+		[]propagation.TextMapPropagator{propagation.TraceContext{}, propagation.B3{}, propagation.Jaeger{}},
+		propagation.WithErrorHandler(func(err error) { errs = append(errs, err) }),
+	)
+
+	header := conflictingHeaders()
+	header.Set("uber-trace-id", "not-a-jaeger-header")
+	p.Extract(context.Background(), header)
+
+	require.Len(t, errs, 2)
+	assert.True(t, errors.Is(errs[0], propagation.ErrSpanContextConflict), errs[0])
+	assert.Contains(t, errs[0].Error(), "propagation.B3")
+	assert.True(t, errors.Is(errs[1], propagation.ErrExtractFailed), errs[1])
+	assert.Contains(t, errs[1].Error(), "propagation.Jaeger")
+}
+
+func TestCompositeErrorHandlerNoFields(t *testing.T) {
+	var errs []error
+	p := propagation.NewCompositeTextMapPropagatorWithOptions(
+		[]propagation.TextMapPropagator{propagation.TraceContext{}, propagation.B3{}},
+		propagation.WithErrorHandler(func(err error) { errs = append(errs, err) }),
+	)
+
+	header := http.Header{}
+	header.Set("traceparent", "00-"+traceIDStr+"-"+spanIDStr+"-01")
+	ctx := p.Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	assert.Empty(t, errs)
+	assert.Equal(t, spanIDStr, trace.SpanContextFromContext(ctx).SpanID().String())
+}
@@ -20,9 +20,12 @@ may be introduced in subsequent minor version releases as we work to track the
 evolving OpenTelemetry specification and user feedback.
 
 OpenTelemetry propagators are used to extract and inject context data from and
-into messages exchanged by applications. The propagator supported by this
-package is the W3C Trace Context encoding
-(https://www.w3.org/TR/trace-context/), and W3C Baggage
-(https://w3c.github.io/baggage/).
+into messages exchanged by applications. The propagators supported by this
+package are the W3C Trace Context encoding
+(https://www.w3.org/TR/trace-context/), W3C Baggage
+(https://w3c.github.io/baggage/), B3
+(https://github.com/openzipkin/b3-propagation), Jaeger
+(https://www.jaegertracing.io/docs/client-libraries/#propagation-format), and
+the headers of the OpenTracing basic tracer.
 */
 package propagation // import "go.opentelemetry.io/otel/propagation"
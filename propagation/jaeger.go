@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Jaeger header names.
+const (
+	jaegerHeader        = "uber-trace-id"
+	jaegerBaggagePrefix = "uberctx-"
+)
+
+// Jaeger flags.
+const (
+	jaegerFlagSampled = 0x01
+	jaegerFlagDebug   = 0x02
+)
+
+type jaegerKeyType int
+
+// jaegerDebugKey is the context key of the Jaeger debug flag.
+const jaegerDebugKey jaegerKeyType = 0
+
+// Jaeger is a propagator that supports the format of the Jaeger clients
+// (https://www.jaegertracing.io/docs/client-libraries/#propagation-format).
+//
+// The span context is propagated in the uber-trace-id header,
+// {trace-id}:{span-id}:{parent-span-id}:{flags}. Trace IDs shorter than 32
+// hex characters and span IDs shorter than 16 are left padded with zeros,
+// and a URL encoded header is accepted. The debug flag implies the span
+// context is sampled and is propagated on injection.
+//
+// Baggage is propagated in one uberctx-{key} header per baggage key, the
+// URL encoded value of which is the baggage value. As the headers are
+// found by their prefix, the carrier must list its keys with Keys to have
+// baggage extracted.
+type Jaeger struct{}
+
+var _ TextMapPropagator = Jaeger{}
+
+// Inject injects the span context and baggage of ctx into the carrier.
+func (j Jaeger) Inject(ctx context.Context, carrier TextMapCarrier) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		var flags int
+		if debug, _ := ctx.Value(jaegerDebugKey).(bool); debug {
+			flags = jaegerFlagSampled | jaegerFlagDebug
+		} else if sc.IsSampled() {
+			flags = jaegerFlagSampled
+		}
+		// The parent span ID is deprecated and always sent as zero.
+		carrier.Set(jaegerHeader, fmt.Sprintf("%s:%s:0:%x", sc.TraceID(), sc.SpanID(), flags))
+	}
+
+	injectPrefixedBaggage(ctx, carrier, jaegerBaggagePrefix)
+}
+
+// Extract extracts a Jaeger span context and baggage from the carrier into
+// a returned Context. If no valid span context is found the span context
+// of ctx is left unchanged.
+func (j Jaeger) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	ctx = extractPrefixedBaggage(ctx, carrier, jaegerBaggagePrefix)
+
+	sc, debug, ok := extractJaeger(carrier.Get(jaegerHeader))
+	if !ok {
+		return ctx
+	}
+	if debug {
+		ctx = context.WithValue(ctx, jaegerDebugKey, true)
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject. The baggage
+// headers are not included as their keys depend on the baggage.
+func (j Jaeger) Fields() []string {
+	return []string{jaegerHeader}
+}
+
+// extractJaeger parses the uber-trace-id header. It reports whether a valid
+// span context was extracted.
+func extractJaeger(header string) (sc trace.SpanContext, debug, ok bool) {
+	if strings.Contains(header, "%") {
+		var err error
+		if header, err = url.QueryUnescape(header); err != nil {
+			return trace.SpanContext{}, false, false
+		}
+	}
+
+	parts := strings.Split(header, ":")
+	if len(parts) != 4 {
+		return trace.SpanContext{}, false, false
+	}
+
+	scc := trace.SpanContextConfig{Remote: true}
+
+	var err error
+	if scc.TraceID, err = trace.TraceIDFromHex(jaegerPad(parts[0], 32)); err != nil {
+		return trace.SpanContext{}, false, false
+	}
+	if scc.SpanID, err = trace.SpanIDFromHex(jaegerPad(parts[1], 16)); err != nil {
+		return trace.SpanContext{}, false, false
+	}
+	// The parent span ID is deprecated and only validated.
+	if _, err = strconv.ParseUint(parts[2], 16, 64); err != nil {
+		return trace.SpanContext{}, false, false
+	}
+
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil {
+		return trace.SpanContext{}, false, false
+	}
+	if flags&jaegerFlagDebug != 0 {
+		debug = true
+		scc.TraceFlags = trace.FlagsSampled
+	} else if flags&jaegerFlagSampled != 0 {
+		scc.TraceFlags = trace.FlagsSampled
+	}
+
+	sc = trace.NewSpanContext(scc)
+	return sc, debug, sc.IsValid()
+}
+
+// jaegerPad left pads the hex ID id with zeros to n characters. An empty or
+// too long id is returned unchanged, failing to parse.
+func jaegerPad(id string, n int) string {
+	if id == "" || len(id) >= n {
+		return id
+	}
+	return strings.Repeat("0", n-len(id)) + id
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestJaegerExtract(t *testing.T) {
+	shortTraceID, _ := trace.TraceIDFromHex("0000000000000000a3ce929d0e0e4736")
+	shortSpanID, _ := trace.SpanIDFromHex("000000000ba902b7")
+	tests := []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			name:   "sampled",
+			header: traceIDStr + ":" + spanIDStr + ":0:1",
+			want:   b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name:   "not sampled with parent",
+			header: traceIDStr + ":" + spanIDStr + ":00f067aa0ba902b8:0",
+			want:   b3SpanContext(0),
+		},
+		{
+			name:   "debug",
+			header: traceIDStr + ":" + spanIDStr + ":0:2",
+			want:   b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name:   "short IDs",
+			header: "a3ce929d0e0e4736:ba902b7:0:1",
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    shortTraceID,
+				SpanID:     shortSpanID,
+				TraceFlags: trace.FlagsSampled,
+				Remote:     true,
+			}),
+		},
+		{
+			name:   "URL encoded",
+			header: traceIDStr + "%3A" + spanIDStr + "%3A0%3A1",
+			want:   b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name:   "missing flags",
+			header: traceIDStr + ":" + spanIDStr + ":0",
+		},
+		{
+			name:   "trace ID too long",
+			header: "0" + traceIDStr + ":" + spanIDStr + ":0:1",
+		},
+		{
+			name:   "zero span ID",
+			header: traceIDStr + ":0:0:1",
+		},
+		{
+			name:   "invalid parent",
+			header: traceIDStr + ":" + spanIDStr + ":x:1",
+		},
+		{
+			name:   "invalid flags",
+			header: traceIDStr + ":" + spanIDStr + ":0:x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("uber-trace-id", tt.header)
+			ctx := propagation.Jaeger{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestJaegerExtractBaggage(t *testing.T) {
+	header := http.Header{}
+	header.Set("uberctx-user", "alice")
+	header.Set("uberctx-greeting", "hello%20world")
+	header.Set("uberctx-invalid", "%zz")
+	header.Set("uberctx-", "no key")
+	ctx := propagation.Jaeger{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	assert.Equal(t, attribute.NewSet(
+		attribute.String("user", "alice"),
+		attribute.String("greeting", "hello world"),
+	), baggage.Set(ctx))
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+}
+
+func TestJaegerInject(t *testing.T) {
+	tests := []struct {
+		name string
+		sc   trace.SpanContext
+		want string
+	}{
+		{
+			name: "sampled",
+			sc:   b3SpanContext(trace.FlagsSampled),
+			want: traceIDStr + ":" + spanIDStr + ":0:1",
+		},
+		{
+			name: "not sampled",
+			sc:   b3SpanContext(0),
+			want: traceIDStr + ":" + spanIDStr + ":0:0",
+		},
+		{
+			name: "invalid span context",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			ctx := trace.ContextWithSpanContext(context.Background(), tt.sc)
+			propagation.Jaeger{}.Inject(ctx, propagation.HeaderCarrier(header))
+			assert.Equal(t, tt.want, header.Get("uber-trace-id"))
+		})
+	}
+}
+
+func TestJaegerInjectBaggage(t *testing.T) {
+	ctx := baggage.ContextWithValues(context.Background(),
+		attribute.String("user", "alice"),
+		attribute.String("greeting", "hello world"),
+	)
+	header := http.Header{}
+	propagation.Jaeger{}.Inject(ctx, propagation.HeaderCarrier(header))
+
+	assert.Equal(t, http.Header{
+		"Uberctx-User":     []string{"alice"},
+		"Uberctx-Greeting": []string{"hello+world"},
+	}, header)
+}
+
+func TestJaegerRoundTripDebug(t *testing.T) {
+	in := http.Header{}
+	in.Set("uber-trace-id", traceIDStr+":"+spanIDStr+":0:3")
+	ctx := propagation.Jaeger{}.Extract(context.Background(), propagation.HeaderCarrier(in))
+
+	out := http.Header{}
+	propagation.Jaeger{}.Inject(ctx, propagation.HeaderCarrier(out))
+	assert.Equal(t, traceIDStr+":"+spanIDStr+":0:3", out.Get("uber-trace-id"))
+}
+
+func TestJaegerFields(t *testing.T) {
+	assert.Equal(t, []string{"uber-trace-id"}, propagation.Jaeger{}.Fields())
+}
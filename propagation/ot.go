@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OT header names.
+const (
+	otTraceIDHeader = "ot-tracer-traceid"
+	otSpanIDHeader  = "ot-tracer-spanid"
+	otSampledHeader = "ot-tracer-sampled"
+	otBaggagePrefix = "ot-baggage-"
+)
+
+// otTraceID64Length is the length of a hex encoded 64 bit trace ID.
+const otTraceID64Length = 16
+
+// OT is a propagator that supports the headers of the OpenTracing basic
+// tracer, used by LightStep tracers among others.
+//
+// The span context is propagated in the ot-tracer-traceid,
+// ot-tracer-spanid and ot-tracer-sampled headers, and baggage in one
+// ot-baggage-{key} header per baggage key. As the baggage headers are found
+// by their prefix, the carrier must list its keys with Keys to have baggage
+// extracted.
+//
+// OpenTracing tracers use 64 bit trace IDs. A 64 bit trace ID is left
+// padded with zeros when extracted, and only the lower 64 bits of the trace
+// ID are injected unless Inject128BitTraceID is set. The trace ID of a span
+// context propagated through an OpenTracing tracer therefore changes if its
+// higher 64 bits are not zero.
+type OT struct {
+	// Inject128BitTraceID injects the full 128 bit trace ID, for tracers
+	// known to support it, instead of its lower 64 bits.
+	Inject128BitTraceID bool
+}
+
+var _ TextMapPropagator = OT{}
+
+// Inject injects the span context and baggage of ctx into the carrier.
+func (ot OT) Inject(ctx context.Context, carrier TextMapCarrier) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		traceID := sc.TraceID().String()
+		if !ot.Inject128BitTraceID {
+			traceID = traceID[len(traceID)-otTraceID64Length:]
+		}
+		carrier.Set(otTraceIDHeader, traceID)
+		carrier.Set(otSpanIDHeader, sc.SpanID().String())
+		if sc.IsSampled() {
+			carrier.Set(otSampledHeader, "true")
+		} else {
+			carrier.Set(otSampledHeader, "false")
+		}
+	}
+
+	injectPrefixedBaggage(ctx, carrier, otBaggagePrefix)
+}
+
+// Extract extracts an OT span context and baggage from the carrier into a
+// returned Context. If no valid span context is found the span context of
+// ctx is left unchanged.
+func (ot OT) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	ctx = extractPrefixedBaggage(ctx, carrier, otBaggagePrefix)
+
+	sc, ok := extractOT(
+		carrier.Get(otTraceIDHeader),
+		carrier.Get(otSpanIDHeader),
+		carrier.Get(otSampledHeader),
+	)
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject. The baggage
+// headers are not included as their keys depend on the baggage.
+func (ot OT) Fields() []string {
+	return []string{otTraceIDHeader, otSpanIDHeader, otSampledHeader}
+}
+
+// extractOT parses the OT headers. It reports whether a valid span context
+// was extracted.
+func extractOT(traceID, spanID, sampled string) (trace.SpanContext, bool) {
+	scc := trace.SpanContextConfig{Remote: true}
+
+	switch len(traceID) {
+	case otTraceID64Length:
+		traceID = strings.Repeat("0", otTraceID64Length) + traceID
+	case 2 * otTraceID64Length:
+	default:
+		return trace.SpanContext{}, false
+	}
+
+	var err error
+	if scc.TraceID, err = trace.TraceIDFromHex(traceID); err != nil {
+		return trace.SpanContext{}, false
+	}
+	if len(spanID) != 16 {
+		return trace.SpanContext{}, false
+	}
+	if scc.SpanID, err = trace.SpanIDFromHex(spanID); err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	switch strings.ToLower(sampled) {
+	case "true", "1":
+		scc.TraceFlags = trace.FlagsSampled
+	case "", "false", "0":
+	default:
+		return trace.SpanContext{}, false
+	}
+
+	sc := trace.NewSpanContext(scc)
+	return sc, sc.IsValid()
+}
@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestOTExtract(t *testing.T) {
+	shortTraceID, _ := trace.TraceIDFromHex("0000000000000000a3ce929d0e0e4736")
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    trace.SpanContext
+	}{
+		{
+			name: "sampled",
+			headers: map[string]string{
+				"ot-tracer-traceid": traceIDStr,
+				"ot-tracer-spanid":  spanIDStr,
+				"ot-tracer-sampled": "true",
+			},
+			want: b3SpanContext(trace.FlagsSampled),
+		},
+		{
+			name: "not sampled",
+			headers: map[string]string{
+				"ot-tracer-traceid": traceIDStr,
+				"ot-tracer-spanid":  spanIDStr,
+				"ot-tracer-sampled": "false",
+			},
+			want: b3SpanContext(0),
+		},
+		{
+			name: "missing sampled",
+			headers: map[string]string{
+				"ot-tracer-traceid": traceIDStr,
+				"ot-tracer-spanid":  spanIDStr,
+			},
+			want: b3SpanContext(0),
+		},
+		{
+			name: "64 bit trace ID",
+			headers: map[string]string{
+				"ot-tracer-traceid": "a3ce929d0e0e4736",
+				"ot-tracer-spanid":  spanIDStr,
+				"ot-tracer-sampled": "1",
+			},
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    shortTraceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled,
+				Remote:     true,
+			}),
+		},
+		{
+			name: "invalid trace ID length",
+			headers: map[string]string{
+				"ot-tracer-traceid": "a3ce929d0e0e473",
+				"ot-tracer-spanid":  spanIDStr,
+			},
+		},
+		{
+			name: "invalid span ID",
+			headers: map[string]string{
+				"ot-tracer-traceid": traceIDStr,
+				"ot-tracer-spanid":  "00f067aa",
+			},
+		},
+		{
+			name: "invalid sampled",
+			headers: map[string]string{
+				"ot-tracer-traceid": traceIDStr,
+				"ot-tracer-spanid":  spanIDStr,
+				"ot-tracer-sampled": "yes",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			ctx := propagation.OT{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestOTExtractBaggage(t *testing.T) {
+	header := http.Header{}
+	header.Set("ot-baggage-user", "alice")
+	header.Set("ot-baggage-greeting", "hello%20world")
+	ctx := propagation.OT{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	assert.Equal(t, attribute.NewSet(
+		attribute.String("user", "alice"),
+		attribute.String("greeting", "hello world"),
+	), baggage.Set(ctx))
+}
+
+func TestOTInject(t *testing.T) {
+	tests := []struct {
+		name string
+		ot   propagation.OT
+		sc   trace.SpanContext
+		want map[string]string
+	}{
+		{
+			name: "64 bit trace ID",
+			sc:   b3SpanContext(trace.FlagsSampled),
+			want: map[string]string{
+				"ot-tracer-traceid": traceIDStr[16:],
+				"ot-tracer-spanid":  spanIDStr,
+				"ot-tracer-sampled": "true",
+			},
+		},
+		{
+			name: "128 bit trace ID",
+			ot:   propagation.OT{Inject128BitTraceID: true},
+			sc:   b3SpanContext(0),
+			want: map[string]string{
+				"ot-tracer-traceid": traceIDStr,
+				"ot-tracer-spanid":  spanIDStr,
+				"ot-tracer-sampled": "false",
+			},
+		},
+		{
+			name: "invalid span context",
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			ctx := trace.ContextWithSpanContext(context.Background(), tt.sc)
+			ctx = baggage.ContextWithValues(ctx, attribute.String("user", "alice"))
+			tt.ot.Inject(ctx, propagation.HeaderCarrier(header))
+			assert.Equal(t, len(tt.want)+1, len(header))
+			for k, v := range tt.want {
+				assert.Equal(t, v, header.Get(k), k)
+			}
+			assert.Equal(t, "alice", header.Get("ot-baggage-user"))
+		})
+	}
+}
+
+func TestOTFields(t *testing.T) {
+	assert.Equal(t,
+		[]string{"ot-tracer-traceid", "ot-tracer-spanid", "ot-tracer-sampled"},
+		propagation.OT{}.Fields(),
+	)
+}
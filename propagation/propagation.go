@@ -17,6 +17,7 @@ package propagation // import "go.opentelemetry.io/otel/propagation"
 import (
 	"context"
 	"net/http"
+	"strings"
 )
 
 // TextMapCarrier is the storage medium used by a TextMapPropagator.
@@ -29,14 +30,44 @@ type TextMapCarrier interface {
 	Keys() []string
 }
 
-// HeaderCarrier adapts http.Header to satisfy the TextMapCarrier interface.
+// ValuesGetter is implemented by a TextMapCarrier that can store several
+// values for a key. Propagators of formats that allow a value to be split
+// over several fields with the same key, like the W3C tracestate and baggage
+// headers, use it to read all of them.
+type ValuesGetter interface {
+	// Values returns all the values associated with the passed key.
+	Values(key string) []string
+}
+
+// getJoined returns the values associated with key in the carrier joined by
+// commas if the carrier is a ValuesGetter, otherwise the value returned by
+// Get.
+func getJoined(carrier TextMapCarrier, key string) string {
+	if vg, ok := carrier.(ValuesGetter); ok {
+		return strings.Join(vg.Values(key), ",")
+	}
+	return carrier.Get(key)
+}
+
+// HeaderCarrier adapts http.Header to satisfy the TextMapCarrier and
+// ValuesGetter interfaces.
 type HeaderCarrier http.Header
 
+var (
+	_ TextMapCarrier = HeaderCarrier{}
+	_ ValuesGetter   = HeaderCarrier{}
+)
+
 // Get returns the value associated with the passed key.
 func (hc HeaderCarrier) Get(key string) string {
 	return http.Header(hc).Get(key)
 }
 
+// Values returns all the values associated with the passed key.
+func (hc HeaderCarrier) Values(key string) []string {
+	return http.Header(hc).Values(key)
+}
+
 // Set stores the key-value pair.
 func (hc HeaderCarrier) Set(key string, value string) {
 	http.Header(hc).Set(key, value)
@@ -99,7 +130,9 @@ func (p compositeTextMapPropagator) Fields() []string {
 // The returned TextMapPropagator will inject and extract cross-cutting
 // concerns in the order the TextMapPropagators were provided. Additionally,
 // the Fields method will return a de-duplicated slice of the keys that are
-// set with the Inject method.
+// set with the Inject method. Use NewCompositeTextMapPropagatorWithOptions to
+// choose which extracted span context is kept and to report extraction
+// failures.
 func NewCompositeTextMapPropagator(p ...TextMapPropagator) TextMapPropagator {
 	return compositeTextMapPropagator(p)
 }
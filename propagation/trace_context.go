@@ -38,7 +38,13 @@ const (
 // to choose if they want to participate in a trace by modifying the
 // traceparent header and relevant parts of the tracestate header containing
 // their proprietary information.
-type TraceContext struct{}
+type TraceContext struct {
+	// InjectRandom makes Inject write the random trace flag of W3C Trace
+	// Context Level 2 when it is set. It is not written by default because
+	// extractors predating Level 2 reject the traceparent headers with it.
+	// The random flag is always extracted.
+	InjectRandom bool
+}
 
 var _ TextMapPropagator = TraceContext{}
 var traceCtxRegExp = regexp.MustCompile("^(?P<version>[0-9a-f]{2})-(?P<traceID>[a-f0-9]{32})-(?P<spanID>[a-f0-9]{16})-(?P<traceFlags>[a-f0-9]{2})(?:-.*)?$")
@@ -52,8 +58,13 @@ func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 
 	carrier.Set(tracestateHeader, sc.TraceState().String())
 
-	// Clear all flags other than the trace-context supported sampling bit.
-	flags := sc.TraceFlags() & trace.FlagsSampled
+	// Clear all flags other than the trace-context supported sampling bit,
+	// and the random bit if it was asked for.
+	mask := trace.FlagsSampled
+	if tc.InjectRandom {
+		mask |= trace.FlagsRandom
+	}
+	flags := sc.TraceFlags() & mask
 
 	h := fmt.Sprintf("%.2x-%s-%s-%s",
 		supportedVersion,
@@ -131,16 +142,17 @@ func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 		return trace.SpanContext{}
 	}
 	opts, err := hex.DecodeString(matches[4])
-	if err != nil || len(opts) < 1 || (version == 0 && opts[0] > 2) {
+	if err != nil || len(opts) < 1 || (version == 0 && opts[0] > 3) {
 		return trace.SpanContext{}
 	}
-	// Clear all flags other than the trace-context supported sampling bit.
-	scc.TraceFlags = trace.TraceFlags(opts[0]) & trace.FlagsSampled
+	// Clear all flags other than the trace-context supported sampling and
+	// random bits.
+	scc.TraceFlags = trace.TraceFlags(opts[0]) & (trace.FlagsSampled | trace.FlagsRandom)
 
 	// Ignore the error returned here. Failure to parse tracestate MUST NOT
 	// affect the parsing of traceparent according to the W3C tracecontext
 	// specification.
-	scc.TraceState, _ = trace.ParseTraceState(carrier.Get(tracestateHeader))
+	scc.TraceState, _ = trace.ParseTraceState(getJoined(carrier, tracestateHeader))
 	scc.Remote = true
 
 	sc := trace.NewSpanContext(scc)
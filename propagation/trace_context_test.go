@@ -53,6 +53,16 @@ func TestExtractValidTraceContextFromHTTPReq(t *testing.T) {
 				Remote:     true,
 			}),
 		},
+		{
+			name:   "valid w3cHeader, sampled and random",
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03",
+			wantSc: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
+				Remote:     true,
+			}),
+		},
 		{
 			name:   "future version",
 			header: "02-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
@@ -275,6 +285,42 @@ func TestInjectTraceContextToHTTPReq(t *testing.T) {
 	}
 }
 
+func TestInjectTraceContextRandomFlag(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	for _, tt := range []struct {
+		name       string
+		prop       propagation.TraceContext
+		wantHeader string
+	}{
+		{
+			name:       "default",
+			prop:       propagation.TraceContext{},
+			wantHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		{
+			name:       "InjectRandom",
+			prop:       propagation.TraceContext{InjectRandom: true},
+			wantHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "http://example.com", nil)
+			tt.prop.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			gotHeader := req.Header.Get("traceparent")
+			if diff := cmp.Diff(gotHeader, tt.wantHeader); diff != "" {
+				t.Errorf("Inject Tracecontext: %s: -got +want %s", tt.name, diff)
+			}
+		})
+	}
+}
+
 func TestTraceContextPropagator_GetAllKeys(t *testing.T) {
 	var propagator propagation.TraceContext
 	want := []string{"traceparent", "tracestate"}
@@ -371,3 +417,19 @@ func TestTraceStatePropagation(t *testing.T) {
 		})
 	}
 }
+
+func TestTraceStateFromMultipleHeaders(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	req.Header.Add("tracestate", "key1=value1")
+	req.Header.Add("tracestate", "key2=value2")
+
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.HeaderCarrier(req.Header))
+	ts := trace.SpanContextFromContext(ctx).TraceState()
+	if got := ts.Get("key1"); got != "value1" {
+		t.Errorf("tracestate key1: got %q, want %q", got, "value1")
+	}
+	if got := ts.Get("key2"); got != "value2" {
+		t.Errorf("tracestate key2: got %q, want %q", got, "value2")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xray provides the AWS X-Ray propagator and an ID generator
+// producing trace IDs accepted by X-Ray.
+//
+// X-Ray requires the first 32 bits of a trace ID to be the epoch time, in
+// seconds, at which the trace started. Use the ID generator with the trace
+// SDK so spans exported to X-Ray, for example through the OpenTelemetry
+// Collector, are accepted:
+//
+//	tp := sdktrace.NewTracerProvider(
+//		sdktrace.WithIDGenerator(xray.NewIDGenerator()),
+//		// ...
+//	)
+//	otel.SetTextMapPropagator(xray.Propagator{})
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package xray // import "go.opentelemetry.io/otel/propagators/aws/xray"
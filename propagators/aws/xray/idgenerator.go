@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray // import "go.opentelemetry.io/otel/propagators/aws/xray"
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errInvalidTraceID = errors.New("invalid X-Ray trace ID")
+
+// idGenerator generates trace IDs whose first 4 bytes are the big-endian
+// Unix time in seconds, as required by X-Ray, and random span IDs.
+type idGenerator struct {
+	sync.Mutex
+	randSource *rand.Rand
+	now        func() time.Time
+}
+
+var _ sdktrace.IDGenerator = &idGenerator{}
+
+// NewIDGenerator returns an IDGenerator generating X-Ray compatible trace
+// IDs: the first 4 bytes are the big-endian Unix time in seconds at which
+// the trace started, the remaining bytes are random. Span IDs are random.
+func NewIDGenerator() sdktrace.IDGenerator {
+	var rngSeed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
+	return &idGenerator{
+		randSource: rand.New(rand.NewSource(rngSeed)),
+		now:        time.Now,
+	}
+}
+
+// NewSpanID returns a non-zero span ID from a randomly-chosen sequence.
+func (gen *idGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	gen.Lock()
+	defer gen.Unlock()
+	return gen.spanID()
+}
+
+// NewIDs returns a timestamp-prefixed trace ID and a random span ID.
+func (gen *idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	gen.Lock()
+	defer gen.Unlock()
+
+	tid := trace.TraceID{}
+	binary.BigEndian.PutUint32(tid[:4], uint32(gen.now().Unix()))
+	gen.randSource.Read(tid[4:])
+	return tid, gen.spanID()
+}
+
+func (gen *idGenerator) spanID() trace.SpanID {
+	sid := trace.SpanID{}
+	for !sid.IsValid() {
+		gen.randSource.Read(sid[:])
+	}
+	return sid
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestIDGeneratorTraceIDTimestamp(t *testing.T) {
+	gen := NewIDGenerator().(*idGenerator)
+	now := time.Unix(1465510280, 0)
+	gen.now = func() time.Time { return now }
+
+	tid, sid := gen.NewIDs(context.Background())
+	assert.True(t, tid.IsValid())
+	assert.True(t, sid.IsValid())
+	assert.Equal(t, uint32(now.Unix()), binary.BigEndian.Uint32(tid[:4]))
+	assert.Equal(t, "5759e988", tid.String()[:8])
+}
+
+func TestIDGeneratorUnique(t *testing.T) {
+	gen := NewIDGenerator()
+	ctx := context.Background()
+
+	tid1, sid1 := gen.NewIDs(ctx)
+	tid2, sid2 := gen.NewIDs(ctx)
+	assert.NotEqual(t, tid1, tid2)
+	assert.NotEqual(t, sid1, sid2)
+
+	sid3 := gen.NewSpanID(ctx, tid1)
+	assert.True(t, sid3.IsValid())
+	assert.NotEqual(t, sid1, sid3)
+}
+
+func TestIDGeneratorWithPropagator(t *testing.T) {
+	tid, sid := NewIDGenerator().NewIDs(context.Background())
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: tid, SpanID: sid})
+
+	carrier := mapCarrier{}
+	Propagator{}.Inject(trace.ContextWithSpanContext(context.Background(), sc), carrier)
+	got := trace.SpanContextFromContext(Propagator{}.Extract(context.Background(), carrier))
+	assert.Equal(t, tid, got.TraceID())
+	assert.Equal(t, sid, got.SpanID())
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray // import "go.opentelemetry.io/otel/propagators/aws/xray"
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	traceHeaderKey       = "X-Amzn-Trace-Id"
+	traceHeaderDelimiter = ";"
+	kvDelimiter          = "="
+	traceIDKey           = "Root"
+	parentIDKey          = "Parent"
+	sampleFlagKey        = "Sampled"
+	traceIDVersion       = "1"
+	traceIDDelimiter     = "-"
+	isSampled            = "1"
+	notSampled           = "0"
+
+	// traceIDLength is the length of an X-Ray trace ID:
+	// 1-{8 hexadecimal epoch}-{24 hexadecimal}.
+	traceIDLength = 35
+)
+
+// Propagator serializes span contexts to and from the AWS X-Ray
+// X-Amzn-Trace-Id header
+// (https://docs.aws.amazon.com/xray/latest/devguide/xray-concepts.html#xray-concepts-tracingheader).
+type Propagator struct{}
+
+var _ propagation.TextMapPropagator = Propagator{}
+
+// Inject injects the span context of ctx into the carrier.
+func (Propagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	tid := sc.TraceID().String()
+	sampled := notSampled
+	if sc.IsSampled() {
+		sampled = isSampled
+	}
+	header := strings.Join([]string{
+		traceIDKey + kvDelimiter + traceIDVersion + traceIDDelimiter + tid[:8] + traceIDDelimiter + tid[8:],
+		parentIDKey + kvDelimiter + sc.SpanID().String(),
+		sampleFlagKey + kvDelimiter + sampled,
+	}, traceHeaderDelimiter)
+	carrier.Set(traceHeaderKey, header)
+}
+
+// Extract extracts a span context from the carrier into a returned Context.
+// If the X-Amzn-Trace-Id header is missing or invalid, the passed ctx is
+// returned.
+func (Propagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	sc, ok := extract(carrier.Get(traceHeaderKey))
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject.
+func (Propagator) Fields() []string {
+	return []string{traceHeaderKey}
+}
+
+// extract parses an X-Amzn-Trace-Id header. Unknown keys, such as Self or
+// Lineage, are ignored. A missing or "?" sampling decision results in a
+// span context that is not sampled.
+func extract(header string) (trace.SpanContext, bool) {
+	if header == "" {
+		return trace.SpanContext{}, false
+	}
+
+	scc := trace.SpanContextConfig{Remote: true}
+	var err error
+	for _, part := range strings.Split(header, traceHeaderDelimiter) {
+		part = strings.TrimSpace(part)
+		i := strings.Index(part, kvDelimiter)
+		if i < 0 {
+			return trace.SpanContext{}, false
+		}
+		key, value := part[:i], part[i+1:]
+		switch key {
+		case traceIDKey:
+			if scc.TraceID, err = parseTraceID(value); err != nil {
+				return trace.SpanContext{}, false
+			}
+		case parentIDKey:
+			if len(value) != 16 {
+				return trace.SpanContext{}, false
+			}
+			if scc.SpanID, err = trace.SpanIDFromHex(value); err != nil {
+				return trace.SpanContext{}, false
+			}
+		case sampleFlagKey:
+			switch value {
+			case isSampled:
+				scc.TraceFlags = trace.FlagsSampled
+			case notSampled, "?":
+			default:
+				return trace.SpanContext{}, false
+			}
+		}
+	}
+
+	sc := trace.NewSpanContext(scc)
+	return sc, sc.IsValid()
+}
+
+// parseTraceID converts an X-Ray trace ID to an OpenTelemetry trace ID.
+func parseTraceID(id string) (trace.TraceID, error) {
+	if len(id) != traceIDLength ||
+		id[:2] != traceIDVersion+traceIDDelimiter ||
+		id[10:11] != traceIDDelimiter {
+		return trace.TraceID{}, errInvalidTraceID
+	}
+	return trace.TraceIDFromHex(id[2:10] + id[11:])
+}
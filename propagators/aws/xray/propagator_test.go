@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mapCarrier map[string]string
+
+func (c mapCarrier) Get(key string) string { return c[key] }
+
+func (c mapCarrier) Set(key, value string) { c[key] = value }
+
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+var (
+	traceID, _ = trace.TraceIDFromHex("5759e988bd862e3fe1be46a994272793")
+	spanID, _  = trace.SpanIDFromHex("53995c3f42cd8ad8")
+)
+
+func TestPropagatorExtract(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			name:   "sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled,
+				Remote:     true,
+			}),
+		},
+		{
+			name:   "not sampled with unknown keys and spaces",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793; Parent=53995c3f42cd8ad8; Sampled=0; Lineage=a87bd80c:0",
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+				Remote:  true,
+			}),
+		},
+		{
+			name:   "deferred",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=?",
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+				Remote:  true,
+			}),
+		},
+		{name: "empty"},
+		{
+			name:   "missing parent",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Sampled=1",
+		},
+		{
+			name:   "invalid version",
+			header: "Root=2-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8",
+		},
+		{
+			name:   "invalid trace ID",
+			header: "Root=1-5759e988-bd862e3fe1be46a99427;Parent=53995c3f42cd8ad8",
+		},
+		{
+			name:   "invalid sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=yes",
+		},
+		{
+			name:   "malformed",
+			header: "Root",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := Propagator{}.Extract(context.Background(), mapCarrier{traceHeaderKey: tc.header})
+			assert.Equal(t, tc.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestPropagatorInject(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	carrier := mapCarrier{}
+	Propagator{}.Inject(trace.ContextWithSpanContext(context.Background(), sc), carrier)
+	assert.Equal(t, mapCarrier{
+		traceHeaderKey: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+	}, carrier)
+
+	carrier = mapCarrier{}
+	Propagator{}.Inject(context.Background(), carrier)
+	assert.Empty(t, carrier)
+}
+
+func TestPropagatorFields(t *testing.T) {
+	assert.Equal(t, []string{"X-Amzn-Trace-Id"}, Propagator{}.Fields())
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel // import "go.opentelemetry.io/otel"
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Compile time check that RateLimitedErrorHandler implements
+// ClassifiedErrorHandler.
+var _ ClassifiedErrorHandler = (*RateLimitedErrorHandler)(nil)
+
+// RateLimitedErrorHandler is a ClassifiedErrorHandler that logs errors, at
+// most once per interval for each component and severity. Errors handled
+// within the interval of the last logged one are dropped and their number
+// is included in the next logged message.
+//
+// This prevents flooding the log when a component fails repeatedly, for
+// example an exporter that cannot reach its collector.
+type RateLimitedErrorHandler struct {
+	l        *log.Logger
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	limits map[rateLimitKey]*rateLimit
+}
+
+type rateLimitKey struct {
+	component string
+	severity  Severity
+}
+
+type rateLimit struct {
+	last       time.Time
+	suppressed int
+}
+
+// NewRateLimitedErrorHandler returns a RateLimitedErrorHandler logging to l
+// at most once per interval for each component and severity. If l is nil
+// errors are logged to STDERR.
+func NewRateLimitedErrorHandler(l *log.Logger, interval time.Duration) *RateLimitedErrorHandler {
+	if l == nil {
+		l = log.New(os.Stderr, "", log.LstdFlags)
+	}
+	return &RateLimitedErrorHandler{
+		l:        l,
+		interval: interval,
+		now:      time.Now,
+		limits:   make(map[rateLimitKey]*rateLimit),
+	}
+}
+
+// Handle implements ErrorHandler. The error is handled as one with
+// SeverityError from an unnamed component.
+func (h *RateLimitedErrorHandler) Handle(err error) {
+	h.HandleClassified("", SeverityError, err)
+}
+
+// HandleClassified implements ClassifiedErrorHandler.
+func (h *RateLimitedErrorHandler) HandleClassified(component string, sev Severity, err error) {
+	key := rateLimitKey{component: component, severity: sev}
+	now := h.now()
+
+	h.mu.Lock()
+	limit, ok := h.limits[key]
+	if !ok {
+		limit = &rateLimit{}
+		h.limits[key] = limit
+	} else if now.Sub(limit.last) < h.interval {
+		limit.suppressed++
+		h.mu.Unlock()
+		return
+	}
+	suppressed := limit.suppressed
+	limit.last = now
+	limit.suppressed = 0
+	h.mu.Unlock()
+
+	msg := fmt.Sprintf("%s: %v", sev, err)
+	if component != "" {
+		msg = component + " " + msg
+	}
+	if suppressed > 0 {
+		msg = fmt.Sprintf("%s (%d similar errors suppressed)", msg, suppressed)
+	}
+	h.l.Print(msg)
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel
+
+import (
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitedErrorHandler(t *testing.T) {
+	l := new(errLogger)
+	h := NewRateLimitedErrorHandler(log.New(l, "", 0), time.Minute)
+	now := time.Unix(0, 0)
+	h.now = func() time.Time { return now }
+
+	const component = "go.opentelemetry.io/otel/exporters/otlp"
+	err := errors.New("connection refused")
+	for i := 0; i < 5; i++ {
+		h.HandleClassified(component, SeverityError, err)
+	}
+	// Other severities and components are limited separately.
+	h.HandleClassified(component, SeverityWarning, err)
+	h.Handle(err)
+
+	now = now.Add(time.Minute)
+	h.HandleClassified(component, SeverityError, err)
+	h.HandleClassified(component, SeverityError, err)
+
+	assert.Equal(t, []string{
+		component + " error: connection refused",
+		component + " warning: connection refused",
+		"error: connection refused",
+		component + " error: connection refused (4 similar errors suppressed)",
+	}, l.Got())
+}
+
+func TestLoggingErrorHandlerClassified(t *testing.T) {
+	l := new(errLogger)
+	h := &loggingErrorHandler{l: log.New(l, "", 0)}
+	h.HandleClassified("component", SeverityWarning, errors.New("retrying"))
+	assert.Equal(t, []string{"component warning: retrying"}, l.Got())
+
+	delegate := new(errLogger)
+	h.setDelegate(NewRateLimitedErrorHandler(log.New(delegate, "", 0), time.Minute))
+	h.HandleClassified("component", SeverityWarning, errors.New("retrying"))
+	h.HandleClassified("component", SeverityWarning, errors.New("retrying"))
+	assert.Len(t, l.Got(), 1, "original handler used after delegation")
+	assert.Equal(t, []string{"component warning: retrying"}, delegate.Got())
+}
+
+type plainErrorHandler struct{ errs []error }
+
+func (h *plainErrorHandler) Handle(err error) { h.errs = append(h.errs, err) }
+
+func TestLoggingErrorHandlerClassifiedUnclassifiedDelegate(t *testing.T) {
+	h := &loggingErrorHandler{l: log.New(new(errLogger), "", 0)}
+	d := new(plainErrorHandler)
+	h.setDelegate(d)
+
+	err := errors.New("dropped")
+	h.HandleClassified("component", SeverityError, err)
+	assert.Equal(t, []error{err}, d.errs)
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerremote // import "go.opentelemetry.io/otel/samplers/jaegerremote"
+
+import (
+	"net/http"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	defaultSamplingServerURL = "http://localhost:5778/sampling"
+	defaultPollingInterval   = time.Minute
+	defaultSamplingRate      = 0.001
+	defaultMaxOperations     = 2000
+)
+
+type config struct {
+	samplingServerURL string
+	pollingInterval   time.Duration
+	initialSampler    sdktrace.Sampler
+	maxOperations     int
+	httpClient        *http.Client
+}
+
+func newConfig(options ...Option) config {
+	c := config{
+		samplingServerURL: defaultSamplingServerURL,
+		pollingInterval:   defaultPollingInterval,
+		initialSampler:    sdktrace.TraceIDRatioBased(defaultSamplingRate),
+		maxOperations:     defaultMaxOperations,
+		httpClient:        http.DefaultClient,
+	}
+	for _, o := range options {
+		o.apply(&c)
+	}
+	return c
+}
+
+// Option configures a Sampler.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(c *config) {
+	fn(c)
+}
+
+// WithSamplingServerURL sets the URL of the sampling endpoint the strategies
+// are fetched from. The service name is passed to it as the "service" query
+// parameter.
+// The default value is "http://localhost:5778/sampling", the endpoint of a
+// local Jaeger agent.
+func WithSamplingServerURL(url string) Option {
+	return optionFunc(func(c *config) {
+		c.samplingServerURL = url
+	})
+}
+
+// WithPollingInterval sets the interval between fetches of the sampling
+// strategies.
+// The default value is one minute.
+func WithPollingInterval(interval time.Duration) Option {
+	return optionFunc(func(c *config) {
+		if interval > 0 {
+			c.pollingInterval = interval
+		}
+	})
+}
+
+// WithInitialSampler sets the sampler used until the first sampling
+// strategies are fetched.
+// The default is a TraceIDRatioBased sampler with a ratio of 0.001.
+func WithInitialSampler(s sdktrace.Sampler) Option {
+	return optionFunc(func(c *config) {
+		if s != nil {
+			c.initialSampler = s
+		}
+	})
+}
+
+// WithMaxOperations sets the maximum number of operations, span names, that
+// are sampled with a dedicated strategy. Spans of other operations use the
+// default strategy of the service.
+// The default value is 2000.
+func WithMaxOperations(n int) Option {
+	return optionFunc(func(c *config) {
+		c.maxOperations = n
+	})
+}
+
+// WithHTTPClient sets the client used to fetch the sampling strategies.
+// The default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(c *config) {
+		if client != nil {
+			c.httpClient = client
+		}
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerremote // import "go.opentelemetry.io/otel/samplers/jaegerremote"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Sampler is a sdktrace.Sampler that applies the sampling strategies a
+// Jaeger sampling endpoint serves for a service.
+type Sampler struct {
+	serviceName string
+	cfg         config
+
+	mu       sync.RWMutex
+	sampler  sdktrace.Sampler
+	strategy *strategyResponse
+
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ sdktrace.Sampler = (*Sampler)(nil)
+
+// New returns a Sampler for the service serviceName and starts polling the
+// sampling endpoint for its strategies. Close should be called to stop the
+// polling once the Sampler is no longer used.
+func New(serviceName string, options ...Option) *Sampler {
+	cfg := newConfig(options...)
+	s := &Sampler{
+		serviceName: serviceName,
+		cfg:         cfg,
+		sampler:     cfg.initialSampler,
+		stopCh:      make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+	go s.poll()
+	return s
+}
+
+// ShouldSample returns the decision of the sampler for the current
+// strategies.
+func (s *Sampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	s.mu.RLock()
+	sampler := s.sampler
+	s.mu.RUnlock()
+	return sampler.ShouldSample(p)
+}
+
+// Description returns the description of the Sampler.
+func (s *Sampler) Description() string {
+	s.mu.RLock()
+	sampler := s.sampler
+	s.mu.RUnlock()
+	return fmt.Sprintf("JaegerRemoteSampler{%s}", sampler.Description())
+}
+
+// Close stops polling the sampling endpoint. The last strategies received
+// keep being applied.
+func (s *Sampler) Close() {
+	s.closeOnce.Do(func() {
+		close(s.stopCh)
+		<-s.doneCh
+	})
+}
+
+func (s *Sampler) poll() {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(s.cfg.pollingInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.update(); err != nil {
+			select {
+			case <-s.stopCh:
+				// The fetch was canceled by Close.
+				return
+			default:
+				otel.Handle(err)
+			}
+		}
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update fetches the strategies and replaces the sampler if they changed.
+func (s *Sampler) update() error {
+	strategy, err := s.fetch()
+	if err != nil {
+		return fmt.Errorf("jaegerremote: failed to fetch sampling strategy: %w", err)
+	}
+
+	s.mu.RLock()
+	unchanged := reflect.DeepEqual(strategy, s.strategy)
+	s.mu.RUnlock()
+	if unchanged {
+		// Keep the state of the rate limiters.
+		return nil
+	}
+
+	sampler, err := strategy.newSampler(s.cfg.maxOperations)
+	if err != nil {
+		return fmt.Errorf("jaegerremote: invalid sampling strategy: %w", err)
+	}
+	s.mu.Lock()
+	s.sampler = sampler
+	s.strategy = strategy
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Sampler) fetch() (*strategyResponse, error) {
+	u, err := url.Parse(s.cfg.samplingServerURL)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("service", s.serviceName)
+	u.RawQuery = q.Encode()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.cfg.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, body)
+	}
+
+	strategy := &strategyResponse{}
+	if err := json.Unmarshal(body, strategy); err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
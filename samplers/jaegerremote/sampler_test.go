@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerremote
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newStrategyServer(t *testing.T, body *atomic.Value) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-service", r.URL.Query().Get("service"))
+		_, _ = w.Write([]byte(body.Load().(string)))
+	}))
+}
+
+func params(name string) sdktrace.SamplingParameters {
+	return sdktrace.SamplingParameters{
+		ParentContext: context.Background(),
+		TraceID:       trace.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		Name:          name,
+	}
+}
+
+func TestSamplerUpdates(t *testing.T) {
+	var body atomic.Value
+	body.Store(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":1}}`)
+	srv := newStrategyServer(t, &body)
+	defer srv.Close()
+
+	s := New("test-service",
+		WithSamplingServerURL(srv.URL),
+		WithPollingInterval(10*time.Millisecond),
+		WithInitialSampler(sdktrace.NeverSample()),
+	)
+	defer s.Close()
+
+	assert.Eventually(t, func() bool {
+		return s.ShouldSample(params("op")).Decision == sdktrace.RecordAndSample
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, "JaegerRemoteSampler{AlwaysOnSampler}", s.Description())
+
+	body.Store(`{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0}}`)
+	assert.Eventually(t, func() bool {
+		return s.ShouldSample(params("op")).Decision == sdktrace.Drop
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSamplerFetchError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	s := New("test-service",
+		WithSamplingServerURL(srv.URL),
+		WithPollingInterval(time.Hour),
+		WithInitialSampler(sdktrace.AlwaysSample()),
+	)
+	defer s.Close()
+
+	assert.Error(t, s.update())
+	assert.Equal(t, sdktrace.RecordAndSample, s.ShouldSample(params("op")).Decision)
+}
+
+func TestStrategyResponseUnmarshal(t *testing.T) {
+	for _, body := range []string{
+		`{"strategyType":"RATE_LIMITING","rateLimitingSampling":{"maxTracesPerSecond":2}}`,
+		`{"strategyType":1,"rateLimitingSampling":{"maxTracesPerSecond":2}}`,
+	} {
+		r := &strategyResponse{}
+		require.NoError(t, json.Unmarshal([]byte(body), r))
+		s, err := r.newSampler(defaultMaxOperations)
+		require.NoError(t, err)
+		assert.Equal(t, "RateLimitingSampler{2}", s.Description())
+	}
+
+	var st strategyType
+	assert.Error(t, st.UnmarshalJSON([]byte(`"UNKNOWN"`)))
+	assert.Error(t, st.UnmarshalJSON([]byte(`7`)))
+	assert.Error(t, st.UnmarshalJSON([]byte(`{}`)))
+}
+
+func TestPerOperationStrategy(t *testing.T) {
+	r := &strategyResponse{
+		StrategyType: probabilistic,
+		OperationSampling: &operationStrategies{
+			DefaultSamplingProbability:       0,
+			DefaultLowerBoundTracesPerSecond: 1,
+			PerOperationStrategies: []operationStrategy{
+				{Operation: "always", ProbabilisticSampling: probabilisticStrategy{SamplingRate: 1}},
+				{Operation: "ignored", ProbabilisticSampling: probabilisticStrategy{SamplingRate: 1}},
+			},
+		},
+	}
+	s, err := r.newSampler(1)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, sdktrace.RecordAndSample, s.ShouldSample(params("always")).Decision)
+	}
+	// Operations without a strategy are only sampled by the lower bound.
+	assert.Equal(t, sdktrace.RecordAndSample, s.ShouldSample(params("ignored")).Decision)
+	assert.Equal(t, sdktrace.Drop, s.ShouldSample(params("ignored")).Decision)
+	assert.Equal(t, sdktrace.Drop, s.ShouldSample(params("other")).Decision)
+}
+
+func TestMissingStrategy(t *testing.T) {
+	_, err := (&strategyResponse{StrategyType: probabilistic}).newSampler(defaultMaxOperations)
+	assert.Error(t, err)
+	_, err = (&strategyResponse{StrategyType: rateLimiting}).newSampler(defaultMaxOperations)
+	assert.Error(t, err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(2)
+	assert.True(t, rl.allow())
+	assert.True(t, rl.allow())
+	assert.False(t, rl.allow())
+
+	rl.mu.Lock()
+	rl.last = rl.last.Add(-time.Second)
+	rl.mu.Unlock()
+	assert.True(t, rl.allow())
+
+	assert.False(t, newRateLimiter(0).allow())
+}
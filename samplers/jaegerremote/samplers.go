@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerremote // import "go.opentelemetry.io/otel/samplers/jaegerremote"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// rateLimiter is a token bucket allowing up to rate operations per second
+// with bursts of at most max(1, rate) operations.
+type rateLimiter struct {
+	rate float64
+	max  float64
+
+	mu      sync.Mutex
+	balance float64
+	last    time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	max := rate
+	if max < 1 {
+		max = 1
+	}
+	return &rateLimiter{rate: rate, max: max, balance: max, last: time.Now()}
+}
+
+// allow reports whether an operation is allowed now and, if so, consumes
+// the credit for it.
+func (rl *rateLimiter) allow() bool {
+	if rl.rate <= 0 {
+		return false
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.balance += now.Sub(rl.last).Seconds() * rl.rate
+	rl.last = now
+	if rl.balance > rl.max {
+		rl.balance = rl.max
+	}
+	if rl.balance < 1 {
+		return false
+	}
+	rl.balance--
+	return true
+}
+
+func result(p sdktrace.SamplingParameters, d sdktrace.SamplingDecision) sdktrace.SamplingResult {
+	return sdktrace.SamplingResult{
+		Decision:   d,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+// rateLimitingSampler samples at most a fixed number of traces per second.
+type rateLimitingSampler struct {
+	limiter *rateLimiter
+}
+
+func newRateLimitingSampler(maxTracesPerSecond float64) *rateLimitingSampler {
+	return &rateLimitingSampler{limiter: newRateLimiter(maxTracesPerSecond)}
+}
+
+func (s *rateLimitingSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if s.limiter.allow() {
+		return result(p, sdktrace.RecordAndSample)
+	}
+	return result(p, sdktrace.Drop)
+}
+
+func (s *rateLimitingSampler) Description() string {
+	return fmt.Sprintf("RateLimitingSampler{%g}", s.limiter.rate)
+}
+
+// guaranteedThroughputSampler samples traces with a probability and
+// additionally samples traces not sampled by it up to a lower bound number
+// of traces per second.
+type guaranteedThroughputSampler struct {
+	probabilistic sdktrace.Sampler
+	lowerBound    *rateLimiter
+}
+
+func newGuaranteedThroughputSampler(samplingRate, lowerBound float64) *guaranteedThroughputSampler {
+	return &guaranteedThroughputSampler{
+		probabilistic: sdktrace.TraceIDRatioBased(samplingRate),
+		lowerBound:    newRateLimiter(lowerBound),
+	}
+}
+
+func (s *guaranteedThroughputSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	r := s.probabilistic.ShouldSample(p)
+	if r.Decision == sdktrace.RecordAndSample || !s.lowerBound.allow() {
+		return r
+	}
+	return result(p, sdktrace.RecordAndSample)
+}
+
+func (s *guaranteedThroughputSampler) Description() string {
+	return fmt.Sprintf("GuaranteedThroughputSampler{%s,lowerBound:%g}", s.probabilistic.Description(), s.lowerBound.rate)
+}
+
+// perOperationSampler delegates to a dedicated sampler for each known
+// operation, the span name, and to a default sampler for the others.
+type perOperationSampler struct {
+	operations     map[string]sdktrace.Sampler
+	defaultSampler sdktrace.Sampler
+}
+
+func (s *perOperationSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if opSampler, ok := s.operations[p.Name]; ok {
+		return opSampler.ShouldSample(p)
+	}
+	return s.defaultSampler.ShouldSample(p)
+}
+
+func (s *perOperationSampler) Description() string {
+	return fmt.Sprintf("PerOperationSampler{operations:%d,default:%s}", len(s.operations), s.defaultSampler.Description())
+}
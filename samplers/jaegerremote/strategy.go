@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerremote // import "go.opentelemetry.io/otel/samplers/jaegerremote"
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// strategyType is the kind of sampling strategy served for a service. It is
+// encoded either by name or by its Thrift enum value depending on the
+// version of the sampling endpoint.
+type strategyType int
+
+const (
+	probabilistic strategyType = iota
+	rateLimiting
+)
+
+func (t *strategyType) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		switch name {
+		case "PROBABILISTIC":
+			*t = probabilistic
+		case "RATE_LIMITING":
+			*t = rateLimiting
+		default:
+			return fmt.Errorf("unknown sampling strategy type: %q", name)
+		}
+		return nil
+	}
+
+	var n int
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("invalid sampling strategy type: %s", b)
+	}
+	if n != int(probabilistic) && n != int(rateLimiting) {
+		return fmt.Errorf("unknown sampling strategy type: %d", n)
+	}
+	*t = strategyType(n)
+	return nil
+}
+
+// strategyResponse is the response of a Jaeger sampling endpoint.
+type strategyResponse struct {
+	StrategyType          strategyType           `json:"strategyType"`
+	ProbabilisticSampling *probabilisticStrategy `json:"probabilisticSampling,omitempty"`
+	RateLimitingSampling  *rateLimitingStrategy  `json:"rateLimitingSampling,omitempty"`
+	OperationSampling     *operationStrategies   `json:"operationSampling,omitempty"`
+}
+
+type probabilisticStrategy struct {
+	SamplingRate float64 `json:"samplingRate"`
+}
+
+type rateLimitingStrategy struct {
+	MaxTracesPerSecond float64 `json:"maxTracesPerSecond"`
+}
+
+type operationStrategies struct {
+	DefaultSamplingProbability       float64             `json:"defaultSamplingProbability"`
+	DefaultLowerBoundTracesPerSecond float64             `json:"defaultLowerBoundTracesPerSecond"`
+	PerOperationStrategies           []operationStrategy `json:"perOperationStrategies"`
+}
+
+type operationStrategy struct {
+	Operation             string                `json:"operation"`
+	ProbabilisticSampling probabilisticStrategy `json:"probabilisticSampling"`
+}
+
+// newSampler returns the sampler applying the strategy of r. Per-operation
+// strategies take precedence over the service wide strategy.
+func (r *strategyResponse) newSampler(maxOperations int) (sdktrace.Sampler, error) {
+	if opSampling := r.OperationSampling; opSampling != nil {
+		ops := make(map[string]sdktrace.Sampler)
+		for _, s := range opSampling.PerOperationStrategies {
+			if maxOperations >= 0 && len(ops) >= maxOperations {
+				break
+			}
+			ops[s.Operation] = newGuaranteedThroughputSampler(s.ProbabilisticSampling.SamplingRate, opSampling.DefaultLowerBoundTracesPerSecond)
+		}
+		return &perOperationSampler{
+			operations: ops,
+			defaultSampler: newGuaranteedThroughputSampler(
+				opSampling.DefaultSamplingProbability,
+				opSampling.DefaultLowerBoundTracesPerSecond,
+			),
+		}, nil
+	}
+
+	switch r.StrategyType {
+	case probabilistic:
+		if r.ProbabilisticSampling == nil {
+			return nil, fmt.Errorf("missing probabilistic sampling strategy")
+		}
+		return sdktrace.TraceIDRatioBased(r.ProbabilisticSampling.SamplingRate), nil
+	case rateLimiting:
+		if r.RateLimitingSampling == nil {
+			return nil, fmt.Errorf("missing rate limiting sampling strategy")
+		}
+		return newRateLimitingSampler(r.RateLimitingSampling.MaxTracesPerSecond), nil
+	}
+	return nil, fmt.Errorf("unknown sampling strategy type: %d", r.StrategyType)
+}
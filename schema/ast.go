@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema // import "go.opentelemetry.io/otel/schema"
+
+// Schema is the content of a schema file.
+type Schema struct {
+	// FileFormat is the version of the schema file format.
+	FileFormat string `yaml:"file_format"`
+	// SchemaURL is the schema URL of the latest version described by the
+	// file.
+	SchemaURL string `yaml:"schema_url"`
+	// Versions maps the versions of the schema to their changes from the
+	// previous version.
+	Versions map[string]VersionDef `yaml:"versions"`
+}
+
+// VersionDef contains the changes introduced by a version of a schema.
+type VersionDef struct {
+	// All contains changes that apply to all telemetry.
+	All Attributes `yaml:"all"`
+	// Resources contains changes that apply to resources.
+	Resources Attributes `yaml:"resources"`
+	// Spans contains changes that apply to spans.
+	Spans Spans `yaml:"spans"`
+	// Metrics contains changes that apply to metrics.
+	Metrics Metrics `yaml:"metrics"`
+}
+
+// Attributes is a section containing attribute changes.
+type Attributes struct {
+	Changes []AttributesChange `yaml:"changes"`
+}
+
+// AttributesChange is a single attribute change.
+type AttributesChange struct {
+	RenameAttributes *RenameAttributes `yaml:"rename_attributes"`
+}
+
+// RenameAttributes renames attributes.
+type RenameAttributes struct {
+	// AttributeMap maps the previous names of the attributes to their new
+	// names.
+	AttributeMap map[string]string `yaml:"attribute_map"`
+}
+
+// Spans is a section containing span changes.
+type Spans struct {
+	Changes []SpansChange `yaml:"changes"`
+}
+
+// SpansChange is a single span change.
+type SpansChange struct {
+	RenameAttributes *RenameSpanAttributes `yaml:"rename_attributes"`
+}
+
+// RenameSpanAttributes renames span attributes.
+type RenameSpanAttributes struct {
+	// AttributeMap maps the previous names of the attributes to their new
+	// names.
+	AttributeMap map[string]string `yaml:"attribute_map"`
+	// ApplyToSpans limits the change to the spans with these names. The
+	// change applies to all spans if it is empty.
+	ApplyToSpans []string `yaml:"apply_to_spans"`
+}
+
+// Metrics is a section containing metric changes.
+type Metrics struct {
+	Changes []MetricsChange `yaml:"changes"`
+}
+
+// MetricsChange is a single metric change. Only one of its fields is set.
+type MetricsChange struct {
+	// RenameMetrics maps the previous names of metrics to their new names.
+	RenameMetrics    map[string]string       `yaml:"rename_metrics"`
+	RenameAttributes *RenameMetricAttributes `yaml:"rename_attributes"`
+}
+
+// RenameMetricAttributes renames metric attributes.
+type RenameMetricAttributes struct {
+	// AttributeMap maps the previous names of the attributes to their new
+	// names.
+	AttributeMap map[string]string `yaml:"attribute_map"`
+	// ApplyToMetrics limits the change to the metrics with these names.
+	// The change applies to all metrics if it is empty.
+	ApplyToMetrics []string `yaml:"apply_to_metrics"`
+}
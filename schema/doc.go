@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package schema parses OpenTelemetry schema files and translates telemetry
+between the versions of a schema.
+
+A schema file describes the changes made to the semantic conventions between
+versions, such as renamed attributes and metrics. Telemetry produced for one
+version of a schema can be translated to any other version described by the
+same file:
+
+	s, err := schema.ParseFile("1.2.0.yaml")
+	if err != nil {
+		return err
+	}
+	t, err := s.NewTranslator(
+		"https://opentelemetry.io/schemas/1.0.0",
+		"https://opentelemetry.io/schemas/1.2.0",
+	)
+	if err != nil {
+		return err
+	}
+	attrs = t.Resource(attrs)
+
+Only file format 1.0.0 is supported. The changes of the "all", "resources",
+"spans" and "metrics" sections are applied, other sections are ignored.
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+*/
+package schema // import "go.opentelemetry.io/otel/schema"
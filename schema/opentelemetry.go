@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema // import "go.opentelemetry.io/otel/schema"
+
+// OpenTelemetrySchemaURL is the schema URL of the latest version of the
+// OpenTelemetry semantic conventions described by OpenTelemetry.
+const OpenTelemetrySchemaURL = "https://opentelemetry.io/schemas/1.8.0"
+
+// OpenTelemetry returns the schema of the OpenTelemetry semantic
+// conventions, from version 1.4.0 to the version of
+// OpenTelemetrySchemaURL. Its versions match the SchemaURL of the
+// go.opentelemetry.io/otel/semconv packages, such as
+// go.opentelemetry.io/otel/semconv/v1.4.0, so a Translator upgrades the
+// attributes produced by a library using an older package to the names of
+// a newer one:
+//
+//	t, err := schema.OpenTelemetry().NewTranslator(semconv14.SchemaURL, semconv18.SchemaURL)
+//	if err != nil {
+//		return err
+//	}
+//	attrs = t.Span(name, attrs)
+//
+// A new Schema is returned by each call, it can be modified by the caller.
+func OpenTelemetry() *Schema {
+	return &Schema{
+		FileFormat: "1.0.0",
+		SchemaURL:  OpenTelemetrySchemaURL,
+		Versions: map[string]VersionDef{
+			"1.8.0": {
+				Spans: Spans{
+					Changes: []SpansChange{
+						{
+							RenameAttributes: &RenameSpanAttributes{
+								AttributeMap: map[string]string{
+									"db.cassandra.keyspace": "db.name",
+									"db.hbase.namespace":    "db.name",
+								},
+							},
+						},
+					},
+				},
+			},
+			"1.7.0": {},
+			"1.6.1": {},
+			"1.5.0": {},
+			"1.4.0": {},
+		},
+	}
+}
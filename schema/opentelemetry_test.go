@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv14 "go.opentelemetry.io/otel/semconv/v1.4.0"
+	semconv17 "go.opentelemetry.io/otel/semconv/v1.7.0"
+	semconv18 "go.opentelemetry.io/otel/semconv/v1.8.0"
+)
+
+func TestOpenTelemetryIsValid(t *testing.T) {
+	s := OpenTelemetry()
+	require.NoError(t, s.validate())
+	assert.Equal(t, semconv18.SchemaURL, s.SchemaURL)
+	for _, u := range []string{semconv14.SchemaURL, semconv17.SchemaURL, semconv18.SchemaURL} {
+		_, err := s.NewTranslator(u, OpenTelemetrySchemaURL)
+		assert.NoError(t, err, u)
+	}
+}
+
+func TestOpenTelemetryTranslator(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		semconv14.DBSystemCassandra,
+		semconv14.DBCassandraKeyspaceKey.String("mykeyspace"),
+	}
+
+	up, err := OpenTelemetry().NewTranslator(semconv14.SchemaURL, semconv18.SchemaURL)
+	require.NoError(t, err)
+	upgraded := up.Span("SELECT mykeyspace.users", attrs)
+	assert.Equal(t, []attribute.KeyValue{
+		semconv18.DBSystemCassandra,
+		semconv18.DBNameKey.String("mykeyspace"),
+	}, upgraded)
+
+	same, err := OpenTelemetry().NewTranslator(semconv14.SchemaURL, semconv17.SchemaURL)
+	require.NoError(t, err)
+	assert.Equal(t, attrs, same.Span("SELECT mykeyspace.users", attrs))
+
+	// Both db.cassandra.keyspace and db.hbase.namespace were renamed to
+	// db.name, which is left unchanged when downgrading.
+	down, err := OpenTelemetry().NewTranslator(semconv18.SchemaURL, semconv17.SchemaURL)
+	require.NoError(t, err)
+	assert.Equal(t, upgraded, down.Span("SELECT mykeyspace.users", upgraded))
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema // import "go.opentelemetry.io/otel/schema"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// supportedFileFormat is the latest schema file format that can be parsed.
+var supportedFileFormat = version{major: 1, minor: 0, patch: 0}
+
+// ErrUnsupportedFileFormat is returned when parsing a schema file with a
+// file format that is not supported.
+var ErrUnsupportedFileFormat = errors.New("unsupported schema file format")
+
+// ParseFile parses the schema file at path.
+func ParseFile(path string) (*Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// schemaFile is the decoded content of a schema file. Versions without
+// changes are decoded as nil VersionDefs, Schema would drop them.
+type schemaFile struct {
+	FileFormat string                 `yaml:"file_format"`
+	SchemaURL  string                 `yaml:"schema_url"`
+	Versions   map[string]*VersionDef `yaml:"versions"`
+}
+
+// Parse parses a schema file read from r and validates its content.
+func Parse(r io.Reader) (*Schema, error) {
+	var f schemaFile
+	if err := yaml.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("parsing schema file: %w", err)
+	}
+
+	s := &Schema{
+		FileFormat: f.FileFormat,
+		SchemaURL:  f.SchemaURL,
+		Versions:   make(map[string]VersionDef, len(f.Versions)),
+	}
+	for v, def := range f.Versions {
+		if def == nil {
+			def = &VersionDef{}
+		}
+		s.Versions[v] = *def
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// validate returns an error if s is not a valid schema.
+func (s *Schema) validate() error {
+	format, err := parseVersion(s.FileFormat)
+	if err != nil {
+		return fmt.Errorf("invalid file_format: %w", err)
+	}
+	if format.major != supportedFileFormat.major || format.compare(supportedFileFormat) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFileFormat, s.FileFormat)
+	}
+
+	_, latest, err := splitURL(s.SchemaURL)
+	if err != nil {
+		return err
+	}
+	if _, ok := s.Versions[latest.String()]; !ok {
+		return fmt.Errorf("version %s of schema_url %q is not defined", latest, s.SchemaURL)
+	}
+
+	for name, def := range s.Versions {
+		v, err := parseVersion(name)
+		if err != nil {
+			return err
+		}
+		if v.compare(latest) > 0 {
+			return fmt.Errorf("version %s is newer than schema_url %q", name, s.SchemaURL)
+		}
+		for _, c := range def.Metrics.Changes {
+			if c.RenameMetrics != nil && c.RenameAttributes != nil {
+				return fmt.Errorf("version %s: metric change renames both metrics and attributes", name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFile(t *testing.T) {
+	s, err := ParseFile("testdata/valid-example.yaml")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.0.0", s.FileFormat)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.2.0", s.SchemaURL)
+	assert.Len(t, s.Versions, 3)
+	assert.Equal(t, VersionDef{
+		All: Attributes{Changes: []AttributesChange{{
+			RenameAttributes: &RenameAttributes{
+				AttributeMap: map[string]string{"k8s.cluster.name": "kubernetes.cluster.name"},
+			},
+		}}},
+		Resources: Attributes{Changes: []AttributesChange{{
+			RenameAttributes: &RenameAttributes{
+				AttributeMap: map[string]string{"browser.user_agent": "user_agent.original"},
+			},
+		}}},
+		Spans: Spans{Changes: []SpansChange{{
+			RenameAttributes: &RenameSpanAttributes{
+				AttributeMap: map[string]string{"peer.service": "peer.service.name"},
+				ApplyToSpans: []string{"HTTP GET"},
+			},
+		}}},
+		Metrics: Metrics{Changes: []MetricsChange{
+			{RenameMetrics: map[string]string{"container.cpu.usage.total": "cpu.usage.total"}},
+			{RenameAttributes: &RenameMetricAttributes{
+				AttributeMap:   map[string]string{"status": "state"},
+				ApplyToMetrics: []string{"cpu.usage.total"},
+			}},
+		}},
+	}, s.Versions["1.2.0"])
+	assert.Equal(t, VersionDef{}, s.Versions["1.0.0"])
+}
+
+func TestParseFileUnsupportedFileFormat(t *testing.T) {
+	_, err := ParseFile("testdata/unsupported-file-format.yaml")
+	assert.ErrorIs(t, err, ErrUnsupportedFileFormat)
+}
+
+func TestParseFileNotFound(t *testing.T) {
+	_, err := ParseFile("testdata/does-not-exist.yaml")
+	assert.Error(t, err)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		errMsg string
+	}{
+		{
+			name:   "not yaml",
+			schema: "{",
+			errMsg: "parsing schema file",
+		},
+		{
+			name:   "invalid file format",
+			schema: "file_format: 1\nschema_url: https://opentelemetry.io/schemas/1.0.0\nversions:\n  1.0.0:\n",
+			errMsg: `invalid file_format: invalid version "1"`,
+		},
+		{
+			name:   "relative schema URL",
+			schema: "file_format: 1.0.0\nschema_url: /schemas/1.0.0\nversions:\n  1.0.0:\n",
+			errMsg: `invalid schema URL "/schemas/1.0.0": not absolute`,
+		},
+		{
+			name:   "schema URL without version",
+			schema: "file_format: 1.0.0\nschema_url: https://opentelemetry.io/schemas/latest\nversions:\n  1.0.0:\n",
+			errMsg: `invalid schema URL "https://opentelemetry.io/schemas/latest": invalid version "latest"`,
+		},
+		{
+			name:   "schema URL version not defined",
+			schema: "file_format: 1.0.0\nschema_url: https://opentelemetry.io/schemas/1.1.0\nversions:\n  1.0.0:\n",
+			errMsg: `version 1.1.0 of schema_url "https://opentelemetry.io/schemas/1.1.0" is not defined`,
+		},
+		{
+			name:   "invalid version",
+			schema: "file_format: 1.0.0\nschema_url: https://opentelemetry.io/schemas/1.0.0\nversions:\n  1.0.0:\n  v1:\n",
+			errMsg: `invalid version "v1"`,
+		},
+		{
+			name:   "newer version",
+			schema: "file_format: 1.0.0\nschema_url: https://opentelemetry.io/schemas/1.0.0\nversions:\n  1.0.0:\n  1.0.1:\n",
+			errMsg: `version 1.0.1 is newer than schema_url "https://opentelemetry.io/schemas/1.0.0"`,
+		},
+		{
+			name: "ambiguous metric change",
+			schema: `file_format: 1.0.0
+schema_url: https://opentelemetry.io/schemas/1.0.0
+versions:
+  1.0.0:
+    metrics:
+      changes:
+        - rename_metrics:
+            a: b
+          rename_attributes:
+            attribute_map:
+              c: d
+`,
+			errMsg: "version 1.0.0: metric change renames both metrics and attributes",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(test.schema))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errMsg)
+		})
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	v, err := parseVersion("1.20.3")
+	require.NoError(t, err)
+	assert.Equal(t, version{major: 1, minor: 20, patch: 3}, v)
+	assert.Equal(t, "1.20.3", v.String())
+
+	for _, s := range []string{"", "1", "1.2", "1.2.3.4", "1.2.x", "1.-2.3", "01.2.3"} {
+		_, err := parseVersion(s)
+		assert.Errorf(t, err, "parseVersion(%q)", s)
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	v := func(s string) version {
+		v, err := parseVersion(s)
+		require.NoError(t, err)
+		return v
+	}
+	assert.Equal(t, 0, v("1.2.3").compare(v("1.2.3")))
+	assert.Equal(t, -1, v("1.2.3").compare(v("1.2.4")))
+	assert.Equal(t, -1, v("1.2.3").compare(v("1.10.0")))
+	assert.Equal(t, 1, v("2.0.0").compare(v("1.10.10")))
+}
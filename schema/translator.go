@@ -0,0 +1,283 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema // import "go.opentelemetry.io/otel/schema"
+
+import (
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// section identifies the telemetry a change applies to.
+type section int
+
+const (
+	sectionAll section = iota
+	sectionResources
+	sectionSpans
+	sectionMetrics
+)
+
+// change is a single change of a schema, already inverted when translating
+// to an older version.
+type change struct {
+	section section
+	// attributes maps attribute names to their names after the change.
+	attributes map[attribute.Key]attribute.Key
+	// metrics maps metric names to their names after the change.
+	metrics map[string]string
+	// applyTo limits the change to the spans or metrics with these names.
+	// The change applies to all of them if it is nil.
+	applyTo map[string]struct{}
+}
+
+func (c change) appliesTo(name string) bool {
+	if c.applyTo == nil {
+		return true
+	}
+	_, ok := c.applyTo[name]
+	return ok
+}
+
+// Translator translates telemetry from one version of a schema to another.
+type Translator struct {
+	changes []change
+}
+
+// NewTranslator returns a Translator from the version of the schema at
+// fromURL to the version at toURL. Both versions must be defined by s and
+// the URLs must belong to the same schema family as s.SchemaURL.
+func (s *Schema) NewTranslator(fromURL, toURL string) (*Translator, error) {
+	family, _, err := splitURL(s.SchemaURL)
+	if err != nil {
+		return nil, err
+	}
+	fromFamily, from, err := splitURL(fromURL)
+	if err != nil {
+		return nil, err
+	}
+	toFamily, to, err := splitURL(toURL)
+	if err != nil {
+		return nil, err
+	}
+	if fromFamily != family || toFamily != family {
+		return nil, fmt.Errorf("schema URLs %q and %q do not belong to %q", fromURL, toURL, family)
+	}
+	for _, v := range []version{from, to} {
+		if _, ok := s.Versions[v.String()]; !ok {
+			return nil, fmt.Errorf("version %s is not defined by %q", v, s.SchemaURL)
+		}
+	}
+
+	versions := make([]version, 0, len(s.Versions))
+	for name := range s.Versions {
+		// The versions were validated when parsing.
+		v, _ := parseVersion(name)
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].compare(versions[j]) < 0 })
+
+	t := &Translator{}
+	switch from.compare(to) {
+	case -1:
+		// Upgrade by applying the changes of (from, to] in order.
+		for _, v := range versions {
+			if v.compare(from) > 0 && v.compare(to) <= 0 {
+				t.changes = append(t.changes, versionChanges(s.Versions[v.String()])...)
+			}
+		}
+	case 1:
+		// Downgrade by reverting the changes of (to, from] in reverse order.
+		for i := len(versions) - 1; i >= 0; i-- {
+			v := versions[i]
+			if v.compare(to) > 0 && v.compare(from) <= 0 {
+				changes := versionChanges(s.Versions[v.String()])
+				for j := len(changes) - 1; j >= 0; j-- {
+					t.changes = append(t.changes, changes[j].invert())
+				}
+			}
+		}
+	}
+	return t, nil
+}
+
+// versionChanges returns the changes of def in the order they are applied.
+func versionChanges(def VersionDef) []change {
+	var changes []change
+	for _, c := range def.All.Changes {
+		if c.RenameAttributes != nil {
+			changes = append(changes, change{
+				section:    sectionAll,
+				attributes: attributeMap(c.RenameAttributes.AttributeMap),
+			})
+		}
+	}
+	for _, c := range def.Resources.Changes {
+		if c.RenameAttributes != nil {
+			changes = append(changes, change{
+				section:    sectionResources,
+				attributes: attributeMap(c.RenameAttributes.AttributeMap),
+			})
+		}
+	}
+	for _, c := range def.Spans.Changes {
+		if c.RenameAttributes != nil {
+			changes = append(changes, change{
+				section:    sectionSpans,
+				attributes: attributeMap(c.RenameAttributes.AttributeMap),
+				applyTo:    nameSet(c.RenameAttributes.ApplyToSpans),
+			})
+		}
+	}
+	for _, c := range def.Metrics.Changes {
+		switch {
+		case c.RenameMetrics != nil:
+			changes = append(changes, change{
+				section: sectionMetrics,
+				metrics: c.RenameMetrics,
+			})
+		case c.RenameAttributes != nil:
+			changes = append(changes, change{
+				section:    sectionMetrics,
+				attributes: attributeMap(c.RenameAttributes.AttributeMap),
+				applyTo:    nameSet(c.RenameAttributes.ApplyToMetrics),
+			})
+		}
+	}
+	return changes
+}
+
+// invert returns the change that reverts c. The names c applies to are
+// the names before c was applied, which are the names after the inverted
+// change is applied, so they are left unchanged. A name several names were
+// renamed to cannot be reverted unambiguously, it is left unchanged too.
+func (c change) invert() change {
+	inv := change{section: c.section, applyTo: c.applyTo}
+	if c.attributes != nil {
+		inv.attributes = make(map[attribute.Key]attribute.Key, len(c.attributes))
+		merged := make(map[attribute.Key]bool)
+		for from, to := range c.attributes {
+			if _, ok := inv.attributes[to]; ok {
+				merged[to] = true
+			}
+			inv.attributes[to] = from
+		}
+		for to := range merged {
+			delete(inv.attributes, to)
+		}
+	}
+	if c.metrics != nil {
+		inv.metrics = make(map[string]string, len(c.metrics))
+		merged := make(map[string]bool)
+		for from, to := range c.metrics {
+			if _, ok := inv.metrics[to]; ok {
+				merged[to] = true
+			}
+			inv.metrics[to] = from
+		}
+		for to := range merged {
+			delete(inv.metrics, to)
+		}
+	}
+	return inv
+}
+
+func attributeMap(m map[string]string) map[attribute.Key]attribute.Key {
+	attrs := make(map[attribute.Key]attribute.Key, len(m))
+	for from, to := range m {
+		attrs[attribute.Key(from)] = attribute.Key(to)
+	}
+	return attrs
+}
+
+func nameSet(names []string) map[string]struct{} {
+	if len(names) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		set[n] = struct{}{}
+	}
+	return set
+}
+
+// renameAttributes returns attrs with the keys renamed by m. The attrs
+// slice is copied before it is modified.
+func renameAttributes(attrs []attribute.KeyValue, m map[attribute.Key]attribute.Key) []attribute.KeyValue {
+	var renamed []attribute.KeyValue
+	for i, kv := range attrs {
+		to, ok := m[kv.Key]
+		if !ok {
+			continue
+		}
+		if renamed == nil {
+			renamed = make([]attribute.KeyValue, len(attrs))
+			copy(renamed, attrs)
+		}
+		renamed[i].Key = to
+	}
+	if renamed == nil {
+		return attrs
+	}
+	return renamed
+}
+
+// Resource returns the resource attributes attrs translated to the target
+// version.
+func (t *Translator) Resource(attrs []attribute.KeyValue) []attribute.KeyValue {
+	for _, c := range t.changes {
+		if c.section == sectionAll || c.section == sectionResources {
+			attrs = renameAttributes(attrs, c.attributes)
+		}
+	}
+	return attrs
+}
+
+// Span returns the attributes attrs of the span named name translated to
+// the target version.
+func (t *Translator) Span(name string, attrs []attribute.KeyValue) []attribute.KeyValue {
+	for _, c := range t.changes {
+		if c.section == sectionAll || (c.section == sectionSpans && c.appliesTo(name)) {
+			attrs = renameAttributes(attrs, c.attributes)
+		}
+	}
+	return attrs
+}
+
+// Metric returns the name and attributes attrs of a metric translated to
+// the target version.
+func (t *Translator) Metric(name string, attrs []attribute.KeyValue) (string, []attribute.KeyValue) {
+	for _, c := range t.changes {
+		switch {
+		case c.section == sectionAll:
+			attrs = renameAttributes(attrs, c.attributes)
+		case c.section != sectionMetrics:
+		case c.metrics != nil:
+			if to, ok := c.metrics[name]; ok {
+				name = to
+			}
+		case c.appliesTo(name):
+			attrs = renameAttributes(attrs, c.attributes)
+		}
+	}
+	return name, attrs
+}
+
+// MetricName returns the metric name translated to the target version.
+func (t *Translator) MetricName(name string) string {
+	name, _ = t.Metric(name, nil)
+	return name
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	schema100 = "https://opentelemetry.io/schemas/1.0.0"
+	schema110 = "https://opentelemetry.io/schemas/1.1.0"
+	schema120 = "https://opentelemetry.io/schemas/1.2.0"
+)
+
+func newTranslator(t *testing.T, from, to string) *Translator {
+	s, err := ParseFile("testdata/valid-example.yaml")
+	require.NoError(t, err)
+	tr, err := s.NewTranslator(from, to)
+	require.NoError(t, err)
+	return tr
+}
+
+func TestNewTranslatorErrors(t *testing.T) {
+	s, err := ParseFile("testdata/valid-example.yaml")
+	require.NoError(t, err)
+
+	_, err = s.NewTranslator("https://example.com/schemas/1.0.0", schema120)
+	assert.EqualError(t, err, `schema URLs "https://example.com/schemas/1.0.0" and "https://opentelemetry.io/schemas/1.2.0" do not belong to "https://opentelemetry.io/schemas"`)
+
+	_, err = s.NewTranslator(schema100, "https://opentelemetry.io/schemas/1.3.0")
+	assert.EqualError(t, err, `version 1.3.0 is not defined by "https://opentelemetry.io/schemas/1.2.0"`)
+
+	_, err = s.NewTranslator(schema100, "not a url")
+	assert.Error(t, err)
+}
+
+func TestTranslatorResource(t *testing.T) {
+	v100 := []attribute.KeyValue{
+		attribute.String("k8s.cluster.name", "c"),
+		attribute.String("browser.user_agent", "ua"),
+		attribute.String("telemetry.auto.version", "1"),
+		attribute.String("service.name", "svc"),
+	}
+	v120 := []attribute.KeyValue{
+		attribute.String("kubernetes.cluster.name", "c"),
+		attribute.String("user_agent.original", "ua"),
+		attribute.String("telemetry.auto_version", "1"),
+		attribute.String("service.name", "svc"),
+	}
+
+	assert.Equal(t, v120, newTranslator(t, schema100, schema120).Resource(v100))
+	assert.Equal(t, v100, newTranslator(t, schema120, schema100).Resource(v120))
+	assert.Equal(t, v100, newTranslator(t, schema100, schema100).Resource(v100))
+
+	// Only the changes of 1.2.0 are reverted.
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("k8s.cluster.name", "c"),
+		attribute.String("browser.user_agent", "ua"),
+		attribute.String("telemetry.auto_version", "1"),
+		attribute.String("service.name", "svc"),
+	}, newTranslator(t, schema120, schema110).Resource(v120))
+}
+
+func TestTranslatorDoesNotModifyInput(t *testing.T) {
+	attrs := []attribute.KeyValue{attribute.String("k8s.cluster.name", "c")}
+	newTranslator(t, schema100, schema120).Resource(attrs)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("k8s.cluster.name", "c")}, attrs)
+}
+
+func TestTranslatorSpan(t *testing.T) {
+	tr := newTranslator(t, schema100, schema120)
+	attrs := []attribute.KeyValue{
+		attribute.String("k8s.cluster.name", "c"),
+		attribute.String("peer.service", "p"),
+		attribute.String("browser.user_agent", "ua"),
+	}
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("kubernetes.cluster.name", "c"),
+		attribute.String("peer.service.name", "p"),
+		attribute.String("browser.user_agent", "ua"),
+	}, tr.Span("HTTP GET", attrs))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("kubernetes.cluster.name", "c"),
+		attribute.String("peer.service", "p"),
+		attribute.String("browser.user_agent", "ua"),
+	}, tr.Span("HTTP POST", attrs))
+}
+
+func TestTranslatorMetric(t *testing.T) {
+	up := newTranslator(t, schema100, schema120)
+	name, attrs := up.Metric("container.cpu.usage.total", []attribute.KeyValue{
+		attribute.String("status", "idle"),
+		attribute.String("k8s.cluster.name", "c"),
+	})
+	assert.Equal(t, "cpu.usage.total", name)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("state", "idle"),
+		attribute.String("kubernetes.cluster.name", "c"),
+	}, attrs)
+
+	down := newTranslator(t, schema120, schema100)
+	name, attrs = down.Metric(name, attrs)
+	assert.Equal(t, "container.cpu.usage.total", name)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("status", "idle"),
+		attribute.String("k8s.cluster.name", "c"),
+	}, attrs)
+
+	_, attrs = up.Metric("memory.usage", []attribute.KeyValue{attribute.String("status", "idle")})
+	assert.Equal(t, []attribute.KeyValue{attribute.String("status", "idle")}, attrs)
+
+	assert.Equal(t, "cpu.usage.total", up.MetricName("container.cpu.usage.total"))
+	assert.Equal(t, "memory.usage", up.MetricName("memory.usage"))
+}
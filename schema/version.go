@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema // import "go.opentelemetry.io/otel/schema"
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// version is a semantic version without pre-release or build metadata.
+type version struct {
+	major, minor, patch int
+}
+
+// parseVersion parses a major.minor.patch version.
+func parseVersion(s string) (version, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return version{}, fmt.Errorf("invalid version %q", s)
+	}
+	var v [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || p != strconv.Itoa(n) {
+			return version{}, fmt.Errorf("invalid version %q", s)
+		}
+		v[i] = n
+	}
+	return version{major: v[0], minor: v[1], patch: v[2]}, nil
+}
+
+// compare returns -1, 0 or 1 if v is less than, equal to or greater than o.
+func (v version) compare(o version) int {
+	switch {
+	case v.major != o.major:
+		return compareInt(v.major, o.major)
+	case v.minor != o.minor:
+		return compareInt(v.minor, o.minor)
+	default:
+		return compareInt(v.patch, o.patch)
+	}
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (v version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+// splitURL splits a schema URL into the schema family, the URL without the
+// last path segment, and the version in the last path segment.
+func splitURL(schemaURL string) (string, version, error) {
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return "", version{}, fmt.Errorf("invalid schema URL %q: %w", schemaURL, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", version{}, fmt.Errorf("invalid schema URL %q: not absolute", schemaURL)
+	}
+	i := strings.LastIndex(schemaURL, "/")
+	v, err := parseVersion(schemaURL[i+1:])
+	if err != nil {
+		return "", version{}, fmt.Errorf("invalid schema URL %q: %w", schemaURL, err)
+	}
+	return schemaURL[:i], v, nil
+}
@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the root of a declarative SDK configuration.
+type Config struct {
+	// FileFormat is the version of the configuration file format.
+	FileFormat string `yaml:"file_format"`
+
+	// Disabled, when true, results in an SDK that creates no-op
+	// TracerProvider and MeterProvider.
+	Disabled bool `yaml:"disabled"`
+
+	// Resource describes the entity producing telemetry.
+	Resource *Resource `yaml:"resource"`
+
+	// TracerProvider configures the TracerProvider. If nil, a no-op
+	// TracerProvider is used.
+	TracerProvider *TracerProvider `yaml:"tracer_provider"`
+
+	// MeterProvider configures the MeterProvider. If nil, a no-op
+	// MeterProvider is used.
+	MeterProvider *MeterProvider `yaml:"meter_provider"`
+}
+
+// Resource configures the resource associated with all telemetry.
+type Resource struct {
+	// Attributes are the resource attributes. Values must be strings,
+	// booleans, integers, floating point numbers or lists of one of these.
+	Attributes map[string]interface{} `yaml:"attributes"`
+}
+
+// TracerProvider configures a TracerProvider.
+type TracerProvider struct {
+	// Processors are the span processors to register, in order.
+	Processors []SpanProcessor `yaml:"processors"`
+
+	// Limits configures the span limits.
+	Limits *SpanLimits `yaml:"limits"`
+
+	// Sampler configures the sampler. The SDK default is used if nil.
+	Sampler *Sampler `yaml:"sampler"`
+}
+
+// SpanProcessor configures a span processor. Exactly one field must be set.
+type SpanProcessor struct {
+	Batch  *BatchSpanProcessor  `yaml:"batch"`
+	Simple *SimpleSpanProcessor `yaml:"simple"`
+}
+
+// BatchSpanProcessor configures a batch span processor. Unset values use
+// the SDK defaults.
+type BatchSpanProcessor struct {
+	// ScheduleDelay is the delay between two consecutive exports, in
+	// milliseconds.
+	ScheduleDelay *int `yaml:"schedule_delay"`
+
+	// ExportTimeout is the maximum time an export may take, in
+	// milliseconds.
+	ExportTimeout *int `yaml:"export_timeout"`
+
+	// MaxQueueSize is the maximum number of spans kept in the queue.
+	MaxQueueSize *int `yaml:"max_queue_size"`
+
+	// MaxExportBatchSize is the maximum number of spans in one export.
+	MaxExportBatchSize *int `yaml:"max_export_batch_size"`
+
+	// Exporter is the exporter spans are sent to.
+	Exporter Exporter `yaml:"exporter"`
+}
+
+// SimpleSpanProcessor configures a span processor that exports each span
+// synchronously as it ends.
+type SimpleSpanProcessor struct {
+	// Exporter is the exporter spans are sent to.
+	Exporter Exporter `yaml:"exporter"`
+}
+
+// SpanLimits configures the limits applied to spans. Unset values use the
+// SDK defaults.
+type SpanLimits struct {
+	AttributeCountLimit       *int `yaml:"attribute_count_limit"`
+	AttributeValueLengthLimit *int `yaml:"attribute_value_length_limit"`
+	EventCountLimit           *int `yaml:"event_count_limit"`
+	LinkCountLimit            *int `yaml:"link_count_limit"`
+	EventAttributeCountLimit  *int `yaml:"event_attribute_count_limit"`
+	LinkAttributeCountLimit   *int `yaml:"link_attribute_count_limit"`
+}
+
+// Sampler configures a sampler. Exactly one field must be set.
+type Sampler struct {
+	AlwaysOn          *struct{}          `yaml:"always_on"`
+	AlwaysOff         *struct{}          `yaml:"always_off"`
+	TraceIDRatioBased *TraceIDRatioBased `yaml:"trace_id_ratio_based"`
+	ParentBased       *ParentBased       `yaml:"parent_based"`
+}
+
+// TraceIDRatioBased configures a sampler that samples a fraction of traces.
+type TraceIDRatioBased struct {
+	// Ratio is the fraction of traces sampled, between 0 and 1.
+	Ratio float64 `yaml:"ratio"`
+}
+
+// ParentBased configures a sampler that follows the sampling decision of
+// the parent span. Root spans are sampled by Root, which defaults to
+// always_on. The remaining samplers default to those of the SDK.
+type ParentBased struct {
+	Root                   *Sampler `yaml:"root"`
+	RemoteParentSampled    *Sampler `yaml:"remote_parent_sampled"`
+	RemoteParentNotSampled *Sampler `yaml:"remote_parent_not_sampled"`
+	LocalParentSampled     *Sampler `yaml:"local_parent_sampled"`
+	LocalParentNotSampled  *Sampler `yaml:"local_parent_not_sampled"`
+}
+
+// MeterProvider configures a MeterProvider.
+type MeterProvider struct {
+	// Readers are the metric readers. At most one reader is currently
+	// supported.
+	Readers []MetricReader `yaml:"readers"`
+}
+
+// MetricReader configures a metric reader. Exactly one field must be set.
+type MetricReader struct {
+	Periodic *PeriodicMetricReader `yaml:"periodic"`
+}
+
+// PeriodicMetricReader configures a reader that collects and exports
+// metrics at a fixed interval. Unset values use the SDK defaults.
+type PeriodicMetricReader struct {
+	// Interval is the delay between two consecutive exports, in
+	// milliseconds.
+	Interval *int `yaml:"interval"`
+
+	// Timeout is the maximum time an export may take, in milliseconds.
+	Timeout *int `yaml:"timeout"`
+
+	// Exporter is the exporter metrics are sent to.
+	Exporter Exporter `yaml:"exporter"`
+}
+
+// Exporter configures an exporter. Exactly one field must be set.
+type Exporter struct {
+	OTLP    *OTLP    `yaml:"otlp"`
+	Console *Console `yaml:"console"`
+}
+
+// OTLP configures an OTLP exporter.
+type OTLP struct {
+	// Protocol is the transport protocol, either "grpc" (the default) or
+	// "http/protobuf".
+	Protocol string `yaml:"protocol"`
+
+	// Endpoint is the address of the receiver. A "http://" scheme makes
+	// the connection insecure.
+	Endpoint string `yaml:"endpoint"`
+
+	// Insecure disables client transport security.
+	Insecure bool `yaml:"insecure"`
+
+	// Headers are sent with every export request.
+	Headers map[string]string `yaml:"headers"`
+
+	// Compression is either "gzip" or "none" (the default).
+	Compression string `yaml:"compression"`
+
+	// Timeout is the maximum time an export request may take, in
+	// milliseconds.
+	Timeout *int `yaml:"timeout"`
+}
+
+// Console configures an exporter that writes telemetry to stdout.
+type Console struct {
+	// PrettyPrint enables indented output.
+	PrettyPrint bool `yaml:"pretty_print"`
+}
+
+var envRefRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// expandEnv replaces ${NAME} and ${NAME:-default} references with the value
+// of the NAME environment variable.
+func expandEnv(data []byte) []byte {
+	return envRefRegexp.ReplaceAllFunc(data, func(ref []byte) []byte {
+		m := envRefRegexp.FindSubmatch(ref)
+		if v, ok := os.LookupEnv(string(m[1])); ok && v != "" {
+			return []byte(v)
+		}
+		return m[3]
+	})
+}
+
+// Parse parses a YAML or JSON configuration. Environment variable
+// references are expanded before parsing, and unknown fields are reported
+// as errors.
+func Parse(data []byte) (*Config, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(expandEnv(data)))
+	dec.KnownFields(true)
+
+	cfg := &Config{}
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// ParseFile parses the YAML or JSON configuration file at path.
+func ParseFile(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/config"
+)
+
+const yamlConfig = `
+file_format: "0.1"
+resource:
+  attributes:
+    service.name: ${SERVICE_NAME}
+    deployment.environment: ${DEPLOYMENT_ENV:-production}
+tracer_provider:
+  limits:
+    attribute_count_limit: 64
+  sampler:
+    parent_based:
+      root:
+        trace_id_ratio_based:
+          ratio: 0.5
+  processors:
+    - batch:
+        schedule_delay: 1000
+        max_queue_size: 100
+        exporter:
+          otlp:
+            protocol: http/protobuf
+            endpoint: http://localhost:4318
+            headers:
+              api-key: secret
+meter_provider:
+  readers:
+    - periodic:
+        interval: 30000
+        exporter:
+          console: {}
+`
+
+func TestParse(t *testing.T) {
+	require.NoError(t, os.Setenv("SERVICE_NAME", "checkout"))
+	defer os.Unsetenv("SERVICE_NAME")
+
+	cfg, err := config.Parse([]byte(yamlConfig))
+	require.NoError(t, err)
+
+	assert.Equal(t, "0.1", cfg.FileFormat)
+	assert.Equal(t, map[string]interface{}{
+		"service.name":           "checkout",
+		"deployment.environment": "production",
+	}, cfg.Resource.Attributes)
+
+	tp := cfg.TracerProvider
+	require.NotNil(t, tp)
+	assert.Equal(t, 64, *tp.Limits.AttributeCountLimit)
+	assert.Nil(t, tp.Limits.EventCountLimit)
+	assert.Equal(t, 0.5, tp.Sampler.ParentBased.Root.TraceIDRatioBased.Ratio)
+	require.Len(t, tp.Processors, 1)
+	batch := tp.Processors[0].Batch
+	require.NotNil(t, batch)
+	assert.Equal(t, 1000, *batch.ScheduleDelay)
+	assert.Equal(t, 100, *batch.MaxQueueSize)
+	assert.Equal(t, &config.OTLP{
+		Protocol: "http/protobuf",
+		Endpoint: "http://localhost:4318",
+		Headers:  map[string]string{"api-key": "secret"},
+	}, batch.Exporter.OTLP)
+
+	mp := cfg.MeterProvider
+	require.NotNil(t, mp)
+	require.Len(t, mp.Readers, 1)
+	assert.Equal(t, 30000, *mp.Readers[0].Periodic.Interval)
+	assert.NotNil(t, mp.Readers[0].Periodic.Exporter.Console)
+}
+
+func TestParseJSON(t *testing.T) {
+	cfg, err := config.Parse([]byte(`{
+		"file_format": "0.1",
+		"tracer_provider": {
+			"sampler": {"always_off": {}},
+			"processors": [{"simple": {"exporter": {"console": {}}}}]
+		}
+	}`))
+	require.NoError(t, err)
+	require.NotNil(t, cfg.TracerProvider)
+	assert.NotNil(t, cfg.TracerProvider.Sampler.AlwaysOff)
+	require.Len(t, cfg.TracerProvider.Processors, 1)
+	assert.NotNil(t, cfg.TracerProvider.Processors[0].Simple.Exporter.Console)
+}
+
+func TestParseEmpty(t *testing.T) {
+	cfg, err := config.Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &config.Config{}, cfg)
+}
+
+func TestParseUnknownField(t *testing.T) {
+	_, err := config.Parse([]byte("tracer_provider:\n  sampler:\n    always_sometimes: {}\n"))
+	assert.Error(t, err)
+}
+
+func TestParseFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "otel.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("disabled: true\n"), 0600))
+
+	cfg, err := config.ParseFile(path)
+	require.NoError(t, err)
+	assert.True(t, cfg.Disabled)
+
+	_, err = config.ParseFile(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
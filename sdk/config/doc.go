@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config builds an OpenTelemetry SDK from a declarative
+// configuration file.
+//
+// The configuration file is YAML (JSON documents are also accepted as they
+// are valid YAML) describing the resource, the TracerProvider and the
+// MeterProvider to construct. For example:
+//
+//	file_format: "0.1"
+//	resource:
+//	  attributes:
+//	    service.name: checkout
+//	tracer_provider:
+//	  sampler:
+//	    parent_based:
+//	      root:
+//	        trace_id_ratio_based:
+//	          ratio: 0.25
+//	  processors:
+//	    - batch:
+//	        schedule_delay: 5000
+//	        exporter:
+//	          otlp:
+//	            protocol: grpc
+//	            endpoint: http://collector:4317
+//	            headers:
+//	              api-key: ${API_KEY}
+//	meter_provider:
+//	  readers:
+//	    - periodic:
+//	        interval: 60000
+//	        exporter:
+//	          console: {}
+//
+// All durations are expressed in milliseconds. References of the form
+// ${NAME} or ${NAME:-default} are replaced with the value of the NAME
+// environment variable before the file is parsed.
+//
+// NewSDKFromEnv reads the file named by the OTEL_EXPERIMENTAL_CONFIG_FILE
+// environment variable, allowing the telemetry setup of a service to be
+// standardized without code changes.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package config // import "go.opentelemetry.io/otel/sdk/config"
@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// metricExporter is a metric exporter that holds resources released on
+// shutdown.
+type metricExporter interface {
+	export.Exporter
+	Shutdown(context.Context) error
+}
+
+var errExporterNotSet = errors.New("config: exactly one exporter must be set")
+
+func newSpanExporter(ctx context.Context, cfg Exporter) (sdktrace.SpanExporter, error) {
+	switch {
+	case cfg.OTLP != nil && cfg.Console == nil:
+		return newOTLPExporter(ctx, cfg.OTLP)
+	case cfg.Console != nil && cfg.OTLP == nil:
+		opts := []stdouttrace.Option{stdouttrace.WithWriter(os.Stdout)}
+		if cfg.Console.PrettyPrint {
+			opts = append(opts, stdouttrace.WithPrettyPrint())
+		}
+		return stdouttrace.NewExporter(opts...)
+	}
+	return nil, errExporterNotSet
+}
+
+func newMetricExporter(ctx context.Context, cfg Exporter) (metricExporter, error) {
+	switch {
+	case cfg.OTLP != nil && cfg.Console == nil:
+		return newOTLPExporter(ctx, cfg.OTLP)
+	case cfg.Console != nil && cfg.OTLP == nil:
+		opts := []stdoutmetric.Option{stdoutmetric.WithWriter(os.Stdout)}
+		if cfg.Console.PrettyPrint {
+			opts = append(opts, stdoutmetric.WithPrettyPrint())
+		}
+		return stdoutmetric.NewExporter(opts...)
+	}
+	return nil, errExporterNotSet
+}
+
+func newOTLPExporter(ctx context.Context, cfg *OTLP) (*otlp.Exporter, error) {
+	var driver otlp.ProtocolDriver
+	switch cfg.Protocol {
+	case "", "grpc":
+		driver = otlpgrpc.NewDriver(otlpGRPCOptions(cfg)...)
+	case "http/protobuf":
+		driver = otlphttp.NewDriver(otlpHTTPOptions(cfg)...)
+	default:
+		return nil, fmt.Errorf("config: unsupported otlp protocol %q", cfg.Protocol)
+	}
+	return otlp.NewExporter(ctx, driver)
+}
+
+// parseEndpoint splits an endpoint in its host and path, reporting whether
+// the endpoint uses the insecure "http" scheme. Endpoints without a scheme
+// are returned unchanged.
+func parseEndpoint(endpoint string) (host, path string, insecure bool) {
+	if !strings.Contains(endpoint, "://") {
+		return endpoint, "", false
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint, "", false
+	}
+	return u.Host, strings.TrimSuffix(u.Path, "/"), strings.EqualFold(u.Scheme, "http")
+}
+
+func otlpGRPCOptions(cfg *OTLP) []otlpgrpc.Option {
+	var opts []otlpgrpc.Option
+	host, _, insecure := parseEndpoint(cfg.Endpoint)
+	if host != "" {
+		opts = append(opts, otlpgrpc.WithEndpoint(host))
+	}
+	if insecure || cfg.Insecure {
+		opts = append(opts, otlpgrpc.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlpgrpc.WithHeaders(cfg.Headers))
+	}
+	if cfg.Compression != "" {
+		opts = append(opts, otlpgrpc.WithCompressor(cfg.Compression))
+	}
+	if cfg.Timeout != nil {
+		opts = append(opts, otlpgrpc.WithTimeout(millis(*cfg.Timeout)))
+	}
+	return opts
+}
+
+func otlpHTTPOptions(cfg *OTLP) []otlphttp.Option {
+	var opts []otlphttp.Option
+	host, path, insecure := parseEndpoint(cfg.Endpoint)
+	if host != "" {
+		opts = append(opts, otlphttp.WithEndpoint(host))
+	}
+	if path != "" {
+		opts = append(opts,
+			otlphttp.WithTracesURLPath(path+"/v1/traces"),
+			otlphttp.WithMetricsURLPath(path+"/v1/metrics"),
+		)
+	}
+	if insecure || cfg.Insecure {
+		opts = append(opts, otlphttp.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlphttp.WithHeaders(cfg.Headers))
+	}
+	if cfg.Compression == "gzip" {
+		opts = append(opts, otlphttp.WithCompression(otlp.GzipCompression))
+	}
+	if cfg.Timeout != nil {
+		opts = append(opts, otlphttp.WithTimeout(millis(*cfg.Timeout)))
+	}
+	return opts
+}
+
+func millis(ms int) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"context"
+	"errors"
+
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	errMetricReaderNotSet = errors.New("config: exactly one metric reader must be set")
+	errTooManyReaders     = errors.New("config: at most one metric reader is supported")
+)
+
+// newController returns a started controller for the configured reader and
+// the exporter it pushes to. A nil controller is returned if no reader is
+// configured.
+func newController(ctx context.Context, cfg *MeterProvider, res *resource.Resource) (*controller.Controller, metricExporter, error) {
+	if len(cfg.Readers) == 0 {
+		return nil, nil, nil
+	}
+	if len(cfg.Readers) > 1 {
+		return nil, nil, errTooManyReaders
+	}
+
+	periodic := cfg.Readers[0].Periodic
+	if periodic == nil {
+		return nil, nil, errMetricReaderNotSet
+	}
+
+	exp, err := newMetricExporter(ctx, periodic.Exporter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := []controller.Option{
+		controller.WithExporter(exp),
+		controller.WithResource(res),
+	}
+	if periodic.Interval != nil {
+		opts = append(opts, controller.WithCollectPeriod(millis(*periodic.Interval)))
+	}
+	if periodic.Timeout != nil {
+		opts = append(opts, controller.WithPushTimeout(millis(*periodic.Timeout)))
+	}
+
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), exp),
+		opts...,
+	)
+	if err := cont.Start(ctx); err != nil {
+		_ = exp.Shutdown(ctx)
+		return nil, nil, err
+	}
+	return cont, exp, nil
+}
@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConfigFileEnvKey is the environment variable naming the configuration
+// file used by NewSDKFromEnv.
+const ConfigFileEnvKey = "OTEL_EXPERIMENTAL_CONFIG_FILE"
+
+// ErrNoConfigFile is returned by NewSDKFromEnv when the ConfigFileEnvKey
+// environment variable is not set.
+var ErrNoConfigFile = errors.New("config: " + ConfigFileEnvKey + " is not set")
+
+// SDK holds the providers built from a Config.
+type SDK struct {
+	tracerProvider *sdktrace.TracerProvider
+	controller     *controller.Controller
+	metricExporter metricExporter
+}
+
+// NewSDK builds the TracerProvider and MeterProvider described by cfg. The
+// metric pipeline, if any, is started. It is the responsibility of the
+// caller to call Shutdown on the returned SDK.
+func NewSDK(ctx context.Context, cfg *Config) (*SDK, error) {
+	sdk := &SDK{}
+	if cfg == nil || cfg.Disabled {
+		return sdk, nil
+	}
+
+	res, err := newResource(cfg.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.TracerProvider != nil {
+		sdk.tracerProvider, err = newTracerProvider(ctx, cfg.TracerProvider, res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.MeterProvider != nil {
+		sdk.controller, sdk.metricExporter, err = newController(ctx, cfg.MeterProvider, res)
+		if err != nil {
+			_ = sdk.Shutdown(ctx)
+			return nil, err
+		}
+	}
+	return sdk, nil
+}
+
+// NewSDKFromEnv parses the configuration file named by the
+// OTEL_EXPERIMENTAL_CONFIG_FILE environment variable and builds an SDK from
+// it. ErrNoConfigFile is returned if the variable is not set.
+func NewSDKFromEnv(ctx context.Context) (*SDK, error) {
+	path := os.Getenv(ConfigFileEnvKey)
+	if path == "" {
+		return nil, ErrNoConfigFile
+	}
+	cfg, err := ParseFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewSDK(ctx, cfg)
+}
+
+// TracerProvider returns the configured TracerProvider, or a no-op
+// TracerProvider if none was configured.
+func (s *SDK) TracerProvider() trace.TracerProvider {
+	if s.tracerProvider == nil {
+		return trace.NewNoopTracerProvider()
+	}
+	return s.tracerProvider
+}
+
+// MeterProvider returns the configured MeterProvider, or a no-op
+// MeterProvider if none was configured.
+func (s *SDK) MeterProvider() metric.MeterProvider {
+	if s.controller == nil {
+		return metric.NoopMeterProvider{}
+	}
+	return s.controller.MeterProvider()
+}
+
+// Shutdown flushes and shuts down the configured providers and their
+// exporters.
+func (s *SDK) Shutdown(ctx context.Context) error {
+	var errs []error
+	if s.tracerProvider != nil {
+		if err := s.tracerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if s.controller != nil {
+		if err := s.controller.Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+		if err := s.metricExporter.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return fmt.Errorf("config: failed to shutdown SDK: %v", errs)
+	}
+}
+
+// newResource returns the default resource merged with the configured
+// attributes.
+func newResource(cfg *Resource) (*resource.Resource, error) {
+	if cfg == nil {
+		return resource.Default(), nil
+	}
+
+	keys := make([]string, 0, len(cfg.Attributes))
+	for k := range cfg.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]attribute.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		kv, err := keyValue(k, cfg.Attributes[k])
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, kv)
+	}
+	return resource.Merge(resource.Default(), resource.NewWithAttributes(attrs...)), nil
+}
+
+func keyValue(k string, v interface{}) (attribute.KeyValue, error) {
+	switch val := v.(type) {
+	case string:
+		return attribute.String(k, val), nil
+	case bool:
+		return attribute.Bool(k, val), nil
+	case int:
+		return attribute.Int64(k, int64(val)), nil
+	case float64:
+		return attribute.Float64(k, val), nil
+	case []interface{}:
+		return arrayKeyValue(k, val)
+	}
+	return attribute.KeyValue{}, fmt.Errorf("config: unsupported value for resource attribute %q: %v", k, v)
+}
+
+// arrayKeyValue converts a list of values of the same type to an array
+// attribute.
+func arrayKeyValue(k string, vals []interface{}) (attribute.KeyValue, error) {
+	if len(vals) == 0 {
+		return attribute.Array(k, []string{}), nil
+	}
+
+	var (
+		strs   []string
+		bools  []bool
+		ints   []int64
+		floats []float64
+	)
+	for _, v := range vals {
+		switch val := v.(type) {
+		case string:
+			strs = append(strs, val)
+		case bool:
+			bools = append(bools, val)
+		case int:
+			ints = append(ints, int64(val))
+		case float64:
+			floats = append(floats, val)
+		default:
+			return attribute.KeyValue{}, fmt.Errorf("config: unsupported value for resource attribute %q: %v", k, v)
+		}
+	}
+
+	switch len(vals) {
+	case len(strs):
+		return attribute.Array(k, strs), nil
+	case len(bools):
+		return attribute.Array(k, bools), nil
+	case len(ints):
+		return attribute.Array(k, ints), nil
+	case len(floats):
+		return attribute.Array(k, floats), nil
+	}
+	return attribute.KeyValue{}, fmt.Errorf("config: resource attribute %q mixes value types", k)
+}
@@ -0,0 +1,281 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestNewSDKDisabled(t *testing.T) {
+	ctx := context.Background()
+	sdk, err := NewSDK(ctx, &Config{
+		Disabled:       true,
+		TracerProvider: &TracerProvider{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, trace.NewNoopTracerProvider(), sdk.TracerProvider())
+	assert.Equal(t, metric.NoopMeterProvider{}, sdk.MeterProvider())
+	assert.NoError(t, sdk.Shutdown(ctx))
+}
+
+// captureStdout redirects os.Stdout, which console exporters write to, to a
+// temporary file. The returned function restores os.Stdout and returns
+// what was written.
+func captureStdout(t *testing.T) func() string {
+	f, err := ioutil.TempFile("", "stdout")
+	require.NoError(t, err)
+	orig := os.Stdout
+	os.Stdout = f
+	return func() string {
+		os.Stdout = orig
+		defer os.Remove(f.Name())
+		require.NoError(t, f.Close())
+		data, err := ioutil.ReadFile(f.Name())
+		require.NoError(t, err)
+		return string(data)
+	}
+}
+
+func TestNewSDK(t *testing.T) {
+	ctx := context.Background()
+	restore := captureStdout(t)
+	sdk, err := NewSDK(ctx, &Config{
+		Resource: &Resource{Attributes: map[string]interface{}{
+			"service.name": "checkout",
+			"replicas":     3,
+			"zones":        []interface{}{"a", "b"},
+		}},
+		TracerProvider: &TracerProvider{
+			Sampler: &Sampler{AlwaysOn: &struct{}{}},
+			Processors: []SpanProcessor{
+				{Batch: &BatchSpanProcessor{Exporter: Exporter{Console: &Console{}}}},
+			},
+		},
+		MeterProvider: &MeterProvider{
+			Readers: []MetricReader{
+				{Periodic: &PeriodicMetricReader{Interval: intPtr(60000), Exporter: Exporter{Console: &Console{}}}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, span := sdk.TracerProvider().Tracer("test").Start(ctx, "span")
+	assert.True(t, span.SpanContext().IsSampled())
+	span.End()
+
+	assert.IsType(t, &sdktrace.TracerProvider{}, sdk.TracerProvider())
+	assert.NotEqual(t, metric.NoopMeterProvider{}, sdk.MeterProvider())
+	assert.True(t, sdk.controller.IsRunning())
+	assert.NoError(t, sdk.Shutdown(ctx))
+	assert.False(t, sdk.controller.IsRunning())
+	assert.Contains(t, restore(), `"Name":"span"`)
+}
+
+func TestNewSDKErrors(t *testing.T) {
+	console := Exporter{Console: &Console{}}
+	for _, tc := range []struct {
+		name string
+		cfg  *Config
+	}{
+		{
+			name: "no exporter",
+			cfg: &Config{TracerProvider: &TracerProvider{
+				Processors: []SpanProcessor{{Simple: &SimpleSpanProcessor{}}},
+			}},
+		},
+		{
+			name: "two exporters",
+			cfg: &Config{TracerProvider: &TracerProvider{
+				Processors: []SpanProcessor{{Simple: &SimpleSpanProcessor{
+					Exporter: Exporter{Console: &Console{}, OTLP: &OTLP{}},
+				}}},
+			}},
+		},
+		{
+			name: "no processor",
+			cfg: &Config{TracerProvider: &TracerProvider{
+				Processors: []SpanProcessor{{}},
+			}},
+		},
+		{
+			name: "unknown protocol",
+			cfg: &Config{TracerProvider: &TracerProvider{
+				Processors: []SpanProcessor{{Simple: &SimpleSpanProcessor{
+					Exporter: Exporter{OTLP: &OTLP{Protocol: "http/xml"}},
+				}}},
+			}},
+		},
+		{
+			name: "two samplers",
+			cfg: &Config{TracerProvider: &TracerProvider{
+				Sampler: &Sampler{AlwaysOn: &struct{}{}, AlwaysOff: &struct{}{}},
+			}},
+		},
+		{
+			name: "invalid ratio",
+			cfg: &Config{TracerProvider: &TracerProvider{
+				Sampler: &Sampler{TraceIDRatioBased: &TraceIDRatioBased{Ratio: 2}},
+			}},
+		},
+		{
+			name: "invalid resource attribute",
+			cfg: &Config{Resource: &Resource{Attributes: map[string]interface{}{
+				"nested": map[string]interface{}{"a": "b"},
+			}}},
+		},
+		{
+			name: "mixed resource array",
+			cfg: &Config{Resource: &Resource{Attributes: map[string]interface{}{
+				"mixed": []interface{}{"a", 1},
+			}}},
+		},
+		{
+			name: "two readers",
+			cfg: &Config{MeterProvider: &MeterProvider{Readers: []MetricReader{
+				{Periodic: &PeriodicMetricReader{Exporter: console}},
+				{Periodic: &PeriodicMetricReader{Exporter: console}},
+			}}},
+		},
+		{
+			name: "no reader",
+			cfg:  &Config{MeterProvider: &MeterProvider{Readers: []MetricReader{{}}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewSDK(context.Background(), tc.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewSDKFromEnv(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, os.Unsetenv(ConfigFileEnvKey))
+	_, err := NewSDKFromEnv(ctx)
+	assert.ErrorIs(t, err, ErrNoConfigFile)
+
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "otel.yaml")
+	data := `
+tracer_provider:
+  sampler:
+    always_off: {}
+  processors:
+    - simple:
+        exporter:
+          console: {}
+`
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+
+	require.NoError(t, os.Setenv(ConfigFileEnvKey, path))
+	defer os.Unsetenv(ConfigFileEnvKey)
+
+	sdk, err := NewSDKFromEnv(ctx)
+	require.NoError(t, err)
+	_, span := sdk.TracerProvider().Tracer("test").Start(ctx, "span")
+	assert.False(t, span.SpanContext().IsSampled())
+	span.End()
+	assert.NoError(t, sdk.Shutdown(ctx))
+}
+
+func TestNewResource(t *testing.T) {
+	res, err := newResource(&Resource{Attributes: map[string]interface{}{
+		"service.name": "checkout",
+		"enabled":      true,
+		"replicas":     3,
+		"load":         0.5,
+		"ports":        []interface{}{80, 443},
+	}})
+	require.NoError(t, err)
+
+	got := map[attribute.Key]attribute.Value{}
+	for _, kv := range res.Attributes() {
+		got[kv.Key] = kv.Value
+	}
+	assert.Equal(t, "checkout", got["service.name"].AsString())
+	assert.Equal(t, true, got["enabled"].AsBool())
+	assert.Equal(t, int64(3), got["replicas"].AsInt64())
+	assert.Equal(t, 0.5, got["load"].AsFloat64())
+	assert.Equal(t, [2]int64{80, 443}, got["ports"].AsArray())
+	assert.Contains(t, got, attribute.Key("telemetry.sdk.name"))
+}
+
+func TestNewSampler(t *testing.T) {
+	sampler, err := newSampler(&Sampler{ParentBased: &ParentBased{
+		Root:                &Sampler{TraceIDRatioBased: &TraceIDRatioBased{Ratio: 0.25}},
+		RemoteParentSampled: &Sampler{AlwaysOff: &struct{}{}},
+	}})
+	require.NoError(t, err)
+	want := sdktrace.ParentBased(
+		sdktrace.TraceIDRatioBased(0.25),
+		sdktrace.WithRemoteParentSampled(sdktrace.NeverSample()),
+	)
+	assert.Equal(t, want.Description(), sampler.Description())
+
+	sampler, err = newSampler(&Sampler{ParentBased: &ParentBased{}})
+	require.NoError(t, err)
+	assert.Equal(t, sdktrace.ParentBased(sdktrace.AlwaysSample()).Description(), sampler.Description())
+
+	_, err = newSampler(&Sampler{ParentBased: &ParentBased{Root: &Sampler{}}})
+	assert.Error(t, err)
+}
+
+func TestSpanLimits(t *testing.T) {
+	assert.Equal(t, sdktrace.SpanLimits{
+		AttributeCountLimit:        10,
+		AttributeValueLengthLimit:  256,
+		AttributePerLinkCountLimit: 2,
+	}, spanLimits(&SpanLimits{
+		AttributeCountLimit:       intPtr(10),
+		AttributeValueLengthLimit: intPtr(256),
+		LinkAttributeCountLimit:   intPtr(2),
+	}))
+}
+
+func TestParseEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		endpoint string
+		host     string
+		path     string
+		insecure bool
+	}{
+		{"localhost:4317", "localhost:4317", "", false},
+		{"http://localhost:4318", "localhost:4318", "", true},
+		{"https://collector:4318/otlp/", "collector:4318", "/otlp", false},
+		{"HTTP://collector", "collector", "", true},
+	} {
+		host, path, insecure := parseEndpoint(tc.endpoint)
+		assert.Equal(t, tc.host, host, tc.endpoint)
+		assert.Equal(t, tc.path, path, tc.endpoint)
+		assert.Equal(t, tc.insecure, insecure, tc.endpoint)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var (
+	errSpanProcessorNotSet = errors.New("config: exactly one span processor must be set")
+	errSamplerNotSet       = errors.New("config: exactly one sampler must be set")
+	errInvalidRatio        = errors.New("config: trace_id_ratio_based ratio must be between 0 and 1")
+)
+
+func newTracerProvider(ctx context.Context, cfg *TracerProvider, res *resource.Resource) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+
+	if cfg.Sampler != nil {
+		sampler, err := newSampler(cfg.Sampler)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithSampler(sampler))
+	}
+
+	if cfg.Limits != nil {
+		opts = append(opts, sdktrace.WithSpanLimits(spanLimits(cfg.Limits)))
+	}
+
+	var processors []sdktrace.SpanProcessor
+	for _, pCfg := range cfg.Processors {
+		sp, err := newSpanProcessor(ctx, pCfg)
+		if err != nil {
+			for _, p := range processors {
+				_ = p.Shutdown(ctx)
+			}
+			return nil, err
+		}
+		processors = append(processors, sp)
+	}
+	for _, sp := range processors {
+		opts = append(opts, sdktrace.WithSpanProcessor(sp))
+	}
+
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+func newSpanProcessor(ctx context.Context, cfg SpanProcessor) (sdktrace.SpanProcessor, error) {
+	switch {
+	case cfg.Batch != nil && cfg.Simple == nil:
+		exp, err := newSpanExporter(ctx, cfg.Batch.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		return sdktrace.NewBatchSpanProcessor(exp, batchOptions(cfg.Batch)...), nil
+	case cfg.Simple != nil && cfg.Batch == nil:
+		exp, err := newSpanExporter(ctx, cfg.Simple.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		return sdktrace.NewSimpleSpanProcessor(exp), nil
+	}
+	return nil, errSpanProcessorNotSet
+}
+
+func batchOptions(cfg *BatchSpanProcessor) []sdktrace.BatchSpanProcessorOption {
+	var opts []sdktrace.BatchSpanProcessorOption
+	if cfg.ScheduleDelay != nil {
+		opts = append(opts, sdktrace.WithBatchTimeout(millis(*cfg.ScheduleDelay)))
+	}
+	if cfg.ExportTimeout != nil {
+		opts = append(opts, sdktrace.WithExportTimeout(millis(*cfg.ExportTimeout)))
+	}
+	if cfg.MaxQueueSize != nil {
+		opts = append(opts, sdktrace.WithMaxQueueSize(*cfg.MaxQueueSize))
+	}
+	if cfg.MaxExportBatchSize != nil {
+		opts = append(opts, sdktrace.WithMaxExportBatchSize(*cfg.MaxExportBatchSize))
+	}
+	return opts
+}
+
+// spanLimits returns the configured limits. Unset limits are left zero so
+// the SDK defaults are used.
+func spanLimits(cfg *SpanLimits) sdktrace.SpanLimits {
+	var sl sdktrace.SpanLimits
+	set := func(dst *int, src *int) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	set(&sl.AttributeCountLimit, cfg.AttributeCountLimit)
+	set(&sl.AttributeValueLengthLimit, cfg.AttributeValueLengthLimit)
+	set(&sl.EventCountLimit, cfg.EventCountLimit)
+	set(&sl.LinkCountLimit, cfg.LinkCountLimit)
+	set(&sl.AttributePerEventCountLimit, cfg.EventAttributeCountLimit)
+	set(&sl.AttributePerLinkCountLimit, cfg.LinkAttributeCountLimit)
+	return sl
+}
+
+func newSampler(cfg *Sampler) (sdktrace.Sampler, error) {
+	var (
+		sampler sdktrace.Sampler
+		n       int
+	)
+	if cfg.AlwaysOn != nil {
+		sampler = sdktrace.AlwaysSample()
+		n++
+	}
+	if cfg.AlwaysOff != nil {
+		sampler = sdktrace.NeverSample()
+		n++
+	}
+	if cfg.TraceIDRatioBased != nil {
+		r := cfg.TraceIDRatioBased.Ratio
+		if r < 0 || r > 1 {
+			return nil, errInvalidRatio
+		}
+		sampler = sdktrace.TraceIDRatioBased(r)
+		n++
+	}
+	if cfg.ParentBased != nil {
+		var err error
+		sampler, err = newParentBased(cfg.ParentBased)
+		if err != nil {
+			return nil, err
+		}
+		n++
+	}
+	if n != 1 {
+		return nil, errSamplerNotSet
+	}
+	return sampler, nil
+}
+
+func newParentBased(cfg *ParentBased) (sdktrace.Sampler, error) {
+	root := sdktrace.AlwaysSample()
+	if cfg.Root != nil {
+		var err error
+		if root, err = newSampler(cfg.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	var opts []sdktrace.ParentBasedSamplerOption
+	for _, s := range []struct {
+		cfg  *Sampler
+		with func(sdktrace.Sampler) sdktrace.ParentBasedSamplerOption
+	}{
+		{cfg.RemoteParentSampled, sdktrace.WithRemoteParentSampled},
+		{cfg.RemoteParentNotSampled, sdktrace.WithRemoteParentNotSampled},
+		{cfg.LocalParentSampled, sdktrace.WithLocalParentSampled},
+		{cfg.LocalParentNotSampled, sdktrace.WithLocalParentNotSampled},
+	} {
+		if s.cfg == nil {
+			continue
+		}
+		sampler, err := newSampler(s.cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, s.with(sampler))
+	}
+	return sdktrace.ParentBased(root, opts...), nil
+}
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // These interfaces describe the various ways to access state from an
@@ -80,6 +82,32 @@ type (
 		time.Time
 	}
 
+	// Exemplar is a measurement sampled from those that were
+	// aggregated, along with the span that was active when it was
+	// recorded.
+	Exemplar struct {
+		// Value is the measured value.
+		Value number.Number
+
+		// Time is when the measurement was recorded.
+		Time time.Time
+
+		// SpanContext identifies the span in the context the
+		// measurement was recorded with.
+		SpanContext trace.SpanContext
+
+		// FilteredAttributes are the attributes of the
+		// measurement that were removed before it was exported.
+		FilteredAttributes []attribute.KeyValue
+	}
+
+	// Exemplars returns measurements sampled from those that were
+	// aggregated.
+	Exemplars interface {
+		Aggregation
+		Exemplars() ([]Exemplar, error)
+	}
+
 	// Buckets represents histogram buckets boundaries and counts.
 	//
 	// For a Histogram with N defined boundaries, e.g, [x, y, z].
@@ -101,6 +129,48 @@ type (
 		Histogram() (Buckets, error)
 	}
 
+	// ExponentialBuckets represents the populated buckets of one
+	// sign of an exponential histogram.
+	//
+	// Counts[i] holds the count of values in the bucket with index
+	// Offset+i, which covers the range (base**(Offset+i),
+	// base**(Offset+i+1)] where base is 2**(2**-Scale).
+	ExponentialBuckets struct {
+		// Offset is the bucket index of the first entry in
+		// Counts.
+		Offset int32
+
+		// Counts holds the count in each bucket.
+		Counts []uint64
+	}
+
+	// ExponentialDistribution represents the state of an
+	// exponential histogram.
+	ExponentialDistribution struct {
+		// Scale determines the resolution of the buckets.
+		// Higher values yield narrower buckets.
+		Scale int32
+
+		// ZeroCount is the number of values equal to zero.
+		ZeroCount uint64
+
+		// Positive holds the buckets of positive values.
+		Positive ExponentialBuckets
+
+		// Negative holds the buckets of negative values,
+		// indexed by absolute value.
+		Negative ExponentialBuckets
+	}
+
+	// ExponentialHistogram returns the count of events in base-2
+	// exponential buckets.
+	ExponentialHistogram interface {
+		Aggregation
+		Count() (uint64, error)
+		Sum() (number.Number, error)
+		ExponentialHistogram() (ExponentialDistribution, error)
+	}
+
 	// MinMaxSumCount supports the Min, Max, Sum, and Count interfaces.
 	MinMaxSumCount interface {
 		Aggregation
@@ -134,6 +204,13 @@ const (
 	HistogramKind      Kind = "Histogram"
 	LastValueKind      Kind = "Lastvalue"
 	ExactKind          Kind = "Exact"
+
+	ExponentialHistogramKind Kind = "ExponentialHistogram"
+
+	// DropKind is the Kind of an aggregation that discards all
+	// measurements.  No Aggregator is allocated for it, the
+	// instrument is disabled.
+	DropKind Kind = "Drop"
 )
 
 var (
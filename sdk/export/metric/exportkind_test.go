@@ -72,3 +72,31 @@ func TestExportKindSelectors(t *testing.T) {
 		require.False(t, seks.ExportKindFor(&desc, akind).MemoryRequired(ikind))
 	}
 }
+
+func TestInstrumentKindExportKindSelector(t *testing.T) {
+	kinds := map[metric.InstrumentKind]ExportKind{
+		metric.CounterInstrumentKind:       DeltaExportKind,
+		metric.ValueRecorderInstrumentKind: DeltaExportKind,
+	}
+	eks := InstrumentKindExportKindSelector(kinds, nil)
+
+	// Changes to the map do not affect the selector.
+	kinds[metric.SumObserverInstrumentKind] = DeltaExportKind
+
+	for _, ikind := range append(deltaMemoryKinds, cumulativeMemoryKinds...) {
+		desc := metric.NewDescriptor("instrument", ikind, number.Int64Kind)
+		want := CumulativeExportKind
+		if ikind == metric.CounterInstrumentKind || ikind == metric.ValueRecorderInstrumentKind {
+			want = DeltaExportKind
+		}
+		require.Equal(t, want, eks.ExportKindFor(&desc, aggregation.SumKind), ikind)
+	}
+
+	eks = InstrumentKindExportKindSelector(map[metric.InstrumentKind]ExportKind{
+		metric.UpDownCounterInstrumentKind: CumulativeExportKind,
+	}, DeltaExportKindSelector())
+	desc := metric.NewDescriptor("instrument", metric.UpDownCounterInstrumentKind, number.Int64Kind)
+	require.Equal(t, CumulativeExportKind, eks.ExportKindFor(&desc, aggregation.SumKind))
+	desc = metric.NewDescriptor("instrument", metric.CounterInstrumentKind, number.Int64Kind)
+	require.Equal(t, DeltaExportKind, eks.ExportKindFor(&desc, aggregation.SumKind))
+}
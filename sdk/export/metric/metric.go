@@ -382,7 +382,8 @@ func (kind ExportKind) Includes(has ExportKind) bool {
 func (kind ExportKind) MemoryRequired(mkind metric.InstrumentKind) bool {
 	switch mkind {
 	case metric.ValueRecorderInstrumentKind, metric.ValueObserverInstrumentKind,
-		metric.CounterInstrumentKind, metric.UpDownCounterInstrumentKind:
+		metric.CounterInstrumentKind, metric.UpDownCounterInstrumentKind,
+		metric.GaugeInstrumentKind:
 		// Delta-oriented instruments:
 		return kind.Includes(CumulativeExportKind)
 
@@ -397,13 +398,18 @@ func (kind ExportKind) MemoryRequired(mkind metric.InstrumentKind) bool {
 }
 
 type (
-	constantExportKindSelector  ExportKind
-	statelessExportKindSelector struct{}
+	constantExportKindSelector   ExportKind
+	statelessExportKindSelector  struct{}
+	instrumentExportKindSelector struct {
+		kinds    map[metric.InstrumentKind]ExportKind
+		fallback ExportKindSelector
+	}
 )
 
 var (
 	_ ExportKindSelector = constantExportKindSelector(0)
 	_ ExportKindSelector = statelessExportKindSelector{}
+	_ ExportKindSelector = instrumentExportKindSelector{}
 )
 
 // ConstantExportKindSelector returns an ExportKindSelector that returns
@@ -431,6 +437,32 @@ func StatelessExportKindSelector() ExportKindSelector {
 	return statelessExportKindSelector{}
 }
 
+// InstrumentKindExportKindSelector returns an ExportKindSelector that
+// returns the ExportKind configured in kinds for the instrument kind of a
+// descriptor. The ExportKind of instrument kinds missing from kinds is
+// selected by fallback, or is CumulativeExportKind if fallback is nil.
+//
+// For example, synchronous sums and histograms can be exported as deltas
+// with:
+//
+//	InstrumentKindExportKindSelector(map[metric.InstrumentKind]ExportKind{
+//		metric.CounterInstrumentKind:       DeltaExportKind,
+//		metric.ValueRecorderInstrumentKind: DeltaExportKind,
+//	}, CumulativeExportKindSelector())
+func InstrumentKindExportKindSelector(kinds map[metric.InstrumentKind]ExportKind, fallback ExportKindSelector) ExportKindSelector {
+	if fallback == nil {
+		fallback = CumulativeExportKindSelector()
+	}
+	s := instrumentExportKindSelector{
+		kinds:    make(map[metric.InstrumentKind]ExportKind, len(kinds)),
+		fallback: fallback,
+	}
+	for ikind, ekind := range kinds {
+		s.kinds[ikind] = ekind
+	}
+	return s
+}
+
 // ExportKindFor implements ExportKindSelector.
 func (c constantExportKindSelector) ExportKindFor(_ *metric.Descriptor, _ aggregation.Kind) ExportKind {
 	return ExportKind(c)
@@ -443,3 +475,11 @@ func (s statelessExportKindSelector) ExportKindFor(desc *metric.Descriptor, kind
 	}
 	return DeltaExportKind
 }
+
+// ExportKindFor implements ExportKindSelector.
+func (s instrumentExportKindSelector) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) ExportKind {
+	if ekind, ok := s.kinds[desc.InstrumentKind()]; ok {
+		return ekind
+	}
+	return s.fallback.ExportKindFor(desc, kind)
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/export/metric"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+type (
+	// multiExporter is an Exporter exporting checkpoints with
+	// several Exporters.
+	multiExporter struct {
+		exporters []Exporter
+	}
+
+	// timeoutExporter bounds the time spent by an Exporter to
+	// export and shut down.
+	timeoutExporter struct {
+		Exporter
+		timeout time.Duration
+	}
+
+	// shutdowner is implemented by Exporters that can be shut down.
+	shutdowner interface {
+		Shutdown(ctx context.Context) error
+	}
+)
+
+var (
+	_ Exporter = (*multiExporter)(nil)
+	_ Exporter = timeoutExporter{}
+)
+
+// NewMultiExporter returns an Exporter that exports checkpoints with each
+// of exporters concurrently, for example to write to a new backend while
+// migrating from the current one.
+//
+// Every exporter is passed every checkpoint, even if other exporters
+// fail.  The errors of the exporters that fail are combined in the error
+// returned.  Use NewTimeoutExporter to bound the time spent exporting with
+// each exporter.
+//
+// The ExportKind of the first exporter is used for all of them, the
+// exporters must select the same ExportKinds.  To export to exporters
+// selecting different ExportKinds, use a PeriodicReader of the
+// go.opentelemetry.io/otel/sdk/metric/controller/basic package for each of
+// them instead.
+//
+// The returned Exporter has a Shutdown method shutting down each of
+// exporters that has a Shutdown method.
+func NewMultiExporter(exporters ...Exporter) Exporter {
+	return &multiExporter{exporters: exporters}
+}
+
+// ExportKindFor returns the ExportKind of the first exporter.
+func (e *multiExporter) ExportKindFor(descriptor *metric.Descriptor, aggregatorKind aggregation.Kind) ExportKind {
+	if len(e.exporters) == 0 {
+		return CumulativeExportKind
+	}
+	return e.exporters[0].ExportKindFor(descriptor, aggregatorKind)
+}
+
+// Export exports checkpointSet with each exporter concurrently and waits
+// for all of them to return.
+func (e *multiExporter) Export(ctx context.Context, checkpointSet CheckpointSet) error {
+	return e.each(func(exp Exporter) error {
+		return exp.Export(ctx, checkpointSet)
+	})
+}
+
+// Shutdown shuts down each exporter with a Shutdown method concurrently
+// and waits for all of them to return.
+func (e *multiExporter) Shutdown(ctx context.Context) error {
+	return e.each(func(exp Exporter) error {
+		if s, ok := exp.(shutdowner); ok {
+			return s.Shutdown(ctx)
+		}
+		return nil
+	})
+}
+
+// each calls f for each exporter concurrently, and returns the errors it
+// returned.
+func (e *multiExporter) each(f func(Exporter) error) error {
+	errs := make([]error, len(e.exporters))
+	var wg sync.WaitGroup
+	for i, exp := range e.exporters {
+		wg.Add(1)
+		go func(i int, exp Exporter) {
+			defer wg.Done()
+			errs[i] = f(exp)
+		}(i, exp)
+	}
+	wg.Wait()
+
+	var msgs []string
+	for i, err := range errs {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("exporter %d: %v", i, err))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("multi exporter: %s", strings.Join(msgs, "; "))
+}
+
+// NewTimeoutExporter returns an Exporter that exports checkpoints with,
+// and shuts down, exporter with a context that is canceled after timeout,
+// in addition to the context it is passed.
+func NewTimeoutExporter(exporter Exporter, timeout time.Duration) Exporter {
+	return timeoutExporter{Exporter: exporter, timeout: timeout}
+}
+
+func (e timeoutExporter) Export(ctx context.Context, checkpointSet CheckpointSet) error {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	return e.Exporter.Export(ctx, checkpointSet)
+}
+
+func (e timeoutExporter) Shutdown(ctx context.Context) error {
+	s, ok := e.Exporter.(shutdowner)
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	return s.Shutdown(ctx)
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+type testExporter struct {
+	ExportKindSelector
+	err      error
+	block    bool
+	exported int
+	shutdown bool
+}
+
+func (e *testExporter) Export(ctx context.Context, _ CheckpointSet) error {
+	if e.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	e.exported++
+	return e.err
+}
+
+func (e *testExporter) Shutdown(context.Context) error {
+	e.shutdown = true
+	return e.err
+}
+
+func TestMultiExporter(t *testing.T) {
+	failing := &testExporter{ExportKindSelector: DeltaExportKindSelector(), err: errors.New("unavailable")}
+	exp := &testExporter{ExportKindSelector: CumulativeExportKindSelector()}
+	blocking := &testExporter{ExportKindSelector: CumulativeExportKindSelector(), block: true}
+	multi := NewMultiExporter(failing, exp, NewTimeoutExporter(blocking, time.Millisecond))
+
+	desc := metric.NewDescriptor("counter", metric.CounterInstrumentKind, 0)
+	assert.Equal(t, DeltaExportKind, multi.ExportKindFor(&desc, aggregation.SumKind))
+
+	err := multi.Export(context.Background(), nil)
+	assert.EqualError(t, err, "multi exporter: exporter 0: unavailable; exporter 2: context deadline exceeded")
+	assert.Equal(t, 1, failing.exported)
+	assert.Equal(t, 1, exp.exported, "checkpoints are exported when other exporters fail")
+
+	err = multi.(interface {
+		Shutdown(context.Context) error
+	}).Shutdown(context.Background())
+	assert.EqualError(t, err, "multi exporter: exporter 0: unavailable")
+	assert.True(t, failing.shutdown)
+	assert.True(t, exp.shutdown)
+	assert.True(t, blocking.shutdown)
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faas provides helpers for using the OpenTelemetry SDK in
+// Function-as-a-Service environments, like AWS Lambda, where the process
+// may be suspended as soon as an invocation returns.
+package faas // import "go.opentelemetry.io/otel/sdk/faas"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Flusher exports all telemetry it holds that has not yet been exported.
+// It is implemented by the TracerProvider of go.opentelemetry.io/otel/sdk/trace
+// and the Controller of go.opentelemetry.io/otel/sdk/metric/controller/basic.
+type Flusher interface {
+	ForceFlush(ctx context.Context) error
+}
+
+// Handler handles a function invocation. It has the method set of the
+// Handler of github.com/aws/aws-lambda-go/lambda, so the value returned by
+// WrapHandler can be passed to lambda.StartHandler.
+type Handler interface {
+	Invoke(ctx context.Context, payload []byte) ([]byte, error)
+}
+
+// HandlerFunc is an adapter to use an ordinary function as a Handler.
+type HandlerFunc func(ctx context.Context, payload []byte) ([]byte, error)
+
+var _ Handler = HandlerFunc(nil)
+
+// Invoke calls f(ctx, payload).
+func (f HandlerFunc) Invoke(ctx context.Context, payload []byte) ([]byte, error) {
+	return f(ctx, payload)
+}
+
+// WrapHandler returns a Handler that invokes h and then calls ForceFlush on
+// each of the flushers, in order, before returning. The flushers are called
+// even if h returns an error or panics. They are passed the context of the
+// invocation, so its deadline bounds the time spent flushing. Errors
+// returned by the flushers are sent to the global error handler and do not
+// affect the result of the invocation.
+func WrapHandler(h Handler, flushers ...Flusher) Handler {
+	return HandlerFunc(func(ctx context.Context, payload []byte) ([]byte, error) {
+		defer Flush(ctx, flushers...)
+		return h.Invoke(ctx, payload)
+	})
+}
+
+// Flush calls ForceFlush on each of the flushers, in order, sending any
+// errors to the global error handler. It can be deferred by handlers that
+// are not wrapped with WrapHandler.
+func Flush(ctx context.Context, flushers ...Flusher) {
+	for _, f := range flushers {
+		if err := f.ForceFlush(ctx); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
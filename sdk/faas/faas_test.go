@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faas_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/faas"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ faas.Flusher = (*sdktrace.TracerProvider)(nil)
+
+type storingHandler struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (s *storingHandler) Handle(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, err)
+}
+
+func (s *storingHandler) reset() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	errs := s.errs
+	s.errs = nil
+	return errs
+}
+
+var handler = &storingHandler{}
+
+func init() {
+	otel.SetErrorHandler(handler)
+}
+
+type flusher struct {
+	calls []string
+	name  string
+	err   error
+}
+
+func (f *flusher) ForceFlush(context.Context) error {
+	f.calls = append(f.calls, f.name)
+	return f.err
+}
+
+func TestWrapHandler(t *testing.T) {
+	handler.reset()
+	var calls []string
+	flushErr := errors.New("flush failed")
+	f1 := &flusher{name: "first", err: flushErr}
+	f2 := &flusher{name: "second"}
+
+	h := faas.WrapHandler(faas.HandlerFunc(func(ctx context.Context, payload []byte) ([]byte, error) {
+		calls = append(calls, "invoke")
+		return append([]byte("echo "), payload...), nil
+	}), f1, f2)
+
+	out, err := h.Invoke(context.Background(), []byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "echo hello", string(out))
+	assert.Equal(t, []string{"invoke"}, calls)
+	assert.Equal(t, []string{"first"}, f1.calls)
+	assert.Equal(t, []string{"second"}, f2.calls)
+	assert.Equal(t, []error{flushErr}, handler.reset())
+}
+
+func TestWrapHandlerFlushesOnError(t *testing.T) {
+	invokeErr := errors.New("invocation failed")
+	f := &flusher{name: "flusher"}
+	h := faas.WrapHandler(faas.HandlerFunc(func(context.Context, []byte) ([]byte, error) {
+		return nil, invokeErr
+	}), f)
+
+	_, err := h.Invoke(context.Background(), nil)
+	assert.Equal(t, invokeErr, err)
+	assert.Equal(t, []string{"flusher"}, f.calls)
+}
+
+func TestWrapHandlerFlushesOnPanic(t *testing.T) {
+	f := &flusher{name: "flusher"}
+	h := faas.WrapHandler(faas.HandlerFunc(func(context.Context, []byte) ([]byte, error) {
+		panic("invocation panicked")
+	}), f)
+
+	assert.Panics(t, func() { _, _ = h.Invoke(context.Background(), nil) })
+	assert.Equal(t, []string{"flusher"}, f.calls)
+}
+
+func TestWrapHandlerFlushesBatchedSpans(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(
+		exp,
+		sdktrace.WithMaxExportBatchSize(10),
+		sdktrace.WithBatchTimeout(time.Hour),
+	))
+	defer func() { assert.NoError(t, tp.Shutdown(context.Background())) }()
+	tr := tp.Tracer("TestWrapHandlerFlushesBatchedSpans")
+
+	const n = 25
+	h := faas.WrapHandler(faas.HandlerFunc(func(ctx context.Context, payload []byte) ([]byte, error) {
+		for i := 0; i < n; i++ {
+			_, span := tr.Start(ctx, "span")
+			span.End()
+		}
+		return payload, nil
+	}), tp)
+
+	_, err := h.Invoke(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, exp.GetSpans(), n)
+}
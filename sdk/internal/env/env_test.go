@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntEnvOr(t *testing.T) {
+	const key = "OTEL_TEST_INT_ENV"
+	defer os.Unsetenv(key)
+
+	require.NoError(t, os.Unsetenv(key))
+	assert.Equal(t, 7, IntEnvOr(key, 7))
+
+	require.NoError(t, os.Setenv(key, "42"))
+	assert.Equal(t, 42, IntEnvOr(key, 7))
+
+	require.NoError(t, os.Setenv(key, "-1"))
+	assert.Equal(t, -1, IntEnvOr(key, 7))
+
+	require.NoError(t, os.Setenv(key, "many"))
+	assert.Equal(t, 7, IntEnvOr(key, 7))
+}
@@ -0,0 +1,333 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Defaults for BatchProcessorOptions.
+const (
+	DefaultMaxQueueSize       = 2048
+	DefaultBatchTimeout       = 1000 * time.Millisecond
+	DefaultExportTimeout      = 30000 * time.Millisecond
+	DefaultMaxExportBatchSize = 512
+)
+
+// BatchProcessorOption configures a batch Processor.
+type BatchProcessorOption func(o *BatchProcessorOptions)
+
+// BatchProcessorOptions are the options of a batch Processor.
+type BatchProcessorOptions struct {
+	// MaxQueueSize is the maximum queue size to buffer records for delayed
+	// processing. If the queue gets full it drops the records. Use
+	// BlockOnQueueFull to change this behavior.
+	// The default value of MaxQueueSize is 2048.
+	MaxQueueSize int
+
+	// BatchTimeout is the maximum duration for constructing a batch.
+	// Processor forcefully sends available records when timeout is reached.
+	// The default value of BatchTimeout is 1000 msec.
+	BatchTimeout time.Duration
+
+	// ExportTimeout specifies the maximum duration for exporting records. If
+	// the timeout is reached, the export will be cancelled.
+	// The default value of ExportTimeout is 30000 msec.
+	ExportTimeout time.Duration
+
+	// MaxExportBatchSize is the maximum number of records to process in a
+	// single batch.
+	// The default value of MaxExportBatchSize is 512.
+	MaxExportBatchSize int
+
+	// BlockOnQueueFull blocks OnEmit if the queue is full AND if
+	// BlockOnQueueFull is set to true.
+	// Blocking option should be used carefully as it can severely affect
+	// the performance of an application.
+	BlockOnQueueFull bool
+}
+
+// WithMaxQueueSize sets the maximum queue size.
+func WithMaxQueueSize(size int) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.MaxQueueSize = size
+	}
+}
+
+// WithMaxExportBatchSize sets the maximum number of records in a batch.
+func WithMaxExportBatchSize(size int) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.MaxExportBatchSize = size
+	}
+}
+
+// WithBatchTimeout sets the maximum duration for constructing a batch.
+func WithBatchTimeout(delay time.Duration) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.BatchTimeout = delay
+	}
+}
+
+// WithExportTimeout sets the maximum duration of an export.
+func WithExportTimeout(timeout time.Duration) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.ExportTimeout = timeout
+	}
+}
+
+// WithBlocking makes OnEmit block while the queue is full instead of
+// dropping records.
+func WithBlocking() BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.BlockOnQueueFull = true
+	}
+}
+
+// batchProcessor is a Processor that batches asynchronously-received
+// records and sends them to an Exporter.
+type batchProcessor struct {
+	e Exporter
+	o BatchProcessorOptions
+
+	queue   chan Record
+	dropped uint32
+
+	batch      []Record
+	batchMutex sync.Mutex
+	timer      *time.Timer
+	stopWait   sync.WaitGroup
+	stopOnce   sync.Once
+	stopCh     chan struct{}
+}
+
+var _ Processor = (*batchProcessor)(nil)
+
+// NewBatchProcessor creates a new Processor that will send batches of
+// emitted records to the exporter with the supplied options.
+//
+// If the exporter is nil, the processor will perform no action.
+func NewBatchProcessor(exporter Exporter, options ...BatchProcessorOption) Processor {
+	o := BatchProcessorOptions{
+		BatchTimeout:       DefaultBatchTimeout,
+		ExportTimeout:      DefaultExportTimeout,
+		MaxQueueSize:       DefaultMaxQueueSize,
+		MaxExportBatchSize: DefaultMaxExportBatchSize,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	bp := &batchProcessor{
+		e:      exporter,
+		o:      o,
+		batch:  make([]Record, 0, o.MaxExportBatchSize),
+		timer:  time.NewTimer(o.BatchTimeout),
+		queue:  make(chan Record, o.MaxQueueSize),
+		stopCh: make(chan struct{}),
+	}
+
+	bp.stopWait.Add(1)
+	go func() {
+		defer bp.stopWait.Done()
+		bp.processQueue()
+		bp.drainQueue()
+	}()
+
+	return bp
+}
+
+// OnEmit enqueues the record for later processing.
+func (bp *batchProcessor) OnEmit(_ context.Context, r Record) {
+	// Do not enqueue records if we are just going to drop them.
+	if bp.e == nil {
+		return
+	}
+	bp.enqueue(r)
+}
+
+// Shutdown flushes the queue and waits until all records are processed.
+// It only executes once. Subsequent call does nothing.
+func (bp *batchProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	bp.stopOnce.Do(func() {
+		wait := make(chan struct{})
+		go func() {
+			close(bp.stopCh)
+			bp.stopWait.Wait()
+			if bp.e != nil {
+				if err := bp.e.Shutdown(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
+			close(wait)
+		}()
+		// Wait until the wait group is done or the context is cancelled
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+// ForceFlush exports all records that have not yet been exported.
+func (bp *batchProcessor) ForceFlush(ctx context.Context) error {
+	var err error
+	if bp.e != nil {
+		wait := make(chan error, 1)
+		go func() {
+			wait <- bp.exportRecords(ctx)
+		}()
+		// Wait until the export is finished or the context is cancelled/timed out
+		select {
+		case err = <-wait:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	return err
+}
+
+// exportRecords is a subroutine of processing and draining the queue.
+func (bp *batchProcessor) exportRecords(ctx context.Context) error {
+	bp.timer.Reset(bp.o.BatchTimeout)
+
+	bp.batchMutex.Lock()
+	defer bp.batchMutex.Unlock()
+
+	if bp.o.ExportTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, bp.o.ExportTimeout)
+		defer cancel()
+	}
+
+	if l := len(bp.batch); l > 0 {
+		err := bp.e.ExportLogs(ctx, bp.batch)
+
+		// A new batch is always created after exporting, even if the batch
+		// failed to be exported. Any retry logic is the responsibility of
+		// the exporter.
+		bp.batch = make([]Record, 0, bp.o.MaxExportBatchSize)
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processQueue removes records from the queue until the processor is shut
+// down. It calls the exporter in batches of up to MaxExportBatchSize
+// waiting up to BatchTimeout to form a batch.
+func (bp *batchProcessor) processQueue() {
+	defer bp.timer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for {
+		select {
+		case <-bp.stopCh:
+			return
+		case <-bp.timer.C:
+			if err := bp.exportRecords(ctx); err != nil {
+				otel.Handle(err)
+			}
+		case r := <-bp.queue:
+			bp.batchMutex.Lock()
+			bp.batch = append(bp.batch, r)
+			shouldExport := len(bp.batch) >= bp.o.MaxExportBatchSize
+			bp.batchMutex.Unlock()
+			if shouldExport {
+				if !bp.timer.Stop() {
+					<-bp.timer.C
+				}
+				if err := bp.exportRecords(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
+		}
+	}
+}
+
+// drainQueue exports the records remaining in the queue once the
+// processor is shut down.
+func (bp *batchProcessor) drainQueue() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for {
+		select {
+		case r, ok := <-bp.queue:
+			if !ok {
+				if err := bp.exportRecords(ctx); err != nil {
+					otel.Handle(err)
+				}
+				return
+			}
+
+			bp.batchMutex.Lock()
+			bp.batch = append(bp.batch, r)
+			shouldExport := len(bp.batch) == bp.o.MaxExportBatchSize
+			bp.batchMutex.Unlock()
+
+			if shouldExport {
+				if err := bp.exportRecords(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
+		default:
+			close(bp.queue)
+		}
+	}
+}
+
+func (bp *batchProcessor) enqueue(r Record) {
+	// This ensures the bp.queue<- below does not panic as the processor
+	// shuts down.
+	defer func() {
+		x := recover()
+		switch err := x.(type) {
+		case nil:
+			return
+		case runtime.Error:
+			if err.Error() == "send on closed channel" {
+				return
+			}
+		}
+		panic(x)
+	}()
+
+	select {
+	case <-bp.stopCh:
+		return
+	default:
+	}
+
+	if bp.o.BlockOnQueueFull {
+		bp.queue <- r
+		return
+	}
+
+	select {
+	case bp.queue <- r:
+	default:
+		atomic.AddUint32(&bp.dropped, 1)
+	}
+}
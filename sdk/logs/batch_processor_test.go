@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/logs"
+)
+
+func TestBatchProcessorShutdownExportsQueue(t *testing.T) {
+	exp := &testExporter{}
+	bp := logs.NewBatchProcessor(exp, logs.WithBatchTimeout(time.Hour))
+	for i := 0; i < 10; i++ {
+		bp.OnEmit(context.Background(), logs.Record{Name: "r"})
+	}
+	require.NoError(t, bp.Shutdown(context.Background()))
+	assert.Len(t, exp.Records(), 10)
+	assert.True(t, exp.shutdown)
+
+	// Records emitted after shutdown are dropped.
+	bp.OnEmit(context.Background(), logs.Record{Name: "r"})
+	assert.Len(t, exp.Records(), 10)
+}
+
+func TestBatchProcessorMaxExportBatchSize(t *testing.T) {
+	exp := &testExporter{}
+	bp := logs.NewBatchProcessor(
+		exp,
+		logs.WithBatchTimeout(time.Hour),
+		logs.WithMaxExportBatchSize(5),
+		logs.WithBlocking(),
+	)
+	for i := 0; i < 12; i++ {
+		bp.OnEmit(context.Background(), logs.Record{Name: "r"})
+	}
+	require.NoError(t, bp.Shutdown(context.Background()))
+	assert.Len(t, exp.Records(), 12)
+	assert.Equal(t, 3, exp.batches)
+}
+
+func TestBatchProcessorBatchTimeout(t *testing.T) {
+	exp := &testExporter{}
+	bp := logs.NewBatchProcessor(exp, logs.WithBatchTimeout(10*time.Millisecond))
+	defer func() { _ = bp.Shutdown(context.Background()) }()
+
+	bp.OnEmit(context.Background(), logs.Record{Name: "r"})
+	assert.Eventually(t, func() bool {
+		return len(exp.Records()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBatchProcessorForceFlush(t *testing.T) {
+	exp := &testExporter{}
+	bp := logs.NewBatchProcessor(exp, logs.WithBatchTimeout(time.Hour), logs.WithBlocking())
+	defer func() { _ = bp.Shutdown(context.Background()) }()
+
+	bp.OnEmit(context.Background(), logs.Record{Name: "r"})
+	// The record is picked up asynchronously from the queue.
+	assert.Eventually(t, func() bool {
+		require.NoError(t, bp.ForceFlush(context.Background()))
+		return len(exp.Records()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBatchProcessorNilExporter(t *testing.T) {
+	bp := logs.NewBatchProcessor(nil)
+	bp.OnEmit(context.Background(), logs.Record{})
+	assert.NoError(t, bp.ForceFlush(context.Background()))
+	assert.NoError(t, bp.Shutdown(context.Background()))
+}
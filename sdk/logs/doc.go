@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package logs contains the OpenTelemetry SDK representation of log records.
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+
+The LoggerProvider type implements the go.opentelemetry.io/otel/log API.
+Records emitted by its Loggers are correlated with the active span and
+passed to the registered Processors. The batch Processor, created with
+NewBatchProcessor or the WithBatcher option, exports records in batches to
+an Exporter:
+
+	exp, err := otlplogs.NewExporter(ctx, client)
+	if err != nil {
+		// handle error
+	}
+	lp := logs.NewLoggerProvider(logs.WithBatcher(exp))
+	defer func() { _ = lp.Shutdown(ctx) }()
+
+The Record type defined here is the data exchanged between the SDK and log
+exporters, such as the OTLP log exporter found in the
+go.opentelemetry.io/otel/exporters/otlp/otlplogs package.
+
+The following assumes a basic familiarity with OpenTelemetry concepts.
+See https://opentelemetry.io.
+*/
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
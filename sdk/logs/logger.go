@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// logger is the SDK implementation of log.Logger.
+type logger struct {
+	provider               *LoggerProvider
+	instrumentationLibrary instrumentation.Library
+}
+
+var _ log.Logger = &logger{}
+
+// Emit converts the record to an SDK Record and passes it to the
+// Processors of the LoggerProvider. The record is correlated with the span
+// active in ctx.
+func (l *logger) Emit(ctx context.Context, r log.Record) {
+	procs := l.provider.getProcessors()
+	if len(procs) == 0 {
+		return
+	}
+
+	record := Record{
+		Timestamp:              r.Timestamp,
+		Severity:               r.Severity,
+		SeverityText:           r.SeverityText,
+		Name:                   r.Name,
+		Body:                   r.Body,
+		Attributes:             r.Attributes,
+		SpanContext:            trace.SpanContextFromContext(ctx),
+		Resource:               l.provider.resource,
+		InstrumentationLibrary: l.instrumentationLibrary,
+	}
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+	if limit := l.provider.limit; len(record.Attributes) > limit {
+		record.DroppedAttributeCount = len(record.Attributes) - limit
+		record.Attributes = record.Attributes[:limit:limit]
+	}
+
+	for _, proc := range procs {
+		proc.OnEmit(ctx, record)
+	}
+}
+
+// Enabled reports whether any Processor is registered with the
+// LoggerProvider.
+func (l *logger) Enabled(context.Context, log.Severity) bool {
+	return len(l.provider.getProcessors()) > 0
+}
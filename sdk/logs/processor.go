@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
+
+import "context"
+
+// Processor is a processing pipeline for log records emitted by the Loggers
+// of a LoggerProvider.
+type Processor interface {
+	// OnEmit is called when a record is emitted. It is called
+	// synchronously by the emitting goroutine and should not block.
+	OnEmit(ctx context.Context, record Record)
+
+	// Shutdown is called when the LoggerProvider is shutdown. It should
+	// flush any pending records and release the processor resources. It
+	// should honor the deadline and cancellation of ctx.
+	Shutdown(ctx context.Context) error
+
+	// ForceFlush exports all records that have not yet been exported. It
+	// should honor the deadline and cancellation of ctx.
+	ForceFlush(ctx context.Context) error
+}
@@ -0,0 +1,232 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/log"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	defaultLoggerName = "go.opentelemetry.io/otel/sdk/logger"
+
+	// DefaultAttributeCountLimit is the default maximum allowed attribute
+	// count of a log record.
+	DefaultAttributeCountLimit = 128
+)
+
+// loggerProviderConfig holds the configuration of a LoggerProvider.
+type loggerProviderConfig struct {
+	processors          []Processor
+	resource            *resource.Resource
+	attributeCountLimit int
+}
+
+// LoggerProvider is an OpenTelemetry LoggerProvider. It provides Loggers
+// emitting log records to the registered Processors.
+type LoggerProvider struct {
+	mu           sync.Mutex
+	namedLogger  map[instrumentation.Library]*logger
+	processors   atomic.Value
+	resource     *resource.Resource
+	limit        int
+	shutdownOnce sync.Once
+}
+
+var _ log.LoggerProvider = &LoggerProvider{}
+
+// NewLoggerProvider returns a new and configured LoggerProvider.
+//
+// By default the returned LoggerProvider is configured with:
+//   - no Processors, all records are dropped
+//   - the resource.Default() Resource
+//   - an attribute count limit of DefaultAttributeCountLimit.
+//
+// The passed opts are used to override these default values and configure
+// the returned LoggerProvider appropriately.
+func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
+	cfg := &loggerProviderConfig{}
+	for _, opt := range opts {
+		opt.apply(cfg)
+	}
+	if cfg.resource == nil {
+		cfg.resource = resource.Default()
+	}
+	if cfg.attributeCountLimit <= 0 {
+		cfg.attributeCountLimit = DefaultAttributeCountLimit
+	}
+
+	p := &LoggerProvider{
+		namedLogger: make(map[instrumentation.Library]*logger),
+		resource:    cfg.resource,
+		limit:       cfg.attributeCountLimit,
+	}
+	p.processors.Store(cfg.processors)
+	return p
+}
+
+// Logger returns a Logger with the given name and options. If a Logger for
+// the given name and options does not exist it is created, otherwise the
+// existing Logger is returned.
+//
+// If name is empty, a default name is used instead.
+//
+// This method is safe to be called concurrently.
+func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	c := log.NewLoggerConfig(opts...)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if name == "" {
+		name = defaultLoggerName
+	}
+	il := instrumentation.Library{
+		Name:      name,
+		Version:   c.InstrumentationVersion(),
+		SchemaURL: c.SchemaURL(),
+	}
+	l, ok := p.namedLogger[il]
+	if !ok {
+		l = &logger{
+			provider:               p,
+			instrumentationLibrary: il,
+		}
+		p.namedLogger[il] = l
+	}
+	return l
+}
+
+// RegisterProcessor adds the given Processor to the list of Processors.
+func (p *LoggerProvider) RegisterProcessor(proc Processor) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	old := p.getProcessors()
+	procs := make([]Processor, 0, len(old)+1)
+	procs = append(procs, old...)
+	procs = append(procs, proc)
+	p.processors.Store(procs)
+}
+
+func (p *LoggerProvider) getProcessors() []Processor {
+	procs, _ := p.processors.Load().([]Processor)
+	return procs
+}
+
+// ForceFlush immediately exports all records that have not yet been
+// exported for all the registered Processors.
+func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
+	for _, proc := range p.getProcessors() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := proc.ForceFlush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown shuts down the Processors in the order they were registered.
+// Records emitted after Shutdown is called are dropped. Only the first
+// call to Shutdown has an effect.
+func (p *LoggerProvider) Shutdown(ctx context.Context) error {
+	var err error
+	p.shutdownOnce.Do(func() {
+		p.mu.Lock()
+		procs := p.getProcessors()
+		p.processors.Store([]Processor(nil))
+		p.mu.Unlock()
+
+		for _, proc := range procs {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			default:
+			}
+
+			if e := proc.Shutdown(ctx); e != nil && err == nil {
+				err = e
+			}
+		}
+	})
+	return err
+}
+
+// LoggerProviderOption configures a LoggerProvider.
+type LoggerProviderOption interface {
+	apply(*loggerProviderConfig)
+}
+
+type loggerProviderOptionFunc func(*loggerProviderConfig)
+
+func (fn loggerProviderOptionFunc) apply(cfg *loggerProviderConfig) {
+	fn(cfg)
+}
+
+// WithSyncer registers the exporter with the LoggerProvider using a simple
+// Processor.
+//
+// This is not recommended for production use. The WithBatcher option is
+// recommended for production use instead.
+func WithSyncer(e Exporter) LoggerProviderOption {
+	return WithProcessor(NewSimpleProcessor(e))
+}
+
+// WithBatcher registers the exporter with the LoggerProvider using a batch
+// Processor configured with the passed opts.
+func WithBatcher(e Exporter, opts ...BatchProcessorOption) LoggerProviderOption {
+	return WithProcessor(NewBatchProcessor(e, opts...))
+}
+
+// WithProcessor registers the Processor with a LoggerProvider.
+func WithProcessor(proc Processor) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg *loggerProviderConfig) {
+		cfg.processors = append(cfg.processors, proc)
+	})
+}
+
+// WithResource returns a LoggerProviderOption that will configure the
+// Resource r as a LoggerProvider's Resource. It represents the entity
+// producing telemetry.
+//
+// If this option is not used, the LoggerProvider will use the
+// resource.Default() Resource by default.
+func WithResource(r *resource.Resource) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg *loggerProviderConfig) {
+		cfg.resource = resource.Merge(resource.Environment(), r)
+	})
+}
+
+// WithAttributeCountLimit returns a LoggerProviderOption that limits the
+// number of attributes of a log record. Attributes over the limit are
+// dropped and counted in the DroppedAttributeCount of the record.
+//
+// If this option is not used, or limit is not positive,
+// DefaultAttributeCountLimit is used.
+func WithAttributeCountLimit(limit int) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg *loggerProviderConfig) {
+		cfg.attributeCountLimit = limit
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/logs"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type testExporter struct {
+	mu       sync.Mutex
+	records  []logs.Record
+	batches  int
+	shutdown bool
+	err      error
+}
+
+func (e *testExporter) ExportLogs(_ context.Context, records []logs.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = append(e.records, records...)
+	e.batches++
+	return e.err
+}
+
+func (e *testExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return nil
+}
+
+func (e *testExporter) Records() []logs.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]logs.Record(nil), e.records...)
+}
+
+func TestLoggerEmit(t *testing.T) {
+	exp := &testExporter{}
+	res := resource.NewWithAttributes(attribute.String("service.name", "test"))
+	lp := logs.NewLoggerProvider(logs.WithSyncer(exp), logs.WithResource(res))
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	logger := lp.Logger("test", log.WithInstrumentationVersion("v1"))
+	assert.Same(t, logger, lp.Logger("test", log.WithInstrumentationVersion("v1")))
+	assert.True(t, logger.Enabled(ctx, log.SeverityDebug))
+
+	ts := time.Unix(1000, 0)
+	logger.Emit(ctx, log.Record{
+		Timestamp:    ts,
+		Severity:     log.SeverityWarn,
+		SeverityText: "WARN",
+		Name:         "event",
+		Body:         attribute.StringValue("hello"),
+		Attributes:   []attribute.KeyValue{attribute.Int("count", 1)},
+	})
+	logger.Emit(context.Background(), log.Record{Severity: log.SeverityInfo})
+
+	records := exp.Records()
+	require.Len(t, records, 2)
+	assert.Equal(t, logs.Record{
+		Timestamp:    ts,
+		Severity:     log.SeverityWarn,
+		SeverityText: "WARN",
+		Name:         "event",
+		Body:         attribute.StringValue("hello"),
+		Attributes:   []attribute.KeyValue{attribute.Int("count", 1)},
+		SpanContext:  sc,
+		Resource:     resource.Merge(resource.Environment(), res),
+		InstrumentationLibrary: instrumentation.Library{
+			Name:    "test",
+			Version: "v1",
+		},
+	}, records[0])
+	assert.False(t, records[1].SpanContext.IsValid())
+	assert.False(t, records[1].Timestamp.IsZero(), "default timestamp")
+}
+
+func TestLoggerAttributeCountLimit(t *testing.T) {
+	exp := &testExporter{}
+	lp := logs.NewLoggerProvider(logs.WithSyncer(exp), logs.WithAttributeCountLimit(1))
+
+	attrs := []attribute.KeyValue{attribute.Int("a", 1), attribute.Int("b", 2)}
+	lp.Logger("test").Emit(context.Background(), log.Record{Attributes: attrs})
+
+	records := exp.Records()
+	require.Len(t, records, 1)
+	assert.Equal(t, attrs[:1], records[0].Attributes)
+	assert.Equal(t, 1, records[0].DroppedAttributeCount)
+}
+
+func TestLoggerProviderWithoutProcessors(t *testing.T) {
+	lp := logs.NewLoggerProvider()
+	logger := lp.Logger("")
+	assert.False(t, logger.Enabled(context.Background(), log.SeverityFatal))
+	assert.NotPanics(t, func() {
+		logger.Emit(context.Background(), log.Record{})
+	})
+	assert.NoError(t, lp.ForceFlush(context.Background()))
+	assert.NoError(t, lp.Shutdown(context.Background()))
+}
+
+func TestLoggerProviderShutdown(t *testing.T) {
+	exp := &testExporter{}
+	lp := logs.NewLoggerProvider()
+	lp.RegisterProcessor(logs.NewSimpleProcessor(exp))
+	logger := lp.Logger("test")
+	assert.True(t, logger.Enabled(context.Background(), log.SeverityInfo))
+
+	require.NoError(t, lp.Shutdown(context.Background()))
+	assert.True(t, exp.shutdown)
+	assert.False(t, logger.Enabled(context.Background(), log.SeverityInfo))
+
+	logger.Emit(context.Background(), log.Record{})
+	assert.Empty(t, exp.Records())
+	assert.NoError(t, lp.Shutdown(context.Background()))
+}
+
+type errProcessor struct{ err error }
+
+func (errProcessor) OnEmit(context.Context, logs.Record) {}
+func (p errProcessor) Shutdown(context.Context) error    { return p.err }
+func (p errProcessor) ForceFlush(context.Context) error  { return p.err }
+
+func TestLoggerProviderErrors(t *testing.T) {
+	want := errors.New("processor error")
+	lp := logs.NewLoggerProvider(logs.WithProcessor(errProcessor{want}))
+	assert.ErrorIs(t, lp.ForceFlush(context.Background()), want)
+	assert.ErrorIs(t, lp.Shutdown(context.Background()), want)
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Record is a log record produced by the SDK and passed to an Exporter.
+type Record struct {
+	// Timestamp is the time when the event occurred. A zero value means the
+	// time is unknown.
+	Timestamp time.Time
+
+	// Severity is the normalized severity of the record.
+	Severity log.Severity
+
+	// SeverityText is the original string representation of the severity
+	// as it is known at the source (also known as log level).
+	SeverityText string
+
+	// Name is a short event identifier that does not contain varying parts.
+	Name string
+
+	// Body is the body of the log record, for example a human-readable
+	// message.
+	Body attribute.Value
+
+	// Attributes describe the specific event occurrence.
+	Attributes []attribute.KeyValue
+
+	// DroppedAttributeCount is the number of attributes that were not
+	// recorded due to configured limits being reached.
+	DroppedAttributeCount int
+
+	// SpanContext identifies the span that was active when the record was
+	// emitted. It is invalid if the record is not correlated with a span.
+	SpanContext trace.SpanContext
+
+	// Resource describes the entity that produced the record.
+	Resource *resource.Resource
+
+	// InstrumentationLibrary is the library that emitted the record.
+	InstrumentationLibrary instrumentation.Library
+}
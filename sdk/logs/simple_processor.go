@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs // import "go.opentelemetry.io/otel/sdk/logs"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+// simpleProcessor is a Processor that synchronously sends all emitted
+// records to an Exporter immediately.
+type simpleProcessor struct {
+	exporterMu sync.RWMutex
+	exporter   Exporter
+	stopOnce   sync.Once
+}
+
+var _ Processor = (*simpleProcessor)(nil)
+
+// NewSimpleProcessor returns a new Processor that will synchronously send
+// emitted records to the exporter immediately.
+//
+// This Processor is not recommended for production use. The synchronous
+// nature of this Processor make it good for testing, debugging, or showing
+// examples of other feature, but it will be slow and have a high computation
+// resource usage overhead. The batch Processor is recommended for production
+// use instead.
+func NewSimpleProcessor(exporter Exporter) Processor {
+	return &simpleProcessor{exporter: exporter}
+}
+
+// OnEmit immediately exports the record.
+func (p *simpleProcessor) OnEmit(ctx context.Context, r Record) {
+	p.exporterMu.RLock()
+	defer p.exporterMu.RUnlock()
+
+	if p.exporter != nil {
+		if err := p.exporter.ExportLogs(ctx, []Record{r}); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
+
+// Shutdown shuts down the exporter this Processor exports to.
+func (p *simpleProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		// Zero the exporter field so subsequent calls to OnEmit are
+		// ignored, then shut the exporter down without holding the lock.
+		p.exporterMu.Lock()
+		exp := p.exporter
+		p.exporter = nil
+		p.exporterMu.Unlock()
+
+		if exp == nil {
+			return
+		}
+
+		done := make(chan error, 1)
+		go func() { done <- exp.Shutdown(ctx) }()
+
+		select {
+		case err = <-done:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+// ForceFlush does nothing as there is no data to flush.
+func (p *simpleProcessor) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exemplar provides the exemplar sampling shared by the
+// aggregators that support aggregation.Exemplars.
+//
+// Only measurements recorded with the context of a sampled span are
+// eligible to become exemplars, so that every exported exemplar links
+// to a trace that was also exported.
+package exemplar // import "go.opentelemetry.io/otel/sdk/metric/aggregator/exemplar"
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultReservoirSize is the number of exemplars a Reservoir holds.
+const DefaultReservoirSize = 1
+
+// Filterable is implemented by aggregators that sample exemplars. The
+// processor calls AddFilteredAttributes with the attributes it removes
+// from the measurements aggregated by the aggregator, so they are
+// exported with the exemplars instead.
+type Filterable interface {
+	AddFilteredAttributes(kvs []attribute.KeyValue)
+}
+
+// New returns an exemplar for the measurement of num recorded with
+// ctx, and false if the measurement is not eligible to be an exemplar.
+func New(ctx context.Context, num number.Number) (aggregation.Exemplar, bool) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsSampled() {
+		return aggregation.Exemplar{}, false
+	}
+	return aggregation.Exemplar{
+		Value:       num,
+		Time:        time.Now(),
+		SpanContext: sc,
+	}, true
+}
+
+// AddFilteredAttributes appends kvs to the FilteredAttributes of each
+// of exemplars.
+func AddFilteredAttributes(exemplars []aggregation.Exemplar, kvs []attribute.KeyValue) {
+	for i := range exemplars {
+		e := &exemplars[i]
+		e.FilteredAttributes = append(e.FilteredAttributes[:len(e.FilteredAttributes):len(e.FilteredAttributes)], kvs...)
+	}
+}
+
+// Reservoir holds a fixed-size sample of exemplars chosen uniformly
+// from the measurements offered to it. The zero value is an empty
+// Reservoir of DefaultReservoirSize and is safe for concurrent use.
+type Reservoir struct {
+	lock      sync.Mutex
+	exemplars []aggregation.Exemplar
+	// seen is the number of eligible measurements offered since the
+	// Reservoir was last reset.
+	seen int64
+}
+
+// Offer offers the measurement of num recorded with ctx to the
+// Reservoir.
+func (r *Reservoir) Offer(ctx context.Context, num number.Number) {
+	e, ok := New(ctx, num)
+	if !ok {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.seen++
+	if len(r.exemplars) < DefaultReservoirSize {
+		r.exemplars = append(r.exemplars, e)
+		return
+	}
+	// Reservoir sampling: replace an existing exemplar with
+	// probability size/seen.
+	if i := rand.Int63n(r.seen); i < DefaultReservoirSize {
+		r.exemplars[i] = e
+	}
+}
+
+// Exemplars returns the exemplars in the Reservoir.
+func (r *Reservoir) Exemplars() []aggregation.Exemplar {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.exemplars
+}
+
+// Move moves the exemplars of r into o, which must not be in use
+// concurrently, and resets r. If o is nil the exemplars are discarded.
+func (r *Reservoir) Move(o *Reservoir) {
+	r.lock.Lock()
+	exemplars, seen := r.exemplars, r.seen
+	r.exemplars, r.seen = nil, 0
+	r.lock.Unlock()
+
+	if o != nil {
+		o.exemplars, o.seen = exemplars, seen
+	}
+}
+
+// Merge combines the exemplars of o into r, retaining a sample that is
+// uniform over the measurements offered to both.
+func (r *Reservoir) Merge(o *Reservoir) {
+	o.lock.Lock()
+	exemplars, seen := o.exemplars, o.seen
+	o.lock.Unlock()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	total := r.seen + seen
+	for _, e := range exemplars {
+		if len(r.exemplars) < DefaultReservoirSize {
+			r.exemplars = append(r.exemplars, e)
+			continue
+		}
+		// Keep an exemplar of o in proportion to the number
+		// of measurements offered to o.
+		if rand.Int63n(total) < seen {
+			r.exemplars[rand.Intn(len(r.exemplars))] = e
+		}
+	}
+	r.seen = total
+}
+
+// AddFilteredAttributes appends kvs to the FilteredAttributes of the
+// exemplars in the Reservoir.
+func (r *Reservoir) AddFilteredAttributes(kvs []attribute.KeyValue) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	AddFilteredAttributes(r.exemplars, kvs)
+}
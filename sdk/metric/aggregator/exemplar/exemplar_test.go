@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exemplar_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exemplar"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanContext(flags trace.TraceFlags) trace.SpanContext {
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: flags,
+	})
+}
+
+func sampledContext() context.Context {
+	return trace.ContextWithSpanContext(context.Background(), spanContext(trace.FlagsSampled))
+}
+
+func TestNew(t *testing.T) {
+	_, ok := exemplar.New(context.Background(), number.NewInt64Number(1))
+	assert.False(t, ok, "no span")
+
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext(0))
+	_, ok = exemplar.New(ctx, number.NewInt64Number(1))
+	assert.False(t, ok, "unsampled span")
+
+	e, ok := exemplar.New(sampledContext(), number.NewInt64Number(1))
+	require.True(t, ok)
+	assert.Equal(t, number.NewInt64Number(1), e.Value)
+	assert.Equal(t, spanContext(trace.FlagsSampled), e.SpanContext)
+	assert.False(t, e.Time.IsZero())
+}
+
+func TestReservoir(t *testing.T) {
+	var r exemplar.Reservoir
+	r.Offer(context.Background(), number.NewInt64Number(1))
+	assert.Len(t, r.Exemplars(), 0)
+
+	for i := int64(0); i < 10; i++ {
+		r.Offer(sampledContext(), number.NewInt64Number(i))
+	}
+	assert.Len(t, r.Exemplars(), exemplar.DefaultReservoirSize)
+
+	var o exemplar.Reservoir
+	r.Move(&o)
+	assert.Len(t, r.Exemplars(), 0)
+	assert.Len(t, o.Exemplars(), exemplar.DefaultReservoirSize)
+
+	r.Merge(&o)
+	assert.Len(t, r.Exemplars(), exemplar.DefaultReservoirSize)
+	r.Merge(&o)
+	assert.Len(t, r.Exemplars(), exemplar.DefaultReservoirSize)
+
+	r.Move(nil)
+	assert.Len(t, r.Exemplars(), 0)
+}
+
+func TestAddFilteredAttributes(t *testing.T) {
+	var r, o exemplar.Reservoir
+	r.Offer(sampledContext(), number.NewInt64Number(1))
+	r.AddFilteredAttributes([]attribute.KeyValue{attribute.String("a", "1")})
+	o.Merge(&r)
+
+	// Adding attributes to one reservoir does not modify the
+	// exemplars shared with another.
+	o.AddFilteredAttributes([]attribute.KeyValue{attribute.String("b", "2")})
+	assert.Equal(t, []attribute.KeyValue{attribute.String("a", "1")}, r.Exemplars()[0].FilteredAttributes)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("a", "1"),
+		attribute.String("b", "2"),
+	}, o.Exemplars()[0].FilteredAttributes)
+}
@@ -0,0 +1,433 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exponential provides an Aggregator that counts values in
+// base-2 exponential buckets.
+//
+// Buckets are indexed at a scale that determines their width: at scale
+// s the base is 2**(2**-s) and bucket i covers the range (base**i,
+// base**(i+1)]. The aggregator starts at the configured maximum scale
+// and reduces it whenever the recorded values would need more than
+// the configured maximum number of buckets, merging neighboring
+// buckets as it does.
+package exponential // import "go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+)
+
+const (
+	// DefaultMaxSize is the default maximum number of buckets used
+	// for each of the positive and negative ranges.
+	DefaultMaxSize = 160
+
+	// DefaultMaxScale is the default, and largest supported, scale.
+	DefaultMaxScale = 20
+
+	// MinScale is the smallest supported scale. At this scale every
+	// finite float64 value maps to one of a handful of buckets.
+	MinScale = -10
+
+	// MinSize is the smallest supported maximum number of buckets.
+	MinSize = 4
+)
+
+// ErrInfInput is returned by Update when the value is infinite.
+var ErrInfInput = errors.New("infinite value is out of range for this aggregator")
+
+type (
+	// Aggregator observes events and counts them in exponential
+	// buckets. It also calculates the sum and count of all events.
+	Aggregator struct {
+		lock     sync.Mutex
+		kind     number.Kind
+		maxSize  int32
+		maxScale int32
+		state    *state
+	}
+
+	// config describes how the histogram is aggregated.
+	config struct {
+		maxSize  int32
+		maxScale int32
+	}
+
+	// Option configures an exponential histogram config.
+	Option interface {
+		// apply sets one or more config fields.
+		apply(*config)
+	}
+
+	// state represents the state of an exponential histogram.
+	state struct {
+		sum       number.Number
+		count     uint64
+		zeroCount uint64
+		scale     int32
+		positive  buckets
+		negative  buckets
+	}
+
+	// buckets is a contiguous range of bucket counts starting at
+	// index offset.
+	buckets struct {
+		offset int32
+		counts []uint64
+	}
+)
+
+// WithMaxSize sets the maximum number of buckets used for each of the
+// positive and negative ranges. Values less than MinSize are raised
+// to MinSize.
+func WithMaxSize(size int32) Option {
+	return maxSizeOption(size)
+}
+
+type maxSizeOption int32
+
+func (o maxSizeOption) apply(config *config) {
+	config.maxSize = int32(o)
+}
+
+// WithMaxScale sets the scale the aggregator starts from. The value is
+// limited to the range [MinScale, DefaultMaxScale].
+func WithMaxScale(scale int32) Option {
+	return maxScaleOption(scale)
+}
+
+type maxScaleOption int32
+
+func (o maxScaleOption) apply(config *config) {
+	config.maxScale = int32(o)
+}
+
+var _ export.Aggregator = &Aggregator{}
+var _ aggregation.Sum = &Aggregator{}
+var _ aggregation.Count = &Aggregator{}
+var _ aggregation.ExponentialHistogram = &Aggregator{}
+
+// New returns cnt new aggregators for computing exponential
+// histograms.
+func New(cnt int, desc *metric.Descriptor, opts ...Option) []Aggregator {
+	cfg := config{
+		maxSize:  DefaultMaxSize,
+		maxScale: DefaultMaxScale,
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+	if cfg.maxSize < MinSize {
+		cfg.maxSize = MinSize
+	}
+	if cfg.maxScale > DefaultMaxScale {
+		cfg.maxScale = DefaultMaxScale
+	} else if cfg.maxScale < MinScale {
+		cfg.maxScale = MinScale
+	}
+
+	aggs := make([]Aggregator, cnt)
+	for i := range aggs {
+		aggs[i] = Aggregator{
+			kind:     desc.NumberKind(),
+			maxSize:  cfg.maxSize,
+			maxScale: cfg.maxScale,
+			state:    &state{scale: cfg.maxScale},
+		}
+	}
+	return aggs
+}
+
+// Aggregation returns an interface for reading the state of this aggregator.
+func (c *Aggregator) Aggregation() aggregation.Aggregation {
+	return c
+}
+
+// Kind returns aggregation.ExponentialHistogramKind.
+func (c *Aggregator) Kind() aggregation.Kind {
+	return aggregation.ExponentialHistogramKind
+}
+
+// Sum returns the sum of all values in the checkpoint.
+func (c *Aggregator) Sum() (number.Number, error) {
+	return c.state.sum, nil
+}
+
+// Count returns the number of values in the checkpoint.
+func (c *Aggregator) Count() (uint64, error) {
+	return c.state.count, nil
+}
+
+// ExponentialHistogram returns the scale, zero count, and bucket counts
+// in the checkpoint.
+func (c *Aggregator) ExponentialHistogram() (aggregation.ExponentialDistribution, error) {
+	return aggregation.ExponentialDistribution{
+		Scale:     c.state.scale,
+		ZeroCount: c.state.zeroCount,
+		Positive: aggregation.ExponentialBuckets{
+			Offset: c.state.positive.offset,
+			Counts: c.state.positive.counts,
+		},
+		Negative: aggregation.ExponentialBuckets{
+			Offset: c.state.negative.offset,
+			Counts: c.state.negative.counts,
+		},
+	}, nil
+}
+
+// SynchronizedMove saves the current state into oa and resets the
+// current state to the empty set.
+func (c *Aggregator) SynchronizedMove(oa export.Aggregator, desc *metric.Descriptor) error {
+	o, _ := oa.(*Aggregator)
+
+	if oa != nil && o == nil {
+		return aggregator.NewInconsistentAggregatorError(c, oa)
+	}
+
+	if o != nil {
+		o.clearState()
+	}
+
+	c.lock.Lock()
+	if o != nil {
+		c.state, o.state = o.state, c.state
+	} else {
+		c.clearState()
+	}
+	c.lock.Unlock()
+
+	return nil
+}
+
+func (c *Aggregator) clearState() {
+	c.state.positive.clear()
+	c.state.negative.clear()
+	c.state.sum = 0
+	c.state.count = 0
+	c.state.zeroCount = 0
+	c.state.scale = c.maxScale
+}
+
+// Update adds the recorded measurement to the current data set.
+func (c *Aggregator) Update(_ context.Context, number number.Number, desc *metric.Descriptor) error {
+	kind := desc.NumberKind()
+	value := number.CoerceToFloat64(kind)
+	if math.IsInf(value, 0) {
+		return ErrInfInput
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.state.count++
+	c.state.sum.AddNumber(kind, number)
+
+	if value == 0 {
+		c.state.zeroCount++
+		return nil
+	}
+
+	b := &c.state.positive
+	if value < 0 {
+		b = &c.state.negative
+		value = -value
+	}
+
+	index := mapToIndex(value, c.state.scale)
+	if change := b.scaleChange(index, index, c.maxSize); change > 0 {
+		c.state.downscale(change)
+		index >>= change
+	}
+	b.increment(index, 1)
+
+	return nil
+}
+
+// Merge combines two exponential histograms into a single one, reducing
+// the scale of the result as needed to fit both.
+func (c *Aggregator) Merge(oa export.Aggregator, desc *metric.Descriptor) error {
+	o, _ := oa.(*Aggregator)
+	if o == nil {
+		return aggregator.NewInconsistentAggregatorError(c, oa)
+	}
+
+	c.state.sum.AddNumber(desc.NumberKind(), o.state.sum)
+	c.state.count += o.state.count
+	c.state.zeroCount += o.state.zeroCount
+
+	if o.state.scale < c.state.scale {
+		c.state.downscale(c.state.scale - o.state.scale)
+	}
+
+	// Indexes of o shifted to the current scale of c.
+	shift := o.state.scale - c.state.scale
+	change := c.state.positive.mergeScaleChange(&o.state.positive, shift, c.maxSize)
+	if nc := c.state.negative.mergeScaleChange(&o.state.negative, shift, c.maxSize); nc > change {
+		change = nc
+	}
+	c.state.downscale(change)
+	shift += change
+
+	c.state.positive.merge(&o.state.positive, shift)
+	c.state.negative.merge(&o.state.negative, shift)
+	return nil
+}
+
+// downscale reduces the scale by change, merging buckets as needed.
+func (s *state) downscale(change int32) {
+	if change <= 0 {
+		return
+	}
+	s.positive.downscale(change)
+	s.negative.downscale(change)
+	s.scale -= change
+}
+
+// low returns the index of the first bucket.
+func (b *buckets) low() int32 {
+	return b.offset
+}
+
+// high returns the index of the last bucket.
+func (b *buckets) high() int32 {
+	return b.offset + int32(len(b.counts)) - 1
+}
+
+// clear removes all buckets, retaining the allocated memory.
+func (b *buckets) clear() {
+	b.offset = 0
+	b.counts = b.counts[:0]
+}
+
+// scaleChange returns how much the scale must be reduced for b to also
+// hold the indexes in [low, high] using at most maxSize buckets.
+func (b *buckets) scaleChange(low, high, maxSize int32) int32 {
+	if len(b.counts) != 0 {
+		if b.low() < low {
+			low = b.low()
+		}
+		if b.high() > high {
+			high = b.high()
+		}
+	}
+
+	var change int32
+	for high-low >= maxSize {
+		high >>= 1
+		low >>= 1
+		change++
+	}
+	return change
+}
+
+// mergeScaleChange returns how much the scale must be reduced for b to
+// also hold the buckets of o, whose indexes are shifted right by shift.
+func (b *buckets) mergeScaleChange(o *buckets, shift, maxSize int32) int32 {
+	if len(o.counts) == 0 {
+		return 0
+	}
+	return b.scaleChange(o.low()>>shift, o.high()>>shift, maxSize)
+}
+
+// downscale merges buckets so that each covers the range of 2**change
+// buckets at the current scale.
+func (b *buckets) downscale(change int32) {
+	if len(b.counts) == 0 {
+		return
+	}
+	offset := b.offset >> change
+	size := b.high()>>change - offset + 1
+
+	// Merge in place: the destination index never exceeds the
+	// source index.
+	for i := 1; i < len(b.counts); i++ {
+		dst := (b.offset+int32(i))>>change - offset
+		if dst != int32(i) {
+			b.counts[dst] += b.counts[i]
+			b.counts[i] = 0
+		}
+	}
+	b.offset = offset
+	b.counts = b.counts[:size]
+}
+
+// increment adds cnt to the bucket at index, growing b as needed.
+func (b *buckets) increment(index int32, cnt uint64) {
+	switch {
+	case len(b.counts) == 0:
+		b.offset = index
+		b.counts = append(b.counts[:0], cnt)
+		return
+	case index < b.offset:
+		grow := int(b.offset - index)
+		counts := make([]uint64, grow+len(b.counts))
+		copy(counts[grow:], b.counts)
+		b.counts = counts
+		b.offset = index
+	case index > b.high():
+		for i := b.high(); i < index; i++ {
+			b.counts = append(b.counts, 0)
+		}
+	}
+	b.counts[index-b.offset] += cnt
+}
+
+// merge adds the counts of o, whose indexes are shifted right by
+// shift, into b.
+func (b *buckets) merge(o *buckets, shift int32) {
+	for i, cnt := range o.counts {
+		if cnt == 0 {
+			continue
+		}
+		b.increment((o.offset+int32(i))>>shift, cnt)
+	}
+}
+
+// mapToIndex returns the index of the bucket that holds the positive,
+// finite value at scale.
+func mapToIndex(value float64, scale int32) int32 {
+	frac, exp := math.Frexp(value)
+	// value == frac * 2**exp where frac is in [0.5, 1), so value is in
+	// (2**(exp-1), 2**exp) unless it is exactly 2**(exp-1), which is
+	// the inclusive upper boundary of the bucket below.
+	if scale <= 0 {
+		index := int32(exp - 1)
+		if frac == 0.5 {
+			index--
+		}
+		return index >> -scale
+	}
+
+	if frac == 0.5 {
+		return int32(exp-1)<<scale - 1
+	}
+	index := int32(math.Ceil(math.Log(value)*math.Ldexp(math.Log2E, int(scale)))) - 1
+
+	// Correct for rounding error so the index stays within the
+	// buckets that span (2**(exp-1), 2**exp).
+	if low := int32(exp-1) << scale; index < low {
+		index = low
+	} else if high := int32(exp)<<scale - 1; index > high {
+		index = high
+	}
+	return index
+}
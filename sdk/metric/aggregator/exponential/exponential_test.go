@@ -0,0 +1,232 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exponential_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+)
+
+func float64Desc() *metric.Descriptor {
+	return aggregatortest.NewAggregatorTest(metric.ValueRecorderInstrumentKind, number.Float64Kind)
+}
+
+func update(t *testing.T, agg *exponential.Aggregator, desc *metric.Descriptor, values ...float64) {
+	for _, v := range values {
+		aggregatortest.CheckedUpdate(t, agg, number.NewFloat64Number(v), desc)
+	}
+}
+
+func distribution(t *testing.T, agg *exponential.Aggregator) aggregation.ExponentialDistribution {
+	dist, err := agg.ExponentialHistogram()
+	require.NoError(t, err)
+	return dist
+}
+
+// expectedIndex returns the bucket index of value at scale by searching
+// the bucket boundaries.
+func expectedIndex(value float64, scale int32) int32 {
+	value = math.Abs(value)
+	base := math.Exp2(math.Exp2(float64(-scale)))
+	index := int32(math.Floor(math.Log(value) / math.Log(base)))
+	for math.Pow(base, float64(index)) >= value {
+		index--
+	}
+	for math.Pow(base, float64(index+1)) < value {
+		index++
+	}
+	return index
+}
+
+func TestExactPowersOfTwo(t *testing.T) {
+	desc := float64Desc()
+	agg := &exponential.New(1, desc, exponential.WithMaxScale(0))[0]
+	update(t, agg, desc, 1, 2, 4, 8)
+
+	// Bucket boundaries are inclusive above, so 1 is in (0.5, 1].
+	dist := distribution(t, agg)
+	assert.Equal(t, int32(0), dist.Scale)
+	assert.Equal(t, int32(-1), dist.Positive.Offset)
+	assert.Equal(t, []uint64{1, 1, 1, 1}, dist.Positive.Counts)
+	assert.Len(t, dist.Negative.Counts, 0)
+}
+
+func TestDownscale(t *testing.T) {
+	desc := float64Desc()
+	agg := &exponential.New(1, desc, exponential.WithMaxScale(0), exponential.WithMaxSize(4))[0]
+	update(t, agg, desc, 1, 2, 4, 8, 16)
+
+	dist := distribution(t, agg)
+	assert.Equal(t, int32(-1), dist.Scale)
+	assert.Equal(t, int32(-1), dist.Positive.Offset)
+	assert.Equal(t, []uint64{1, 2, 2}, dist.Positive.Counts)
+}
+
+func TestZeroAndNegative(t *testing.T) {
+	desc := float64Desc()
+	agg := &exponential.New(1, desc, exponential.WithMaxScale(0))[0]
+	update(t, agg, desc, 0, -3, 3, 0)
+
+	count, err := agg.Count()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), count)
+
+	sum, err := agg.Sum()
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, sum.AsFloat64())
+
+	dist := distribution(t, agg)
+	assert.Equal(t, uint64(2), dist.ZeroCount)
+	assert.Equal(t, int32(1), dist.Positive.Offset)
+	assert.Equal(t, []uint64{1}, dist.Positive.Counts)
+	assert.Equal(t, int32(1), dist.Negative.Offset)
+	assert.Equal(t, []uint64{1}, dist.Negative.Counts)
+}
+
+func TestInfInput(t *testing.T) {
+	desc := float64Desc()
+	agg := &exponential.New(1, desc)[0]
+	err := agg.Update(context.Background(), number.NewFloat64Number(math.Inf(1)), desc)
+	assert.True(t, errors.Is(err, exponential.ErrInfInput))
+}
+
+func TestOptionLimits(t *testing.T) {
+	desc := float64Desc()
+	agg := &exponential.New(1, desc, exponential.WithMaxScale(100), exponential.WithMaxSize(1))[0]
+	update(t, agg, desc, 1)
+	assert.Equal(t, int32(exponential.DefaultMaxScale), distribution(t, agg).Scale)
+
+	// Extreme values fit even with the smallest size.
+	update(t, agg, desc, math.SmallestNonzeroFloat64, math.MaxFloat64)
+	dist := distribution(t, agg)
+	assert.LessOrEqual(t, len(dist.Positive.Counts), exponential.MinSize)
+	assert.GreaterOrEqual(t, dist.Scale, int32(exponential.MinScale))
+
+	agg = &exponential.New(1, desc, exponential.WithMaxScale(-100))[0]
+	update(t, agg, desc, 1)
+	assert.Equal(t, int32(exponential.MinScale), distribution(t, agg).Scale)
+}
+
+func TestBuckets(t *testing.T) {
+	for _, size := range []int32{4, 20, exponential.DefaultMaxSize} {
+		desc := float64Desc()
+		agg := &exponential.New(1, desc, exponential.WithMaxSize(size))[0]
+
+		values := make([]float64, 1000)
+		for i := range values {
+			values[i] = math.Exp(rand.NormFloat64() * 10)
+			if rand.Intn(2) == 0 {
+				values[i] = -values[i]
+			}
+		}
+		update(t, agg, desc, values...)
+
+		dist := distribution(t, agg)
+		assert.LessOrEqual(t, len(dist.Positive.Counts), int(size))
+		assert.LessOrEqual(t, len(dist.Negative.Counts), int(size))
+
+		pos := make([]uint64, len(dist.Positive.Counts))
+		neg := make([]uint64, len(dist.Negative.Counts))
+		for _, v := range values {
+			idx := expectedIndex(v, dist.Scale)
+			if v > 0 {
+				pos[idx-dist.Positive.Offset]++
+			} else {
+				neg[idx-dist.Negative.Offset]++
+			}
+		}
+		assert.Equal(t, pos, dist.Positive.Counts, "size %d", size)
+		assert.Equal(t, neg, dist.Negative.Counts, "size %d", size)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
+		desc := aggregatortest.NewAggregatorTest(metric.ValueRecorderInstrumentKind, profile.NumberKind)
+		aggs := exponential.New(4, desc, exponential.WithMaxSize(20))
+		agg1, agg2, ckpt1, ckpt2 := &aggs[0], &aggs[1], &aggs[2], &aggs[3]
+		all := &exponential.New(1, desc, exponential.WithMaxSize(20))[0]
+
+		for i := 0; i < 100; i++ {
+			x := profile.Random(+1)
+			aggregatortest.CheckedUpdate(t, agg1, x, desc)
+			aggregatortest.CheckedUpdate(t, all, x, desc)
+
+			// Values in the second aggregator are much
+			// smaller, forcing a different scale.
+			y := number.NewFloat64Number(x.CoerceToFloat64(profile.NumberKind) / 1e6)
+			if profile.NumberKind == number.Int64Kind {
+				y = profile.Random(-1)
+			}
+			aggregatortest.CheckedUpdate(t, agg2, y, desc)
+			aggregatortest.CheckedUpdate(t, all, y, desc)
+		}
+
+		require.NoError(t, agg1.SynchronizedMove(ckpt1, desc))
+		require.NoError(t, agg2.SynchronizedMove(ckpt2, desc))
+		aggregatortest.CheckedMerge(t, ckpt1, ckpt2, desc)
+
+		count, err := ckpt1.Count()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(200), count)
+
+		sum, err := ckpt1.Sum()
+		require.NoError(t, err)
+		allSum, err := all.Sum()
+		require.NoError(t, err)
+		assert.InEpsilon(t, allSum.CoerceToFloat64(profile.NumberKind), sum.CoerceToFloat64(profile.NumberKind), 1e-9)
+
+		assert.Equal(t, distribution(t, all), distribution(t, ckpt1))
+	})
+}
+
+func TestSynchronizedMoveReset(t *testing.T) {
+	aggregatortest.SynchronizedMoveResetTest(
+		t,
+		metric.ValueRecorderInstrumentKind,
+		func(desc *metric.Descriptor) export.Aggregator {
+			return &exponential.New(1, desc)[0]
+		},
+	)
+}
+
+func TestSynchronizedMoveRestoresScale(t *testing.T) {
+	desc := float64Desc()
+	aggs := exponential.New(2, desc, exponential.WithMaxSize(4))
+	agg, ckpt := &aggs[0], &aggs[1]
+
+	update(t, agg, desc, 1, 1e100)
+	require.NoError(t, agg.SynchronizedMove(ckpt, desc))
+	assert.Less(t, distribution(t, ckpt).Scale, int32(0))
+
+	update(t, agg, desc, 1)
+	require.NoError(t, agg.SynchronizedMove(ckpt, desc))
+	dist := distribution(t, ckpt)
+	assert.Equal(t, int32(exponential.DefaultMaxScale), dist.Scale)
+	assert.Equal(t, []uint64{1}, dist.Positive.Counts)
+}
@@ -19,11 +19,13 @@ import (
 	"sort"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/number"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exemplar"
 )
 
 // Note: This code uses a Mutex to govern access to the exclusive
@@ -61,6 +63,11 @@ type (
 		bucketCounts []uint64
 		sum          number.Number
 		count        uint64
+
+		// exemplars holds the last measurement recorded in a
+		// sampled span for each bucket. It is allocated on
+		// the first such measurement.
+		exemplars []aggregation.Exemplar
 	}
 )
 
@@ -101,9 +108,15 @@ var _ export.Aggregator = &Aggregator{}
 var _ aggregation.Sum = &Aggregator{}
 var _ aggregation.Count = &Aggregator{}
 var _ aggregation.Histogram = &Aggregator{}
+var _ aggregation.Exemplars = &Aggregator{}
+var _ exemplar.Filterable = &Aggregator{}
 
 // New returns a new aggregator for computing Histograms.
 //
+// The bucket boundaries are those of the WithExplicitBoundaries option,
+// if present, otherwise those advised by the instrument descriptor, if
+// any, otherwise the defaults for the descriptor number kind.
+//
 // A Histogram observe events and counts them in pre-defined buckets.
 // And also provides the total sum and count of all observations.
 //
@@ -113,7 +126,11 @@ var _ aggregation.Histogram = &Aggregator{}
 func New(cnt int, desc *metric.Descriptor, opts ...Option) []Aggregator {
 	var cfg config
 
-	if desc.NumberKind() == number.Int64Kind {
+	if advice := desc.ExplicitBucketBoundaries(); advice != nil {
+		// Boundaries advised by the instrumentation replace the
+		// defaults, but not the boundaries configured with options.
+		cfg.explicitBoundaries = advice
+	} else if desc.NumberKind() == number.Int64Kind {
 		cfg.explicitBoundaries = defaultInt64ExplicitBoundaries
 	} else {
 		cfg.explicitBoundaries = defaultFloat64ExplicitBoundaries
@@ -170,6 +187,28 @@ func (c *Aggregator) Histogram() (aggregation.Buckets, error) {
 	}, nil
 }
 
+// Exemplars returns the last measurement recorded in a sampled span for
+// each bucket that has one, in bucket order.
+func (c *Aggregator) Exemplars() ([]aggregation.Exemplar, error) {
+	var exemplars []aggregation.Exemplar
+	for _, e := range c.state.exemplars {
+		if !e.Time.IsZero() {
+			exemplars = append(exemplars, e)
+		}
+	}
+	return exemplars, nil
+}
+
+// AddFilteredAttributes records kvs as filtered attributes of the
+// exemplars in the checkpoint.
+func (c *Aggregator) AddFilteredAttributes(kvs []attribute.KeyValue) {
+	for i := range c.state.exemplars {
+		if !c.state.exemplars[i].Time.IsZero() {
+			exemplar.AddFilteredAttributes(c.state.exemplars[i:i+1], kvs)
+		}
+	}
+}
+
 // SynchronizedMove saves the current state into oa and resets the current state to
 // the empty set.  Since no locks are taken, there is a chance that
 // the independent Sum, Count and Bucket Count are not consistent with each
@@ -215,12 +254,15 @@ func (c *Aggregator) clearState() {
 	for i := range c.state.bucketCounts {
 		c.state.bucketCounts[i] = 0
 	}
+	for i := range c.state.exemplars {
+		c.state.exemplars[i] = aggregation.Exemplar{}
+	}
 	c.state.sum = 0
 	c.state.count = 0
 }
 
 // Update adds the recorded measurement to the current data set.
-func (c *Aggregator) Update(_ context.Context, number number.Number, desc *metric.Descriptor) error {
+func (c *Aggregator) Update(ctx context.Context, number number.Number, desc *metric.Descriptor) error {
 	kind := desc.NumberKind()
 	asFloat := number.CoerceToFloat64(kind)
 
@@ -250,6 +292,13 @@ func (c *Aggregator) Update(_ context.Context, number number.Number, desc *metri
 	c.state.sum.AddNumber(kind, number)
 	c.state.bucketCounts[bucketID]++
 
+	if e, ok := exemplar.New(ctx, number); ok {
+		if c.state.exemplars == nil {
+			c.state.exemplars = make([]aggregation.Exemplar, len(c.state.bucketCounts))
+		}
+		c.state.exemplars[bucketID] = e
+	}
+
 	return nil
 }
 
@@ -266,5 +315,18 @@ func (c *Aggregator) Merge(oa export.Aggregator, desc *metric.Descriptor) error
 	for i := 0; i < len(c.state.bucketCounts); i++ {
 		c.state.bucketCounts[i] += o.state.bucketCounts[i]
 	}
+
+	// Keep the most recent exemplar of each bucket.
+	for i, e := range o.state.exemplars {
+		if e.Time.IsZero() {
+			continue
+		}
+		if c.state.exemplars == nil {
+			c.state.exemplars = make([]aggregation.Exemplar, len(c.state.bucketCounts))
+		}
+		if e.Time.After(c.state.exemplars[i].Time) {
+			c.state.exemplars[i] = e
+		}
+	}
 	return nil
 }
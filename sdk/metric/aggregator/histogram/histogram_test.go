@@ -28,6 +28,7 @@ import (
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const count = 100
@@ -294,3 +295,62 @@ func TestHistogramDefaultBoundaries(t *testing.T) {
 		require.EqualValues(t, expect, bucks.Counts)
 	})
 }
+
+func TestHistogramAdvisedBoundaries(t *testing.T) {
+	advice := metric.WithExplicitBucketBoundaries(100, 10, 1000)
+	for _, nkind := range []number.Kind{number.Int64Kind, number.Float64Kind} {
+		desc := metric.NewDescriptor("latency", metric.ValueRecorderInstrumentKind, nkind, advice)
+
+		agg := &histogram.New(1, &desc)[0]
+		bucks, err := agg.Histogram()
+		require.NoError(t, err)
+		require.Equal(t, []float64{10, 100, 1000}, bucks.Boundaries)
+
+		// Explicitly configured boundaries take precedence.
+		agg = &histogram.New(1, &desc, histogram.WithExplicitBoundaries([]float64{5, 50}))[0]
+		bucks, err = agg.Histogram()
+		require.NoError(t, err)
+		require.Equal(t, []float64{5, 50}, bucks.Boundaries)
+	}
+}
+
+func TestHistogramExemplars(t *testing.T) {
+	descriptor := aggregatortest.NewAggregatorTest(metric.ValueRecorderInstrumentKind, number.Float64Kind)
+	aggs := histogram.New(3, descriptor, histogram.WithExplicitBoundaries(testBoundaries))
+	agg, ckpt1, ckpt2 := &aggs[0], &aggs[1], &aggs[2]
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	// Each bucket keeps its last exemplar.
+	for _, v := range []float64{100, 200, 600} {
+		require.NoError(t, agg.Update(ctx, number.NewFloat64Number(v), descriptor))
+	}
+	require.NoError(t, agg.Update(context.Background(), number.NewFloat64Number(800), descriptor))
+	require.NoError(t, agg.SynchronizedMove(ckpt1, descriptor))
+
+	exemplars, err := ckpt1.Exemplars()
+	require.NoError(t, err)
+	require.Len(t, exemplars, 2)
+	require.Equal(t, 200.0, exemplars[0].Value.AsFloat64())
+	require.Equal(t, 600.0, exemplars[1].Value.AsFloat64())
+
+	// Merging keeps the most recent exemplar of each bucket.
+	require.NoError(t, agg.Update(ctx, number.NewFloat64Number(300), descriptor))
+	require.NoError(t, agg.SynchronizedMove(ckpt2, descriptor))
+	require.NoError(t, ckpt1.Merge(ckpt2, descriptor))
+
+	exemplars, err = ckpt1.Exemplars()
+	require.NoError(t, err)
+	require.Len(t, exemplars, 3)
+	require.Equal(t, 300.0, exemplars[1].Value.AsFloat64())
+
+	// Reset clears the exemplars.
+	require.NoError(t, ckpt1.SynchronizedMove(nil, descriptor))
+	exemplars, err = ckpt1.Exemplars()
+	require.NoError(t, err)
+	require.Len(t, exemplars, 0)
+}
@@ -17,11 +17,13 @@ package sum // import "go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/number"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exemplar"
 )
 
 // Aggregator aggregates counter events.
@@ -29,11 +31,16 @@ type Aggregator struct {
 	// current holds current increments to this counter record
 	// current needs to be aligned for 64-bit atomic operations.
 	value number.Number
+
+	// exemplars samples measurements recorded in sampled spans.
+	exemplars exemplar.Reservoir
 }
 
 var _ export.Aggregator = &Aggregator{}
 var _ export.Subtractor = &Aggregator{}
 var _ aggregation.Sum = &Aggregator{}
+var _ aggregation.Exemplars = &Aggregator{}
+var _ exemplar.Filterable = &Aggregator{}
 
 // New returns a new counter aggregator implemented by atomic
 // operations.  This aggregator implements the aggregation.Sum
@@ -58,11 +65,24 @@ func (c *Aggregator) Sum() (number.Number, error) {
 	return c.value, nil
 }
 
+// Exemplars returns the measurements sampled in the last checkpoint.
+// This will never return an error.
+func (c *Aggregator) Exemplars() ([]aggregation.Exemplar, error) {
+	return c.exemplars.Exemplars(), nil
+}
+
+// AddFilteredAttributes records kvs as filtered attributes of the
+// exemplars in the last checkpoint.
+func (c *Aggregator) AddFilteredAttributes(kvs []attribute.KeyValue) {
+	c.exemplars.AddFilteredAttributes(kvs)
+}
+
 // SynchronizedMove atomically saves the current value into oa and resets the
 // current sum to zero.
 func (c *Aggregator) SynchronizedMove(oa export.Aggregator, _ *metric.Descriptor) error {
 	if oa == nil {
 		c.value.SetRawAtomic(0)
+		c.exemplars.Move(nil)
 		return nil
 	}
 	o, _ := oa.(*Aggregator)
@@ -70,12 +90,14 @@ func (c *Aggregator) SynchronizedMove(oa export.Aggregator, _ *metric.Descriptor
 		return aggregator.NewInconsistentAggregatorError(c, oa)
 	}
 	o.value = c.value.SwapNumberAtomic(number.Number(0))
+	c.exemplars.Move(&o.exemplars)
 	return nil
 }
 
 // Update atomically adds to the current value.
-func (c *Aggregator) Update(_ context.Context, num number.Number, desc *metric.Descriptor) error {
+func (c *Aggregator) Update(ctx context.Context, num number.Number, desc *metric.Descriptor) error {
 	c.value.AddNumberAtomic(desc.NumberKind(), num)
+	c.exemplars.Offer(ctx, num)
 	return nil
 }
 
@@ -86,6 +108,7 @@ func (c *Aggregator) Merge(oa export.Aggregator, desc *metric.Descriptor) error
 		return aggregator.NewInconsistentAggregatorError(c, oa)
 	}
 	c.value.AddNumber(desc.NumberKind(), o.value)
+	c.exemplars.Merge(&o.exemplars)
 	return nil
 }
 
@@ -15,6 +15,7 @@
 package sum
 
 import (
+	"context"
 	"os"
 	"testing"
 	"unsafe"
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/metric/number"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const count = 100
@@ -152,3 +154,29 @@ func TestSynchronizedMoveReset(t *testing.T) {
 		},
 	)
 }
+
+func TestExemplars(t *testing.T) {
+	descriptor := aggregatortest.NewAggregatorTest(metric.CounterInstrumentKind, number.Int64Kind)
+	agg, ckpt := new2()
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	require.NoError(t, agg.Update(context.Background(), number.NewInt64Number(1), descriptor))
+	require.NoError(t, agg.Update(ctx, number.NewInt64Number(2), descriptor))
+	require.NoError(t, agg.SynchronizedMove(ckpt, descriptor))
+
+	exemplars, err := ckpt.Exemplars()
+	require.NoError(t, err)
+	require.Len(t, exemplars, 1)
+	require.Equal(t, number.NewInt64Number(2), exemplars[0].Value)
+	require.Equal(t, sc, exemplars[0].SpanContext)
+
+	exemplars, err = agg.Exemplars()
+	require.NoError(t, err)
+	require.Len(t, exemplars, 0)
+}
@@ -119,6 +119,40 @@ func BenchmarkInt64CounterAddWithLabels_16(b *testing.B) {
 	benchmarkLabels(b, 16)
 }
 
+func benchmarkBoundLabels(b *testing.B, n int) {
+	ctx := context.Background()
+	fix := newFixture(b)
+	labs := makeLabels(n)
+	cnt := fix.meterMust().NewInt64Counter("int64.sum")
+	handle := cnt.Bind(labs...)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		handle.Add(ctx, 1)
+	}
+}
+
+func BenchmarkInt64CounterHandleAddWithLabels_1(b *testing.B) {
+	benchmarkBoundLabels(b, 1)
+}
+
+func BenchmarkInt64CounterHandleAddWithLabels_2(b *testing.B) {
+	benchmarkBoundLabels(b, 2)
+}
+
+func BenchmarkInt64CounterHandleAddWithLabels_4(b *testing.B) {
+	benchmarkBoundLabels(b, 4)
+}
+
+func BenchmarkInt64CounterHandleAddWithLabels_8(b *testing.B) {
+	benchmarkBoundLabels(b, 8)
+}
+
+func BenchmarkInt64CounterHandleAddWithLabels_16(b *testing.B) {
+	benchmarkBoundLabels(b, 16)
+}
+
 // Note: performance does not depend on label set size for the
 // benchmarks below--all are benchmarked for a single attribute.
 
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+// config contains the options for configuring an Accumulator.
+type config struct {
+	// CardinalityLimit is the maximum number of distinct label
+	// sets aggregated for each instrument, including the overflow
+	// label set.  Once it is reached, measurements for new label
+	// sets are aggregated with the overflow label set instead.
+	//
+	// Default value is 0, the number of label sets is unlimited.
+	CardinalityLimit int
+}
+
+// Option is the interface that applies the value to a configuration option.
+type Option interface {
+	// applyAccumulator sets the Option value of a config.
+	applyAccumulator(*config)
+}
+
+// WithCardinalityLimit sets the maximum number of distinct label sets
+// aggregated for each instrument.  When the limit is reached,
+// measurements for label sets that are not already being aggregated are
+// aggregated in a single series with the `otel.metric.overflow=true`
+// label, and are counted by the `otel.sdk.metric.overflow` instrument of
+// the Accumulator.  A limit of zero or less disables the limit.
+func WithCardinalityLimit(limit int) Option {
+	return cardinalityLimitOption(limit)
+}
+
+type cardinalityLimitOption int
+
+func (o cardinalityLimitOption) applyAccumulator(cfg *config) {
+	cfg.CardinalityLimit = int(o)
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controllerTime "go.opentelemetry.io/otel/sdk/metric/controller/time"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -52,10 +53,53 @@ type config struct {
 	// export.Exporter.  These will directly call Collect() and ForEach().
 	Exporter export.Exporter
 
+	// ExportKindSelector overrides the ExportKindSelector of the
+	// Exporter when the Controller exports checkpoints.  The Processor
+	// of the Controller must be created with the same selector, as it
+	// decides which Records are kept in memory.
+	//
+	// Default value is nil, the Exporter selects the ExportKind.
+	ExportKindSelector export.ExportKindSelector
+
 	// PushTimeout is the timeout of the Context when a exporter is configured.
 	//
 	// Default value is 10s.  If zero, no Export timeout is applied.
 	PushTimeout time.Duration
+
+	// Clock is used to schedule collections and to timestamp
+	// checkpoints.  Tests may use a mock clock to advance time
+	// deterministically.
+	//
+	// Default value is the system clock.
+	Clock controllerTime.Clock
+
+	// CardinalityLimit is the maximum number of distinct label
+	// sets aggregated for each instrument.  See
+	// sdk.WithCardinalityLimit.
+	//
+	// Default value is 0, the number of label sets is unlimited.
+	CardinalityLimit int
+
+	// Readers are additional PeriodicReaders collecting and
+	// exporting the metrics of the Controller.
+	//
+	// Default value is nil, the Controller only has its own
+	// Checkpointer and Exporter.
+	Readers []*PeriodicReader
+}
+
+// newConfig returns a config with the default values, configured
+// with opts.
+func newConfig(opts []Option) *config {
+	c := &config{
+		CollectPeriod:  DefaultPeriod,
+		CollectTimeout: DefaultPeriod,
+		PushTimeout:    DefaultPeriod,
+	}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	return c
 }
 
 // Option is the interface that applies the value to a configuration option.
@@ -110,6 +154,32 @@ func (o exporterOption) apply(cfg *config) {
 	cfg.Exporter = o.exporter
 }
 
+// WithExportKindSelector sets the ExportKindSelector configuration option
+// of a Config.
+func WithExportKindSelector(selector export.ExportKindSelector) Option {
+	return exportKindSelectorOption{selector}
+}
+
+type exportKindSelectorOption struct {
+	selector export.ExportKindSelector
+}
+
+func (o exportKindSelectorOption) apply(cfg *config) {
+	cfg.ExportKindSelector = o.selector
+}
+
+// WithCardinalityLimit sets the CardinalityLimit configuration option
+// of a Config.
+func WithCardinalityLimit(limit int) Option {
+	return cardinalityLimitOption(limit)
+}
+
+type cardinalityLimitOption int
+
+func (o cardinalityLimitOption) apply(cfg *config) {
+	cfg.CardinalityLimit = int(o)
+}
+
 // WithPushTimeout sets the PushTimeout configuration option of a Config.
 func WithPushTimeout(timeout time.Duration) Option {
 	return pushTimeoutOption(timeout)
@@ -120,3 +190,26 @@ type pushTimeoutOption time.Duration
 func (o pushTimeoutOption) apply(cfg *config) {
 	cfg.PushTimeout = time.Duration(o)
 }
+
+// WithClock sets the Clock configuration option of a Config.
+func WithClock(clock controllerTime.Clock) Option {
+	return clockOption{clock}
+}
+
+type clockOption struct{ clock controllerTime.Clock }
+
+func (o clockOption) apply(cfg *config) {
+	cfg.Clock = o.clock
+}
+
+// WithReader registers a PeriodicReader with the Controller.  The option
+// may be passed several times to register several readers.
+func WithReader(reader *PeriodicReader) Option {
+	return readerOption{reader}
+}
+
+type readerOption struct{ reader *PeriodicReader }
+
+func (o readerOption) apply(cfg *config) {
+	cfg.Readers = append(cfg.Readers, o.reader)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/controller/controllertest"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -35,3 +36,11 @@ func TestWithResource(t *testing.T) {
 	WithResource(r).apply(c)
 	assert.Equal(t, r.Equivalent(), c.Resource.Equivalent())
 }
+
+func TestWithClock(t *testing.T) {
+	mock := controllertest.NewMockClock()
+
+	c := &config{}
+	WithClock(mock).apply(c)
+	assert.Equal(t, mock, c.Clock)
+}
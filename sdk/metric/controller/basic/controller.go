@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/registry"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
@@ -36,10 +37,18 @@ import (
 // - the timeout for Collect().
 const DefaultPeriod = 10 * time.Second
 
+// component is the component the errors of the controller and its readers
+// are reported with.
+const component = "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+
 // ErrControllerStarted indicates that a controller was started more
 // than once.
 var ErrControllerStarted = fmt.Errorf("controller already started")
 
+// ErrReaderNotRegistered indicates that a PeriodicReader was flushed
+// before it was registered with a Controller using WithReader.
+var ErrReaderNotRegistered = fmt.Errorf("reader not registered with a Controller")
+
 // Controller organizes and synchronizes collection of metric data in
 // both "pull" and "push" configurations.  This supports two distinct
 // modes:
@@ -54,20 +63,22 @@ var ErrControllerStarted = fmt.Errorf("controller already started")
 // The controller supports mixing push and pull access to metric data
 // using the export.CheckpointSet RWLock interface.  Collection will
 // be blocked by a pull request in the basic controller.
+//
+// Additional PeriodicReaders registered with WithReader are started and
+// stopped with the controller and export the same instruments
+// independently.
 type Controller struct {
-	lock         sync.Mutex
-	accumulator  *sdk.Accumulator
-	provider     *registry.MeterProvider
-	checkpointer export.Checkpointer
-	exporter     export.Exporter
-	wg           sync.WaitGroup
-	stopCh       chan struct{}
-	clock        controllerTime.Clock
-	ticker       controllerTime.Ticker
+	lock     sync.Mutex
+	provider *registry.MeterProvider
+	clock    controllerTime.Clock
+
+	// reader collects into the checkpointer and exports with the
+	// exporter the Controller is created with.
+	reader *PeriodicReader
+	// readers are the additional readers registered with WithReader.
+	readers []*PeriodicReader
 
-	collectPeriod  time.Duration
-	collectTimeout time.Duration
-	pushTimeout    time.Duration
+	collectPeriod time.Duration
 
 	// collectedTime is used only in configurations with no
 	// exporter, when ticker != nil.
@@ -78,38 +89,51 @@ type Controller struct {
 // options (including optional exporter) to configure a metric
 // export pipeline.
 func New(checkpointer export.Checkpointer, opts ...Option) *Controller {
-	c := &config{
-		CollectPeriod:  DefaultPeriod,
-		CollectTimeout: DefaultPeriod,
-		PushTimeout:    DefaultPeriod,
-	}
-	for _, opt := range opts {
-		opt.apply(c)
-	}
+	c := newConfig(opts)
 	if c.Resource == nil {
 		c.Resource = resource.Default()
 	}
+	if c.Clock == nil {
+		c.Clock = controllerTime.RealClock{}
+	}
 
-	impl := sdk.NewAccumulator(
-		checkpointer,
-		c.Resource,
-	)
-	return &Controller{
-		provider:     registry.NewMeterProvider(impl),
-		accumulator:  impl,
+	reader := &PeriodicReader{
 		checkpointer: checkpointer,
 		exporter:     c.Exporter,
-		stopCh:       nil,
-		clock:        controllerTime.RealClock{},
+		kindSelector: c.ExportKindSelector,
 
 		collectPeriod:  c.CollectPeriod,
 		collectTimeout: c.CollectTimeout,
 		pushTimeout:    c.PushTimeout,
 	}
+	readers := append([]*PeriodicReader{reader}, c.Readers...)
+	accumulators := make([]*sdk.Accumulator, len(readers))
+	for i, r := range readers {
+		r.accumulator = sdk.NewAccumulator(
+			r.checkpointer,
+			c.Resource,
+			sdk.WithCardinalityLimit(c.CardinalityLimit),
+		)
+		accumulators[i] = r.accumulator
+	}
+
+	var impl metric.MeterImpl = reader.accumulator
+	if len(accumulators) > 1 {
+		impl = sdk.NewAccumulatorGroup(accumulators...)
+	}
+	return &Controller{
+		provider: registry.NewMeterProvider(impl, registry.WithConflictHandler(otel.Handle)),
+		clock:    c.Clock,
+		reader:   reader,
+		readers:  c.Readers,
+
+		collectPeriod: c.CollectPeriod,
+	}
 }
 
 // SetClock supports setting a mock clock for testing.  This must be
-// called before Start().
+// called before Start().  WithClock can be used to configure the clock
+// when the Controller is created instead.
 func (c *Controller) SetClock(clock controllerTime.Clock) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -124,7 +148,8 @@ func (c *Controller) MeterProvider() metric.MeterProvider {
 // Start begins a ticker that periodically collects and exports
 // metrics with the configured interval.  This is required for calling
 // a configured Exporter (see WithExporter) and is otherwise optional
-// when only pulling metric data.
+// when only pulling metric data.  The PeriodicReaders registered with
+// WithReader are started with their own interval.
 //
 // The passed context is passed to Collect() and subsequently to
 // asynchronous instrument callbacks.  Returns an error when the
@@ -137,135 +162,49 @@ func (c *Controller) Start(ctx context.Context) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.stopCh != nil {
-		return ErrControllerStarted
+	if err := c.reader.start(ctx, c.clock); err != nil {
+		return err
 	}
-
-	c.wg.Add(1)
-	c.stopCh = make(chan struct{})
-	c.ticker = c.clock.Ticker(c.collectPeriod)
-	go c.runTicker(ctx, c.stopCh)
+	for _, r := range c.readers {
+		if err := r.start(ctx, c.clock); err != nil {
+			otel.HandleClassified(component, otel.SeverityError, err)
+		}
+	}
+	global.Info("metric controller started", "period", c.collectPeriod, "exporter", c.reader.exporter != nil, "readers", len(c.readers))
 	return nil
 }
 
-// Stop waits for the background goroutine to return and then collects
+// Stop waits for the background goroutines to return and then collects
 // and exports metrics one last time before returning.  The passed
 // context is passed to the final Collect() and subsequently to the
-// final asynchronous instruments.
+// final asynchronous instruments.  The PeriodicReaders registered with
+// WithReader are stopped first, the error of the last export of the
+// Controller is returned and the errors of the readers are sent to
+// otel.HandleClassified.
 //
 // Note that Stop() will not cancel an ongoing collection or export.
 func (c *Controller) Stop(ctx context.Context) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.stopCh == nil {
+	if !c.reader.isRunning() {
 		return nil
 	}
 
-	close(c.stopCh)
-	c.stopCh = nil
-	c.wg.Wait()
-	c.ticker.Stop()
-	c.ticker = nil
-
-	return c.collect(ctx)
-}
-
-// runTicker collection on ticker events until the stop channel is closed.
-func (c *Controller) runTicker(ctx context.Context, stopCh chan struct{}) {
-	defer c.wg.Done()
-	for {
-		select {
-		case <-stopCh:
-			return
-		case <-c.ticker.C():
-			if err := c.collect(ctx); err != nil {
-				otel.Handle(err)
-			}
+	for _, r := range c.readers {
+		if err := r.stop(ctx); err != nil {
+			otel.HandleClassified(component, otel.SeverityError, err)
 		}
 	}
-}
-
-// collect computes a checkpoint and optionally exports it.
-func (c *Controller) collect(ctx context.Context) error {
-	if err := c.checkpoint(ctx, func() bool {
-		return true
-	}); err != nil {
-		return err
-	}
-	if c.exporter == nil {
-		return nil
-	}
-	// Note: this is not subject to collectTimeout.  This blocks the next
-	// collection despite collectTimeout because it holds a lock.
-	if err := c.export(ctx); err != nil {
-		return err
-	}
-	return nil
-}
-
-// checkpoint calls the Accumulator and Checkpointer interfaces to
-// compute the CheckpointSet.  This applies the configured collection
-// timeout.  Note that this does not try to cancel a Collect or Export
-// when Stop() is called.
-func (c *Controller) checkpoint(ctx context.Context, cond func() bool) error {
-	ckpt := c.checkpointer.CheckpointSet()
-	ckpt.Lock()
-	defer ckpt.Unlock()
-
-	if !cond() {
-		return nil
-	}
-	c.checkpointer.StartCollection()
-
-	if c.collectTimeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, c.collectTimeout)
-		defer cancel()
-	}
-
-	_ = c.accumulator.Collect(ctx)
-
-	var err error
-	select {
-	case <-ctx.Done():
-		err = ctx.Err()
-	default:
-		// The context wasn't done, ok.
-	}
-
-	// Finish the checkpoint whether the accumulator timed out or not.
-	if cerr := c.checkpointer.FinishCollection(); cerr != nil {
-		if err == nil {
-			err = cerr
-		} else {
-			err = fmt.Errorf("%s: %w", cerr.Error(), err)
-		}
-	}
-
+	err := c.reader.stop(ctx)
+	global.Info("metric controller stopped")
 	return err
 }
 
-// export calls the exporter with a read lock on the CheckpointSet,
-// applying the configured export timeout.
-func (c *Controller) export(ctx context.Context) error {
-	ckpt := c.checkpointer.CheckpointSet()
-	ckpt.RLock()
-	defer ckpt.RUnlock()
-
-	if c.pushTimeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, c.pushTimeout)
-		defer cancel()
-	}
-
-	return c.exporter.Export(ctx, ckpt)
-}
-
 // ForEach gives the caller read-locked access to the current
 // export.CheckpointSet.
 func (c *Controller) ForEach(ks export.ExportKindSelector, f func(export.Record) error) error {
-	ckpt := c.checkpointer.CheckpointSet()
+	ckpt := c.reader.checkpointer.CheckpointSet()
 	ckpt.RLock()
 	defer ckpt.RUnlock()
 
@@ -276,9 +215,7 @@ func (c *Controller) ForEach(ks export.ExportKindSelector, f func(export.Record)
 // indicating that the current export.CheckpointSet is being kept
 // up-to-date.
 func (c *Controller) IsRunning() bool {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-	return c.ticker != nil
+	return c.reader.isRunning()
 }
 
 // Collect requests a collection.  The collection will be skipped if
@@ -291,7 +228,28 @@ func (c *Controller) Collect(ctx context.Context) error {
 		return ErrControllerStarted
 	}
 
-	return c.checkpoint(ctx, c.shouldCollect)
+	return c.reader.checkpoint(ctx, c.shouldCollect)
+}
+
+// ForceFlush collects and exports metrics immediately, whether or not the
+// controller was started and regardless of the collection period. This is
+// intended for environments that may suspend the process as soon as a unit
+// of work completes, like FaaS runtimes.
+//
+// The PeriodicReaders registered with WithReader are flushed as well.  The
+// first error is returned and the following ones are sent to otel.HandleClassified.
+func (c *Controller) ForceFlush(ctx context.Context) error {
+	err := c.reader.ForceFlush(ctx)
+	for _, r := range c.readers {
+		if rerr := r.ForceFlush(ctx); rerr != nil {
+			if err == nil {
+				err = rerr
+			} else {
+				otel.HandleClassified(component, otel.SeverityError, rerr)
+			}
+		}
+	}
+	return err
 }
 
 // shouldCollect returns true if the collector should collect now,
@@ -421,3 +421,76 @@ func TestCollectAfterStopThenStartAgain(t *testing.T) {
 		"one.lastvalue//": 6,
 	}, exp.Values())
 }
+
+func TestForceFlush(t *testing.T) {
+	exp := processortest.NewExporter(
+		export.CumulativeExportKindSelector(),
+		attribute.DefaultEncoder(),
+	)
+	cont := controller.New(
+		processor.New(
+			processortest.AggregatorSelector(),
+			exp,
+		),
+		controller.WithCollectPeriod(time.Hour),
+		controller.WithExporter(exp),
+		controller.WithResource(resource.Empty()),
+	)
+	mock := controllertest.NewMockClock()
+	cont.SetClock(mock)
+
+	calls := 0
+	_ = metric.Must(cont.MeterProvider().Meter("named")).NewInt64SumObserver("one.lastvalue",
+		func(ctx context.Context, result metric.Int64ObserverResult) {
+			calls++
+			result.Observe(int64(calls))
+		},
+	)
+
+	// ForceFlush exports although the collection period has not elapsed,
+	// both before the controller is started and while it is running.
+	require.NoError(t, cont.ForceFlush(context.Background()))
+	require.EqualValues(t, map[string]float64{
+		"one.lastvalue//": 1,
+	}, exp.Values())
+
+	require.NoError(t, cont.Start(context.Background()))
+	require.NoError(t, cont.ForceFlush(context.Background()))
+	require.EqualValues(t, map[string]float64{
+		"one.lastvalue//": 2,
+	}, exp.Values())
+	require.NoError(t, cont.Stop(context.Background()))
+}
+
+func TestDuplicateInstrumentRegistration(t *testing.T) {
+	cont := controller.New(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+		),
+		controller.WithResource(resource.Empty()),
+	)
+	ctx := context.Background()
+	meter := metric.Must(cont.MeterProvider().Meter("named"))
+	require.NoError(t, testHandler.Flush())
+
+	counter := meter.NewInt64Counter("dup.sum")
+	require.Equal(t, counter, meter.NewInt64Counter("dup.sum"))
+	require.NoError(t, testHandler.Flush())
+
+	// The conflicting registration is reported, and its instrument
+	// still records.
+	recorder := meter.NewFloat64ValueRecorder("dup.sum")
+	err := testHandler.Flush()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duplicate registration of metric dup.sum")
+
+	counter.Add(ctx, 1, attribute.String("A", "B"))
+	recorder.Record(ctx, 2.5, attribute.String("C", "D"))
+
+	require.NoError(t, cont.Collect(ctx))
+	require.EqualValues(t, map[string]float64{
+		"dup.sum/A=B/": 1,
+		"dup.sum/C=D/": 2.5,
+	}, getMap(t, cont))
+}
@@ -41,12 +41,20 @@ var testResource = resource.NewWithAttributes(attribute.String("R", "V"))
 
 type handler struct {
 	sync.Mutex
-	err error
+	err       error
+	component string
+	severity  otel.Severity
 }
 
 func (h *handler) Handle(err error) {
+	h.HandleClassified("", otel.SeverityError, err)
+}
+
+func (h *handler) HandleClassified(component string, sev otel.Severity, err error) {
 	h.Lock()
 	h.err = err
+	h.component = component
+	h.severity = sev
 	h.Unlock()
 }
 
@@ -58,6 +66,13 @@ func (h *handler) Flush() error {
 	return err
 }
 
+// Classification returns the component and severity of the last error.
+func (h *handler) Classification() (string, otel.Severity) {
+	h.Lock()
+	defer h.Unlock()
+	return h.component, h.severity
+}
+
 var testHandler *handler
 
 func init() {
@@ -106,17 +121,16 @@ func TestPushDoubleStart(t *testing.T) {
 func TestPushTicker(t *testing.T) {
 	exporter := newExporter()
 	checkpointer := newCheckpointer()
+	mock := controllertest.NewMockClock()
 	p := controller.New(
 		checkpointer,
 		controller.WithExporter(exporter),
 		controller.WithCollectPeriod(time.Second),
 		controller.WithResource(testResource),
+		controller.WithClock(mock),
 	)
 	meter := p.MeterProvider().Meter("name")
 
-	mock := controllertest.NewMockClock()
-	p.SetClock(mock)
-
 	ctx := context.Background()
 
 	counter := metric.Must(meter).NewInt64Counter("counter.sum")
@@ -219,12 +233,65 @@ func TestPushExportError(t *testing.T) {
 				require.EqualValues(t, tt.expected, exporter.Values())
 				require.NoError(t, testHandler.Flush())
 			} else {
+				component, sev := testHandler.Classification()
 				err := testHandler.Flush()
 				require.Error(t, err)
 				require.Equal(t, tt.expectedError, err)
+				require.Equal(t, "go.opentelemetry.io/otel/sdk/metric/controller/basic", component)
+				require.Equal(t, otel.SeverityError, sev)
 			}
 
 			require.NoError(t, p.Stop(ctx))
 		})
 	}
 }
+
+func TestPushExportKindSelector(t *testing.T) {
+	// The exporter prefers cumulative sums, the controller exports the
+	// sum observer as deltas.
+	exporter := processortest.NewExporter(
+		export.CumulativeExportKindSelector(),
+		attribute.DefaultEncoder(),
+	)
+	selector := export.InstrumentKindExportKindSelector(map[metric.InstrumentKind]export.ExportKind{
+		metric.SumObserverInstrumentKind: export.DeltaExportKind,
+	}, export.CumulativeExportKindSelector())
+	mock := controllertest.NewMockClock()
+	p := controller.New(
+		processor.New(processortest.AggregatorSelector(), selector),
+		controller.WithExporter(exporter),
+		controller.WithExportKindSelector(selector),
+		controller.WithCollectPeriod(time.Second),
+		controller.WithResource(testResource),
+		controller.WithClock(mock),
+	)
+	meter := p.MeterProvider().Meter("name")
+
+	ctx := context.Background()
+
+	observed := int64(3)
+	_ = metric.Must(meter).NewInt64SumObserver("observer.sum", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(observed)
+	})
+
+	require.NoError(t, p.Start(ctx))
+
+	mock.Add(time.Second)
+	runtime.Gosched()
+
+	require.EqualValues(t, map[string]float64{
+		"observer.sum//R=V": 3,
+	}, exporter.Values())
+	exporter.Reset()
+
+	observed = 10
+
+	mock.Add(time.Second)
+	runtime.Gosched()
+
+	require.EqualValues(t, map[string]float64{
+		"observer.sum//R=V": 7,
+	}, exporter.Values())
+
+	require.NoError(t, p.Stop(ctx))
+}
@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic // import "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	sdk "go.opentelemetry.io/otel/sdk/metric"
+	controllerTime "go.opentelemetry.io/otel/sdk/metric/controller/time"
+)
+
+// PeriodicReader collects the metrics of the instruments of a Controller
+// into its own Checkpointer and exports them with its own Exporter at a
+// fixed interval while the Controller is started.
+//
+// A Controller has a PeriodicReader for the Checkpointer and Exporter it
+// is created with, and additional PeriodicReaders are registered with
+// WithReader.  Every PeriodicReader aggregates the measurements separately,
+// so the readers of a Controller may use different export kinds,
+// collection periods, and timeouts.  For example, one Controller can serve
+// Prometheus scrapes with its own Checkpointer while a PeriodicReader
+// pushes the same metrics to an OTLP endpoint.
+type PeriodicReader struct {
+	lock         sync.Mutex
+	accumulator  *sdk.Accumulator
+	checkpointer export.Checkpointer
+	exporter     export.Exporter
+	kindSelector export.ExportKindSelector
+	wg           sync.WaitGroup
+	stopCh       chan struct{}
+	ticker       controllerTime.Ticker
+
+	collectPeriod  time.Duration
+	collectTimeout time.Duration
+	pushTimeout    time.Duration
+}
+
+// NewPeriodicReader returns a PeriodicReader collecting metrics into
+// checkpointer and exporting them with exporter.  The reader must be
+// registered with a single Controller using WithReader.
+//
+// The collection period, collection timeout, export timeout, and
+// ExportKindSelector of the reader are configured with the
+// WithCollectPeriod, WithCollectTimeout, WithPushTimeout, and
+// WithExportKindSelector options.  Other options are ignored, the reader
+// uses the Resource and Clock of its Controller.
+func NewPeriodicReader(checkpointer export.Checkpointer, exporter export.Exporter, opts ...Option) *PeriodicReader {
+	c := newConfig(opts)
+	return &PeriodicReader{
+		checkpointer: checkpointer,
+		exporter:     exporter,
+		kindSelector: c.ExportKindSelector,
+
+		collectPeriod:  c.CollectPeriod,
+		collectTimeout: c.CollectTimeout,
+		pushTimeout:    c.PushTimeout,
+	}
+}
+
+// start begins a ticker that periodically collects and exports metrics.
+// It returns ErrControllerStarted if the reader was already started.
+func (r *PeriodicReader) start(ctx context.Context, clock controllerTime.Clock) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.stopCh != nil {
+		return ErrControllerStarted
+	}
+
+	r.wg.Add(1)
+	r.stopCh = make(chan struct{})
+	r.ticker = clock.Ticker(r.collectPeriod)
+	go r.runTicker(ctx, r.stopCh)
+	return nil
+}
+
+// stop waits for the background goroutine to return and then collects
+// and exports metrics one last time.
+func (r *PeriodicReader) stop(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.stopCh == nil {
+		return nil
+	}
+
+	close(r.stopCh)
+	r.stopCh = nil
+	r.wg.Wait()
+	r.ticker.Stop()
+	r.ticker = nil
+
+	return r.collect(ctx)
+}
+
+// isRunning returns true if the reader was started.
+func (r *PeriodicReader) isRunning() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.ticker != nil
+}
+
+// runTicker collection on ticker events until the stop channel is closed.
+func (r *PeriodicReader) runTicker(ctx context.Context, stopCh chan struct{}) {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-r.ticker.C():
+			if err := r.collect(ctx); err != nil {
+				otel.HandleClassified(component, otel.SeverityError, err)
+			}
+		}
+	}
+}
+
+// ForceFlush collects and exports metrics immediately, whether or not the
+// Controller of the reader was started and regardless of the collection
+// period.  It returns ErrReaderNotRegistered if the reader is not
+// registered with a Controller.
+func (r *PeriodicReader) ForceFlush(ctx context.Context) error {
+	if r.accumulator == nil {
+		return ErrReaderNotRegistered
+	}
+	return r.collect(ctx)
+}
+
+// collect computes a checkpoint and optionally exports it.
+func (r *PeriodicReader) collect(ctx context.Context) error {
+	if err := r.checkpoint(ctx, func() bool {
+		return true
+	}); err != nil {
+		return err
+	}
+	if r.exporter == nil {
+		return nil
+	}
+	// Note: this is not subject to collectTimeout.  This blocks the next
+	// collection despite collectTimeout because it holds a lock.
+	return r.export(ctx)
+}
+
+// checkpoint calls the Accumulator and Checkpointer interfaces to
+// compute the CheckpointSet.  This applies the configured collection
+// timeout.  Note that this does not try to cancel a Collect or Export
+// when Stop() is called.
+func (r *PeriodicReader) checkpoint(ctx context.Context, cond func() bool) error {
+	ckpt := r.checkpointer.CheckpointSet()
+	ckpt.Lock()
+	defer ckpt.Unlock()
+
+	if !cond() {
+		return nil
+	}
+	r.checkpointer.StartCollection()
+
+	if r.collectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.collectTimeout)
+		defer cancel()
+	}
+
+	n := r.accumulator.Collect(ctx)
+	global.Debug("collected metrics", "records", n)
+
+	var err error
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	default:
+		// The context wasn't done, ok.
+	}
+
+	// Finish the checkpoint whether the accumulator timed out or not.
+	if cerr := r.checkpointer.FinishCollection(); cerr != nil {
+		if err == nil {
+			err = cerr
+		} else {
+			err = fmt.Errorf("%s: %w", cerr.Error(), err)
+		}
+	}
+
+	return err
+}
+
+// export calls the exporter with a read lock on the CheckpointSet,
+// applying the configured export timeout.
+func (r *PeriodicReader) export(ctx context.Context) error {
+	ckpt := r.checkpointer.CheckpointSet()
+	ckpt.RLock()
+	defer ckpt.RUnlock()
+
+	if r.pushTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.pushTimeout)
+		defer cancel()
+	}
+
+	if r.kindSelector != nil {
+		return r.exporter.Export(ctx, selectedCheckpointSet{
+			CheckpointSet: ckpt,
+			selector:      r.kindSelector,
+		})
+	}
+	return r.exporter.Export(ctx, ckpt)
+}
+
+// selectedCheckpointSet is a CheckpointSet that selects the ExportKind
+// of its Records with selector instead of the selector of the exporter.
+type selectedCheckpointSet struct {
+	export.CheckpointSet
+	selector export.ExportKindSelector
+}
+
+// ForEach implements export.CheckpointSet.
+func (s selectedCheckpointSet) ForEach(_ export.ExportKindSelector, f func(export.Record) error) error {
+	return s.CheckpointSet.ForEach(s.selector, f)
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	"go.opentelemetry.io/otel/sdk/metric/controller/controllertest"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/processor/processortest"
+)
+
+func TestPeriodicReader(t *testing.T) {
+	exporter := processortest.NewExporter(
+		export.DeltaExportKindSelector(),
+		attribute.DefaultEncoder(),
+	)
+	reader := controller.NewPeriodicReader(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.DeltaExportKindSelector(),
+		),
+		exporter,
+		controller.WithCollectPeriod(2*time.Second),
+	)
+	mock := controllertest.NewMockClock()
+	cont := controller.New(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+			processor.WithMemory(true),
+		),
+		controller.WithCollectPeriod(time.Second),
+		controller.WithResource(testResource),
+		controller.WithClock(mock),
+		controller.WithReader(reader),
+	)
+
+	ctx := context.Background()
+	counter := metric.Must(cont.MeterProvider().Meter("name")).NewInt64Counter("counter.sum")
+	require.NoError(t, cont.Start(ctx))
+
+	counter.Add(ctx, 3)
+	mock.Add(time.Second)
+	runtime.Gosched()
+	require.Equal(t, 0, exporter.ExportCount())
+
+	mock.Add(time.Second)
+	runtime.Gosched()
+	require.Equal(t, 1, exporter.ExportCount())
+	require.EqualValues(t, map[string]float64{
+		"counter.sum//R=V": 3,
+	}, exporter.Values())
+	exporter.Reset()
+
+	counter.Add(ctx, 4)
+	mock.Add(time.Second)
+	runtime.Gosched()
+	mock.Add(time.Second)
+	runtime.Gosched()
+
+	// The reader exports deltas while the controller keeps
+	// cumulative values, at its own collection period.
+	require.Equal(t, 1, exporter.ExportCount())
+	require.EqualValues(t, map[string]float64{
+		"counter.sum//R=V": 4,
+	}, exporter.Values())
+	records := processortest.NewOutput(attribute.DefaultEncoder())
+	require.NoError(t, cont.ForEach(export.CumulativeExportKindSelector(), records.AddRecord))
+	require.EqualValues(t, map[string]float64{
+		"counter.sum//R=V": 7,
+	}, records.Map())
+
+	require.NoError(t, cont.Stop(ctx))
+	require.False(t, cont.IsRunning())
+}
+
+func TestPeriodicReaderForceFlushNotRegistered(t *testing.T) {
+	reader := controller.NewPeriodicReader(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+		),
+		processortest.NewExporter(
+			export.CumulativeExportKindSelector(),
+			attribute.DefaultEncoder(),
+		),
+	)
+	require.ErrorIs(t, reader.ForceFlush(context.Background()), controller.ErrReaderNotRegistered)
+}
+
+func TestPeriodicReaderForceFlush(t *testing.T) {
+	exporter := processortest.NewExporter(
+		export.CumulativeExportKindSelector(),
+		attribute.DefaultEncoder(),
+	)
+	reader := controller.NewPeriodicReader(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+		),
+		exporter,
+		controller.WithCollectPeriod(time.Hour),
+	)
+	cont := controller.New(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+		),
+		controller.WithCollectPeriod(0),
+		controller.WithResource(testResource),
+		controller.WithReader(reader),
+	)
+
+	calls := 0
+	meter := metric.Must(cont.MeterProvider().Meter("named"))
+	var observer metric.Int64ValueObserver
+	batch := meter.NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		calls++
+		result.Observe(nil, observer.Observation(int64(calls)))
+	})
+	observer = batch.NewInt64ValueObserver("batch.lastvalue")
+
+	// The reader is flushed with the controller, without being started.
+	// Its Accumulator runs the callback for its own collection only.
+	require.NoError(t, cont.ForceFlush(context.Background()))
+	require.Equal(t, 2, calls)
+	require.EqualValues(t, map[string]float64{
+		"batch.lastvalue//R=V": 2,
+	}, exporter.Values())
+
+	require.NoError(t, reader.ForceFlush(context.Background()))
+	require.Equal(t, 3, calls)
+	require.EqualValues(t, map[string]float64{
+		"batch.lastvalue//R=V": 3,
+	}, exporter.Values())
+}
+
+func TestControllerForceFlushReaderErrors(t *testing.T) {
+	newFailingReader := func(err error) *controller.PeriodicReader {
+		exporter := processortest.NewExporter(
+			export.CumulativeExportKindSelector(),
+			attribute.DefaultEncoder(),
+		)
+		exporter.InjectErr = func(export.Record) error { return err }
+		return controller.NewPeriodicReader(
+			processor.New(
+				processortest.AggregatorSelector(),
+				export.CumulativeExportKindSelector(),
+			),
+			exporter,
+			controller.WithCollectPeriod(time.Hour),
+		)
+	}
+	err1 := errors.New("first reader failed")
+	err2 := errors.New("second reader failed")
+	cont := controller.New(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+		),
+		controller.WithCollectPeriod(0),
+		controller.WithResource(testResource),
+		controller.WithReader(newFailingReader(err1)),
+		controller.WithReader(newFailingReader(err2)),
+	)
+
+	ctx := context.Background()
+	counter := metric.Must(cont.MeterProvider().Meter("name")).NewInt64Counter("counter.sum")
+	counter.Add(ctx, 1)
+
+	require.NoError(t, testHandler.Flush())
+	require.ErrorIs(t, cont.ForceFlush(ctx), err1)
+
+	// The error of the second reader is passed to the ErrorHandler.
+	component, sev := testHandler.Classification()
+	require.ErrorIs(t, testHandler.Flush(), err2)
+	require.Equal(t, "go.opentelemetry.io/otel/sdk/metric/controller/basic", component)
+	require.Equal(t, otel.SeverityError, sev)
+}
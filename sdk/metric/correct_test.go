@@ -86,10 +86,15 @@ type testSelector struct {
 
 func (ts *testSelector) AggregatorFor(desc *metric.Descriptor, aggPtrs ...*export.Aggregator) {
 	ts.newAggCount += len(aggPtrs)
+	if desc.Name() == "otel.sdk.metric.overflow" {
+		// Aggregate the overflow counts of the Accumulator as sums.
+		sumDesc := metric.NewDescriptor(desc.Name()+".sum", desc.InstrumentKind(), desc.NumberKind())
+		desc = &sumDesc
+	}
 	processortest.AggregatorSelector().AggregatorFor(desc, aggPtrs...)
 }
 
-func newSDK(t *testing.T) (metric.Meter, *metricsdk.Accumulator, *correctnessProcessor) {
+func newSDK(t *testing.T, opts ...metricsdk.Option) (metric.Meter, *metricsdk.Accumulator, *correctnessProcessor) {
 	testHandler.Reset()
 	processor := &correctnessProcessor{
 		t:            t,
@@ -98,6 +103,7 @@ func newSDK(t *testing.T) (metric.Meter, *metricsdk.Accumulator, *correctnessPro
 	accum := metricsdk.NewAccumulator(
 		processor,
 		testResource,
+		opts...,
 	)
 	meter := metric.WrapMeterImpl(accum, "test")
 	return meter, accum, processor
@@ -474,6 +480,123 @@ func TestObserverBatch(t *testing.T) {
 	}, out.Map())
 }
 
+func TestObserverBatchUnregister(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t)
+
+	var gauge metric.Int64ValueObserver
+	batch := Must(meter).NewBatchObserver(
+		func(_ context.Context, result metric.BatchObserverResult) {
+			result.Observe(nil, gauge.Observation(1))
+		})
+	gauge = batch.NewInt64ValueObserver("int.valueobserver.lastvalue")
+	_ = Must(meter).NewInt64SumObserver("int.sumobserver.sum",
+		func(_ context.Context, result metric.Int64ObserverResult) {
+			result.Observe(10)
+		})
+
+	collected := sdk.Collect(ctx)
+	require.Equal(t, 2, collected)
+
+	batch.Unregister()
+	processor.accumulations = nil
+
+	collected = sdk.Collect(ctx)
+	require.Equal(t, 1, collected)
+
+	out := processortest.NewOutput(attribute.DefaultEncoder())
+	for _, rec := range processor.accumulations {
+		require.NoError(t, out.AddAccumulation(rec))
+	}
+	require.EqualValues(t, map[string]float64{
+		"int.sumobserver.sum//R=V": 10,
+	}, out.Map())
+}
+
+// cardinalityOutput returns the output of the accumulations, and
+// separately the counts of the overflow instrument of the Accumulator
+// by instrument name.
+func cardinalityOutput(t *testing.T, accumulations []export.Accumulation) (map[string]float64, map[string]int64) {
+	out := processortest.NewOutput(attribute.DefaultEncoder())
+	overflows := map[string]int64{}
+	for _, rec := range accumulations {
+		if rec.Descriptor().Name() != "otel.sdk.metric.overflow" {
+			require.NoError(t, out.AddAccumulation(rec))
+			continue
+		}
+		require.Equal(t, "go.opentelemetry.io/otel/sdk/metric", rec.Descriptor().InstrumentationName())
+		name, _ := rec.Labels().Value("instrument.name")
+		sum, err := rec.Aggregator().(aggregation.Sum).Sum()
+		require.NoError(t, err)
+		overflows[name.AsString()] = sum.AsInt64()
+	}
+	return out.Map(), overflows
+}
+
+func TestCardinalityLimit(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t, metricsdk.WithCardinalityLimit(3))
+
+	counter := Must(meter).NewInt64Counter("int.sum")
+	for i := 0; i < 5; i++ {
+		counter.Add(ctx, 1, attribute.Int("I", i))
+	}
+	counter.Add(ctx, 10, attribute.Int("I", 0))
+
+	sdk.Collect(ctx)
+
+	out, overflows := cardinalityOutput(t, processor.accumulations)
+	require.EqualValues(t, map[string]float64{
+		"int.sum/I=0/R=V":                       11,
+		"int.sum/I=1/R=V":                       1,
+		"int.sum/otel.metric.overflow=true/R=V": 3,
+	}, out)
+	require.EqualValues(t, map[string]int64{"int.sum": 3}, overflows)
+
+	// The records are removed after a collection without updates,
+	// making room for new label sets.
+	sdk.Collect(ctx)
+	counter.Add(ctx, 1, attribute.Int("I", 5))
+	counter.Add(ctx, 1, attribute.Int("I", 6))
+	counter.Add(ctx, 1, attribute.Int("I", 7))
+
+	processor.accumulations = nil
+	sdk.Collect(ctx)
+
+	out, overflows = cardinalityOutput(t, processor.accumulations)
+	require.EqualValues(t, map[string]float64{
+		"int.sum/I=5/R=V":                       1,
+		"int.sum/I=6/R=V":                       1,
+		"int.sum/otel.metric.overflow=true/R=V": 1,
+	}, out)
+	require.EqualValues(t, map[string]int64{"int.sum": 4}, overflows)
+}
+
+func TestCardinalityLimitObserver(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t, metricsdk.WithCardinalityLimit(3))
+
+	_ = Must(meter).NewInt64SumObserver("int.sumobserver.sum", func(_ context.Context, result metric.Int64ObserverResult) {
+		for i := 0; i < 5; i++ {
+			result.Observe(int64(i+1), attribute.Int("I", i))
+		}
+	})
+
+	sdk.Collect(ctx)
+	processor.accumulations = nil
+	sdk.Collect(ctx)
+
+	// The overflow label set aggregates the observations of the
+	// label sets beyond the limit in each collection.
+	out, overflows := cardinalityOutput(t, processor.accumulations)
+	require.EqualValues(t, map[string]float64{
+		"int.sumobserver.sum/I=0/R=V":                       1,
+		"int.sumobserver.sum/I=1/R=V":                       2,
+		"int.sumobserver.sum/otel.metric.overflow=true/R=V": 12,
+	}, out)
+	require.EqualValues(t, map[string]int64{"int.sumobserver.sum": 3}, overflows)
+}
+
 func TestRecordBatch(t *testing.T) {
 	ctx := context.Background()
 	meter, sdk, processor := newSDK(t)
@@ -509,6 +632,31 @@ func TestRecordBatch(t *testing.T) {
 	}, out.Map())
 }
 
+func TestGaugeLastValue(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t)
+
+	gauge1 := Must(meter).NewInt64Gauge("int64.lastvalue")
+	gauge2 := Must(meter).NewFloat64Gauge("float64.lastvalue")
+	bound := gauge2.Bind(attribute.String("C", "D"))
+
+	gauge1.Set(ctx, 10, attribute.String("A", "B"))
+	gauge1.Set(ctx, -5, attribute.String("A", "B"))
+	bound.Set(ctx, 2.5)
+	bound.Set(ctx, 1.5)
+
+	sdk.Collect(ctx)
+
+	out := processortest.NewOutput(attribute.DefaultEncoder())
+	for _, rec := range processor.accumulations {
+		require.NoError(t, out.AddAccumulation(rec))
+	}
+	require.EqualValues(t, map[string]float64{
+		"int64.lastvalue/A=B/R=V":   -5,
+		"float64.lastvalue/C=D/R=V": 1.5,
+	}, out.Map())
+}
+
 // TestRecordPersistence ensures that a direct-called instrument that
 // is repeatedly used each interval results in a persistent record, so
 // that its encoded labels will be cached across collection intervals.
@@ -529,6 +677,28 @@ func TestRecordPersistence(t *testing.T) {
 	require.Equal(t, 4, processor.newAggCount)
 }
 
+// TestBoundInstrumentAllocs ensures that recording with a bound
+// instrument does not allocate, unlike direct calls that process their
+// labels each time. Aggregators are chosen that do not allocate
+// themselves.
+func TestBoundInstrumentAllocs(t *testing.T) {
+	ctx := context.Background()
+	meter, _, _ := newSDK(t)
+
+	labels := []attribute.KeyValue{
+		attribute.String("A", "B"),
+		attribute.String("C", "D"),
+		attribute.Int("E", 1),
+	}
+	counter := Must(meter).NewInt64Counter("int64.sum").Bind(labels...)
+	valuerecorder := Must(meter).NewFloat64ValueRecorder("float64.minmaxsumcount").Bind(labels...)
+
+	require.Zero(t, testing.AllocsPerRun(100, func() {
+		counter.Add(ctx, 1)
+		valuerecorder.Record(ctx, 1.5)
+	}))
+}
+
 func TestIncorrectInstruments(t *testing.T) {
 	// The Batch observe/record APIs are susceptible to
 	// uninitialized instruments.
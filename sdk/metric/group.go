@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+)
+
+type (
+	// AccumulatorGroup implements the OpenTelemetry Meter API by
+	// recording every measurement in each Accumulator of a group.
+	//
+	// Each Accumulator of the group is collected independently, so
+	// the Accumulators may be bound to Processors with different
+	// export kinds and be collected at different intervals.
+	// Asynchronous instrument callbacks are called once for each
+	// collection of each Accumulator.
+	AccumulatorGroup struct {
+		accumulators []*Accumulator
+	}
+
+	// groupSyncInstrument is a synchronous instrument with one
+	// syncInstrument for each Accumulator of a group, in the same
+	// order.
+	groupSyncInstrument struct {
+		descriptor metric.Descriptor
+		insts      []*syncInstrument
+	}
+
+	// groupAsyncInstrument is an asynchronous instrument with one
+	// asyncInstrument for each Accumulator of a group, in the same
+	// order.
+	groupAsyncInstrument struct {
+		descriptor metric.Descriptor
+		insts      []*asyncInstrument
+	}
+
+	// groupBound is a bound synchronous instrument of a group.
+	groupBound []metric.BoundSyncImpl
+)
+
+var (
+	_ metric.MeterImpl           = &AccumulatorGroup{}
+	_ metric.AsyncUnregisterImpl = &AccumulatorGroup{}
+	_ metric.SyncImpl            = &groupSyncInstrument{}
+	_ metric.AsyncImpl           = &groupAsyncInstrument{}
+	_ metric.BoundSyncImpl       = groupBound{}
+)
+
+// NewAccumulatorGroup returns an AccumulatorGroup recording every
+// measurement in each of accumulators.
+func NewAccumulatorGroup(accumulators ...*Accumulator) *AccumulatorGroup {
+	return &AccumulatorGroup{accumulators: accumulators}
+}
+
+// NewSyncInstrument implements metric.MeterImpl.
+func (g *AccumulatorGroup) NewSyncInstrument(descriptor metric.Descriptor) (metric.SyncImpl, error) {
+	inst := &groupSyncInstrument{
+		descriptor: descriptor,
+		insts:      make([]*syncInstrument, len(g.accumulators)),
+	}
+	for i, m := range g.accumulators {
+		inst.insts[i] = m.newSyncInstrument(descriptor)
+	}
+	return inst, nil
+}
+
+// NewAsyncInstrument implements metric.MeterImpl.  The runner is
+// registered with each Accumulator of the group.  The instruments of
+// all the Accumulators are created before the runner is registered with
+// any of them, and registering cannot fail, so an Accumulator of the
+// group is never left with a registered instrument the group does not
+// return.
+func (g *AccumulatorGroup) NewAsyncInstrument(descriptor metric.Descriptor, runner metric.AsyncRunner) (metric.AsyncImpl, error) {
+	inst := &groupAsyncInstrument{
+		descriptor: descriptor,
+		insts:      make([]*asyncInstrument, len(g.accumulators)),
+	}
+	for i, m := range g.accumulators {
+		inst.insts[i] = m.newAsyncInstrument(descriptor)
+	}
+	for i, m := range g.accumulators {
+		m.registerAsync(inst.insts[i], runner)
+	}
+	return inst, nil
+}
+
+// UnregisterAsync implements metric.AsyncUnregisterImpl.
+func (g *AccumulatorGroup) UnregisterAsync(runner metric.AsyncRunner) error {
+	for _, m := range g.accumulators {
+		if err := m.UnregisterAsync(runner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordBatch implements metric.MeterImpl.
+func (g *AccumulatorGroup) RecordBatch(ctx context.Context, kvs []attribute.KeyValue, measurements ...metric.Measurement) {
+	for _, m := range g.accumulators {
+		m.RecordBatch(ctx, kvs, measurements...)
+	}
+}
+
+// Descriptor implements metric.InstrumentImpl.
+func (s *groupSyncInstrument) Descriptor() metric.Descriptor {
+	return s.descriptor
+}
+
+// Implementation implements metric.InstrumentImpl.
+func (s *groupSyncInstrument) Implementation() interface{} {
+	return s
+}
+
+// Bind implements metric.SyncImpl.
+func (s *groupSyncInstrument) Bind(kvs []attribute.KeyValue) metric.BoundSyncImpl {
+	bound := make(groupBound, len(s.insts))
+	for i, inst := range s.insts {
+		bound[i] = inst.Bind(kvs)
+	}
+	return bound
+}
+
+// RecordOne implements metric.SyncImpl.
+func (s *groupSyncInstrument) RecordOne(ctx context.Context, num number.Number, kvs []attribute.KeyValue) {
+	for _, inst := range s.insts {
+		inst.RecordOne(ctx, num, kvs)
+	}
+}
+
+// forAccumulator returns the syncInstrument of m, or nil if m is not
+// part of the group.
+func (s *groupSyncInstrument) forAccumulator(m *Accumulator) *syncInstrument {
+	for _, inst := range s.insts {
+		if inst.meter == m {
+			return inst
+		}
+	}
+	return nil
+}
+
+// Descriptor implements metric.InstrumentImpl.
+func (a *groupAsyncInstrument) Descriptor() metric.Descriptor {
+	return a.descriptor
+}
+
+// Implementation implements metric.InstrumentImpl.
+func (a *groupAsyncInstrument) Implementation() interface{} {
+	return a
+}
+
+// forAccumulator returns the asyncInstrument of m, or nil if m is not
+// part of the group.
+func (a *groupAsyncInstrument) forAccumulator(m *Accumulator) *asyncInstrument {
+	for _, inst := range a.insts {
+		if inst.meter == m {
+			return inst
+		}
+	}
+	return nil
+}
+
+// RecordOne implements metric.BoundSyncImpl.
+func (b groupBound) RecordOne(ctx context.Context, num number.Number) {
+	for _, bound := range b {
+		bound.RecordOne(ctx, num)
+	}
+}
+
+// Unbind implements metric.BoundSyncImpl.
+func (b groupBound) Unbind() {
+	for _, bound := range b {
+		bound.Unbind()
+	}
+}
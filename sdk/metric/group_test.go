@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/processor/processortest"
+)
+
+func TestAccumulatorGroup(t *testing.T) {
+	ctx := context.Background()
+	_, sdk1, processor1 := newSDK(t)
+	_, sdk2, processor2 := newSDK(t)
+	meter := metric.WrapMeterImpl(metricsdk.NewAccumulatorGroup(sdk1, sdk2), "test")
+
+	counter := Must(meter).NewInt64Counter("int64.sum")
+	valuerecorder := Must(meter).NewFloat64ValueRecorder("float64.exact")
+	var observer metric.Int64ValueObserver
+	batch := Must(meter).NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		result.Observe(nil, observer.Observation(5))
+	})
+	observer = batch.NewInt64ValueObserver("int64.lastvalue")
+
+	counter.Add(ctx, 1, attribute.String("A", "B"))
+	bound := counter.Bind(attribute.String("A", "B"))
+	bound.Add(ctx, 2)
+	bound.Unbind()
+	meter.RecordBatch(ctx, []attribute.KeyValue{attribute.String("C", "D")},
+		counter.Measurement(3),
+		valuerecorder.Measurement(4),
+	)
+
+	for _, c := range []struct {
+		sdk       *metricsdk.Accumulator
+		processor *correctnessProcessor
+	}{{sdk1, processor1}, {sdk2, processor2}} {
+		c.sdk.Collect(ctx)
+		out := processortest.NewOutput(attribute.DefaultEncoder())
+		for _, rec := range c.processor.accumulations {
+			require.NoError(t, out.AddAccumulation(rec))
+		}
+		require.EqualValues(t, map[string]float64{
+			"int64.sum/A=B/R=V":     3,
+			"int64.sum/C=D/R=V":     3,
+			"float64.exact/C=D/R=V": 4,
+			"int64.lastvalue//R=V":  5,
+		}, out.Map())
+	}
+	require.NoError(t, testHandler.Flush())
+}
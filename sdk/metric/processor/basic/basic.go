@@ -20,10 +20,13 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -33,6 +36,34 @@ type (
 		export.AggregatorSelector
 
 		state
+
+		// streamsMu protects streams, which may be accessed
+		// concurrently by AggregatorFor.
+		streamsMu sync.Mutex
+		// streams holds the stream of each instrument descriptor
+		// seen, and of each descriptor created for a View. A nil
+		// stream indicates the instrument matches no View.
+		streams map[*metric.Descriptor]*viewStream
+		// exported holds the stream of each exported stream identity,
+		// with a nil View for instruments that match no View.
+		exported map[streamID]*viewStream
+	}
+
+	// streamID identifies the data exported for an instrument: streams
+	// with the same name and kind from the same instrumentation library
+	// are the same stream.
+	streamID struct {
+		name           string
+		kind           metric.InstrumentKind
+		library        string
+		libraryVersion string
+	}
+
+	// viewStream is the View applied to an instrument and the
+	// descriptor of the data exported for it.
+	viewStream struct {
+		view       *view.View
+		descriptor *metric.Descriptor
 	}
 
 	stateKey struct {
@@ -122,6 +153,12 @@ var _ export.CheckpointSet = &state{}
 var ErrInconsistentState = fmt.Errorf("inconsistent processor state")
 var ErrInvalidExportKind = fmt.Errorf("invalid export kind")
 
+// ErrViewConflict is passed to the error handler of a Processor when a View
+// renames an instrument to the name of a stream exported for another
+// instrument, from the same instrumentation library and of the same kind,
+// that is not renamed by the same View.
+var ErrViewConflict = fmt.Errorf("view renames an instrument to the name of another stream")
+
 // New returns a basic Processor that is also a Checkpointer using the provided
 // AggregatorSelector to select Aggregators.  The ExportKindSelector
 // is consulted to determine the kind(s) of exporter that will consume
@@ -137,22 +174,113 @@ func New(aselector export.AggregatorSelector, eselector export.ExportKindSelecto
 			processStart:  now,
 			intervalStart: now,
 		},
+		streams:  map[*metric.Descriptor]*viewStream{},
+		exported: map[streamID]*viewStream{},
 	}
 	for _, opt := range opts {
 		opt.applyProcessor(&p.config)
 	}
+	if p.config.ErrorHandler == nil {
+		p.config.ErrorHandler = otel.Handle
+	}
 	return p
 }
 
+// AggregatorFor allocates the aggregators set by the View applied to the
+// instrument described by descriptor, or those selected by the
+// AggregatorSelector of the Processor if the View does not set any.
+func (b *Processor) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*export.Aggregator) {
+	if s := b.streamFor(descriptor); s != nil && s.view.AggregatorFor(descriptor, aggPtrs...) {
+		return
+	}
+	b.AggregatorSelector.AggregatorFor(descriptor, aggPtrs...)
+}
+
+// streamFor returns the stream of the instrument described by desc, or
+// nil if no View matches it.
+//
+// The instruments renamed by the same View to the same stream identity
+// share the exported descriptor, so their data is merged.  A View renaming
+// an instrument to the identity of another stream is not applied to the
+// name and description of the instrument, and the conflict is passed to
+// the error handler of the Processor as an ErrViewConflict.  The conflict is also
+// reported when an instrument matching no View has the identity of a
+// renamed stream, but both streams are then exported.
+func (b *Processor) streamFor(desc *metric.Descriptor) *viewStream {
+	if len(b.config.Views) == 0 {
+		return nil
+	}
+
+	b.streamsMu.Lock()
+	defer b.streamsMu.Unlock()
+	if s, ok := b.streams[desc]; ok {
+		return s
+	}
+	var s *viewStream
+	for i := range b.config.Views {
+		v := &b.config.Views[i]
+		if !v.Matches(desc) {
+			continue
+		}
+		d := v.Descriptor(desc)
+		id := newStreamID(&d)
+		prev, ok := b.exported[id]
+		switch {
+		case !ok:
+			s = &viewStream{view: v, descriptor: &d}
+			b.exported[id] = s
+		case prev.view == v:
+			s = prev
+		default:
+			b.config.ErrorHandler(fmt.Errorf("%w: %s renamed to %s", ErrViewConflict, desc.Name(), d.Name()))
+			s = &viewStream{view: v, descriptor: desc}
+		}
+		// Aggregators are also allocated for the exported
+		// descriptor, they must be of the same kind.
+		b.streams[s.descriptor] = s
+		break
+	}
+	if s == nil {
+		id := newStreamID(desc)
+		if prev, ok := b.exported[id]; !ok {
+			b.exported[id] = &viewStream{descriptor: desc}
+		} else if prev.view != nil {
+			b.config.ErrorHandler(fmt.Errorf("%w: %s", ErrViewConflict, desc.Name()))
+		}
+	}
+	b.streams[desc] = s
+	return s
+}
+
+func newStreamID(desc *metric.Descriptor) streamID {
+	return streamID{
+		name:           desc.Name(),
+		kind:           desc.InstrumentKind(),
+		library:        desc.InstrumentationName(),
+		libraryVersion: desc.InstrumentationVersion(),
+	}
+}
+
 // Process implements export.Processor.
 func (b *Processor) Process(accum export.Accumulation) error {
 	if b.startedCollection != b.finishedCollection+1 {
 		return ErrInconsistentState
 	}
 	desc := accum.Descriptor()
+	labels := accum.Labels()
+	if s := b.streamFor(desc); s != nil {
+		desc = s.descriptor
+		if filter := s.view.Filter(); filter != nil {
+			filtered, dropped := labels.Filter(filter)
+			labels = &filtered
+			if f, ok := accum.Aggregator().(exemplar.Filterable); ok && len(dropped) > 0 {
+				f.AddFilteredAttributes(dropped)
+			}
+		}
+	}
 	key := stateKey{
 		descriptor: desc,
-		distinct:   accum.Labels().Equivalent(),
+		distinct:   labels.Equivalent(),
 		resource:   accum.Resource().Equivalent(),
 	}
 	agg := accum.Aggregator()
@@ -163,7 +291,7 @@ func (b *Processor) Process(accum export.Accumulation) error {
 		stateful := b.ExportKindFor(desc, agg.Aggregation().Kind()).MemoryRequired(desc.InstrumentKind())
 
 		newValue := &stateValue{
-			labels:   accum.Labels(),
+			labels:   labels,
 			resource: accum.Resource(),
 			updated:  b.state.finishedCollection,
 			stateful: stateful,
@@ -233,7 +361,7 @@ func (b *Processor) Process(accum export.Accumulation) error {
 	// before merging below.
 	if !value.currentOwned {
 		tmp := value.current
-		b.AggregatorSelector.AggregatorFor(desc, &value.current)
+		b.AggregatorFor(desc, &value.current)
 		value.currentOwned = true
 		if err := tmp.SynchronizedMove(value.current, desc); err != nil {
 			return err
@@ -14,6 +14,8 @@
 
 package basic // import "go.opentelemetry.io/otel/sdk/metric/processor/basic"
 
+import "go.opentelemetry.io/otel/sdk/metric/view"
+
 // config contains the options for configuring a basic metric processor.
 type config struct {
 	// Memory controls whether the processor remembers metric
@@ -21,6 +23,14 @@ type config struct {
 	// When Memory is true, CheckpointSet.ForEach() will visit
 	// metrics that were not updated in the most recent interval.
 	Memory bool
+
+	// Views customize the data exported for the instruments they
+	// match. The first View matching an instrument is applied to it.
+	Views []view.View
+
+	// ErrorHandler is passed the conflicts found between Views. It is
+	// otel.Handle unless set.
+	ErrorHandler func(error)
 }
 
 type Option interface {
@@ -40,3 +50,29 @@ type memoryOption bool
 func (m memoryOption) applyProcessor(cfg *config) {
 	cfg.Memory = bool(m)
 }
+
+// WithViews sets the Views of a Processor. The first View matching an
+// instrument is applied to the data exported for it, instruments matching
+// no View are exported unchanged.
+func WithViews(views ...view.View) Option {
+	return viewsOption(views)
+}
+
+type viewsOption []view.View
+
+func (v viewsOption) applyProcessor(cfg *config) {
+	cfg.Views = append(cfg.Views, v...)
+}
+
+// WithErrorHandler configures the conflicts found between the Views of a
+// Processor, wrapping ErrViewConflict, to be reported to handler instead
+// of the global error handler.
+func WithErrorHandler(handler func(error)) Option {
+	return errorHandlerOption(handler)
+}
+
+type errorHandlerOption func(error)
+
+func (h errorHandlerOption) applyProcessor(cfg *config) {
+	cfg.ErrorHandler = h
+}
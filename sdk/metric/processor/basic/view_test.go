@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	sdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type errorRecorder struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (h *errorRecorder) Handle(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.errs = append(h.errs, err)
+}
+
+func (h *errorRecorder) flush() []error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	errs := h.errs
+	h.errs = nil
+	return errs
+}
+
+type viewRecord struct {
+	description string
+	labels      string
+	kind        aggregation.Kind
+	agg         aggregation.Aggregation
+}
+
+// collectViews returns a Meter exporting through views and a function
+// collecting its data. The test fails if the views conflict.
+func collectViews(t *testing.T, views ...view.View) (metric.Meter, func() map[string]viewRecord) {
+	return collectViewsWithErrors(t, func(err error) {
+		t.Errorf("unexpected view conflict: %v", err)
+	}, views...)
+}
+
+// collectViewsWithErrors is like collectViews, the conflicts between views
+// are passed to handler.
+func collectViewsWithErrors(t *testing.T, handler func(error), views ...view.View) (metric.Meter, func() map[string]viewRecord) {
+	eselector := export.CumulativeExportKindSelector()
+	proc := basic.New(
+		simple.NewWithExactDistribution(),
+		eselector,
+		basic.WithViews(views...),
+		basic.WithErrorHandler(handler),
+	)
+	accum := sdk.NewAccumulator(proc, resource.Empty())
+	meter := metric.WrapMeterImpl(accum, "testing")
+
+	return meter, func() map[string]viewRecord {
+		data := proc.CheckpointSet()
+		data.Lock()
+		defer data.Unlock()
+
+		proc.StartCollection()
+		accum.Collect(context.Background())
+		require.NoError(t, proc.FinishCollection())
+
+		out := map[string]viewRecord{}
+		require.NoError(t, data.ForEach(eselector, func(r export.Record) error {
+			out[r.Descriptor().Name()+"/"+r.Labels().Encoded(attribute.DefaultEncoder())] = viewRecord{
+				description: r.Descriptor().Description(),
+				labels:      r.Labels().Encoded(attribute.DefaultEncoder()),
+				kind:        r.Aggregation().Kind(),
+				agg:         r.Aggregation(),
+			}
+			return nil
+		}))
+		return out
+	}
+}
+
+func TestViewRenameAndDescription(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("requests"),
+		view.WithRename("http.requests"),
+		view.WithSetDescription("HTTP requests"),
+	)
+	require.NoError(t, err)
+	meter, collect := collectViews(t, v)
+
+	ctx := context.Background()
+	metric.Must(meter).NewInt64Counter("requests").Add(ctx, 2)
+	metric.Must(meter).NewInt64Counter("other").Add(ctx, 1)
+
+	out := collect()
+	require.Len(t, out, 2)
+	assert.Equal(t, "HTTP requests", out["http.requests/"].description)
+	assert.Equal(t, aggregation.SumKind, out["http.requests/"].kind)
+	assert.Contains(t, out, "other/")
+}
+
+func TestViewFilterAttributes(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("req*"),
+		view.WithFilterAttributeKeys("method"),
+	)
+	require.NoError(t, err)
+	meter, collect := collectViews(t, v)
+
+	ctx := context.Background()
+	c := metric.Must(meter).NewInt64Counter("requests")
+	for i := int64(1); i <= 2; i++ {
+		c.Add(ctx, 1, attribute.String("method", "GET"), attribute.String("user", "a"))
+		c.Add(ctx, 2, attribute.String("method", "GET"), attribute.String("user", "b"))
+		c.Add(ctx, 4, attribute.String("method", "POST"), attribute.String("user", "a"))
+
+		// The cumulative state is kept for the filtered attributes.
+		out := collect()
+		require.Len(t, out, 2)
+		sum, err := out["requests/method=GET"].agg.(aggregation.Sum).Sum()
+		require.NoError(t, err)
+		assert.Equal(t, 3*i, sum.AsInt64())
+		sum, err = out["requests/method=POST"].agg.(aggregation.Sum).Sum()
+		require.NoError(t, err)
+		assert.Equal(t, 4*i, sum.AsInt64())
+	}
+}
+
+func TestViewSetAggregation(t *testing.T) {
+	lastValue, err := view.New(
+		view.MatchInstrumentName("latency"),
+		view.WithSetAggregation(aggregation.LastValueKind),
+	)
+	require.NoError(t, err)
+	histogram, err := view.New(
+		view.MatchInstrumentationName("test*"),
+		view.MatchInstrumentKind(metric.ValueRecorderInstrumentKind),
+		view.WithHistogramBoundaries(10, 100),
+	)
+	require.NoError(t, err)
+	meter, collect := collectViews(t, lastValue, histogram)
+
+	ctx := context.Background()
+	latency := metric.Must(meter).NewFloat64ValueRecorder("latency")
+	size := metric.Must(meter).NewFloat64ValueRecorder("size")
+	for _, v := range []float64{5, 50, 500} {
+		latency.Record(ctx, v, attribute.String("A", "B"))
+		size.Record(ctx, v, attribute.String("A", "B"))
+		size.Record(ctx, v, attribute.String("A", "C"))
+	}
+
+	out := collect()
+	require.Len(t, out, 3)
+	assert.Equal(t, aggregation.LastValueKind, out["latency/A=B"].kind)
+	assert.Equal(t, aggregation.HistogramKind, out["size/A=B"].kind)
+	buckets, err := out["size/A=B"].agg.(aggregation.Histogram).Histogram()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, 100}, buckets.Boundaries)
+	assert.Equal(t, []uint64{1, 1, 1}, buckets.Counts)
+}
+
+func TestViewSetAggregationWithFilter(t *testing.T) {
+	// Merging accumulations of different attributes requires the
+	// processor to allocate aggregators of the View aggregation.
+	v, err := view.New(
+		view.MatchInstrumentName("size"),
+		view.WithHistogramBoundaries(10),
+		view.WithFilterAttributeKeys(),
+	)
+	require.NoError(t, err)
+	meter, collect := collectViews(t, v)
+
+	ctx := context.Background()
+	size := metric.Must(meter).NewInt64ValueRecorder("size")
+	size.Record(ctx, 1, attribute.String("A", "B"))
+	size.Record(ctx, 20, attribute.String("A", "C"))
+
+	out := collect()
+	require.Len(t, out, 1)
+	buckets, err := out["size/"].agg.(aggregation.Histogram).Histogram()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 1}, buckets.Counts)
+}
+
+func TestViewFilteredAttributesOnExemplars(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("requests"),
+		view.WithFilterAttributeKeys("method"),
+	)
+	require.NoError(t, err)
+	meter, collect := collectViews(t, v)
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	metric.Must(meter).NewInt64Counter("requests").Add(ctx, 1,
+		attribute.String("method", "GET"), attribute.String("user", "a"))
+
+	out := collect()
+	exemplars, err := out["requests/method=GET"].agg.(aggregation.Exemplars).Exemplars()
+	require.NoError(t, err)
+	require.Len(t, exemplars, 1)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("user", "a")}, exemplars[0].FilteredAttributes)
+}
+
+func TestViewRenameMergesStreams(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("requests"),
+		view.WithRename("http.requests"),
+	)
+	require.NoError(t, err)
+	meter, collect := collectViews(t, v)
+
+	// Instruments with the same identity renamed by the same View are
+	// exported as one stream.
+	ctx := context.Background()
+	metric.Must(meter).NewInt64Counter("requests").Add(ctx, 2)
+	metric.Must(meter).NewInt64Counter("requests").Add(ctx, 3)
+
+	out := collect()
+	require.Len(t, out, 1)
+	sum, err := out["http.requests/"].agg.(aggregation.Sum).Sum()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), sum.AsInt64())
+}
+
+func TestViewRenameConflict(t *testing.T) {
+	renameA, err := view.New(view.MatchInstrumentName("a"), view.WithRename("x"))
+	require.NoError(t, err)
+	renameB, err := view.New(view.MatchInstrumentName("b"), view.WithRename("x"))
+	require.NoError(t, err)
+	renameC, err := view.New(view.MatchInstrumentName("c"), view.WithRename("d"))
+	require.NoError(t, err)
+	viewErrors := &errorRecorder{}
+	meter, collect := collectViewsWithErrors(t, viewErrors.Handle, renameA, renameB, renameC)
+
+	ctx := context.Background()
+	metric.Must(meter).NewInt64Counter("a").Add(ctx, 1)
+	metric.Must(meter).NewInt64Counter("b").Add(ctx, 2)
+	metric.Must(meter).NewInt64Counter("d").Add(ctx, 3)
+	metric.Must(meter).NewInt64Counter("c").Add(ctx, 4)
+
+	// The conflicting instruments keep their own name.
+	out := collect()
+	require.Len(t, out, 4)
+	for name, want := range map[string]int64{"x/": 1, "b/": 2, "d/": 3, "c/": 4} {
+		sum, err := out[name].agg.(aggregation.Sum).Sum()
+		require.NoError(t, err)
+		assert.Equal(t, want, sum.AsInt64(), name)
+	}
+
+	errs := viewErrors.flush()
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.True(t, errors.Is(err, basic.ErrViewConflict), err)
+	}
+}
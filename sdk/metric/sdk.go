@@ -67,12 +67,34 @@ type (
 
 		// resource is applied to all records in this Accumulator.
 		resource *resource.Resource
+
+		// cardinalityLimit is the maximum number of label sets
+		// aggregated for each instrument, including the
+		// overflow label set.  Zero if unlimited.
+		cardinalityLimit int64
+
+		// overflows maps an `overflowKey` to the *int64 number
+		// of measurements aggregated with the overflow label
+		// set of the instrument.
+		overflows sync.Map
 	}
 
 	syncInstrument struct {
+		// cardinality is the number of records of this
+		// instrument in the Accumulator.current map, not
+		// counting the overflow record.
+		cardinality int64
+
 		instrument
 	}
 
+	// overflowKey identifies an instrument in the overflow
+	// counts of an Accumulator.
+	overflowKey struct {
+		instrumentName      string
+		instrumentationName string
+	}
+
 	// mapkey uniquely describes a metric instrument in terms of
 	// its InstrumentID and the encoded form of its labels.
 	mapkey struct {
@@ -120,6 +142,11 @@ type (
 		// metric was disabled by the exporter.
 		current    export.Aggregator
 		checkpoint export.Aggregator
+
+		// overflow is true if this record aggregates the
+		// measurements of label sets that exceeded the
+		// cardinality limit of the instrument.
+		overflow bool
 	}
 
 	instrument struct {
@@ -142,14 +169,25 @@ type (
 )
 
 var (
-	_ metric.MeterImpl     = &Accumulator{}
-	_ metric.AsyncImpl     = &asyncInstrument{}
-	_ metric.SyncImpl      = &syncInstrument{}
-	_ metric.BoundSyncImpl = &record{}
+	_ metric.MeterImpl           = &Accumulator{}
+	_ metric.AsyncUnregisterImpl = &Accumulator{}
+	_ metric.AsyncImpl           = &asyncInstrument{}
+	_ metric.SyncImpl            = &syncInstrument{}
+	_ metric.BoundSyncImpl       = &record{}
 
 	ErrUninitializedInstrument = fmt.Errorf("use of an uninitialized instrument")
+
+	// overflowLabels is the label set that measurements are
+	// aggregated with once the cardinality limit of an instrument
+	// is reached.
+	overflowLabels = attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+	overflowEquiv  = overflowLabels.Equivalent()
 )
 
+// instrumentationName is the name of the instrumentation library of
+// the instruments the Accumulator reports about itself.
+const instrumentationName = "go.opentelemetry.io/otel/sdk/metric"
+
 func (inst *instrument) Descriptor() metric.Descriptor {
 	return inst.descriptor
 }
@@ -181,9 +219,18 @@ func (a *asyncInstrument) observe(num number.Number, labels *attribute.Set) {
 
 func (a *asyncInstrument) getRecorder(labels *attribute.Set) export.Aggregator {
 	lrec, ok := a.recorders[labels.Equivalent()]
+	if !ok && a.atCardinalityLimit(labels) {
+		a.meter.recordOverflow(&a.descriptor)
+		labels = &overflowLabels
+		lrec, ok = a.recorders[overflowEquiv]
+	}
 	if ok {
-		// Note: SynchronizedMove(nil) can't return an error
-		_ = lrec.observed.SynchronizedMove(nil, &a.descriptor)
+		// The overflow label set may be observed several times
+		// in one collection, these observations are aggregated.
+		if labels.Equivalent() != overflowEquiv || lrec.observedEpoch != a.meter.currentEpoch {
+			// Note: SynchronizedMove(nil) can't return an error
+			_ = lrec.observed.SynchronizedMove(nil, &a.descriptor)
+		}
 		lrec.observedEpoch = a.meter.currentEpoch
 		a.recorders[labels.Equivalent()] = lrec
 		return lrec.observed
@@ -204,6 +251,32 @@ func (a *asyncInstrument) getRecorder(labels *attribute.Set) export.Aggregator {
 	return rec
 }
 
+// atCardinalityLimit returns true if labels is a new label set that
+// would exceed the cardinality limit of the instrument.
+func (a *asyncInstrument) atCardinalityLimit(labels *attribute.Set) bool {
+	limit := a.meter.cardinalityLimit
+	if limit <= 0 || labels.Equivalent() == overflowEquiv {
+		return false
+	}
+	n := int64(len(a.recorders))
+	if _, ok := a.recorders[overflowEquiv]; ok {
+		n--
+	}
+	return n >= limit-1
+}
+
+// reserveCardinality counts a new record of the instrument, and
+// returns false without counting it if this would exceed the
+// cardinality limit of the instrument.
+func (s *syncInstrument) reserveCardinality() bool {
+	n := atomic.AddInt64(&s.cardinality, 1)
+	if limit := s.meter.cardinalityLimit; limit > 0 && n >= limit {
+		atomic.AddInt64(&s.cardinality, -1)
+		return false
+	}
+	return true
+}
+
 // acquireHandle gets or creates a `*record` corresponding to `kvs`,
 // the input labels.  The second argument `labels` is passed in to
 // support re-use of the orderedLabels computed by a previous
@@ -243,12 +316,19 @@ func (s *syncInstrument) acquireHandle(kvs []attribute.KeyValue, labelPtr *attri
 		// This entry is no longer mapped, try to add a new entry.
 	}
 
+	overflow := equiv == overflowEquiv
+	if !overflow && !s.reserveCardinality() {
+		s.meter.recordOverflow(&s.descriptor)
+		return s.acquireHandle(nil, &overflowLabels)
+	}
+
 	if rec == nil {
 		rec = &record{}
 		rec.labels = labelPtr
 	}
 	rec.refMapped = refcountMapped{value: 2}
 	rec.inst = s
+	rec.overflow = overflow
 
 	s.meter.processor.AggregatorFor(&s.descriptor, &rec.current, &rec.checkpoint)
 
@@ -262,6 +342,9 @@ func (s *syncInstrument) acquireHandle(kvs []attribute.KeyValue, labelPtr *attri
 			if oldRec.refMapped.ref() {
 				// At this moment it is guaranteed that the entry is in
 				// the map and will not be removed.
+				if !overflow {
+					atomic.AddInt64(&s.cardinality, -1)
+				}
 				return oldRec
 			}
 			// This loaded entry is marked as unmapped (so Collect will remove
@@ -303,36 +386,104 @@ func (s *syncInstrument) RecordOne(ctx context.Context, num number.Number, kvs [
 // processor will call Collect() when it receives a request to scrape
 // current metric values.  A push-based processor should configure its
 // own periodic collection.
-func NewAccumulator(processor export.Processor, resource *resource.Resource) *Accumulator {
-	return &Accumulator{
+func NewAccumulator(processor export.Processor, resource *resource.Resource, opts ...Option) *Accumulator {
+	c := &config{}
+	for _, opt := range opts {
+		opt.applyAccumulator(c)
+	}
+
+	m := &Accumulator{
 		processor:        processor,
 		asyncInstruments: internal.NewAsyncInstrumentState(),
 		resource:         resource,
+		cardinalityLimit: int64(c.CardinalityLimit),
+	}
+	if m.cardinalityLimit > 0 {
+		_ = metric.Must(metric.WrapMeterImpl(m, instrumentationName)).NewInt64SumObserver(
+			"otel.sdk.metric.overflow",
+			m.observeOverflows,
+			metric.WithDescription("Number of measurements aggregated with the overflow label set after the cardinality limit of an instrument was reached"),
+		)
 	}
+	return m
+}
+
+// recordOverflow counts a measurement of the instrument described by
+// descriptor that was aggregated with the overflow label set.
+func (m *Accumulator) recordOverflow(descriptor *metric.Descriptor) {
+	key := overflowKey{
+		instrumentName:      descriptor.Name(),
+		instrumentationName: descriptor.InstrumentationName(),
+	}
+	count, ok := m.overflows.Load(key)
+	if !ok {
+		count, _ = m.overflows.LoadOrStore(key, new(int64))
+	}
+	atomic.AddInt64(count.(*int64), 1)
+}
+
+// observeOverflows observes the number of measurements aggregated with
+// the overflow label set, for each instrument that reached its
+// cardinality limit.
+func (m *Accumulator) observeOverflows(_ context.Context, result metric.Int64ObserverResult) {
+	m.overflows.Range(func(key, value interface{}) bool {
+		k := key.(overflowKey)
+		result.Observe(
+			atomic.LoadInt64(value.(*int64)),
+			attribute.String("instrument.name", k.instrumentName),
+			attribute.String("instrumentation.name", k.instrumentationName),
+		)
+		return true
+	})
 }
 
 // NewSyncInstrument implements metric.MetricImpl.
 func (m *Accumulator) NewSyncInstrument(descriptor metric.Descriptor) (metric.SyncImpl, error) {
+	return m.newSyncInstrument(descriptor), nil
+}
+
+// newSyncInstrument returns a synchronous instrument of m.
+func (m *Accumulator) newSyncInstrument(descriptor metric.Descriptor) *syncInstrument {
 	return &syncInstrument{
 		instrument: instrument{
 			descriptor: descriptor,
 			meter:      m,
 		},
-	}, nil
+	}
 }
 
 // NewAsyncInstrument implements metric.MetricImpl.
 func (m *Accumulator) NewAsyncInstrument(descriptor metric.Descriptor, runner metric.AsyncRunner) (metric.AsyncImpl, error) {
-	a := &asyncInstrument{
+	a := m.newAsyncInstrument(descriptor)
+	m.registerAsync(a, runner)
+	return a, nil
+}
+
+// newAsyncInstrument returns an asynchronous instrument of m that is not
+// yet registered with its runner.
+func (m *Accumulator) newAsyncInstrument(descriptor metric.Descriptor) *asyncInstrument {
+	return &asyncInstrument{
 		instrument: instrument{
 			descriptor: descriptor,
 			meter:      m,
 		},
 	}
+}
+
+// registerAsync registers a with runner, so the runner is called when m
+// is collected.
+func (m *Accumulator) registerAsync(a *asyncInstrument, runner metric.AsyncRunner) {
 	m.asyncLock.Lock()
 	defer m.asyncLock.Unlock()
 	m.asyncInstruments.Register(a, runner)
-	return a, nil
+}
+
+// UnregisterAsync implements metric.AsyncUnregisterImpl.  The
+// callback may call this while a collection is in progress, so the
+// asyncLock is not acquired here.
+func (m *Accumulator) UnregisterAsync(runner metric.AsyncRunner) error {
+	m.asyncInstruments.Unregister(runner)
+	return nil
 }
 
 // Collect traverses the list of active records and observers and
@@ -383,6 +534,9 @@ func (m *Accumulator) collectSyncInstruments() int {
 		// entry in the map, they are busy calling Gosched() awaiting
 		// this deletion:
 		m.current.Delete(inuse.mapkey())
+		if !inuse.overflow {
+			atomic.AddInt64(&inuse.inst.cardinality, -1)
+		}
 
 		// There's a potential race between `LoadInt64` and
 		// `tryUnmap` in this function.  Since this is the
@@ -489,8 +643,9 @@ func (m *Accumulator) RecordBatch(ctx context.Context, kvs []attribute.KeyValue,
 		}
 		h := s.acquireHandle(kvs, labelsPtr)
 
-		// Re-use labels for the next measurement.
-		if i == 0 {
+		// Re-use labels for the next measurement, unless
+		// they were replaced with the overflow labels.
+		if i == 0 && !h.overflow {
 			labelsPtr = h.labels
 		}
 
@@ -534,8 +689,13 @@ func (r *record) mapkey() mapkey {
 // uninitialized instruments and instruments created by another SDK.
 func (m *Accumulator) fromSync(sync metric.SyncImpl) *syncInstrument {
 	if sync != nil {
-		if inst, ok := sync.Implementation().(*syncInstrument); ok {
+		switch inst := sync.Implementation().(type) {
+		case *syncInstrument:
 			return inst
+		case *groupSyncInstrument:
+			if s := inst.forAccumulator(m); s != nil {
+				return s
+			}
 		}
 	}
 	otel.Handle(ErrUninitializedInstrument)
@@ -546,8 +706,13 @@ func (m *Accumulator) fromSync(sync metric.SyncImpl) *syncInstrument {
 // uninitialized instruments and instruments created by another SDK.
 func (m *Accumulator) fromAsync(async metric.AsyncImpl) *asyncInstrument {
 	if async != nil {
-		if inst, ok := async.Implementation().(*asyncInstrument); ok {
+		switch inst := async.Implementation().(type) {
+		case *asyncInstrument:
 			return inst
+		case *groupAsyncInstrument:
+			if a := inst.forAccumulator(m); a != nil {
+				return a
+			}
 		}
 	}
 	otel.Handle(ErrUninitializedInstrument)
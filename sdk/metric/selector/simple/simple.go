@@ -15,9 +15,14 @@
 package simple // import "go.opentelemetry.io/otel/sdk/metric/selector/simple"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/otel/metric"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/exact"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/minmaxsumcount"
@@ -30,12 +35,26 @@ type (
 	selectorHistogram   struct {
 		options []histogram.Option
 	}
+	selectorExponential struct {
+		options []exponential.Option
+	}
+	selectorInstrumentKind struct {
+		kinds    map[metric.InstrumentKind]aggregation.Kind
+		fallback export.AggregatorSelector
+	}
 )
 
+// ErrUnsupportedAggregation is returned by
+// NewWithInstrumentKindAggregation for an aggregation it cannot
+// allocate aggregators for.
+var ErrUnsupportedAggregation = errors.New("unsupported aggregation")
+
 var (
 	_ export.AggregatorSelector = selectorInexpensive{}
 	_ export.AggregatorSelector = selectorExact{}
 	_ export.AggregatorSelector = selectorHistogram{}
+	_ export.AggregatorSelector = selectorExponential{}
+	_ export.AggregatorSelector = selectorInstrumentKind{}
 )
 
 // NewWithInexpensiveDistribution returns a simple aggregator selector
@@ -63,6 +82,47 @@ func NewWithHistogramDistribution(options ...histogram.Option) export.Aggregator
 	return selectorHistogram{options: options}
 }
 
+// NewWithExponentialDistribution returns a simple aggregator selector
+// that uses base-2 exponential histogram aggregators for
+// `ValueRecorder` instruments. Unlike the explicit-boundary histogram,
+// this adapts its bucket boundaries to the range of recorded values.
+func NewWithExponentialDistribution(options ...exponential.Option) export.AggregatorSelector {
+	return selectorExponential{options: options}
+}
+
+// NewWithInstrumentKindAggregation returns an aggregator selector that
+// uses the aggregation set in kinds for the instruments of each
+// instrument kind it contains, and the fallback selector for the other
+// instruments.  For example, `ValueRecorder` instruments may be
+// aggregated as MinMaxSumCount summaries where histograms are too
+// expensive, or dropped with aggregation.DropKind.  If fallback is nil,
+// NewWithInexpensiveDistribution is used.
+//
+// Histograms use the default boundaries.  An error wrapping
+// ErrUnsupportedAggregation is returned if kinds contains an aggregation
+// that is not implemented by this SDK.
+func NewWithInstrumentKindAggregation(kinds map[metric.InstrumentKind]aggregation.Kind, fallback export.AggregatorSelector) (export.AggregatorSelector, error) {
+	s := selectorInstrumentKind{
+		kinds:    make(map[metric.InstrumentKind]aggregation.Kind, len(kinds)),
+		fallback: fallback,
+	}
+	for ik, ak := range kinds {
+		switch ak {
+		case aggregation.DropKind, aggregation.SumKind,
+			aggregation.LastValueKind, aggregation.MinMaxSumCountKind,
+			aggregation.HistogramKind, aggregation.ExactKind,
+			aggregation.ExponentialHistogramKind:
+		default:
+			return nil, fmt.Errorf("%w: %s for %s", ErrUnsupportedAggregation, ak, ik)
+		}
+		s.kinds[ik] = ak
+	}
+	if s.fallback == nil {
+		s.fallback = NewWithInexpensiveDistribution()
+	}
+	return s, nil
+}
+
 func sumAggs(aggPtrs []*export.Aggregator) {
 	aggs := sum.New(len(aggPtrs))
 	for i := range aggPtrs {
@@ -79,7 +139,7 @@ func lastValueAggs(aggPtrs []*export.Aggregator) {
 
 func (selectorInexpensive) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*export.Aggregator) {
 	switch descriptor.InstrumentKind() {
-	case metric.ValueObserverInstrumentKind:
+	case metric.ValueObserverInstrumentKind, metric.GaugeInstrumentKind:
 		lastValueAggs(aggPtrs)
 	case metric.ValueRecorderInstrumentKind:
 		aggs := minmaxsumcount.New(len(aggPtrs), descriptor)
@@ -93,7 +153,7 @@ func (selectorInexpensive) AggregatorFor(descriptor *metric.Descriptor, aggPtrs
 
 func (selectorExact) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*export.Aggregator) {
 	switch descriptor.InstrumentKind() {
-	case metric.ValueObserverInstrumentKind:
+	case metric.ValueObserverInstrumentKind, metric.GaugeInstrumentKind:
 		lastValueAggs(aggPtrs)
 	case metric.ValueRecorderInstrumentKind:
 		aggs := exact.New(len(aggPtrs))
@@ -107,7 +167,7 @@ func (selectorExact) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*ex
 
 func (s selectorHistogram) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*export.Aggregator) {
 	switch descriptor.InstrumentKind() {
-	case metric.ValueObserverInstrumentKind:
+	case metric.ValueObserverInstrumentKind, metric.GaugeInstrumentKind:
 		lastValueAggs(aggPtrs)
 	case metric.ValueRecorderInstrumentKind:
 		aggs := histogram.New(len(aggPtrs), descriptor, s.options...)
@@ -118,3 +178,55 @@ func (s selectorHistogram) AggregatorFor(descriptor *metric.Descriptor, aggPtrs
 		sumAggs(aggPtrs)
 	}
 }
+
+func (s selectorExponential) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*export.Aggregator) {
+	switch descriptor.InstrumentKind() {
+	case metric.ValueObserverInstrumentKind, metric.GaugeInstrumentKind:
+		lastValueAggs(aggPtrs)
+	case metric.ValueRecorderInstrumentKind:
+		aggs := exponential.New(len(aggPtrs), descriptor, s.options...)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	default:
+		sumAggs(aggPtrs)
+	}
+}
+
+func (s selectorInstrumentKind) AggregatorFor(descriptor *metric.Descriptor, aggPtrs ...*export.Aggregator) {
+	kind, ok := s.kinds[descriptor.InstrumentKind()]
+	if !ok {
+		s.fallback.AggregatorFor(descriptor, aggPtrs...)
+		return
+	}
+	switch kind {
+	case aggregation.DropKind:
+		for i := range aggPtrs {
+			*aggPtrs[i] = nil
+		}
+	case aggregation.SumKind:
+		sumAggs(aggPtrs)
+	case aggregation.LastValueKind:
+		lastValueAggs(aggPtrs)
+	case aggregation.MinMaxSumCountKind:
+		aggs := minmaxsumcount.New(len(aggPtrs), descriptor)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.HistogramKind:
+		aggs := histogram.New(len(aggPtrs), descriptor)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.ExactKind:
+		aggs := exact.New(len(aggPtrs))
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.ExponentialHistogramKind:
+		aggs := exponential.New(len(aggPtrs), descriptor)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/number"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/exact"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/minmaxsumcount"
@@ -37,6 +39,7 @@ var (
 	testUpDownSumObserverDesc = metric.NewDescriptor("updownsumobserver", metric.UpDownSumObserverInstrumentKind, number.Int64Kind)
 	testValueRecorderDesc     = metric.NewDescriptor("valuerecorder", metric.ValueRecorderInstrumentKind, number.Int64Kind)
 	testValueObserverDesc     = metric.NewDescriptor("valueobserver", metric.ValueObserverInstrumentKind, number.Int64Kind)
+	testGaugeDesc             = metric.NewDescriptor("gauge", metric.GaugeInstrumentKind, number.Int64Kind)
 )
 
 func oneAgg(sel export.AggregatorSelector, desc *metric.Descriptor) export.Aggregator {
@@ -47,6 +50,7 @@ func oneAgg(sel export.AggregatorSelector, desc *metric.Descriptor) export.Aggre
 
 func testFixedSelectors(t *testing.T, sel export.AggregatorSelector) {
 	require.IsType(t, (*lastvalue.Aggregator)(nil), oneAgg(sel, &testValueObserverDesc))
+	require.IsType(t, (*lastvalue.Aggregator)(nil), oneAgg(sel, &testGaugeDesc))
 	require.IsType(t, (*sum.Aggregator)(nil), oneAgg(sel, &testCounterDesc))
 	require.IsType(t, (*sum.Aggregator)(nil), oneAgg(sel, &testUpDownCounterDesc))
 	require.IsType(t, (*sum.Aggregator)(nil), oneAgg(sel, &testSumObserverDesc))
@@ -70,3 +74,42 @@ func TestHistogramDistribution(t *testing.T) {
 	require.IsType(t, (*histogram.Aggregator)(nil), oneAgg(hist, &testValueRecorderDesc))
 	testFixedSelectors(t, hist)
 }
+
+func TestExponentialDistribution(t *testing.T) {
+	exp := simple.NewWithExponentialDistribution()
+	require.IsType(t, (*exponential.Aggregator)(nil), oneAgg(exp, &testValueRecorderDesc))
+	testFixedSelectors(t, exp)
+}
+
+func TestInstrumentKindAggregation(t *testing.T) {
+	sel, err := simple.NewWithInstrumentKindAggregation(map[metric.InstrumentKind]aggregation.Kind{
+		metric.ValueRecorderInstrumentKind: aggregation.MinMaxSumCountKind,
+		metric.ValueObserverInstrumentKind: aggregation.HistogramKind,
+		metric.CounterInstrumentKind:       aggregation.DropKind,
+	}, simple.NewWithExactDistribution())
+	require.NoError(t, err)
+
+	require.IsType(t, (*minmaxsumcount.Aggregator)(nil), oneAgg(sel, &testValueRecorderDesc))
+	require.IsType(t, (*histogram.Aggregator)(nil), oneAgg(sel, &testValueObserverDesc))
+	require.Nil(t, oneAgg(sel, &testCounterDesc))
+	require.IsType(t, (*sum.Aggregator)(nil), oneAgg(sel, &testUpDownCounterDesc))
+	require.IsType(t, (*sum.Aggregator)(nil), oneAgg(sel, &testSumObserverDesc))
+}
+
+func TestInstrumentKindAggregationFallback(t *testing.T) {
+	sel, err := simple.NewWithInstrumentKindAggregation(map[metric.InstrumentKind]aggregation.Kind{
+		metric.SumObserverInstrumentKind: aggregation.LastValueKind,
+	}, nil)
+	require.NoError(t, err)
+
+	require.IsType(t, (*lastvalue.Aggregator)(nil), oneAgg(sel, &testSumObserverDesc))
+	require.IsType(t, (*minmaxsumcount.Aggregator)(nil), oneAgg(sel, &testValueRecorderDesc))
+	require.IsType(t, (*sum.Aggregator)(nil), oneAgg(sel, &testCounterDesc))
+}
+
+func TestInstrumentKindAggregationUnsupported(t *testing.T) {
+	_, err := simple.NewWithInstrumentKindAggregation(map[metric.InstrumentKind]aggregation.Kind{
+		metric.ValueRecorderInstrumentKind: "Quantiles",
+	}, nil)
+	require.ErrorIs(t, err, simple.ErrUnsupportedAggregation)
+}
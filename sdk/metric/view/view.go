@@ -0,0 +1,302 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package view provides Views, the configuration used by the metric SDK
+// to customize the data exported for instruments without changing the
+// instrumentation. A View matches instruments by name, instrumentation
+// library name, and kind, and can rename them, change their description,
+// restrict their attributes, and change their aggregation.
+package view // import "go.opentelemetry.io/otel/sdk/metric/view"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exact"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/minmaxsumcount"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+)
+
+var (
+	// ErrRenameWildcard is returned by New when a View renaming
+	// instruments can match more than one instrument name.
+	ErrRenameWildcard = errors.New("view: rename requires an exact instrument name match")
+	// ErrUnsupportedAggregation is returned by New when a View sets an
+	// aggregation that is not supported.
+	ErrUnsupportedAggregation = errors.New("view: unsupported aggregation")
+)
+
+// View describes how the data of the instruments it matches is exported.
+// The zero View matches all instruments and does not change them.
+type View struct {
+	instrumentName      *regexp.Regexp
+	hasWildcard         bool
+	instrumentationName *regexp.Regexp
+	instrumentKind      metric.InstrumentKind
+	hasInstrumentKind   bool
+
+	name               string
+	description        string
+	filter             attribute.Filter
+	aggregation        aggregation.Kind
+	boundaries         []float64
+	exponentialOptions []exponential.Option
+}
+
+// New returns a View configured with options.
+//
+// An error is returned if the View renames instruments but its instrument
+// name match contains a wildcard, or if it sets an unsupported aggregation.
+func New(options ...Option) (View, error) {
+	var v View
+	for _, o := range options {
+		o.apply(&v)
+	}
+
+	if v.name != "" && (v.instrumentName == nil || v.hasWildcard) {
+		return View{}, ErrRenameWildcard
+	}
+	switch v.aggregation {
+	case "", aggregation.SumKind, aggregation.MinMaxSumCountKind,
+		aggregation.HistogramKind, aggregation.LastValueKind,
+		aggregation.ExactKind, aggregation.ExponentialHistogramKind,
+		aggregation.DropKind:
+	default:
+		return View{}, fmt.Errorf("%w: %s", ErrUnsupportedAggregation, v.aggregation)
+	}
+	return v, nil
+}
+
+// Matches returns true if the instrument described by desc is matched by
+// the View.
+func (v View) Matches(desc *metric.Descriptor) bool {
+	if v.instrumentName != nil && !v.instrumentName.MatchString(desc.Name()) {
+		return false
+	}
+	if v.instrumentationName != nil && !v.instrumentationName.MatchString(desc.InstrumentationName()) {
+		return false
+	}
+	if v.hasInstrumentKind && v.instrumentKind != desc.InstrumentKind() {
+		return false
+	}
+	return true
+}
+
+// Descriptor returns the descriptor of the data exported for the
+// instrument described by desc, with the name and description set by the
+// View.
+func (v View) Descriptor(desc *metric.Descriptor) metric.Descriptor {
+	name := desc.Name()
+	if v.name != "" {
+		name = v.name
+	}
+	description := desc.Description()
+	if v.description != "" {
+		description = v.description
+	}
+	return metric.NewDescriptor(
+		name,
+		desc.InstrumentKind(),
+		desc.NumberKind(),
+		metric.WithDescription(description),
+		metric.WithUnit(desc.Unit()),
+		metric.WithInstrumentationName(desc.InstrumentationName()),
+		metric.WithInstrumentationVersion(desc.InstrumentationVersion()),
+		metric.WithExplicitBucketBoundaries(desc.ExplicitBucketBoundaries()...),
+	)
+}
+
+// Filter returns the filter of the attributes, labels, exported by the
+// View. It returns nil if all attributes are exported.
+func (v View) Filter() attribute.Filter {
+	return v.filter
+}
+
+// AggregatorFor allocates aggregators of the aggregation set by the View
+// for the instrument described by desc. It returns false, without
+// allocating, if the View does not set an aggregation.
+func (v View) AggregatorFor(desc *metric.Descriptor, aggPtrs ...*export.Aggregator) bool {
+	switch v.aggregation {
+	case aggregation.SumKind:
+		aggs := sum.New(len(aggPtrs))
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.MinMaxSumCountKind:
+		aggs := minmaxsumcount.New(len(aggPtrs), desc)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.HistogramKind:
+		var opts []histogram.Option
+		if v.boundaries != nil {
+			opts = append(opts, histogram.WithExplicitBoundaries(v.boundaries))
+		}
+		aggs := histogram.New(len(aggPtrs), desc, opts...)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.ExponentialHistogramKind:
+		aggs := exponential.New(len(aggPtrs), desc, v.exponentialOptions...)
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.LastValueKind:
+		aggs := lastvalue.New(len(aggPtrs))
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.ExactKind:
+		aggs := exact.New(len(aggPtrs))
+		for i := range aggPtrs {
+			*aggPtrs[i] = &aggs[i]
+		}
+	case aggregation.DropKind:
+		for i := range aggPtrs {
+			*aggPtrs[i] = nil
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// Option applies a configuration option to a View.
+type Option interface {
+	apply(*View)
+}
+
+type optionFunc func(*View)
+
+func (fn optionFunc) apply(v *View) {
+	fn(v)
+}
+
+// wildcardRegexp returns a regular expression matching pattern in full,
+// where "*" matches any sequence of characters and "?" matches any single
+// character.
+func wildcardRegexp(pattern string) (*regexp.Regexp, bool) {
+	var b strings.Builder
+	b.WriteString("^")
+	wildcard := false
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			wildcard = true
+			b.WriteString(".*")
+		case '?':
+			wildcard = true
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String()), wildcard
+}
+
+// MatchInstrumentName configures the View to match instruments whose name
+// matches name. The wildcards "*" and "?" match any sequence of characters
+// and any single character respectively.
+func MatchInstrumentName(name string) Option {
+	return optionFunc(func(v *View) {
+		v.instrumentName, v.hasWildcard = wildcardRegexp(name)
+	})
+}
+
+// MatchInstrumentationName configures the View to match instruments
+// created by the instrumentation libraries whose name matches name. The
+// wildcards "*" and "?" match any sequence of characters and any single
+// character respectively.
+func MatchInstrumentationName(name string) Option {
+	return optionFunc(func(v *View) {
+		v.instrumentationName, _ = wildcardRegexp(name)
+	})
+}
+
+// MatchInstrumentKind configures the View to match instruments of kind.
+func MatchInstrumentKind(kind metric.InstrumentKind) Option {
+	return optionFunc(func(v *View) {
+		v.instrumentKind = kind
+		v.hasInstrumentKind = true
+	})
+}
+
+// WithRename configures the View to export the data of the matched
+// instrument with name. The instrument name matched by the View must not
+// contain wildcards.
+func WithRename(name string) Option {
+	return optionFunc(func(v *View) {
+		v.name = name
+	})
+}
+
+// WithSetDescription configures the View to export the data of matched
+// instruments with description.
+func WithSetDescription(description string) Option {
+	return optionFunc(func(v *View) {
+		v.description = description
+	})
+}
+
+// WithFilterAttributeKeys configures the View to only export the
+// attributes of matched instruments with one of keys. The data of
+// measurements that differ only by other attributes is aggregated
+// together.
+func WithFilterAttributeKeys(keys ...attribute.Key) Option {
+	filter := attribute.NewAllowKeysFilter(keys...)
+	return optionFunc(func(v *View) {
+		v.filter = filter
+	})
+}
+
+// WithSetAggregation configures the View to aggregate the data of matched
+// instruments with the aggregation of kind.  The aggregation.DropKind
+// disables the matched instruments.
+func WithSetAggregation(kind aggregation.Kind) Option {
+	return optionFunc(func(v *View) {
+		v.aggregation = kind
+	})
+}
+
+// WithHistogramBoundaries configures the View to aggregate the data of
+// matched instruments with a histogram using the explicit bucket
+// boundaries.
+func WithHistogramBoundaries(boundaries ...float64) Option {
+	b := append([]float64(nil), boundaries...)
+	return optionFunc(func(v *View) {
+		v.aggregation = aggregation.HistogramKind
+		v.boundaries = b
+	})
+}
+
+// WithExponentialHistogram configures the View to aggregate the data of
+// matched instruments with a base-2 exponential histogram configured
+// with opts.
+func WithExponentialHistogram(opts ...exponential.Option) Option {
+	o := append([]exponential.Option(nil), opts...)
+	return optionFunc(func(v *View) {
+		v.aggregation = aggregation.ExponentialHistogramKind
+		v.exponentialOptions = o
+	})
+}
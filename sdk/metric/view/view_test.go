@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/metric/unit"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exponential"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+)
+
+func TestMatches(t *testing.T) {
+	desc := metric.NewDescriptor(
+		"http.server.duration",
+		metric.ValueRecorderInstrumentKind,
+		number.Float64Kind,
+		metric.WithInstrumentationName("net/http"),
+	)
+
+	tests := []struct {
+		name    string
+		options []Option
+		want    bool
+	}{
+		{name: "empty", want: true},
+		{name: "name", options: []Option{MatchInstrumentName("http.server.duration")}, want: true},
+		{name: "name wildcard", options: []Option{MatchInstrumentName("http.*")}, want: true},
+		{name: "name single wildcard", options: []Option{MatchInstrumentName("http.server.duratio?")}, want: true},
+		{name: "name mismatch", options: []Option{MatchInstrumentName("http.server")}},
+		{name: "name escapes regexp", options: []Option{MatchInstrumentName("http.server.duration|x")}},
+		{name: "instrumentation", options: []Option{MatchInstrumentationName("net/*")}, want: true},
+		{name: "instrumentation mismatch", options: []Option{MatchInstrumentationName("grpc")}},
+		{name: "kind", options: []Option{MatchInstrumentKind(metric.ValueRecorderInstrumentKind)}, want: true},
+		{name: "kind mismatch", options: []Option{MatchInstrumentKind(metric.CounterInstrumentKind)}},
+		{
+			name: "all",
+			options: []Option{
+				MatchInstrumentName("*"),
+				MatchInstrumentationName("net/http"),
+				MatchInstrumentKind(metric.ValueRecorderInstrumentKind),
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := New(test.options...)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, v.Matches(&desc))
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New(WithRename("other"))
+	assert.ErrorIs(t, err, ErrRenameWildcard)
+	_, err = New(MatchInstrumentName("http.*"), WithRename("other"))
+	assert.ErrorIs(t, err, ErrRenameWildcard)
+	_, err = New(WithSetAggregation("unknown"))
+	assert.ErrorIs(t, err, ErrUnsupportedAggregation)
+}
+
+func TestDescriptor(t *testing.T) {
+	desc := metric.NewDescriptor(
+		"requests",
+		metric.CounterInstrumentKind,
+		number.Int64Kind,
+		metric.WithDescription("number of requests"),
+		metric.WithUnit(unit.Dimensionless),
+		metric.WithInstrumentationName("lib"),
+		metric.WithInstrumentationVersion("v1"),
+	)
+
+	v, err := New()
+	require.NoError(t, err)
+	assert.Equal(t, desc, v.Descriptor(&desc))
+
+	v, err = New(
+		MatchInstrumentName("requests"),
+		WithRename("http.requests"),
+		WithSetDescription("HTTP requests"),
+	)
+	require.NoError(t, err)
+	got := v.Descriptor(&desc)
+	assert.Equal(t, "http.requests", got.Name())
+	assert.Equal(t, "HTTP requests", got.Description())
+	assert.Equal(t, desc.InstrumentKind(), got.InstrumentKind())
+	assert.Equal(t, desc.NumberKind(), got.NumberKind())
+	assert.Equal(t, desc.Unit(), got.Unit())
+	assert.Equal(t, desc.InstrumentationName(), got.InstrumentationName())
+	assert.Equal(t, desc.InstrumentationVersion(), got.InstrumentationVersion())
+}
+
+func TestFilter(t *testing.T) {
+	v, err := New()
+	require.NoError(t, err)
+	assert.Nil(t, v.Filter())
+
+	v, err = New(WithFilterAttributeKeys("a", "b"))
+	require.NoError(t, err)
+	f := v.Filter()
+	assert.True(t, f(attribute.String("a", "1")))
+	assert.True(t, f(attribute.String("b", "1")))
+	assert.False(t, f(attribute.String("c", "1")))
+}
+
+func TestAggregatorFor(t *testing.T) {
+	desc := metric.NewDescriptor("size", metric.ValueRecorderInstrumentKind, number.Int64Kind)
+
+	v, err := New()
+	require.NoError(t, err)
+	var agg export.Aggregator
+	assert.False(t, v.AggregatorFor(&desc, &agg))
+	assert.Nil(t, agg)
+
+	for _, kind := range []aggregation.Kind{
+		aggregation.SumKind,
+		aggregation.MinMaxSumCountKind,
+		aggregation.HistogramKind,
+		aggregation.LastValueKind,
+		aggregation.ExactKind,
+		aggregation.ExponentialHistogramKind,
+	} {
+		v, err := New(WithSetAggregation(kind))
+		require.NoError(t, err)
+		var a, b export.Aggregator
+		require.True(t, v.AggregatorFor(&desc, &a, &b))
+		assert.Equal(t, kind, a.Aggregation().Kind())
+		assert.Equal(t, kind, b.Aggregation().Kind())
+	}
+
+	v, err = New(WithSetAggregation(aggregation.DropKind))
+	require.NoError(t, err)
+	agg = &sum.New(1)[0]
+	require.True(t, v.AggregatorFor(&desc, &agg))
+	assert.Nil(t, agg)
+}
+
+func TestHistogramBoundaries(t *testing.T) {
+	desc := metric.NewDescriptor(
+		"latency",
+		metric.ValueRecorderInstrumentKind,
+		number.Float64Kind,
+		metric.WithExplicitBucketBoundaries(1, 2, 3),
+	)
+
+	boundaries := func(v View, desc *metric.Descriptor) []float64 {
+		var agg export.Aggregator
+		require.True(t, v.AggregatorFor(desc, &agg))
+		b, err := agg.(aggregation.Histogram).Histogram()
+		require.NoError(t, err)
+		return b.Boundaries
+	}
+
+	v, err := New(WithSetAggregation(aggregation.HistogramKind))
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, boundaries(v, &desc), "advised boundaries")
+
+	renamed := v.Descriptor(&desc)
+	assert.Equal(t, []float64{1, 2, 3}, renamed.ExplicitBucketBoundaries())
+
+	v, err = New(WithHistogramBoundaries(10, 20))
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, 20}, boundaries(v, &desc), "view boundaries")
+}
+
+func TestExponentialHistogram(t *testing.T) {
+	desc := metric.NewDescriptor("size", metric.ValueRecorderInstrumentKind, number.Float64Kind)
+
+	v, err := New(WithExponentialHistogram(exponential.WithMaxScale(0)))
+	require.NoError(t, err)
+	var agg export.Aggregator
+	require.True(t, v.AggregatorFor(&desc, &agg))
+	require.NoError(t, agg.Update(context.Background(), number.NewFloat64Number(4), &desc))
+
+	dist, err := agg.(aggregation.ExponentialHistogram).ExponentialHistogram()
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), dist.Scale)
+	assert.Equal(t, int32(1), dist.Positive.Offset)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -37,28 +38,127 @@ type Detector interface {
 	Detect(ctx context.Context) (*Resource, error)
 }
 
-// Detect calls all input detectors sequentially and merges each result with the previous one.
-// It returns the merged error too.
+// Detect calls all input detectors concurrently and merges each result with
+// the previous one, in the order the detectors are passed. If any detector
+// fails a *DetectError is returned along with the merged Resource.
 func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
-	var autoDetectedRes *Resource
-	var errInfo []string
-	for _, detector := range detectors {
+	return detect(ctx, 0, detectors)
+}
+
+// DetectorError is the error of a single Detector that failed.
+type DetectorError struct {
+	// Detector that failed.
+	Detector Detector
+	// Err returned by the Detector, or the context error if the Detector
+	// did not return in time.
+	Err error
+}
+
+// Error implements error.
+func (e *DetectorError) Error() string {
+	return fmt.Sprintf("%T: %v", e.Detector, e.Err)
+}
+
+// Unwrap returns the error returned by the Detector.
+func (e *DetectorError) Unwrap() error {
+	return e.Err
+}
+
+// DetectError is returned when one or more detectors fail. The Resource
+// returned with it is merged from the Resources of the detectors that
+// succeeded and the partial Resources of the detectors that failed with an
+// ErrPartialResource error.
+type DetectError struct {
+	// Errors of the detectors that failed, in the order the detectors
+	// were passed.
+	Errors []*DetectorError
+}
+
+// Error implements error.
+func (e *DetectError) Error() string {
+	errInfo := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		errInfo[i] = err.Error()
+	}
+	return fmt.Sprintf("detecting resources: %s", errInfo)
+}
+
+// Is returns true if the error of any of the failed detectors matches
+// target.
+func (e *DetectError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectResult is the outcome of a single Detector.
+type detectResult struct {
+	res *Resource
+	err error
+}
+
+// detect runs detectors concurrently, each with its own context that is
+// canceled after timeout if timeout is positive, and merges their results
+// in order. Detectors that do not return before their context is done are
+// reported as failed with the context error.
+func detect(ctx context.Context, timeout time.Duration, detectors []Detector) (*Resource, error) {
+	results := make([]chan detectResult, len(detectors))
+	for i, detector := range detectors {
 		if detector == nil {
 			continue
 		}
-		res, err := detector.Detect(ctx)
-		if err != nil {
-			errInfo = append(errInfo, err.Error())
-			if !errors.Is(err, ErrPartialResource) {
+		results[i] = make(chan detectResult, 1)
+		go func(detector Detector, result chan<- detectResult) {
+			dCtx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				dCtx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			// Detectors that ignore the context are not waited for past
+			// its deadline. The buffered channel lets them return later.
+			done := make(chan detectResult, 1)
+			go func() {
+				res, err := detector.Detect(dCtx)
+				done <- detectResult{res: res, err: err}
+			}()
+			select {
+			case r := <-done:
+				result <- r
+			case <-dCtx.Done():
+				result <- detectResult{err: dCtx.Err()}
+			}
+		}(detector, results[i])
+	}
+
+	var autoDetectedRes *Resource
+	var detectErr *DetectError
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		r := <-result
+		if r.err != nil {
+			if detectErr == nil {
+				detectErr = &DetectError{}
+			}
+			detectErr.Errors = append(detectErr.Errors, &DetectorError{
+				Detector: detectors[i],
+				Err:      r.err,
+			})
+			if !errors.Is(r.err, ErrPartialResource) {
 				continue
 			}
 		}
-		autoDetectedRes = Merge(autoDetectedRes, res)
+		autoDetectedRes = Merge(autoDetectedRes, r.res)
 	}
 
-	var aggregatedError error
-	if len(errInfo) > 0 {
-		aggregatedError = fmt.Errorf("detecting resources: %s", errInfo)
+	if detectErr != nil {
+		return autoDetectedRes, detectErr
 	}
-	return autoDetectedRes, aggregatedError
+	return autoDetectedRes, nil
 }
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type testDetector struct {
+	detect func(context.Context) (*resource.Resource, error)
+}
+
+func (d *testDetector) Detect(ctx context.Context) (*resource.Resource, error) {
+	return d.detect(ctx)
+}
+
+func detectorFunc(f func(context.Context) (*resource.Resource, error)) resource.Detector {
+	return &testDetector{detect: f}
+}
+
+func attributesDetector(kvs ...attribute.KeyValue) resource.Detector {
+	return detectorFunc(func(context.Context) (*resource.Resource, error) {
+		return resource.NewWithAttributes(kvs...), nil
+	})
+}
+
+func errorDetector(res *resource.Resource, err error) resource.Detector {
+	return detectorFunc(func(context.Context) (*resource.Resource, error) {
+		return res, err
+	})
+}
+
+func TestDetectMergesInOrder(t *testing.T) {
+	slow := detectorFunc(func(context.Context) (*resource.Resource, error) {
+		time.Sleep(10 * time.Millisecond)
+		return resource.NewWithAttributes(attribute.String("k", "slow")), nil
+	})
+
+	res, err := resource.Detect(context.Background(),
+		slow,
+		nil,
+		attributesDetector(attribute.String("k", "fast"), attribute.String("a", "b")),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"k": "fast", "a": "b"}, toMap(res))
+}
+
+func TestDetectErrors(t *testing.T) {
+	errFailed := errors.New("failed")
+	errPartial := fmt.Errorf("%w: missing", resource.ErrPartialResource)
+	failed := errorDetector(resource.NewWithAttributes(attribute.String("dropped", "x")), errFailed)
+	partial := errorDetector(resource.NewWithAttributes(attribute.String("partial", "x")), errPartial)
+
+	res, err := resource.Detect(context.Background(),
+		attributesDetector(attribute.String("ok", "x")),
+		failed,
+		partial,
+	)
+	assert.Equal(t, map[string]string{"ok": "x", "partial": "x"}, toMap(res))
+
+	var detectErr *resource.DetectError
+	require.True(t, errors.As(err, &detectErr))
+	require.Len(t, detectErr.Errors, 2)
+	assert.Equal(t, failed, detectErr.Errors[0].Detector)
+	assert.Equal(t, errFailed, detectErr.Errors[0].Err)
+	assert.Equal(t, partial, detectErr.Errors[1].Detector)
+	assert.Equal(t, errPartial, detectErr.Errors[1].Err)
+
+	assert.True(t, errors.Is(err, errFailed))
+	assert.True(t, errors.Is(err, resource.ErrPartialResource))
+	assert.Equal(t,
+		"detecting resources: [*resource_test.testDetector: failed *resource_test.testDetector: partial resource: missing]",
+		err.Error(),
+	)
+}
+
+func TestNewWithDetectorTimeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	hanging := detectorFunc(func(context.Context) (*resource.Resource, error) {
+		<-block
+		return resource.NewWithAttributes(attribute.String("hanging", "x")), nil
+	})
+	canceled := make(chan error, 1)
+	respectful := detectorFunc(func(ctx context.Context) (*resource.Resource, error) {
+		<-ctx.Done()
+		canceled <- ctx.Err()
+		return nil, ctx.Err()
+	})
+
+	res, err := resource.New(context.Background(),
+		resource.WithDetectors(hanging, respectful),
+		resource.WithAttributes(attribute.String("ok", "x")),
+		resource.WithDetectorTimeout(10*time.Millisecond),
+	)
+	assert.Equal(t, map[string]string{"ok": "x"}, toMap(res))
+	assert.Equal(t, context.DeadlineExceeded, <-canceled)
+
+	var detectErr *resource.DetectError
+	require.True(t, errors.As(err, &detectErr))
+	require.Len(t, detectErr.Errors, 2)
+	assert.Equal(t, hanging, detectErr.Errors[0].Detector)
+	assert.True(t, errors.Is(detectErr.Errors[0], context.DeadlineExceeded))
+	assert.Equal(t, respectful, detectErr.Errors[1].Detector)
+	assert.True(t, errors.Is(detectErr.Errors[1], context.DeadlineExceeded))
+}
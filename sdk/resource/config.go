@@ -16,6 +16,7 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -24,6 +25,10 @@ import (
 type config struct {
 	// detectors that will be evaluated.
 	detectors []Detector
+	// schemaURL of the created Resource.
+	schemaURL string
+	// detectorTimeout is the time each detector is given to return.
+	detectorTimeout time.Duration
 }
 
 // Option is the interface that applies a configuration option.
@@ -58,6 +63,34 @@ func (o detectorsOption) apply(cfg *config) {
 	cfg.detectors = append(cfg.detectors, o.detectors...)
 }
 
+// WithSchemaURL sets the schema URL of the configured Resource. The
+// attributes added to the Resource are expected to conform to the schema.
+func WithSchemaURL(schemaURL string) Option {
+	return schemaURLOption(schemaURL)
+}
+
+type schemaURLOption string
+
+func (o schemaURLOption) apply(cfg *config) {
+	cfg.schemaURL = string(o)
+}
+
+// WithDetectorTimeout limits the time each detector is given to return.
+// A detector that does not return in time is reported as failed with
+// context.DeadlineExceeded and does not contribute to the Resource. The
+// context passed to the detector is canceled when the timeout expires.
+//
+// By default detectors are only limited by the context passed to New.
+func WithDetectorTimeout(timeout time.Duration) Option {
+	return detectorTimeoutOption(timeout)
+}
+
+type detectorTimeoutOption time.Duration
+
+func (o detectorTimeoutOption) apply(cfg *config) {
+	cfg.detectorTimeout = time.Duration(o)
+}
+
 // WithBuiltinDetectors adds the built detectors to the configured resource.
 func WithBuiltinDetectors() Option {
 	return WithDetectors(telemetrySDK{},
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"regexp"
+
+	"go.opentelemetry.io/otel/semconv"
+)
+
+type containerIDProvider func() (string, error)
+
+var (
+	defaultContainerIDProvider containerIDProvider = getContainerIDFromCGroup
+
+	containerID = defaultContainerIDProvider
+)
+
+// cgroupContainerIDRe matches the container ID at the end of a cgroup path,
+// as written by Docker, containerd, CRI-O and Podman. For example:
+//
+//	12:cpu,cpuacct:/docker/<id>
+//	0::/system.slice/docker-<id>.scope
+//	11:devices:/kubepods/besteffort/pod<uid>/crio-<id>.scope
+var cgroupContainerIDRe = regexp.MustCompile(`^.*/(?:.*[-:])?([0-9a-f]{64})(?:\.|\s*$)`)
+
+const cgroupPath = "/proc/self/cgroup"
+
+type cgroupContainerIDDetector struct{}
+
+// Detect returns a *Resource that describes the ID of the container the
+// process is running in, read from the cgroup of the process. An empty
+// Resource is returned if the process is not running in a container.
+func (cgroupContainerIDDetector) Detect(ctx context.Context) (*Resource, error) {
+	containerID, err := containerID()
+	if err != nil {
+		return nil, err
+	}
+	if containerID == "" {
+		return Empty(), nil
+	}
+	return NewWithAttributes(semconv.ContainerIDKey.String(containerID)), nil
+}
+
+func setDefaultContainerProviders() {
+	setContainerProviders(defaultContainerIDProvider)
+}
+
+func setContainerProviders(containerIDProvider containerIDProvider) {
+	containerID = containerIDProvider
+}
+
+// getContainerIDFromCGroup returns the container ID of the current process
+// found in cgroupPath, or an empty string if there is none.
+func getContainerIDFromCGroup() (string, error) {
+	f, err := os.Open(cgroupPath)
+	if errors.Is(err, os.ErrNotExist) {
+		// The process is not running on Linux, or is running in a
+		// sandbox hiding the cgroup.
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return getContainerIDFromReader(f), nil
+}
+
+// getContainerIDFromReader returns the first container ID found in the
+// cgroup file content read from r.
+func getContainerIDFromReader(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if id := getContainerIDFromLine(scanner.Text()); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// getContainerIDFromLine returns the container ID of a single cgroup file
+// line, or an empty string if the line does not contain one.
+func getContainerIDFromLine(line string) string {
+	matches := cgroupContainerIDRe.FindStringSubmatch(line)
+	if len(matches) <= 1 {
+		return ""
+	}
+	return matches[1]
+}
+
+// WithContainerID adds an attribute with the ID of the container the process
+// is running in to the configured Resource.
+func WithContainerID() Option {
+	return WithDetectors(cgroupContainerIDDetector{})
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const fakeContainerID = "ac679f8a8319c8cf7d38e1adf263bc08d231f2ff81abda3915f6e8ba4d64156a"
+
+func TestGetContainerIDFromLine(t *testing.T) {
+	testCases := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "docker",
+			line: "13:name=systemd:/docker/" + fakeContainerID,
+			want: fakeContainerID,
+		},
+		{
+			name: "systemd scope",
+			line: "0::/system.slice/docker-" + fakeContainerID + ".scope",
+			want: fakeContainerID,
+		},
+		{
+			name: "crio",
+			line: "11:devices:/kubepods/besteffort/pod2c6d7b4b/crio-" + fakeContainerID + ".scope",
+			want: fakeContainerID,
+		},
+		{
+			name: "containerd",
+			line: "1:name=systemd:/system.slice/containerd.service/kubepods-burstable.slice:cri-containerd:" + fakeContainerID,
+			want: fakeContainerID,
+		},
+		{
+			name: "not a container",
+			line: "0::/user.slice/user-1000.slice/session-2.scope",
+		},
+		{
+			name: "short id",
+			line: "13:name=systemd:/docker/ac679f8a8319",
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, resource.GetContainerIDFromLine(tc.line))
+		})
+	}
+}
+
+func TestGetContainerIDFromReader(t *testing.T) {
+	cgroup := strings.Join([]string{
+		"14:pids:/",
+		"13:name=systemd:/docker/" + fakeContainerID,
+		"12:cpu,cpuacct:/docker/" + fakeContainerID,
+	}, "\n")
+	assert.Equal(t, fakeContainerID, resource.GetContainerIDFromReader(strings.NewReader(cgroup)))
+	assert.Equal(t, "", resource.GetContainerIDFromReader(strings.NewReader("0::/\n")))
+}
+
+func TestWithContainerID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		provider func() (string, error)
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "container",
+			provider: func() (string, error) { return fakeContainerID, nil },
+			want:     map[string]string{"container.id": fakeContainerID},
+		},
+		{
+			name:     "no container",
+			provider: func() (string, error) { return "", nil },
+			want:     map[string]string{},
+		},
+		{
+			name:     "error",
+			provider: func() (string, error) { return "", errors.New("permission denied") },
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.SetContainerProviders(tc.provider)
+			defer resource.SetDefaultContainerProviders()
+
+			res, err := resource.New(context.Background(), resource.WithContainerID())
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, toMap(res))
+		})
+	}
+}
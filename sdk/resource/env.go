@@ -17,18 +17,29 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
 )
 
-// envVar is the environment variable name OpenTelemetry Resource information can be assigned to.
-const envVar = "OTEL_RESOURCE_ATTRIBUTES"
+const (
+	// envVar is the environment variable name OpenTelemetry Resource information can be assigned to.
+	envVar = "OTEL_RESOURCE_ATTRIBUTES"
+
+	// svcNameVar is the environment variable name the service.name attribute can be assigned to.
+	svcNameVar = "OTEL_SERVICE_NAME"
+)
 
 var (
 	// errMissingValue is returned when a resource value is missing.
 	errMissingValue = fmt.Errorf("%w: missing value", ErrPartialResource)
+
+	// errInvalidAttribute is returned when a resource attribute has an
+	// empty key or a value that is not correctly percent-encoded.
+	errInvalidAttribute = fmt.Errorf("%w: invalid attribute", ErrPartialResource)
 )
 
 // fromEnv is a Detector that implements the Detector and collects
@@ -36,6 +47,11 @@ var (
 // builtin.  If these resource attributes are not wanted, use the
 // WithFromEnv(nil) or WithoutBuiltin() options to explicitly disable
 // them.
+//
+// Attributes are read from the OTEL_RESOURCE_ATTRIBUTES environment
+// variable as a comma-separated list of key=value pairs, with the values
+// percent-encoded. The service.name attribute is overridden by the
+// OTEL_SERVICE_NAME environment variable if it is set.
 type fromEnv struct{}
 
 // compile time assertion that FromEnv implements Detector interface
@@ -44,29 +60,57 @@ var _ Detector = fromEnv{}
 // Detect collects resources from environment
 func (fromEnv) Detect(context.Context) (*Resource, error) {
 	attrs := strings.TrimSpace(os.Getenv(envVar))
+	svcName := strings.TrimSpace(os.Getenv(svcNameVar))
 
-	if attrs == "" {
+	if attrs == "" && svcName == "" {
 		return Empty(), nil
 	}
-	return constructOTResources(attrs)
+
+	var res *Resource
+	var err error
+	if attrs != "" {
+		res, err = constructOTResources(attrs)
+	}
+	if svcName != "" {
+		res = Merge(res, NewWithAttributes(semconv.ServiceNameKey.String(svcName)))
+	}
+	return res, err
 }
 
+// constructOTResources returns a Resource with the attributes in s. Pairs
+// that are not valid are dropped and reported with a wrapped
+// ErrPartialResource error.
 func constructOTResources(s string) (*Resource, error) {
 	pairs := strings.Split(s, ",")
 	attrs := []attribute.KeyValue{}
-	var invalid []string
+	var missing, invalid []string
 	for _, p := range pairs {
 		field := strings.SplitN(p, "=", 2)
 		if len(field) != 2 {
-			invalid = append(invalid, p)
+			missing = append(missing, p)
 			continue
 		}
 		k, v := strings.TrimSpace(field[0]), strings.TrimSpace(field[1])
+		if k == "" {
+			invalid = append(invalid, p)
+			continue
+		}
+		v, err := url.PathUnescape(v)
+		if err != nil {
+			invalid = append(invalid, p)
+			continue
+		}
 		attrs = append(attrs, attribute.String(k, v))
 	}
+
 	var err error
-	if len(invalid) > 0 {
-		err = fmt.Errorf("%w: %v", errMissingValue, invalid)
+	switch {
+	case len(missing) > 0 && len(invalid) > 0:
+		err = fmt.Errorf("%w: %v; invalid attribute: %v", errMissingValue, missing, invalid)
+	case len(missing) > 0:
+		err = fmt.Errorf("%w: %v", errMissingValue, missing)
+	case len(invalid) > 0:
+		err = fmt.Errorf("%w: %v", errInvalidAttribute, invalid)
 	}
 	return NewWithAttributes(attrs...), err
 }
@@ -16,6 +16,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -24,6 +25,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/semconv"
 )
 
 func TestDetectOnePair(t *testing.T) {
@@ -86,3 +88,70 @@ func TestMissingKeyError(t *testing.T) {
 		attribute.String("key", "value"),
 	))
 }
+
+func TestDetectPercentEncodedValues(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar: "key=a%2Cb%3Dc,space=x%20y,plus=1+2",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &fromEnv{}
+	res, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		attribute.String("key", "a,b=c"),
+		attribute.String("space", "x y"),
+		attribute.String("plus", "1+2"),
+	), res)
+}
+
+func TestInvalidAttributeError(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar: "key=value,=empty,bad=%zz,missing",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &fromEnv{}
+	res, err := detector.Detect(context.Background())
+	assert.True(t, errors.Is(err, ErrPartialResource))
+	assert.True(t, errors.Is(err, errMissingValue))
+	assert.Equal(t, `partial resource: missing value: [missing]; invalid attribute: [=empty bad=%zz]`, err.Error())
+	assert.Equal(t, NewWithAttributes(
+		attribute.String("key", "value"),
+	), res)
+}
+
+func TestDetectServiceName(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar:     "key=value,service.name=from-attributes",
+		svcNameVar: "from-service-name",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &fromEnv{}
+	res, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		attribute.String("key", "value"),
+		semconv.ServiceNameKey.String("from-service-name"),
+	), res)
+}
+
+func TestDetectServiceNameOnly(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar:     "",
+		svcNameVar: "from-service-name",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &fromEnv{}
+	res, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.ServiceNameKey.String("from-service-name"),
+	), res)
+}
@@ -15,12 +15,18 @@
 package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 var (
-	SetDefaultOSProviders      = setDefaultOSProviders
-	SetOSProviders             = setOSProviders
-	SetDefaultRuntimeProviders = setDefaultRuntimeProviders
-	SetRuntimeProviders        = setRuntimeProviders
-	SetDefaultUserProviders    = setDefaultUserProviders
-	SetUserProviders           = setUserProviders
+	SetDefaultOSProviders           = setDefaultOSProviders
+	SetOSProviders                  = setOSProviders
+	SetDefaultRuntimeProviders      = setDefaultRuntimeProviders
+	SetRuntimeProviders             = setRuntimeProviders
+	SetDefaultUserProviders         = setDefaultUserProviders
+	SetUserProviders                = setUserProviders
+	SetDefaultContainerProviders    = setDefaultContainerProviders
+	SetContainerProviders           = setContainerProviders
+	SetDefaultHostIDProvider        = setDefaultHostIDProvider
+	SetHostIDProvider               = setHostIDProvider
+	SetDefaultOSDescriptionProvider = setDefaultOSDescriptionProvider
+	SetOSDescriptionProvider        = setOSDescriptionProvider
 )
 
 var (
@@ -29,3 +35,19 @@ var (
 	RuntimeOS   = runtimeOS
 	RuntimeArch = runtimeArch
 )
+
+var (
+	GetContainerIDFromLine   = getContainerIDFromLine
+	GetContainerIDFromReader = getContainerIDFromReader
+	ParseIORegUUID           = parseIORegUUID
+	ParseRegQueryValue       = parseRegQueryValue
+	ParseOSReleaseName       = parseOSReleaseName
+)
+
+// SetServiceAccountNamespacePath sets the service account namespace file
+// read by KubernetesDetector and returns a func restoring the previous one.
+func SetServiceAccountNamespacePath(path string) func() {
+	orig := serviceAccountNamespacePath
+	serviceAccountNamespacePath = path
+	return func() { serviceAccountNamespacePath = orig }
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"go.opentelemetry.io/otel/semconv"
+)
+
+type hostIDProvider func() (string, error)
+
+var (
+	defaultHostIDProvider hostIDProvider = platformHostID
+
+	hostID = defaultHostIDProvider
+)
+
+type hostIDDetector struct{}
+
+// Detect returns a *Resource that describes the unique ID of the host the
+// process is running on. It is the machine-id on Linux, the hardware UUID
+// on macOS and the MachineGuid on Windows. An empty Resource is returned if
+// the ID cannot be found.
+func (hostIDDetector) Detect(ctx context.Context) (*Resource, error) {
+	hostID, err := hostID()
+	if err != nil {
+		return nil, err
+	}
+	if hostID == "" {
+		return Empty(), nil
+	}
+	return NewWithAttributes(semconv.HostIDKey.String(hostID)), nil
+}
+
+func setDefaultHostIDProvider() {
+	setHostIDProvider(defaultHostIDProvider)
+}
+
+func setHostIDProvider(hostIDProvider hostIDProvider) {
+	hostID = hostIDProvider
+}
+
+// WithHostID adds an attribute with the unique ID of the host to the
+// configured Resource.
+func WithHostID() Option {
+	return WithDetectors(hostIDDetector{})
+}
+
+// readFirstFile returns the trimmed content of the first of paths that
+// exists and is not empty, or an empty string if there is none.
+func readFirstFile(paths ...string) (string, error) {
+	for _, p := range paths {
+		b, err := ioutil.ReadFile(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if s := strings.TrimSpace(string(b)); s != "" {
+			return s, nil
+		}
+	}
+	return "", nil
+}
+
+// runCommand returns the trimmed standard output of the command.
+func runCommand(name string, args ...string) (string, error) {
+	out, err := exec.Command(name, args...).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// parseIORegUUID returns the IOPlatformUUID in the output of
+// `ioreg -rd1 -c IOPlatformExpertDevice`.
+func parseIORegUUID(out string) string {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, `"IOPlatformUUID"`) {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 {
+			return strings.Trim(strings.TrimSpace(parts[1]), `"`)
+		}
+	}
+	return ""
+}
+
+// parseRegQueryValue returns the data of the value name in the output of
+// `reg query <key> /v <name>`.
+func parseRegQueryValue(out, name string) string {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// <name>    REG_SZ    <data>
+		if len(fields) >= 3 && fields[0] == name && strings.HasPrefix(fields[1], "REG_") {
+			return strings.Join(fields[2:], " ")
+		}
+	}
+	return ""
+}
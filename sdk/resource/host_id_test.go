@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestWithHostID(t *testing.T) {
+	resource.SetHostIDProvider(func() (string, error) { return "f2c668b579780554f70f72a063dc0864", nil })
+	defer resource.SetDefaultHostIDProvider()
+
+	res, err := resource.New(context.Background(), resource.WithHostID())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"host.id": "f2c668b579780554f70f72a063dc0864",
+	}, toMap(res))
+}
+
+func TestWithHostIDUnknown(t *testing.T) {
+	resource.SetHostIDProvider(func() (string, error) { return "", nil })
+	defer resource.SetDefaultHostIDProvider()
+
+	res, err := resource.New(context.Background(), resource.WithHostID())
+	require.NoError(t, err)
+	assert.Empty(t, toMap(res))
+}
+
+func TestParseIORegUUID(t *testing.T) {
+	out := `+-o J316sAP  <class IOPlatformExpertDevice, id 0x100000222, registered, matched, active, busy 0 (19 ms), retain 40>
+    {
+      "IOPlatformSerialNumber" = "HDWLIF2LM7"
+      "IOPlatformUUID" = "81895B8D-9EF9-4BBB-B0CA-14E8EB4A08A5"
+      "manufacturer" = <"Apple Inc.">
+    }`
+	assert.Equal(t, "81895B8D-9EF9-4BBB-B0CA-14E8EB4A08A5", resource.ParseIORegUUID(out))
+	assert.Equal(t, "", resource.ParseIORegUUID(""))
+}
+
+func TestParseRegQueryValue(t *testing.T) {
+	out := `
+HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion
+    CurrentBuildNumber    REG_SZ    19042
+    ProductName    REG_SZ    Windows 10 Pro
+`
+	assert.Equal(t, "Windows 10 Pro", resource.ParseRegQueryValue(out, "ProductName"))
+	assert.Equal(t, "19042", resource.ParseRegQueryValue(out, "CurrentBuildNumber"))
+	assert.Equal(t, "", resource.ParseRegQueryValue(out, "MachineGuid"))
+}
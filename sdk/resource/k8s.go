@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// Environment variables read by KubernetesDetector. They are expected to be
+// set from the downward API in the container spec, for example:
+//
+//	env:
+//	- name: K8S_POD_NAME
+//	  valueFrom:
+//	    fieldRef:
+//	      fieldPath: metadata.name
+const (
+	k8sPodNameEnv       = "K8S_POD_NAME"
+	k8sPodUIDEnv        = "K8S_POD_UID"
+	k8sNamespaceNameEnv = "K8S_NAMESPACE_NAME"
+	k8sNodeNameEnv      = "K8S_NODE_NAME"
+	k8sContainerNameEnv = "K8S_CONTAINER_NAME"
+)
+
+// DefaultKubernetesPodInfoDir is the directory a downwardAPI volume is
+// expected to be mounted at by KubernetesDetector.
+const DefaultKubernetesPodInfoDir = "/etc/podinfo"
+
+// k8sPodLabelPrefix prefixes the pod label keys to form attribute keys.
+const k8sPodLabelPrefix = "k8s.pod.label."
+
+// serviceAccountNamespacePath is the namespace file of the service account
+// token Kubernetes mounts in every container by default.
+var serviceAccountNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// KubernetesDetector is a Detector that describes the Kubernetes pod the
+// process is running in using the downward API.
+//
+// The following environment variables are read: K8S_POD_NAME,
+// K8S_POD_UID, K8S_NAMESPACE_NAME, K8S_NODE_NAME and K8S_CONTAINER_NAME.
+// Values missing from the environment are read from the "name", "uid" and
+// "namespace" files of a downwardAPI volume mounted at PodInfoDir. The pod
+// labels are read from its "labels" file and added as
+// "k8s.pod.label.<key>" attributes. If the namespace is still unknown it is
+// read from the service account.
+//
+// An empty Resource is returned if none of the sources exist.
+type KubernetesDetector struct {
+	// PodInfoDir is the directory a downwardAPI volume is mounted at.
+	// If empty, DefaultKubernetesPodInfoDir is used.
+	PodInfoDir string
+}
+
+var _ Detector = KubernetesDetector{}
+
+// Detect implements Detector.
+func (d KubernetesDetector) Detect(ctx context.Context) (*Resource, error) {
+	dir := d.PodInfoDir
+	if dir == "" {
+		dir = DefaultKubernetesPodInfoDir
+	}
+
+	var attrs []attribute.KeyValue
+	var errs []string
+	add := func(key attribute.Key, env string, paths ...string) {
+		value := strings.TrimSpace(os.Getenv(env))
+		if value == "" {
+			var err error
+			if value, err = readFirstFile(paths...); err != nil {
+				errs = append(errs, err.Error())
+				return
+			}
+		}
+		if value != "" {
+			attrs = append(attrs, key.String(value))
+		}
+	}
+	add(semconv.K8SPodNameKey, k8sPodNameEnv, filepath.Join(dir, "name"))
+	add(semconv.K8SPodUIDKey, k8sPodUIDEnv, filepath.Join(dir, "uid"))
+	add(semconv.K8SNamespaceNameKey, k8sNamespaceNameEnv, filepath.Join(dir, "namespace"), serviceAccountNamespacePath)
+	add(semconv.K8SNodeNameKey, k8sNodeNameEnv)
+	add(semconv.K8SContainerNameKey, k8sContainerNameEnv)
+
+	labels, err := readKubernetesLabels(filepath.Join(dir, "labels"))
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	attrs = append(attrs, labels...)
+
+	var res *Resource
+	if len(attrs) == 0 {
+		res = Empty()
+	} else {
+		res = NewWithAttributes(attrs...)
+	}
+	if len(errs) > 0 {
+		return res, fmt.Errorf("%w: %s", ErrPartialResource, strings.Join(errs, "; "))
+	}
+	return res, nil
+}
+
+// readKubernetesLabels returns the pod labels in the downward API file at
+// path as attributes. The file contains one key="value" pair per line, with
+// the value quoted as a Go string. Valid labels are returned together with
+// an error describing the invalid ones.
+func readKubernetesLabels(path string) ([]attribute.KeyValue, error) {
+	b, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var attrs []attribute.KeyValue
+	var invalid []string
+	scanner := bufio.NewScanner(strings.NewReader(string(b)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			invalid = append(invalid, line)
+			continue
+		}
+		value, err := strconv.Unquote(parts[1])
+		if err != nil {
+			invalid = append(invalid, line)
+			continue
+		}
+		attrs = append(attrs, attribute.String(k8sPodLabelPrefix+parts[0], value))
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+	if len(invalid) > 0 {
+		return attrs, fmt.Errorf("invalid labels in %s: %q", path, invalid)
+	}
+	return attrs, nil
+}
+
+// WithKubernetes adds attributes describing the Kubernetes pod the process
+// is running in to the configured Resource. See KubernetesDetector for the
+// sources of the attributes.
+func WithKubernetes() Option {
+	return WithDetectors(KubernetesDetector{})
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func writePodInfo(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "podinfo")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	return dir
+}
+
+func clearKubernetesEnv(t *testing.T, env map[string]string) {
+	vars := map[string]string{
+		"K8S_POD_NAME":       "",
+		"K8S_POD_UID":        "",
+		"K8S_NAMESPACE_NAME": "",
+		"K8S_NODE_NAME":      "",
+		"K8S_CONTAINER_NAME": "",
+	}
+	for k, v := range env {
+		vars[k] = v
+	}
+	store, err := ottest.SetEnvVariables(vars)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Restore()) })
+	t.Cleanup(resource.SetServiceAccountNamespacePath(filepath.Join(os.TempDir(), "does-not-exist")))
+}
+
+func TestKubernetesDetectorEmpty(t *testing.T) {
+	clearKubernetesEnv(t, nil)
+
+	res, err := resource.KubernetesDetector{PodInfoDir: writePodInfo(t, nil)}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, resource.Empty(), res)
+}
+
+func TestKubernetesDetectorEnv(t *testing.T) {
+	clearKubernetesEnv(t, map[string]string{
+		"K8S_POD_NAME":       "pod-abc",
+		"K8S_POD_UID":        "1234",
+		"K8S_NAMESPACE_NAME": "prod",
+		"K8S_NODE_NAME":      "node-1",
+		"K8S_CONTAINER_NAME": "app",
+	})
+	dir := writePodInfo(t, map[string]string{
+		"name":      "ignored",
+		"namespace": "ignored",
+	})
+
+	res, err := resource.KubernetesDetector{PodInfoDir: dir}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"k8s.pod.name":       "pod-abc",
+		"k8s.pod.uid":        "1234",
+		"k8s.namespace.name": "prod",
+		"k8s.node.name":      "node-1",
+		"k8s.container.name": "app",
+	}, toMap(res))
+}
+
+func TestKubernetesDetectorFiles(t *testing.T) {
+	clearKubernetesEnv(t, map[string]string{"K8S_NODE_NAME": "node-1"})
+	dir := writePodInfo(t, map[string]string{
+		"name":      "pod-abc\n",
+		"uid":       "1234\n",
+		"namespace": "prod\n",
+		"labels":    "app=\"web\"\ntier=\"front \\\"end\\\"\"\n",
+	})
+
+	res, err := resource.KubernetesDetector{PodInfoDir: dir}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"k8s.pod.name":       "pod-abc",
+		"k8s.pod.uid":        "1234",
+		"k8s.namespace.name": "prod",
+		"k8s.node.name":      "node-1",
+		"k8s.pod.label.app":  "web",
+		"k8s.pod.label.tier": `front "end"`,
+	}, toMap(res))
+}
+
+func TestKubernetesDetectorServiceAccountNamespace(t *testing.T) {
+	clearKubernetesEnv(t, map[string]string{"K8S_POD_NAME": "pod-abc"})
+	sa := writePodInfo(t, map[string]string{"namespace": "prod"})
+	t.Cleanup(resource.SetServiceAccountNamespacePath(filepath.Join(sa, "namespace")))
+
+	res, err := resource.KubernetesDetector{PodInfoDir: writePodInfo(t, nil)}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"k8s.pod.name":       "pod-abc",
+		"k8s.namespace.name": "prod",
+	}, toMap(res))
+}
+
+func TestKubernetesDetectorInvalidLabels(t *testing.T) {
+	clearKubernetesEnv(t, nil)
+	dir := writePodInfo(t, map[string]string{
+		"labels": "app=\"web\"\nbroken\nunquoted=value\n",
+	})
+
+	res, err := resource.KubernetesDetector{PodInfoDir: dir}.Detect(context.Background())
+	assert.ErrorIs(t, err, resource.ErrPartialResource)
+	assert.Equal(t, map[string]string{
+		"k8s.pod.label.app": "web",
+	}, toMap(res))
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// Environment variables set by the AWS Lambda runtime and read by
+// LambdaDetector.
+const (
+	lambdaFunctionNameEnv    = "AWS_LAMBDA_FUNCTION_NAME"
+	lambdaFunctionVersionEnv = "AWS_LAMBDA_FUNCTION_VERSION"
+	lambdaMemorySizeEnv      = "AWS_LAMBDA_FUNCTION_MEMORY_SIZE"
+	lambdaLogStreamNameEnv   = "AWS_LAMBDA_LOG_STREAM_NAME"
+	awsRegionEnv             = "AWS_REGION"
+)
+
+// LambdaDetector is a Detector that describes the AWS Lambda function the
+// process is running in, read from the environment variables set by the
+// Lambda runtime.
+//
+// The faas.name, faas.version, faas.instance and faas.max_memory
+// attributes are read from AWS_LAMBDA_FUNCTION_NAME,
+// AWS_LAMBDA_FUNCTION_VERSION, AWS_LAMBDA_LOG_STREAM_NAME and
+// AWS_LAMBDA_FUNCTION_MEMORY_SIZE, and cloud.region from AWS_REGION. The
+// cloud.provider and cloud.platform attributes are set to "aws" and
+// "aws_lambda".
+//
+// An empty Resource is returned if AWS_LAMBDA_FUNCTION_NAME is not set.
+type LambdaDetector struct{}
+
+var _ Detector = LambdaDetector{}
+
+// Detect implements Detector.
+func (LambdaDetector) Detect(ctx context.Context) (*Resource, error) {
+	name := os.Getenv(lambdaFunctionNameEnv)
+	if name == "" {
+		return Empty(), nil
+	}
+
+	attrs := []attribute.KeyValue{
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSLambda,
+		semconv.FaaSNameKey.String(name),
+	}
+	add := func(key attribute.Key, env string) {
+		if value := os.Getenv(env); value != "" {
+			attrs = append(attrs, key.String(value))
+		}
+	}
+	add(semconv.CloudRegionKey, awsRegionEnv)
+	add(semconv.FaaSVersionKey, lambdaFunctionVersionEnv)
+	add(semconv.FaaSInstanceKey, lambdaLogStreamNameEnv)
+
+	var err error
+	if value := os.Getenv(lambdaMemorySizeEnv); value != "" {
+		memory, perr := strconv.Atoi(value)
+		if perr != nil {
+			err = fmt.Errorf("%w: invalid %s: %v", ErrPartialResource, lambdaMemorySizeEnv, perr)
+		} else {
+			attrs = append(attrs, semconv.FaaSMaxMemoryKey.Int(memory))
+		}
+	}
+
+	return NewWithAttributes(attrs...), err
+}
+
+// WithLambda adds attributes describing the AWS Lambda function the process
+// is running in to the configured Resource. See LambdaDetector for the
+// sources of the attributes.
+func WithLambda() Option {
+	return WithDetectors(LambdaDetector{})
+}
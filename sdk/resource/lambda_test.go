@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func setLambdaEnv(t *testing.T, env map[string]string) {
+	vars := map[string]string{
+		"AWS_LAMBDA_FUNCTION_NAME":        "",
+		"AWS_LAMBDA_FUNCTION_VERSION":     "",
+		"AWS_LAMBDA_FUNCTION_MEMORY_SIZE": "",
+		"AWS_LAMBDA_LOG_STREAM_NAME":      "",
+		"AWS_REGION":                      "",
+	}
+	for k, v := range env {
+		vars[k] = v
+	}
+	store, err := ottest.SetEnvVariables(vars)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Restore()) })
+}
+
+func TestLambdaDetectorEmpty(t *testing.T) {
+	setLambdaEnv(t, nil)
+
+	res, err := resource.LambdaDetector{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, resource.Empty(), res)
+}
+
+func TestLambdaDetector(t *testing.T) {
+	setLambdaEnv(t, map[string]string{
+		"AWS_LAMBDA_FUNCTION_NAME":        "my-function",
+		"AWS_LAMBDA_FUNCTION_VERSION":     "$LATEST",
+		"AWS_LAMBDA_FUNCTION_MEMORY_SIZE": "128",
+		"AWS_LAMBDA_LOG_STREAM_NAME":      "2021/06/28/[$LATEST]2f0a1b",
+		"AWS_REGION":                      "us-east-1",
+	})
+
+	res, err := resource.New(context.Background(), resource.WithLambda())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"cloud.provider":  "aws",
+		"cloud.platform":  "aws_lambda",
+		"cloud.region":    "us-east-1",
+		"faas.name":       "my-function",
+		"faas.version":    "$LATEST",
+		"faas.instance":   "2021/06/28/[$LATEST]2f0a1b",
+		"faas.max_memory": "128",
+	}, toMap(res))
+	v, ok := res.Set().Value("faas.max_memory")
+	require.True(t, ok)
+	assert.Equal(t, attribute.INT64, v.Type())
+}
+
+func TestLambdaDetectorInvalidMemorySize(t *testing.T) {
+	setLambdaEnv(t, map[string]string{
+		"AWS_LAMBDA_FUNCTION_NAME":        "my-function",
+		"AWS_LAMBDA_FUNCTION_MEMORY_SIZE": "lots",
+	})
+
+	res, err := resource.LambdaDetector{}.Detect(context.Background())
+	assert.True(t, errors.Is(err, resource.ErrPartialResource))
+	assert.Equal(t, map[string]string{
+		"cloud.provider": "aws",
+		"cloud.platform": "aws_lambda",
+		"faas.name":      "my-function",
+	}, toMap(res))
+}
@@ -15,13 +15,32 @@
 package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 import (
+	"bufio"
 	"context"
+	"io"
 	"strings"
 
 	"go.opentelemetry.io/otel/semconv"
 )
 
+type osDescriptionProvider func() (string, error)
+
+var (
+	defaultOSDescriptionProvider osDescriptionProvider = platformOSDescription
+
+	osDescription = defaultOSDescriptionProvider
+)
+
+func setDefaultOSDescriptionProvider() {
+	setOSDescriptionProvider(defaultOSDescriptionProvider)
+}
+
+func setOSDescriptionProvider(osDescriptionProvider osDescriptionProvider) {
+	osDescription = osDescriptionProvider
+}
+
 type osTypeDetector struct{}
+type osDescriptionDetector struct{}
 
 // Detect returns a *Resource that describes the operating system type the
 // service is running on.
@@ -37,3 +56,57 @@ func (osTypeDetector) Detect(ctx context.Context) (*Resource, error) {
 func WithOSType() Option {
 	return WithDetectors(osTypeDetector{})
 }
+
+// Detect returns a *Resource that describes the operating system the
+// service is running on, including its version. An empty Resource is
+// returned if the operating system cannot be described.
+func (osDescriptionDetector) Detect(ctx context.Context) (*Resource, error) {
+	description, err := osDescription()
+	if err != nil {
+		return nil, err
+	}
+	if description == "" {
+		return Empty(), nil
+	}
+	return NewWithAttributes(
+		semconv.OSDescriptionKey.String(description),
+	), nil
+}
+
+// WithOSDescription adds an attribute with the operating system description
+// to the configured Resource. The description is a human readable name and
+// version, for example "Ubuntu 20.04.2 LTS (Linux 5.4.0-74-generic)".
+func WithOSDescription() Option {
+	return WithDetectors(osDescriptionDetector{})
+}
+
+// WithOS adds all the OS attributes to the configured Resource.
+// See individual WithOS* functions to configure specific attributes.
+func WithOS() Option {
+	return WithDetectors(
+		osTypeDetector{},
+		osDescriptionDetector{},
+	)
+}
+
+// parseOSReleaseName returns the PRETTY_NAME, or NAME and VERSION if it is
+// not set, of an os-release(5) file read from r.
+func parseOSReleaseName(r io.Reader) string {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		values[parts[0]] = strings.Trim(parts[1], `"'`)
+	}
+	if name := values["PRETTY_NAME"]; name != "" {
+		return name
+	}
+	return strings.TrimSpace(values["NAME"] + " " + values["VERSION"])
+}
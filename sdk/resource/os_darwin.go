@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build darwin
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import "fmt"
+
+// platformHostID returns the hardware UUID of the host.
+func platformHostID() (string, error) {
+	out, err := runCommand("ioreg", "-rd1", "-c", "IOPlatformExpertDevice")
+	if err != nil {
+		return "", err
+	}
+	return parseIORegUUID(out), nil
+}
+
+// platformOSDescription returns the product name, version and build of
+// macOS, for example "macOS 11.4 (20F71)".
+func platformOSDescription() (string, error) {
+	name, err := runCommand("sw_vers", "-productName")
+	if err != nil {
+		return "", err
+	}
+	version, err := runCommand("sw_vers", "-productVersion")
+	if err != nil {
+		return "", err
+	}
+	build, err := runCommand("sw_vers", "-buildVersion")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s (%s)", name, version, build), nil
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build linux
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"fmt"
+	"os"
+)
+
+// platformHostID returns the machine-id of the host.
+func platformHostID() (string, error) {
+	return readFirstFile("/etc/machine-id", "/var/lib/dbus/machine-id")
+}
+
+// platformOSDescription returns the name of the distribution from the
+// os-release file followed by the kernel release, for example
+// "Ubuntu 20.04.2 LTS (Linux 5.4.0-74-generic)".
+func platformOSDescription() (string, error) {
+	kernel, err := readFirstFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return "", err
+	}
+	var name string
+	for _, p := range []string{"/etc/os-release", "/usr/lib/os-release"} {
+		f, err := os.Open(p)
+		if err != nil {
+			continue
+		}
+		name = parseOSReleaseName(f)
+		f.Close()
+		if name != "" {
+			break
+		}
+	}
+	switch {
+	case name == "" && kernel == "":
+		return "", nil
+	case name == "":
+		return "Linux " + kernel, nil
+	case kernel == "":
+		return name, nil
+	}
+	return fmt.Sprintf("%s (Linux %s)", name, kernel), nil
+}
@@ -16,6 +16,8 @@ package resource_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -48,3 +50,93 @@ func TestWithOSType(t *testing.T) {
 
 	restoreProcessAttributesProviders()
 }
+
+func TestWithOSDescription(t *testing.T) {
+	resource.SetOSDescriptionProvider(func() (string, error) {
+		return "Ubuntu 20.04.2 LTS (Linux 5.4.0-74-generic)", nil
+	})
+	defer resource.SetDefaultOSDescriptionProvider()
+
+	res, err := resource.New(context.Background(),
+		resource.WithOSDescription(),
+	)
+
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"os.description": "Ubuntu 20.04.2 LTS (Linux 5.4.0-74-generic)",
+	}, toMap(res))
+}
+
+func TestWithOSDescriptionUnknown(t *testing.T) {
+	resource.SetOSDescriptionProvider(func() (string, error) { return "", nil })
+	defer resource.SetDefaultOSDescriptionProvider()
+
+	res, err := resource.New(context.Background(),
+		resource.WithOSDescription(),
+	)
+
+	require.NoError(t, err)
+	require.Empty(t, toMap(res))
+}
+
+func TestWithOSDescriptionError(t *testing.T) {
+	resource.SetOSDescriptionProvider(func() (string, error) {
+		return "", errors.New("no description")
+	})
+	defer resource.SetDefaultOSDescriptionProvider()
+
+	_, err := resource.New(context.Background(),
+		resource.WithOSDescription(),
+	)
+
+	require.Error(t, err)
+}
+
+func TestWithOS(t *testing.T) {
+	mockRuntimeProviders()
+	defer restoreProcessAttributesProviders()
+	resource.SetOSDescriptionProvider(func() (string, error) { return "Linux 5.4.0", nil })
+	defer resource.SetDefaultOSDescriptionProvider()
+
+	res, err := resource.New(context.Background(),
+		resource.WithOS(),
+	)
+
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"os.type":        "linux",
+		"os.description": "Linux 5.4.0",
+	}, toMap(res))
+}
+
+func TestParseOSReleaseName(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name: "pretty name",
+			content: `NAME="Ubuntu"
+VERSION="20.04.2 LTS (Focal Fossa)"
+# comment
+PRETTY_NAME="Ubuntu 20.04.2 LTS"`,
+			want: "Ubuntu 20.04.2 LTS",
+		},
+		{
+			name:    "name and version",
+			content: "NAME=Alpine\nVERSION='3.13'\n",
+			want:    "Alpine 3.13",
+		},
+		{
+			name:    "empty",
+			content: "",
+			want:    "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, resource.ParseOSReleaseName(strings.NewReader(tc.content)))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build aix dragonfly freebsd netbsd openbsd solaris
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+// platformHostID returns the host ID of the host.
+func platformHostID() (string, error) {
+	id, err := readFirstFile("/etc/hostid")
+	if err != nil || id != "" {
+		return id, err
+	}
+	// FreeBSD and DragonFly BSD expose the hardware UUID through the
+	// kernel environment.
+	id, err = runCommand("kenv", "-q", "smbios.system.uuid")
+	if err != nil {
+		return "", nil
+	}
+	return id, nil
+}
+
+// platformOSDescription returns the output of `uname -srm`, for example
+// "FreeBSD 13.0-RELEASE amd64".
+func platformOSDescription() (string, error) {
+	return runCommand("uname", "-srm")
+}
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build windows
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import "fmt"
+
+const (
+	cryptographyKey   = `HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Cryptography`
+	currentVersionKey = `HKEY_LOCAL_MACHINE\SOFTWARE\Microsoft\Windows NT\CurrentVersion`
+)
+
+// platformHostID returns the MachineGuid of the host.
+func platformHostID() (string, error) {
+	out, err := runCommand("reg", "query", cryptographyKey, "/v", "MachineGuid")
+	if err != nil {
+		return "", err
+	}
+	return parseRegQueryValue(out, "MachineGuid"), nil
+}
+
+// platformOSDescription returns the product name and build number of
+// Windows, for example "Windows 10 Pro (Build 19042)".
+func platformOSDescription() (string, error) {
+	out, err := runCommand("reg", "query", currentVersionKey)
+	if err != nil {
+		return "", err
+	}
+	name := parseRegQueryValue(out, "ProductName")
+	build := parseRegQueryValue(out, "CurrentBuildNumber")
+	if build == "" {
+		return name, nil
+	}
+	return fmt.Sprintf("%s (Build %s)", name, build), nil
+}
@@ -21,6 +21,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"go.opentelemetry.io/otel/semconv"
 )
@@ -107,6 +108,7 @@ type processPIDDetector struct{}
 type processExecutableNameDetector struct{}
 type processExecutablePathDetector struct{}
 type processCommandArgsDetector struct{}
+type processCommandLineDetector struct{}
 type processOwnerDetector struct{}
 type processRuntimeNameDetector struct{}
 type processRuntimeVersionDetector struct{}
@@ -141,6 +143,12 @@ func (processCommandArgsDetector) Detect(ctx context.Context) (*Resource, error)
 	return NewWithAttributes(semconv.ProcessCommandArgsKey.Array(commandArgs())), nil
 }
 
+// Detect returns a *Resource that describes the full command used to launch the
+// process as a single string.
+func (processCommandLineDetector) Detect(ctx context.Context) (*Resource, error) {
+	return NewWithAttributes(semconv.ProcessCommandLineKey.String(strings.Join(commandArgs(), " "))), nil
+}
+
 // Detect returns a *Resource that describes the username of the user that owns the
 // process.
 func (processOwnerDetector) Detect(ctx context.Context) (*Resource, error) {
@@ -197,6 +205,14 @@ func WithProcessCommandArgs() Option {
 	return WithDetectors(processCommandArgsDetector{})
 }
 
+// WithProcessCommandLine adds an attribute with the full command used to launch
+// the process, joined into a single string, to the configured Resource. It is
+// not included in WithProcess as WithProcessCommandArgs describes the same
+// information.
+func WithProcessCommandLine() Option {
+	return WithDetectors(processCommandLineDetector{})
+}
+
 // WithProcessOwner adds an attribute with the username of the user that owns the process
 // to the configured Resource.
 func WithProcessOwner() Option {
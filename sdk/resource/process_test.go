@@ -110,6 +110,7 @@ func TestWithProcessFuncs(t *testing.T) {
 	t.Run("WithExecutableName", testWithProcessExecutableName)
 	t.Run("WithExecutablePath", testWithProcessExecutablePath)
 	t.Run("WithCommandArgs", testWithProcessCommandArgs)
+	t.Run("WithCommandLine", testWithProcessCommandLine)
 	t.Run("WithOwner", testWithProcessOwner)
 	t.Run("WithRuntimeName", testWithProcessRuntimeName)
 	t.Run("WithRuntimeVersion", testWithProcessRuntimeVersion)
@@ -196,6 +197,19 @@ func testWithProcessCommandArgs(t *testing.T) {
 	}, toMap(res))
 }
 
+func testWithProcessCommandLine(t *testing.T) {
+	ctx := context.Background()
+
+	res, err := resource.New(ctx,
+		resource.WithProcessCommandLine(),
+	)
+
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"process.command_line": "mock -t 30",
+	}, toMap(res))
+}
+
 func testWithProcessOwner(t *testing.T) {
 	ctx := context.Background()
 
@@ -16,6 +16,7 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 import (
 	"context"
+	"sync"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -29,28 +30,34 @@ import (
 // (`*resource.Resource`).  The `nil` value is equivalent to an empty
 // Resource.
 type Resource struct {
-	attrs attribute.Set
+	attrs     attribute.Set
+	schemaURL string
 }
 
 var (
 	emptyResource Resource
 
-	defaultResource *Resource = func(r *Resource, err error) *Resource {
-		if err != nil {
-			otel.Handle(err)
-		}
-		return r
-	}(Detect(context.Background(), defaultServiceNameDetector{}, fromEnv{}, telemetrySDK{}))
+	defaultResource     *Resource
+	defaultResourceOnce sync.Once
 )
 
 // New returns a Resource combined from the user-provided detectors.
+//
+// The detectors are run concurrently and their Resources are merged in the
+// order the detectors were configured. If any detector fails a
+// *DetectError is returned along with the Resource merged from the
+// remaining detectors.
 func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	cfg := config{}
 	for _, opt := range opts {
 		opt.apply(&cfg)
 	}
 
-	return Detect(ctx, cfg.detectors...)
+	res, err := detect(ctx, cfg.detectorTimeout, cfg.detectors)
+	if cfg.schemaURL != "" {
+		res = res.withSchemaURL(cfg.schemaURL)
+	}
+	return res, err
 }
 
 // NewWithAttributes creates a resource from attrs. If attrs contains
@@ -72,7 +79,18 @@ func NewWithAttributes(attrs ...attribute.KeyValue) *Resource {
 		return &emptyResource
 	}
 
-	return &Resource{s} //nolint
+	return &Resource{attrs: s}
+}
+
+// withSchemaURL returns a copy of r with the schema URL set to schemaURL.
+func (r *Resource) withSchemaURL(schemaURL string) *Resource {
+	if r == nil {
+		r = Empty()
+	}
+	if r.schemaURL == schemaURL {
+		return r
+	}
+	return &Resource{attrs: r.attrs, schemaURL: schemaURL}
 }
 
 // String implements the Stringer interface and provides a
@@ -105,6 +123,15 @@ func (r *Resource) Iter() attribute.Iterator {
 	return r.attrs.Iter()
 }
 
+// SchemaURL returns the schema URL the attributes of the Resource conform
+// to, or an empty string if it is unknown.
+func (r *Resource) SchemaURL() string {
+	if r == nil {
+		return ""
+	}
+	return r.schemaURL
+}
+
 // Equal returns true when a Resource is equivalent to this Resource.
 func (r *Resource) Equal(eq *Resource) bool {
 	if r == nil {
@@ -113,7 +140,7 @@ func (r *Resource) Equal(eq *Resource) bool {
 	if eq == nil {
 		eq = Empty()
 	}
-	return r.Equivalent() == eq.Equivalent()
+	return r.Equivalent() == eq.Equivalent() && r.schemaURL == eq.schemaURL
 }
 
 // Merge creates a new resource by combining resource a and b.
@@ -121,6 +148,13 @@ func (r *Resource) Equal(eq *Resource) bool {
 // If there are common keys between resource a and b, then the value
 // from resource b will overwrite the value from resource a, even
 // if resource b's value is empty.
+//
+// The schema URL of the merged resource is the one of a or b that is not
+// empty. If a and b have different, non-empty schema URLs, the attributes
+// of a are translated to the schema URL of b using a schema registered
+// with RegisterSchema. If no registered schema describes both schema URLs
+// the merged resource has no schema URL, as its attributes may not
+// conform to either schema.
 func Merge(a, b *Resource) *Resource {
 	if a == nil && b == nil {
 		return Empty()
@@ -132,6 +166,12 @@ func Merge(a, b *Resource) *Resource {
 		return a
 	}
 
+	if a.schemaURL != "" && b.schemaURL != "" && a.schemaURL != b.schemaURL {
+		if t := translator(a.schemaURL, b.schemaURL); t != nil {
+			a = NewWithAttributes(t.Resource(a.Attributes())...).withSchemaURL(b.schemaURL)
+		}
+	}
+
 	// Note: 'b' attributes will overwrite 'a' with last-value-wins in attribute.Key()
 	// Meaning this is equivalent to: append(a.Attributes(), b.Attributes()...)
 	mi := attribute.NewMergeIterator(b.Set(), a.Set())
@@ -139,7 +179,15 @@ func Merge(a, b *Resource) *Resource {
 	for mi.Next() {
 		combine = append(combine, mi.Label())
 	}
-	return NewWithAttributes(combine...)
+	res := NewWithAttributes(combine...)
+
+	schemaURL := a.schemaURL
+	if schemaURL == "" {
+		schemaURL = b.schemaURL
+	} else if b.schemaURL != "" && b.schemaURL != schemaURL {
+		schemaURL = ""
+	}
+	return res.withSchemaURL(schemaURL)
 }
 
 // Empty returns an instance of Resource with no attributes.  It is
@@ -149,8 +197,33 @@ func Empty() *Resource {
 }
 
 // Default returns an instance of Resource with a default
-// "service.name" and OpenTelemetrySDK attributes
+// "service.name" and OpenTelemetrySDK attributes, merged with the
+// attributes of the OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME
+// environment variables.
+//
+// The "service.name" attribute defaults to "unknown_service:" followed by
+// the executable name when it is not set in the environment. The
+// OpenTelemetrySDK attributes cannot be overridden by the environment.
+//
+// The environment is read the first time Default is called. Invalid
+// environment values are reported to the global error handler.
 func Default() *Resource {
+	defaultResourceOnce.Do(func() {
+		var err error
+		defaultResource, err = Detect(
+			context.Background(),
+			defaultServiceNameDetector{},
+			fromEnv{},
+			telemetrySDK{},
+		)
+		if err != nil {
+			otel.Handle(err)
+		}
+		// Detect returns nil if all detectors fail.
+		if defaultResource == nil {
+			defaultResource = Empty()
+		}
+	})
 	return defaultResource
 }
 
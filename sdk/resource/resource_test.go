@@ -168,6 +168,75 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestMergeSchemaURL(t *testing.T) {
+	newResource := func(schemaURL string, kvs ...attribute.KeyValue) *resource.Resource {
+		res, err := resource.New(context.Background(),
+			resource.WithAttributes(kvs...),
+			resource.WithSchemaURL(schemaURL),
+		)
+		require.NoError(t, err)
+		return res
+	}
+
+	cases := []struct {
+		name string
+		a, b *resource.Resource
+		want string
+	}{
+		{
+			name: "no schema URL",
+			a:    resource.NewWithAttributes(kv11),
+			b:    resource.NewWithAttributes(kv21),
+			want: "",
+		},
+		{
+			name: "first schema URL",
+			a:    newResource("https://opentelemetry.io/schemas/1.2.0", kv11),
+			b:    resource.NewWithAttributes(kv21),
+			want: "https://opentelemetry.io/schemas/1.2.0",
+		},
+		{
+			name: "second schema URL",
+			a:    resource.NewWithAttributes(kv11),
+			b:    newResource("https://opentelemetry.io/schemas/1.2.0", kv21),
+			want: "https://opentelemetry.io/schemas/1.2.0",
+		},
+		{
+			name: "same schema URL",
+			a:    newResource("https://opentelemetry.io/schemas/1.2.0", kv11),
+			b:    newResource("https://opentelemetry.io/schemas/1.2.0", kv21),
+			want: "https://opentelemetry.io/schemas/1.2.0",
+		},
+		{
+			name: "conflicting schema URL",
+			a:    newResource("https://opentelemetry.io/schemas/1.1.0", kv11),
+			b:    newResource("https://opentelemetry.io/schemas/1.2.0", kv21),
+			want: "",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res := resource.Merge(c.a, c.b)
+			require.Equal(t, c.want, res.SchemaURL())
+			require.Equal(t, []attribute.KeyValue{kv11, kv21}, res.Attributes())
+		})
+	}
+}
+
+func TestEqualSchemaURL(t *testing.T) {
+	a := resource.NewWithAttributes(kv11)
+	b, err := resource.New(context.Background(),
+		resource.WithAttributes(kv11),
+		resource.WithSchemaURL("https://opentelemetry.io/schemas/1.2.0"),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "", a.SchemaURL())
+	require.Equal(t, "https://opentelemetry.io/schemas/1.2.0", b.SchemaURL())
+	require.False(t, a.Equal(b))
+	require.True(t, b.Equal(b))
+}
+
 func TestDefault(t *testing.T) {
 	res := resource.Default()
 	require.False(t, res.Equal(resource.Empty()))
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/schema"
+)
+
+// schemas are the registered schemas used to translate resources. The
+// schema of the OpenTelemetry semantic conventions is always registered.
+var schemas = struct {
+	sync.RWMutex
+	list []*schema.Schema
+}{list: []*schema.Schema{schema.OpenTelemetry()}}
+
+// RegisterSchema registers s to translate resources between the versions
+// it describes. Merge uses the registered schemas to translate the
+// attributes of a resource to the schema URL of the resource it is merged
+// with when their schema URLs differ. The schema returned by
+// schema.OpenTelemetry is registered by default, so resources using
+// different versions of the go.opentelemetry.io/otel/semconv packages can
+// be merged.
+func RegisterSchema(s *schema.Schema) {
+	if s == nil {
+		return
+	}
+	schemas.Lock()
+	defer schemas.Unlock()
+	schemas.list = append(schemas.list, s)
+}
+
+// translator returns a Translator from fromURL to toURL from the most
+// recently registered schema describing both, or nil if there is none.
+func translator(fromURL, toURL string) *schema.Translator {
+	schemas.RLock()
+	defer schemas.RUnlock()
+	for i := len(schemas.list) - 1; i >= 0; i-- {
+		if t, err := schemas.list[i].NewTranslator(fromURL, toURL); err == nil {
+			return t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/schema"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv14 "go.opentelemetry.io/otel/semconv/v1.4.0"
+	semconv18 "go.opentelemetry.io/otel/semconv/v1.8.0"
+)
+
+const testSchema = `file_format: 1.0.0
+schema_url: https://example.com/schemas/1.1.0
+versions:
+  1.1.0:
+    resources:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              host.id: host.uid
+  1.0.0:
+`
+
+func TestMergeTranslatesSchema(t *testing.T) {
+	s, err := schema.Parse(strings.NewReader(testSchema))
+	require.NoError(t, err)
+
+	newResource := func(schemaURL string, kvs ...attribute.KeyValue) *resource.Resource {
+		res, err := resource.New(context.Background(),
+			resource.WithAttributes(kvs...),
+			resource.WithSchemaURL(schemaURL),
+		)
+		require.NoError(t, err)
+		return res
+	}
+	v100 := newResource("https://example.com/schemas/1.0.0",
+		attribute.String("host.id", "a"),
+		attribute.String("host.name", "h"),
+	)
+	v110 := newResource("https://example.com/schemas/1.1.0",
+		attribute.String("service.name", "s"),
+	)
+
+	// Without a registered schema the schema URL is dropped.
+	res := resource.Merge(v100, v110)
+	assert.Equal(t, "", res.SchemaURL())
+	assert.Equal(t, map[string]string{"host.id": "a", "host.name": "h", "service.name": "s"}, toMap(res))
+
+	resource.RegisterSchema(s)
+
+	res = resource.Merge(v100, v110)
+	assert.Equal(t, "https://example.com/schemas/1.1.0", res.SchemaURL())
+	assert.Equal(t, map[string]string{"host.uid": "a", "host.name": "h", "service.name": "s"}, toMap(res))
+
+	res = resource.Merge(newResource("https://example.com/schemas/1.1.0", attribute.String("host.uid", "a")), v100)
+	assert.Equal(t, "https://example.com/schemas/1.0.0", res.SchemaURL())
+	assert.Equal(t, map[string]string{"host.id": "a", "host.name": "h"}, toMap(res))
+}
+
+func TestMergeSemconvVersions(t *testing.T) {
+	v14, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv14.ServiceNameKey.String("s")),
+		resource.WithSchemaURL(semconv14.SchemaURL),
+	)
+	require.NoError(t, err)
+	v18, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv18.HostNameKey.String("h")),
+		resource.WithSchemaURL(semconv18.SchemaURL),
+	)
+	require.NoError(t, err)
+
+	// The OpenTelemetry schema is registered by default.
+	res := resource.Merge(v14, v18)
+	assert.Equal(t, semconv18.SchemaURL, res.SchemaURL())
+	assert.Equal(t, map[string]string{"service.name": "s", "host.name": "h"}, toMap(res))
+}
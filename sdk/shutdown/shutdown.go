@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shutdown flushes and shuts down the components of the telemetry
+// pipelines of an application in a well defined order.
+//
+// Components are shut down in the order they are passed to Shutdown. The
+// recommended order is the providers that produce telemetry on behalf of
+// the application first, then the metric controller, so telemetry about
+// the shutdown of the other pipelines can still be collected, and the
+// exporters last:
+//
+//	err := shutdown.Shutdown(ctx,
+//		shutdown.WithTimeout(tracerProvider, 5*time.Second),
+//		loggerProvider,
+//		shutdown.Func(controller.Stop),
+//		metricExporter,
+//	)
+package shutdown // import "go.opentelemetry.io/otel/sdk/shutdown"
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Component is a part of a telemetry pipeline that can be shut down. It is
+// implemented by the TracerProvider of go.opentelemetry.io/otel/sdk/trace,
+// the LoggerProvider of go.opentelemetry.io/otel/sdk/logs, and exporters.
+type Component interface {
+	Shutdown(ctx context.Context) error
+}
+
+// Flusher is implemented by components that can export the telemetry they
+// hold without being shut down.
+type Flusher interface {
+	ForceFlush(ctx context.Context) error
+}
+
+// Func is an adapter to use a function as a Component, for example the
+// Stop method of the basic metric controller.
+type Func func(ctx context.Context) error
+
+var _ Component = Func(nil)
+
+// Shutdown calls f(ctx).
+func (f Func) Shutdown(ctx context.Context) error {
+	return f(ctx)
+}
+
+// timeoutComponent bounds the time spent flushing and shutting down a
+// Component.
+type timeoutComponent struct {
+	Component
+	timeout time.Duration
+}
+
+// WithTimeout returns a Component that flushes and shuts down c with a
+// context that is canceled after d, in addition to the context passed to
+// Shutdown.
+func WithTimeout(c Component, d time.Duration) Component {
+	return timeoutComponent{Component: c, timeout: d}
+}
+
+func (c timeoutComponent) Shutdown(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.Component.Shutdown(ctx)
+}
+
+func (c timeoutComponent) ForceFlush(ctx context.Context) error {
+	f, ok := c.Component.(Flusher)
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return f.ForceFlush(ctx)
+}
+
+// Errors holds the errors returned by the components passed to Shutdown, in
+// the order they were returned.
+type Errors []error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "shutdown: " + strings.Join(msgs, "; ")
+}
+
+// Shutdown flushes and then shuts down the components in order.
+//
+// All components implementing Flusher are flushed before the first
+// component is shut down, so telemetry they hold is exported while the
+// rest of the pipelines are still running. Every component is flushed and
+// shut down even if others fail. If any of them fail an Errors holding all
+// of their errors is returned.
+func Shutdown(ctx context.Context, components ...Component) error {
+	var errs Errors
+	for _, c := range components {
+		if f, ok := c.(Flusher); ok {
+			if err := f.ForceFlush(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	for _, c := range components {
+		if err := c.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
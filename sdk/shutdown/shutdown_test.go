@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shutdown_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/shutdown"
+)
+
+type component struct {
+	name     string
+	calls    *[]string
+	flushErr error
+	err      error
+	deadline bool
+}
+
+func (c *component) Shutdown(ctx context.Context) error {
+	_, c.deadline = ctx.Deadline()
+	*c.calls = append(*c.calls, "shutdown "+c.name)
+	return c.err
+}
+
+type flushingComponent struct {
+	*component
+}
+
+func (c flushingComponent) ForceFlush(ctx context.Context) error {
+	*c.calls = append(*c.calls, "flush "+c.name)
+	return c.flushErr
+}
+
+func TestShutdownOrder(t *testing.T) {
+	var calls []string
+	traces := flushingComponent{&component{name: "traces", calls: &calls}}
+	metrics := &component{name: "metrics", calls: &calls}
+	exporter := flushingComponent{&component{name: "exporter", calls: &calls}}
+
+	require.NoError(t, shutdown.Shutdown(context.Background(), traces, metrics, exporter))
+	assert.Equal(t, []string{
+		"flush traces",
+		"flush exporter",
+		"shutdown traces",
+		"shutdown metrics",
+		"shutdown exporter",
+	}, calls)
+}
+
+func TestShutdownErrors(t *testing.T) {
+	var calls []string
+	flushErr := errors.New("flush failed")
+	shutdownErr := errors.New("shutdown failed")
+	a := flushingComponent{&component{name: "a", calls: &calls, flushErr: flushErr}}
+	b := &component{name: "b", calls: &calls, err: shutdownErr}
+	c := &component{name: "c", calls: &calls}
+
+	err := shutdown.Shutdown(context.Background(), a, b, c)
+	assert.Equal(t, shutdown.Errors{flushErr, shutdownErr}, err)
+	assert.EqualError(t, err, "shutdown: flush failed; shutdown failed")
+	assert.Contains(t, calls, "shutdown c", "components must be shut down after a failure")
+}
+
+func TestWithTimeout(t *testing.T) {
+	var calls []string
+	flushed := flushingComponent{&component{name: "flushed", calls: &calls}}
+	plain := &component{name: "plain", calls: &calls}
+
+	err := shutdown.Shutdown(context.Background(),
+		shutdown.WithTimeout(flushed, time.Second),
+		shutdown.WithTimeout(plain, time.Second),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flush flushed", "shutdown flushed", "shutdown plain"}, calls)
+	assert.True(t, flushed.deadline)
+	assert.True(t, plain.deadline)
+}
+
+func TestFunc(t *testing.T) {
+	var got context.Context
+	ctx := context.Background()
+	stop := func(ctx context.Context) error {
+		got = ctx
+		return nil
+	}
+	require.NoError(t, shutdown.Shutdown(ctx, shutdown.Func(stop)))
+	assert.Equal(t, ctx, got)
+}
@@ -26,20 +26,18 @@ import (
 //
 // This is based from https://github.com/hashicorp/golang-lru/blob/master/simplelru/lru.go
 // With a subset of the its operations and specific for holding attribute.KeyValue
+//
+// The zero value with a capacity set is ready to use, and allocates its
+// storage only once an attribute is added.
 type attributesMap struct {
 	attributes   map[attribute.Key]*list.Element
-	evictList    *list.List
+	evictList    list.List
 	droppedCount int
 	capacity     int
 }
 
 func newAttributesMap(capacity int) *attributesMap {
-	lm := &attributesMap{
-		attributes: make(map[attribute.Key]*list.Element),
-		evictList:  list.New(),
-		capacity:   capacity,
-	}
-	return lm
+	return &attributesMap{capacity: capacity}
 }
 
 func (am *attributesMap) add(kv attribute.KeyValue) {
@@ -51,6 +49,9 @@ func (am *attributesMap) add(kv attribute.KeyValue) {
 	}
 
 	// Add new item
+	if am.attributes == nil {
+		am.attributes = make(map[attribute.Key]*list.Element)
+	}
 	entry := am.evictList.PushFront(&kv)
 	am.attributes[kv.Key] = entry
 
@@ -90,6 +90,25 @@ func TestAttributesMapToKeyValue(t *testing.T) {
 	}
 }
 
+func TestAttributesMapZeroValue(t *testing.T) {
+	attrMap := attributesMap{capacity: 1}
+
+	if kv := attrMap.toKeyValue(); kv != nil {
+		t.Errorf("attrMap.toKeyValue(): got '%v'; want nil", kv)
+	}
+	attrMap.removeOldest()
+
+	attrMap.add(attribute.Int("key1", 1))
+	attrMap.add(attribute.Int("key2", 2))
+	kv := attrMap.toKeyValue()
+	if len(kv) != 1 || kv[0] != attribute.Int("key2", 2) {
+		t.Errorf("attrMap.toKeyValue(): got '%v'; want [key2=2]", kv)
+	}
+	if attrMap.droppedCount != 1 {
+		t.Errorf("attrMap.droppedCount: got '%d'; want '%d'", attrMap.droppedCount, 1)
+	}
+}
+
 func BenchmarkAttributesMapToKeyValue(b *testing.B) {
 	attrMap := newAttributesMap(128)
 
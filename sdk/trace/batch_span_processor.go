@@ -22,15 +22,21 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
 )
 
 const (
-	DefaultMaxQueueSize       = 2048
-	DefaultBatchTimeout       = 5000 * time.Millisecond
-	DefaultExportTimeout      = 30000 * time.Millisecond
-	DefaultMaxExportBatchSize = 512
+	DefaultMaxQueueSize         = 2048
+	DefaultBatchTimeout         = 5000 * time.Millisecond
+	DefaultExportTimeout        = 30000 * time.Millisecond
+	DefaultMaxExportBatchSize   = 512
+	DefaultMaxConcurrentExports = 1
 )
 
+// batchSpanProcessorComponent is the component the errors of the batch
+// span processor are reported with.
+const batchSpanProcessorComponent = "go.opentelemetry.io/otel/sdk/trace"
+
 type BatchSpanProcessorOption func(o *BatchSpanProcessorOptions)
 
 type BatchSpanProcessorOptions struct {
@@ -60,11 +66,80 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// BlockTimeout is the maximum duration onEnd() blocks waiting for room
+	// in the queue when BlockOnQueueFull is set to true. The span is
+	// dropped if the queue is still full once it has elapsed.
+	// The default value of BlockTimeout is zero, which blocks until there
+	// is room in the queue.
+	BlockTimeout time.Duration
+
+	// DropHandler, if not nil, is called with each span dropped because
+	// the queue was full. It is called synchronously from onEnd() and
+	// should not block.
+	DropHandler func(ReadOnlySpan)
+
+	// MaxConcurrentExports is the maximum number of batches being exported
+	// at the same time. If it is greater than one, batches are exported
+	// asynchronously and may be received by the exporter out of order, and
+	// export errors are sent to the global error handler instead of being
+	// returned from ForceFlush.
+	// The default value of MaxConcurrentExports is 1.
+	MaxConcurrentExports int
+
+	// Clock is used to schedule BatchTimeout and to measure export
+	// durations. It is intended for tests that need to trigger batch
+	// timeouts without waiting.
+	// The default value of Clock uses the system clock.
+	Clock Clock
+
+	// exportUnsampled exports spans that are recorded but not sampled.
+	exportUnsampled bool
+}
+
+// BatchSpanProcessorStats are statistics about the operation of a batch
+// span processor. They can be used to tune its queue and batch settings.
+type BatchSpanProcessorStats struct {
+	// QueueLength is the number of spans currently waiting in the queue.
+	QueueLength int
+	// DroppedSpans is the number of spans dropped because the queue was
+	// full.
+	DroppedSpans uint64
+	// ExportedBatches is the number of batches successfully exported.
+	ExportedBatches uint64
+	// ExportedSpans is the number of spans in successfully exported
+	// batches.
+	ExportedSpans uint64
+	// FailedBatches is the number of batches the exporter returned an error
+	// for.
+	FailedBatches uint64
+	// ExportDuration is the total time spent exporting batches.
+	ExportDuration time.Duration
+	// LastExportDuration is the time spent exporting the last batch.
+	LastExportDuration time.Duration
+}
+
+// ReadBatchSpanProcessorStats returns the statistics of sp and true if sp
+// was created by NewBatchSpanProcessor, otherwise it returns false.
+func ReadBatchSpanProcessorStats(sp SpanProcessor) (BatchSpanProcessorStats, bool) {
+	bsp, ok := sp.(*batchSpanProcessor)
+	if !ok {
+		return BatchSpanProcessorStats{}, false
+	}
+	return bsp.stats(), true
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
 // spans and sends them to a trace.Exporter when complete.
 type batchSpanProcessor struct {
+	// Statistics are accessed atomically and are kept first in the struct
+	// to guarantee 64-bit alignment.
+	exportedBatches    uint64
+	exportedSpans      uint64
+	failedBatches      uint64
+	exportDuration     int64
+	lastExportDuration int64
+
 	e SpanExporter
 	o BatchSpanProcessorOptions
 
@@ -73,10 +148,15 @@ type batchSpanProcessor struct {
 
 	batch      []ReadOnlySpan
 	batchMutex sync.Mutex
-	timer      *time.Timer
+	timer      Timer
 	stopWait   sync.WaitGroup
 	stopOnce   sync.Once
 	stopCh     chan struct{}
+
+	// exportSem limits the number of concurrent exports when
+	// MaxConcurrentExports is greater than one.
+	exportSem    chan struct{}
+	exportWaitMu sync.Mutex
 }
 
 var _ SpanProcessor = (*batchSpanProcessor)(nil)
@@ -87,22 +167,29 @@ var _ SpanProcessor = (*batchSpanProcessor)(nil)
 // If the exporter is nil, the span processor will preform no action.
 func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorOption) SpanProcessor {
 	o := BatchSpanProcessorOptions{
-		BatchTimeout:       DefaultBatchTimeout,
-		ExportTimeout:      DefaultExportTimeout,
-		MaxQueueSize:       DefaultMaxQueueSize,
-		MaxExportBatchSize: DefaultMaxExportBatchSize,
+		BatchTimeout:         DefaultBatchTimeout,
+		ExportTimeout:        DefaultExportTimeout,
+		MaxQueueSize:         DefaultMaxQueueSize,
+		MaxExportBatchSize:   DefaultMaxExportBatchSize,
+		MaxConcurrentExports: DefaultMaxConcurrentExports,
 	}
 	for _, opt := range options {
 		opt(&o)
 	}
+	if o.Clock == nil {
+		o.Clock = realClock{}
+	}
 	bsp := &batchSpanProcessor{
 		e:      exporter,
 		o:      o,
 		batch:  make([]ReadOnlySpan, 0, o.MaxExportBatchSize),
-		timer:  time.NewTimer(o.BatchTimeout),
+		timer:  o.Clock.NewTimer(o.BatchTimeout),
 		queue:  make(chan ReadOnlySpan, o.MaxQueueSize),
 		stopCh: make(chan struct{}),
 	}
+	if o.MaxConcurrentExports > 1 {
+		bsp.exportSem = make(chan struct{}, o.MaxConcurrentExports)
+	}
 
 	bsp.stopWait.Add(1)
 	go func() {
@@ -137,7 +224,7 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 			bsp.stopWait.Wait()
 			if bsp.e != nil {
 				if err := bsp.e.Shutdown(ctx); err != nil {
-					otel.Handle(err)
+					handleError(err)
 				}
 			}
 			close(wait)
@@ -152,23 +239,78 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 	return err
 }
 
-// ForceFlush exports all ended spans that have not yet been exported.
+// forceFlushSpan is a marker enqueued by ForceFlush. The spans queued
+// before it are exported when it is dequeued, and the result is sent to
+// flushed.
+type forceFlushSpan struct {
+	ReadOnlySpan
+	flushed chan error
+}
+
+// ForceFlush exports all spans ended before it is called that have not yet
+// been exported, including the spans still waiting in the queue. It
+// returns once they are exported or ctx is done.
 func (bsp *batchSpanProcessor) ForceFlush(ctx context.Context) error {
-	var err error
-	if bsp.e != nil {
-		wait := make(chan error)
-		go func() {
-			wait <- bsp.exportSpans(ctx)
-			close(wait)
-		}()
-		// Wait until the export is finished or the context is cancelled/timed out
-		select {
-		case err = <-wait:
-		case <-ctx.Done():
-			err = ctx.Err()
+	if bsp.e == nil {
+		return nil
+	}
+
+	// The channel is buffered so processQueue never blocks signaling a
+	// flush ForceFlush stopped waiting for.
+	flushed := make(chan error, 1)
+	if ok, err := bsp.enqueueFlush(ctx, forceFlushSpan{flushed: flushed}); !ok {
+		return err
+	}
+
+	select {
+	case err := <-flushed:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueueFlush adds the flush marker to the queue, waiting for room until
+// ctx is done. It returns false if the marker was not enqueued, with the
+// error of ctx if it is done. The marker is not enqueued once the
+// processor is shut down, since all spans were then exported.
+func (bsp *batchSpanProcessor) enqueueFlush(ctx context.Context, ffs forceFlushSpan) (ok bool, err error) {
+	// This ensures the bsp.queue<- below does not panic as the
+	// processor shuts down.
+	defer func() {
+		x := recover()
+		switch e := x.(type) {
+		case nil:
+			return
+		case runtime.Error:
+			if e.Error() == "send on closed channel" {
+				ok, err = false, nil
+				return
+			}
 		}
+		panic(x)
+	}()
+
+	select {
+	case <-bsp.stopCh:
+		return false, nil
+	default:
+	}
+
+	select {
+	case bsp.queue <- ffs:
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
 	}
-	return err
+}
+
+// flush exports the current batch and waits for the exports in flight,
+// then signals the flush marker with the result.
+func (bsp *batchSpanProcessor) flush(ctx context.Context, ffs forceFlushSpan) {
+	err := bsp.exportSpans(ctx)
+	bsp.waitExports()
+	ffs.flushed <- err
 }
 
 func WithMaxQueueSize(size int) BatchSpanProcessorOption {
@@ -195,12 +337,49 @@ func WithExportTimeout(timeout time.Duration) BatchSpanProcessorOption {
 	}
 }
 
+// WithBatchClock returns a BatchSpanProcessorOption that configures the
+// Clock used to schedule batch timeouts and measure export durations.
+func WithBatchClock(c Clock) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.Clock = c
+	}
+}
+
 func WithBlocking() BatchSpanProcessorOption {
 	return func(o *BatchSpanProcessorOptions) {
 		o.BlockOnQueueFull = true
 	}
 }
 
+// WithBlockingTimeout returns a BatchSpanProcessorOption that makes OnEnd
+// block for up to timeout while the queue is full instead of dropping the
+// span immediately. Spans are still dropped if the queue stays full for
+// longer than timeout.
+func WithBlockingTimeout(timeout time.Duration) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.BlockOnQueueFull = true
+		o.BlockTimeout = timeout
+	}
+}
+
+// WithDropHandler returns a BatchSpanProcessorOption that configures
+// handler to be called with every span dropped because the queue was full.
+// The handler is called synchronously by OnEnd and should not block.
+func WithDropHandler(handler func(ReadOnlySpan)) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.DropHandler = handler
+	}
+}
+
+// WithMaxConcurrentExports sets the maximum number of batches exported at
+// the same time. When n is greater than one the order batches are exported
+// in is not guaranteed.
+func WithMaxConcurrentExports(n int) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxConcurrentExports = n
+	}
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
@@ -208,6 +387,11 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.batchMutex.Lock()
 	defer bsp.batchMutex.Unlock()
 
+	if bsp.exportSem != nil {
+		bsp.exportAsync(ctx)
+		return nil
+	}
+
 	if bsp.o.ExportTimeout > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, bsp.o.ExportTimeout)
@@ -215,7 +399,9 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	}
 
 	if l := len(bsp.batch); l > 0 {
+		start := bsp.o.Clock.Now()
 		err := bsp.e.ExportSpans(ctx, bsp.batch)
+		bsp.recordExport(l, bsp.o.Clock.Now().Sub(start), err)
 
 		// A new batch is always created after exporting, even if the batch failed to be exported.
 		//
@@ -230,6 +416,78 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	return nil
 }
 
+// exportAsync exports the current batch in a new goroutine once fewer than
+// MaxConcurrentExports exports are in flight. It must be called with the
+// batchMutex held.
+func (bsp *batchSpanProcessor) exportAsync(ctx context.Context) {
+	if len(bsp.batch) == 0 {
+		return
+	}
+	batch := bsp.batch
+	// The exported batch is owned by the exporter until it returns.
+	bsp.batch = make([]ReadOnlySpan, 0, bsp.o.MaxExportBatchSize)
+
+	bsp.exportSem <- struct{}{}
+	go func() {
+		defer func() { <-bsp.exportSem }()
+
+		if bsp.o.ExportTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, bsp.o.ExportTimeout)
+			defer cancel()
+		}
+		start := bsp.o.Clock.Now()
+		err := bsp.e.ExportSpans(ctx, batch)
+		bsp.recordExport(len(batch), bsp.o.Clock.Now().Sub(start), err)
+		if err != nil {
+			handleError(err)
+		}
+	}()
+}
+
+// recordExport updates the statistics with the outcome of exporting n spans.
+func (bsp *batchSpanProcessor) recordExport(n int, d time.Duration, err error) {
+	atomic.AddInt64(&bsp.exportDuration, int64(d))
+	atomic.StoreInt64(&bsp.lastExportDuration, int64(d))
+	global.Debug("exported spans", "count", n, "duration", d, "failed", err != nil, "total_dropped", atomic.LoadUint32(&bsp.dropped))
+	if err != nil {
+		atomic.AddUint64(&bsp.failedBatches, 1)
+		return
+	}
+	atomic.AddUint64(&bsp.exportedBatches, 1)
+	atomic.AddUint64(&bsp.exportedSpans, uint64(n))
+}
+
+// stats returns the current statistics of the processor.
+func (bsp *batchSpanProcessor) stats() BatchSpanProcessorStats {
+	return BatchSpanProcessorStats{
+		QueueLength:        len(bsp.queue),
+		DroppedSpans:       uint64(atomic.LoadUint32(&bsp.dropped)),
+		ExportedBatches:    atomic.LoadUint64(&bsp.exportedBatches),
+		ExportedSpans:      atomic.LoadUint64(&bsp.exportedSpans),
+		FailedBatches:      atomic.LoadUint64(&bsp.failedBatches),
+		ExportDuration:     time.Duration(atomic.LoadInt64(&bsp.exportDuration)),
+		LastExportDuration: time.Duration(atomic.LoadInt64(&bsp.lastExportDuration)),
+	}
+}
+
+// waitExports waits for all asynchronous exports in flight to return.
+func (bsp *batchSpanProcessor) waitExports() {
+	if bsp.exportSem == nil {
+		return
+	}
+
+	bsp.exportWaitMu.Lock()
+	defer bsp.exportWaitMu.Unlock()
+	// All exports have returned once every slot of the semaphore is held.
+	for i := 0; i < cap(bsp.exportSem); i++ {
+		bsp.exportSem <- struct{}{}
+	}
+	for i := 0; i < cap(bsp.exportSem); i++ {
+		<-bsp.exportSem
+	}
+}
+
 // processQueue removes spans from the `queue` channel until processor
 // is shut down. It calls the exporter in batches of up to MaxExportBatchSize
 // waiting up to BatchTimeout to form a batch.
@@ -238,25 +496,34 @@ func (bsp *batchSpanProcessor) processQueue() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	defer bsp.waitExports()
 	for {
 		select {
 		case <-bsp.stopCh:
 			return
-		case <-bsp.timer.C:
+		case <-bsp.timer.C():
 			if err := bsp.exportSpans(ctx); err != nil {
-				otel.Handle(err)
+				handleError(err)
 			}
 		case sd := <-bsp.queue:
+			if ffs, ok := sd.(forceFlushSpan); ok {
+				if !bsp.timer.Stop() {
+					<-bsp.timer.C()
+				}
+				bsp.flush(ctx, ffs)
+				continue
+			}
+
 			bsp.batchMutex.Lock()
 			bsp.batch = append(bsp.batch, sd)
 			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
 			bsp.batchMutex.Unlock()
 			if shouldExport {
 				if !bsp.timer.Stop() {
-					<-bsp.timer.C
+					<-bsp.timer.C()
 				}
 				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
+					handleError(err)
 				}
 			}
 		}
@@ -268,15 +535,20 @@ func (bsp *batchSpanProcessor) processQueue() {
 func (bsp *batchSpanProcessor) drainQueue() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	defer bsp.waitExports()
 	for {
 		select {
 		case sd := <-bsp.queue:
 			if sd == nil {
 				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
+					handleError(err)
 				}
 				return
 			}
+			if ffs, ok := sd.(forceFlushSpan); ok {
+				bsp.flush(ctx, ffs)
+				continue
+			}
 
 			bsp.batchMutex.Lock()
 			bsp.batch = append(bsp.batch, sd)
@@ -285,7 +557,7 @@ func (bsp *batchSpanProcessor) drainQueue() {
 
 			if shouldExport {
 				if err := bsp.exportSpans(ctx); err != nil {
-					otel.Handle(err)
+					handleError(err)
 				}
 			}
 		default:
@@ -295,7 +567,7 @@ func (bsp *batchSpanProcessor) drainQueue() {
 }
 
 func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
-	if !sd.SpanContext().IsSampled() {
+	if !sd.SpanContext().IsSampled() && !bsp.o.exportUnsampled {
 		return
 	}
 
@@ -320,14 +592,35 @@ func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 	default:
 	}
 
-	if bsp.o.BlockOnQueueFull {
-		bsp.queue <- sd
-		return
-	}
-
 	select {
 	case bsp.queue <- sd:
+		return
 	default:
-		atomic.AddUint32(&bsp.dropped, 1)
 	}
+
+	if bsp.o.BlockOnQueueFull {
+		if bsp.o.BlockTimeout <= 0 {
+			bsp.queue <- sd
+			return
+		}
+
+		timer := bsp.o.Clock.NewTimer(bsp.o.BlockTimeout)
+		defer timer.Stop()
+		select {
+		case bsp.queue <- sd:
+			return
+		case <-timer.C():
+		}
+	}
+
+	atomic.AddUint32(&bsp.dropped, 1)
+	if bsp.o.DropHandler != nil {
+		bsp.o.DropHandler(sd)
+	}
+}
+
+// handleError reports err, returned by the exporter of a batch span
+// processor, to the global ErrorHandler.
+func handleError(err error) {
+	otel.HandleClassified(batchSpanProcessorComponent, otel.SeverityError, err)
 }
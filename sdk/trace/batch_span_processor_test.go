@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 type testBatchExporter struct {
@@ -359,6 +361,33 @@ func TestBatchSpanProcessorForceFlushSucceeds(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestBatchSpanProcessorForceFlushQueuedSpans(t *testing.T) {
+	te := testBatchExporter{}
+	tp := basicTracerProvider(t)
+	option := testOption{
+		name: "spans in the queue",
+		o: []sdktrace.BatchSpanProcessorOption{
+			sdktrace.WithMaxQueueSize(4096),
+			sdktrace.WithMaxExportBatchSize(512),
+			sdktrace.WithBatchTimeout(time.Hour),
+		},
+		genNumSpans: 3000,
+	}
+	ssp := createAndRegisterBatchSP(option, &te)
+	tp.RegisterSpanProcessor(ssp)
+	tr := tp.Tracer("BatchSpanProcessorWithOption")
+	generateSpan(t, option.parallel, tr, option)
+
+	require.NoError(t, ssp.ForceFlush(context.Background()))
+	assert.Equal(t, option.genNumSpans, te.len())
+	assert.GreaterOrEqual(t, te.getBatchCount(), 6)
+
+	stats, ok := sdktrace.ReadBatchSpanProcessorStats(ssp)
+	require.True(t, ok)
+	assert.Equal(t, 0, stats.QueueLength)
+	assert.Equal(t, uint64(0), stats.DroppedSpans)
+}
+
 func TestBatchSpanProcessorDropBatchIfFailed(t *testing.T) {
 	te := testBatchExporter{
 		errors: []error{errors.New("fail to export")},
@@ -448,3 +477,201 @@ func TestBatchSpanProcessorForceFlushCancellation(t *testing.T) {
 		t.Errorf("expected %q error, got %v", want, got)
 	}
 }
+
+// concurrentExporter records the maximum number of concurrent exports.
+type concurrentExporter struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	spans       int
+}
+
+func (e *concurrentExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	e.inFlight++
+	if e.inFlight > e.maxInFlight {
+		e.maxInFlight = e.inFlight
+	}
+	e.mu.Unlock()
+
+	time.Sleep(20 * time.Millisecond)
+
+	e.mu.Lock()
+	e.inFlight--
+	e.spans += len(spans)
+	e.mu.Unlock()
+	return nil
+}
+
+func (e *concurrentExporter) Shutdown(context.Context) error { return nil }
+
+func TestBatchSpanProcessorMaxConcurrentExports(t *testing.T) {
+	exp := &concurrentExporter{}
+	bsp := sdktrace.NewBatchSpanProcessor(
+		exp,
+		sdktrace.WithBlocking(),
+		sdktrace.WithMaxExportBatchSize(10),
+		sdktrace.WithMaxConcurrentExports(3),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	generateSpan(t, false, tp.Tracer("MaxConcurrentExports"), testOption{
+		name:        "MaxConcurrentExports",
+		genNumSpans: 105,
+	})
+
+	require.NoError(t, bsp.ForceFlush(context.Background()))
+	require.NoError(t, bsp.Shutdown(context.Background()))
+
+	exp.mu.Lock()
+	defer exp.mu.Unlock()
+	assert.Equal(t, 105, exp.spans)
+	assert.Greater(t, exp.maxInFlight, 1)
+	assert.LessOrEqual(t, exp.maxInFlight, 3)
+	assert.Equal(t, 0, exp.inFlight)
+}
+
+func TestBatchSpanProcessorStats(t *testing.T) {
+	te := testBatchExporter{
+		errors: []error{errors.New("fail to export")},
+		delay:  time.Millisecond,
+	}
+	option := testOption{
+		o: []sdktrace.BatchSpanProcessorOption{
+			sdktrace.WithMaxQueueSize(0),
+			sdktrace.WithMaxExportBatchSize(2000),
+		},
+		genNumSpans: 10,
+	}
+	bsp := createAndRegisterBatchSP(option, &te)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("BatchSpanProcessorStats")
+
+	generateSpan(t, option.parallel, tr, option)
+	assert.Error(t, bsp.ForceFlush(context.Background()))
+	generateSpan(t, option.parallel, tr, option)
+	require.NoError(t, bsp.ForceFlush(context.Background()))
+
+	stats, ok := sdktrace.ReadBatchSpanProcessorStats(bsp)
+	require.True(t, ok)
+	assert.Equal(t, 0, stats.QueueLength)
+	assert.Equal(t, uint64(0), stats.DroppedSpans)
+	assert.Equal(t, uint64(1), stats.FailedBatches)
+	assert.GreaterOrEqual(t, stats.ExportedBatches, uint64(1))
+	assertMaxSpanDiff(t, int(stats.ExportedSpans), 10, 2)
+	assert.GreaterOrEqual(t, int64(stats.LastExportDuration), int64(time.Millisecond))
+	assert.Greater(t, int64(stats.ExportDuration), int64(stats.LastExportDuration))
+}
+
+func TestBatchSpanProcessorStatsDropped(t *testing.T) {
+	bsp := sdktrace.NewBatchSpanProcessor(
+		indefiniteExporter{},
+		sdktrace.WithMaxQueueSize(1),
+		sdktrace.WithMaxExportBatchSize(1),
+		sdktrace.WithExportTimeout(10*time.Millisecond),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	generateSpan(t, false, tp.Tracer("BatchSpanProcessorStatsDropped"), testOption{genNumSpans: 10})
+
+	stats, ok := sdktrace.ReadBatchSpanProcessorStats(bsp)
+	require.True(t, ok)
+	assert.Greater(t, stats.DroppedSpans, uint64(0))
+	assert.LessOrEqual(t, stats.QueueLength, 1)
+	require.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorDropHandler(t *testing.T) {
+	var dropped int32
+	bsp := sdktrace.NewBatchSpanProcessor(
+		indefiniteExporter{},
+		sdktrace.WithMaxQueueSize(1),
+		sdktrace.WithMaxExportBatchSize(1),
+		sdktrace.WithExportTimeout(10*time.Millisecond),
+		sdktrace.WithDropHandler(func(sdktrace.ReadOnlySpan) {
+			atomic.AddInt32(&dropped, 1)
+		}),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	generateSpan(t, false, tp.Tracer("BatchSpanProcessorDropHandler"), testOption{genNumSpans: 10})
+
+	stats, ok := sdktrace.ReadBatchSpanProcessorStats(bsp)
+	require.True(t, ok)
+	assert.Greater(t, stats.DroppedSpans, uint64(0))
+	assert.Equal(t, stats.DroppedSpans, uint64(atomic.LoadInt32(&dropped)))
+	require.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorBlockingTimeout(t *testing.T) {
+	var dropped int32
+	bsp := sdktrace.NewBatchSpanProcessor(
+		indefiniteExporter{},
+		sdktrace.WithMaxQueueSize(1),
+		sdktrace.WithMaxExportBatchSize(1),
+		sdktrace.WithExportTimeout(200*time.Millisecond),
+		sdktrace.WithBlockingTimeout(time.Millisecond),
+		sdktrace.WithDropHandler(func(sdktrace.ReadOnlySpan) {
+			atomic.AddInt32(&dropped, 1)
+		}),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+
+	// The exporter does not return until the export times out, so once one
+	// span is being exported and another is queued every further span
+	// waits for the blocking timeout and is dropped.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		generateSpan(t, false, tp.Tracer("BatchSpanProcessorBlockingTimeout"), testOption{genNumSpans: 5})
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnEnd blocked past the blocking timeout")
+	}
+
+	stats, ok := sdktrace.ReadBatchSpanProcessorStats(bsp)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, stats.DroppedSpans, uint64(3))
+	assert.Equal(t, stats.DroppedSpans, uint64(atomic.LoadInt32(&dropped)))
+
+	require.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorBatchTimeoutWithClock(t *testing.T) {
+	const batchTimeout = time.Hour
+	clock := tracetest.NewMockClock(time.Unix(0, 0))
+	te := testBatchExporter{}
+	bsp := sdktrace.NewBatchSpanProcessor(
+		&te,
+		sdktrace.WithBatchTimeout(batchTimeout),
+		sdktrace.WithBatchClock(clock),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer("BatchTimeoutWithClock").Start(context.Background(), "span")
+	span.End()
+
+	// The span may still be queued when the timer first fires, in which
+	// case an empty batch is skipped and the timer is reset. Keep advancing
+	// the clock until the span is exported.
+	require.Eventually(t, func() bool {
+		clock.Add(batchTimeout)
+		return te.len() == 1
+	}, time.Second, time.Millisecond)
+
+	stats, ok := sdktrace.ReadBatchSpanProcessorStats(bsp)
+	require.True(t, ok)
+	// The mock clock does not move during the export.
+	assert.Equal(t, time.Duration(0), stats.LastExportDuration)
+}
+
+func TestReadBatchSpanProcessorStatsOtherProcessor(t *testing.T) {
+	_, ok := sdktrace.ReadBatchSpanProcessorStats(sdktrace.NewSimpleSpanProcessor(nil))
+	assert.False(t, ok)
+}
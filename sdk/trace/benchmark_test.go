@@ -115,6 +115,94 @@ func BenchmarkSpanWithAttributes_all_2x(b *testing.B) {
 	})
 }
 
+func BenchmarkSpanSetAttributesDuplicates(b *testing.B) {
+	traceBenchmark(b, "Benchmark SetAttributes With Duplicate Keys", func(b *testing.B, t trace.Tracer) {
+		ctx := context.Background()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_, span := t.Start(ctx, "/foo")
+			span.SetAttributes(
+				attribute.Bool("key1", false),
+				attribute.String("key2", "hello"),
+				attribute.Float64("key4", 123.456),
+			)
+			span.SetAttributes(
+				attribute.Bool("key1", true),
+				attribute.String("key2", "world"),
+				attribute.Float64("key4", 654.321),
+			)
+			span.End()
+		}
+	})
+}
+
+func BenchmarkSpanSetAttributes(b *testing.B) {
+	traceBenchmark(b, "Benchmark SetAttributes", func(b *testing.B, t trace.Tracer) {
+		_, span := t.Start(context.Background(), "/foo")
+		defer span.End()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			span.SetAttributes(
+				attribute.Bool("key1", false),
+				attribute.String("key2", "hello"),
+			)
+		}
+	})
+}
+
+func BenchmarkSpanAddEvent(b *testing.B) {
+	traceBenchmark(b, "Benchmark AddEvent", func(b *testing.B, t trace.Tracer) {
+		ctx := context.Background()
+		_, span := t.Start(ctx, "/foo")
+		defer span.End()
+		opt := trace.WithAttributes(
+			attribute.Bool("key1", false),
+			attribute.String("key2", "hello"),
+			attribute.Float64("key4", 123.456),
+			attribute.Int("key5", 123),
+		)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			span.AddEvent("event", opt)
+		}
+	})
+}
+
+func BenchmarkSpanSetAttributesParallel(b *testing.B) {
+	traceBenchmark(b, "Benchmark Parallel SetAttributes", func(b *testing.B, t trace.Tracer) {
+		_, span := t.Start(context.Background(), "/foo")
+		defer span.End()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				span.SetAttributes(
+					attribute.Bool("key1", false),
+					attribute.String("key2", "hello"),
+				)
+			}
+		})
+	})
+}
+
+func BenchmarkSpanAddEventParallel(b *testing.B) {
+	traceBenchmark(b, "Benchmark Parallel AddEvent", func(b *testing.B, t trace.Tracer) {
+		_, span := t.Start(context.Background(), "/foo")
+		defer span.End()
+		opt := trace.WithAttributes(attribute.String("key1", "hello"))
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				span.AddEvent("event", opt)
+			}
+		})
+	})
+}
+
 func BenchmarkTraceID_DotString(b *testing.B) {
 	t, _ := trace.TraceIDFromHex("0000000000000001000000000000002a")
 	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: t})
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/internal"
+)
+
+// Clock is the source of time used by a TracerProvider and a
+// BatchSpanProcessor. It exists so tests can control time deterministically
+// instead of sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer returns a Timer that fires once after d has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock. It mirrors the behavior
+// of a *time.Timer.
+type Timer interface {
+	// C returns the channel the current time is sent on when the Timer
+	// fires.
+	C() <-chan time.Time
+	// Stop prevents the Timer from firing. It returns false if the Timer
+	// already fired or was stopped.
+	Stop() bool
+	// Reset changes the Timer to fire after d has elapsed. It returns true
+	// if the Timer had been active.
+	Reset(d time.Duration) bool
+}
+
+// realClock is the Clock backed by the time package.
+type realClock struct{}
+
+var _ Clock = realClock{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time { return t.Timer.C }
+
+func isRealClock(c Clock) bool {
+	_, ok := c.(realClock)
+	return ok
+}
+
+// endTime returns the time a span started at start ends at according to c.
+// The system clock uses the monotonic clock reading of start so the span
+// duration is not affected by wall clock changes.
+func endTime(c Clock, start time.Time) time.Time {
+	if isRealClock(c) {
+		return internal.MonotonicEndTime(start)
+	}
+	return c.Now()
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithClockSpanTimes(t *testing.T) {
+	start := time.Unix(1000, 0)
+	clock := tracetest.NewMockClock(start)
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithClock(clock),
+		sdktrace.WithSpanProcessor(sr),
+	)
+
+	_, span := tp.Tracer("WithClock").Start(context.Background(), "span")
+	clock.Add(time.Second)
+	span.AddEvent("event")
+	clock.Add(time.Second)
+	span.End()
+
+	ended := sr.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, start, ended[0].StartTime())
+	assert.Equal(t, start.Add(2*time.Second), ended[0].EndTime())
+	require.Len(t, ended[0].Events(), 1)
+	assert.Equal(t, start.Add(time.Second), ended[0].Events()[0].Time)
+}
+
+func TestWithClockExplicitTimestamps(t *testing.T) {
+	clock := tracetest.NewMockClock(time.Unix(1000, 0))
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithClock(clock),
+		sdktrace.WithSpanProcessor(sr),
+	)
+
+	ts := time.Unix(5, 0)
+	_, span := tp.Tracer("WithClock").Start(context.Background(), "span", trace.WithTimestamp(ts))
+	span.AddEvent("event", trace.WithTimestamp(ts.Add(time.Second)))
+	span.End(trace.WithTimestamp(ts.Add(2 * time.Second)))
+
+	ended := sr.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, ts, ended[0].StartTime())
+	assert.Equal(t, ts.Add(2*time.Second), ended[0].EndTime())
+	require.Len(t, ended[0].Events(), 1)
+	assert.Equal(t, ts.Add(time.Second), ended[0].Events()[0].Time)
+}
@@ -14,11 +14,25 @@
 
 package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
+import "go.opentelemetry.io/otel/sdk/internal/env"
+
 // SpanLimits represents the limits of a span.
+//
+// Limits that are not set are read from the OTEL_SPAN_ATTRIBUTE_COUNT_LIMIT,
+// OTEL_SPAN_ATTRIBUTE_VALUE_LENGTH_LIMIT, OTEL_SPAN_EVENT_COUNT_LIMIT,
+// OTEL_SPAN_LINK_COUNT_LIMIT, OTEL_EVENT_ATTRIBUTE_COUNT_LIMIT and
+// OTEL_LINK_ATTRIBUTE_COUNT_LIMIT environment variables, falling back to
+// the defaults if these are not set.
 type SpanLimits struct {
 	// AttributeCountLimit is the maximum allowed span attribute count.
 	AttributeCountLimit int
 
+	// AttributeValueLengthLimit is the maximum allowed length of string
+	// attribute values, including the elements of string array values.
+	// Longer values are truncated. This limit applies to span, event and
+	// link attributes. A negative value means no limit.
+	AttributeValueLengthLimit int
+
 	// EventCountLimit is the maximum allowed span event count.
 	EventCountLimit int
 
@@ -34,26 +48,45 @@ type SpanLimits struct {
 
 func (sl *SpanLimits) ensureDefault() {
 	if sl.EventCountLimit <= 0 {
-		sl.EventCountLimit = DefaultEventCountLimit
+		sl.EventCountLimit = countLimit(env.SpanEventCountKey, DefaultEventCountLimit)
 	}
 	if sl.AttributeCountLimit <= 0 {
-		sl.AttributeCountLimit = DefaultAttributeCountLimit
+		sl.AttributeCountLimit = countLimit(env.SpanAttributeCountKey, DefaultAttributeCountLimit)
+	}
+	if sl.AttributeValueLengthLimit == 0 {
+		sl.AttributeValueLengthLimit = env.IntEnvOr(env.SpanAttributeValueLengthKey, DefaultAttributeValueLengthLimit)
+		if sl.AttributeValueLengthLimit == 0 {
+			sl.AttributeValueLengthLimit = DefaultAttributeValueLengthLimit
+		}
 	}
 	if sl.LinkCountLimit <= 0 {
-		sl.LinkCountLimit = DefaultLinkCountLimit
+		sl.LinkCountLimit = countLimit(env.SpanLinkCountKey, DefaultLinkCountLimit)
 	}
 	if sl.AttributePerEventCountLimit <= 0 {
-		sl.AttributePerEventCountLimit = DefaultAttributePerEventCountLimit
+		sl.AttributePerEventCountLimit = countLimit(env.SpanEventAttributeCountKey, DefaultAttributePerEventCountLimit)
 	}
 	if sl.AttributePerLinkCountLimit <= 0 {
-		sl.AttributePerLinkCountLimit = DefaultAttributePerLinkCountLimit
+		sl.AttributePerLinkCountLimit = countLimit(env.SpanLinkAttributeCountKey, DefaultAttributePerLinkCountLimit)
 	}
 }
 
+// countLimit returns the limit set by the environment variable key, or
+// defaultValue if it is not set or not positive.
+func countLimit(key string, defaultValue int) int {
+	if limit := env.IntEnvOr(key, defaultValue); limit > 0 {
+		return limit
+	}
+	return defaultValue
+}
+
 const (
 	// DefaultAttributeCountLimit is the default maximum allowed span attribute count.
 	DefaultAttributeCountLimit = 128
 
+	// DefaultAttributeValueLengthLimit is the default maximum allowed
+	// attribute value length, unlimited.
+	DefaultAttributeValueLengthLimit = -1
+
 	// DefaultEventCountLimit is the default maximum allowed span event count.
 	DefaultEventCountLimit = 128
 
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/internal/env"
+)
+
+func TestSpanLimitsEnsureDefault(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		env    map[string]string
+		limits SpanLimits
+		want   SpanLimits
+	}{
+		{
+			name: "defaults",
+			want: SpanLimits{
+				AttributeCountLimit:         DefaultAttributeCountLimit,
+				AttributeValueLengthLimit:   DefaultAttributeValueLengthLimit,
+				EventCountLimit:             DefaultEventCountLimit,
+				LinkCountLimit:              DefaultLinkCountLimit,
+				AttributePerEventCountLimit: DefaultAttributePerEventCountLimit,
+				AttributePerLinkCountLimit:  DefaultAttributePerLinkCountLimit,
+			},
+		},
+		{
+			name: "environment",
+			env: map[string]string{
+				env.SpanAttributeCountKey:       "1",
+				env.SpanAttributeValueLengthKey: "2",
+				env.SpanEventCountKey:           "3",
+				env.SpanLinkCountKey:            "4",
+				env.SpanEventAttributeCountKey:  "5",
+				env.SpanLinkAttributeCountKey:   "6",
+			},
+			want: SpanLimits{
+				AttributeCountLimit:         1,
+				AttributeValueLengthLimit:   2,
+				EventCountLimit:             3,
+				LinkCountLimit:              4,
+				AttributePerEventCountLimit: 5,
+				AttributePerLinkCountLimit:  6,
+			},
+		},
+		{
+			name: "programmatic overrides environment",
+			env: map[string]string{
+				env.SpanAttributeCountKey:       "1",
+				env.SpanAttributeValueLengthKey: "2",
+			},
+			limits: SpanLimits{
+				AttributeCountLimit:       10,
+				AttributeValueLengthLimit: -1,
+			},
+			want: SpanLimits{
+				AttributeCountLimit:         10,
+				AttributeValueLengthLimit:   -1,
+				EventCountLimit:             DefaultEventCountLimit,
+				LinkCountLimit:              DefaultLinkCountLimit,
+				AttributePerEventCountLimit: DefaultAttributePerEventCountLimit,
+				AttributePerLinkCountLimit:  DefaultAttributePerLinkCountLimit,
+			},
+		},
+		{
+			name: "invalid environment",
+			env: map[string]string{
+				env.SpanAttributeCountKey:       "-5",
+				env.SpanAttributeValueLengthKey: "0",
+				env.SpanEventCountKey:           "many",
+			},
+			want: SpanLimits{
+				AttributeCountLimit:         DefaultAttributeCountLimit,
+				AttributeValueLengthLimit:   DefaultAttributeValueLengthLimit,
+				EventCountLimit:             DefaultEventCountLimit,
+				LinkCountLimit:              DefaultLinkCountLimit,
+				AttributePerEventCountLimit: DefaultAttributePerEventCountLimit,
+				AttributePerLinkCountLimit:  DefaultAttributePerLinkCountLimit,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				require.NoError(t, os.Setenv(k, v))
+			}
+			defer func() {
+				for k := range tc.env {
+					require.NoError(t, os.Unsetenv(k))
+				}
+			}()
+
+			sl := tc.limits
+			sl.ensureDefault()
+			assert.Equal(t, tc.want, sl)
+		})
+	}
+}
@@ -30,12 +30,83 @@ type IDGenerator interface {
 	NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID
 }
 
+// RandomTraceIDGenerator is implemented by IDGenerators that report
+// whether the rightmost 7 bytes of the trace IDs they generate are random,
+// as required by the random flag of the W3C Trace Context Level 2
+// specification. The FlagsRandom trace flag is set on root spans with a
+// trace ID from an IDGenerator reporting so.
+//
+// The IDGenerators of this package only report it when created with the
+// WithRandomTraceFlag option.
+type RandomTraceIDGenerator interface {
+	RandomTraceIDs() bool
+}
+
+// IDGeneratorOption configures the IDGenerators returned by
+// NewRandomIDGenerator and NewTimeOrderedIDGenerator.
+type IDGeneratorOption func(*idGeneratorConfig)
+
+type idGeneratorConfig struct {
+	randSource rand.Source
+	clock      Clock
+	randomFlag bool
+}
+
+// WithRandSource sets the source of the random bytes of generated IDs. A
+// deterministic source can be used to generate the same IDs in tests. The
+// default source is seeded from crypto/rand.
+func WithRandSource(src rand.Source) IDGeneratorOption {
+	return func(cfg *idGeneratorConfig) {
+		cfg.randSource = src
+	}
+}
+
+// WithIDClock sets the Clock used to timestamp the trace IDs generated by
+// the IDGenerator returned by NewTimeOrderedIDGenerator. The default Clock
+// uses the system clock.
+func WithIDClock(c Clock) IDGeneratorOption {
+	return func(cfg *idGeneratorConfig) {
+		cfg.clock = c
+	}
+}
+
+// WithRandomTraceFlag makes the IDGenerator report that its trace IDs are
+// random, so root spans with these trace IDs have the FlagsRandom trace
+// flag set. It is not set by default because implementations of the W3C
+// Trace Context specification predating Level 2 reject the traceparent
+// headers of spans with the flag set.
+func WithRandomTraceFlag() IDGeneratorOption {
+	return func(cfg *idGeneratorConfig) {
+		cfg.randomFlag = true
+	}
+}
+
+func newIDGeneratorConfig(opts []IDGeneratorOption) idGeneratorConfig {
+	var cfg idGeneratorConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.randSource == nil {
+		var rngSeed int64
+		_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
+		cfg.randSource = rand.NewSource(rngSeed)
+	}
+	if cfg.clock == nil {
+		cfg.clock = realClock{}
+	}
+	return cfg
+}
+
 type randomIDGenerator struct {
 	sync.Mutex
 	randSource *rand.Rand
+	randomFlag bool
 }
 
-var _ IDGenerator = &randomIDGenerator{}
+var (
+	_ IDGenerator            = &randomIDGenerator{}
+	_ RandomTraceIDGenerator = &randomIDGenerator{}
+)
 
 // NewSpanID returns a non-zero span ID from a randomly-chosen sequence.
 func (gen *randomIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
@@ -58,10 +129,81 @@ func (gen *randomIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.
 	return tid, sid
 }
 
+// RandomTraceIDs returns true if the IDGenerator was created with the
+// WithRandomTraceFlag option.
+func (gen *randomIDGenerator) RandomTraceIDs() bool {
+	return gen.randomFlag
+}
+
+// NewRandomIDGenerator returns an IDGenerator generating random trace and
+// span IDs. It is the IDGenerator used by a TracerProvider by default.
+func NewRandomIDGenerator(opts ...IDGeneratorOption) IDGenerator {
+	cfg := newIDGeneratorConfig(opts)
+	return &randomIDGenerator{
+		randSource: rand.New(cfg.randSource),
+		randomFlag: cfg.randomFlag,
+	}
+}
+
 func defaultIDGenerator() IDGenerator {
-	gen := &randomIDGenerator{}
-	var rngSeed int64
-	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
-	gen.randSource = rand.New(rand.NewSource(rngSeed))
-	return gen
+	return NewRandomIDGenerator()
+}
+
+// timeOrderedIDGenerator generates trace IDs with the layout of a UUIDv7.
+type timeOrderedIDGenerator struct {
+	randomIDGenerator
+
+	clock Clock
+}
+
+var _ RandomTraceIDGenerator = &timeOrderedIDGenerator{}
+
+// NewTimeOrderedIDGenerator returns an IDGenerator generating trace IDs
+// that are ordered by the time they were generated, improving the storage
+// locality of traces in backends indexing them by trace ID.
+//
+// Trace IDs have the layout of a version 7 UUID (RFC 9562): the first 6
+// bytes hold the number of milliseconds since the Unix epoch, the other
+// bytes are random except for the UUID version and variant bits. The
+// rightmost 7 bytes are random, so root spans have the FlagsRandom trace
+// flag set if the WithRandomTraceFlag option is passed. Span IDs are
+// random.
+func NewTimeOrderedIDGenerator(opts ...IDGeneratorOption) IDGenerator {
+	cfg := newIDGeneratorConfig(opts)
+	return &timeOrderedIDGenerator{
+		randomIDGenerator: randomIDGenerator{
+			randSource: rand.New(cfg.randSource),
+			randomFlag: cfg.randomFlag,
+		},
+		clock: cfg.clock,
+	}
+}
+
+// NewIDs returns a time-ordered trace ID and a random span ID.
+func (gen *timeOrderedIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	ms := uint64(gen.clock.Now().UnixNano() / 1e6)
+
+	gen.Lock()
+	defer gen.Unlock()
+	tid := trace.TraceID{}
+	gen.randSource.Read(tid[6:])
+	sid := trace.SpanID{}
+	gen.randSource.Read(sid[:])
+
+	tid[0] = byte(ms >> 40)
+	tid[1] = byte(ms >> 32)
+	tid[2] = byte(ms >> 24)
+	tid[3] = byte(ms >> 16)
+	tid[4] = byte(ms >> 8)
+	tid[5] = byte(ms)
+	// UUID version 7 and RFC 4122 variant.
+	tid[6] = tid[6]&0x0f | 0x70
+	tid[8] = tid[8]&0x3f | 0x80
+	return tid, sid
+}
+
+// randomTraceIDs returns whether gen reports generating random trace IDs.
+func randomTraceIDs(gen IDGenerator) bool {
+	r, ok := gen.(RandomTraceIDGenerator)
+	return ok && r.RandomTraceIDs()
 }
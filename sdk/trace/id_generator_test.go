@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRandomIDGeneratorWithRandSource(t *testing.T) {
+	ctx := context.Background()
+	gen1 := sdktrace.NewRandomIDGenerator(sdktrace.WithRandSource(rand.NewSource(1)))
+	gen2 := sdktrace.NewRandomIDGenerator(sdktrace.WithRandSource(rand.NewSource(1)))
+
+	tid1, sid1 := gen1.NewIDs(ctx)
+	tid2, sid2 := gen2.NewIDs(ctx)
+	assert.Equal(t, tid1, tid2)
+	assert.Equal(t, sid1, sid2)
+	assert.Equal(t, gen1.NewSpanID(ctx, tid1), gen2.NewSpanID(ctx, tid2))
+}
+
+func TestTimeOrderedIDGenerator(t *testing.T) {
+	ctx := context.Background()
+	clock := tracetest.NewMockClock(time.Unix(1700000000, 123e6))
+	gen := sdktrace.NewTimeOrderedIDGenerator(
+		sdktrace.WithIDClock(clock),
+		sdktrace.WithRandSource(rand.NewSource(1)),
+	)
+
+	tid, sid := gen.NewIDs(ctx)
+	assert.True(t, tid.IsValid())
+	assert.True(t, sid.IsValid())
+
+	var ms [8]byte
+	copy(ms[2:], tid[:6])
+	assert.Equal(t, uint64(1700000000123), binary.BigEndian.Uint64(ms[:]))
+	assert.Equal(t, byte(0x70), tid[6]&0xf0, "UUID version")
+	assert.Equal(t, byte(0x80), tid[8]&0xc0, "UUID variant")
+
+	clock.Add(time.Millisecond)
+	next, _ := gen.NewIDs(ctx)
+	assert.Equal(t, -1, bytes.Compare(tid[:], next[:]))
+}
+
+func TestRootSpanRandomFlag(t *testing.T) {
+	for _, gen := range []sdktrace.IDGenerator{
+		sdktrace.NewRandomIDGenerator(sdktrace.WithRandomTraceFlag()),
+		sdktrace.NewTimeOrderedIDGenerator(sdktrace.WithRandomTraceFlag()),
+	} {
+		tp := sdktrace.NewTracerProvider(sdktrace.WithIDGenerator(gen))
+		ctx, root := tp.Tracer("TestRootSpanRandomFlag").Start(context.Background(), "root")
+		assert.True(t, root.SpanContext().TraceFlags().IsRandom())
+		_, child := tp.Tracer("TestRootSpanRandomFlag").Start(ctx, "child")
+		assert.True(t, child.SpanContext().TraceFlags().IsRandom())
+	}
+}
+
+type sequentialIDGenerator struct {
+	next byte
+}
+
+func (g *sequentialIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	g.next++
+	return trace.TraceID{g.next}, trace.SpanID{g.next}
+}
+
+func (g *sequentialIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	g.next++
+	return trace.SpanID{g.next}
+}
+
+func TestRootSpanNotRandomFlag(t *testing.T) {
+	for _, gen := range []sdktrace.IDGenerator{
+		&sequentialIDGenerator{},
+		sdktrace.NewRandomIDGenerator(),
+		sdktrace.NewTimeOrderedIDGenerator(),
+	} {
+		tp := sdktrace.NewTracerProvider(sdktrace.WithIDGenerator(gen))
+		_, root := tp.Tracer("TestRootSpanNotRandomFlag").Start(context.Background(), "root")
+		assert.False(t, root.SpanContext().TraceFlags().IsRandom())
+		assert.True(t, root.SpanContext().IsSampled())
+	}
+}
+
+func TestDefaultIDGeneratorNotRandomFlag(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	_, root := tp.Tracer("TestDefaultIDGeneratorNotRandomFlag").Start(context.Background(), "root")
+	assert.Equal(t, trace.FlagsSampled, root.SpanContext().TraceFlags())
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"sync/atomic"
+	"unsafe"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// pendingWriteLimit is the number of pending writes at which the writer
+// applies them, bounding the memory held by a span that is written to
+// often but not read.
+const pendingWriteLimit = 64
+
+// pendingWrite is a SetAttributes or AddEvent call that has not yet been
+// applied to the span.
+type pendingWrite struct {
+	next *pendingWrite
+
+	attributes []attribute.KeyValue
+
+	event   Event
+	isEvent bool
+	// truncated is the number of attribute values of event truncated to
+	// the attribute value length limit.
+	truncated int
+}
+
+// pendingWrites is a lock-free stack of the writes to a span that have not
+// yet been applied. Writers push onto it without acquiring the span lock,
+// and the writes are applied, in the order they were pushed, by the next
+// reader of the span while holding the lock.
+type pendingWrites struct {
+	head  unsafe.Pointer // *pendingWrite
+	count int32
+}
+
+// push adds w to the pending writes and returns the number of writes
+// pending.
+func (p *pendingWrites) push(w *pendingWrite) int32 {
+	for {
+		head := atomic.LoadPointer(&p.head)
+		w.next = (*pendingWrite)(head)
+		if atomic.CompareAndSwapPointer(&p.head, head, unsafe.Pointer(w)) {
+			return atomic.AddInt32(&p.count, 1)
+		}
+	}
+}
+
+// drain removes all the pending writes and returns them as a list in the
+// order they were pushed.
+func (p *pendingWrites) drain() *pendingWrite {
+	head := (*pendingWrite)(atomic.SwapPointer(&p.head, nil))
+	var ordered *pendingWrite
+	var n int32
+	for head != nil {
+		next := head.next
+		head.next = ordered
+		ordered = head
+		head = next
+		n++
+	}
+	if n > 0 {
+		atomic.AddInt32(&p.count, -n)
+	}
+	return ordered
+}
@@ -51,6 +51,13 @@ type tracerProviderConfig struct {
 
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
+
+	// clock is used to timestamp spans and events.
+	clock Clock
+
+	// linkNewRootsToParent adds a link to the parent of spans started as
+	// new roots.
+	linkNewRootsToParent bool
 }
 
 type TracerProvider struct {
@@ -61,6 +68,9 @@ type TracerProvider struct {
 	idGenerator    IDGenerator
 	spanLimits     SpanLimits
 	resource       *resource.Resource
+	clock          Clock
+
+	linkNewRootsToParent bool
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -71,7 +81,8 @@ var _ trace.TracerProvider = &TracerProvider{}
 //  - a ParentBased(AlwaysSample) Sampler
 //  - a random number IDGenerator
 //  - the resource.Default() Resource
-//  - the default SpanLimits.
+//  - the default SpanLimits
+//  - a Clock backed by the time package.
 //
 // The passed opts are used to override these default values and configure the
 // returned TracerProvider appropriately.
@@ -90,6 +101,9 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 		idGenerator: o.idGenerator,
 		spanLimits:  o.spanLimits,
 		resource:    o.resource,
+		clock:       o.clock,
+
+		linkNewRootsToParent: o.linkNewRootsToParent,
 	}
 
 	for _, sp := range o.processors {
@@ -130,7 +144,15 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 	return t
 }
 
-// RegisterSpanProcessor adds the given SpanProcessor to the list of SpanProcessors
+// RegisterSpanProcessor adds the given SpanProcessor to the list of
+// SpanProcessors. It is safe to call concurrently with the other methods of
+// the TracerProvider and with spans being started and ended: s is called for
+// all spans started after the registration returns, and for the end of the
+// spans started before that are still running.
+//
+// Processors can so be attached to a running process, for example to
+// temporarily export spans to a debugging exporter, and removed with
+// UnregisterSpanProcessor.
 func (p *TracerProvider) RegisterSpanProcessor(s SpanProcessor) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -146,7 +168,10 @@ func (p *TracerProvider) RegisterSpanProcessor(s SpanProcessor) {
 	p.spanProcessors.Store(new)
 }
 
-// UnregisterSpanProcessor removes the given SpanProcessor from the list of SpanProcessors
+// UnregisterSpanProcessor removes the given SpanProcessor from the list of
+// SpanProcessors and shuts it down. It does nothing if s is not registered
+// with the TracerProvider. It is safe to call concurrently with the other
+// methods of the TracerProvider and with spans being started and ended.
 func (p *TracerProvider) UnregisterSpanProcessor(s SpanProcessor) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -164,15 +189,17 @@ func (p *TracerProvider) UnregisterSpanProcessor(s SpanProcessor) {
 		if sps.sp == s {
 			stopOnce = sps
 			idx = i
+			break
 		}
 	}
-	if stopOnce != nil {
-		stopOnce.state.Do(func() {
-			if err := s.Shutdown(context.Background()); err != nil {
-				otel.Handle(err)
-			}
-		})
+	if stopOnce == nil {
+		return
 	}
+	stopOnce.state.Do(func() {
+		if err := s.Shutdown(context.Background()); err != nil {
+			otel.Handle(err)
+		}
+	})
 	if len(spss) > 1 {
 		copy(spss[idx:], spss[idx+1:])
 	}
@@ -184,6 +211,16 @@ func (p *TracerProvider) UnregisterSpanProcessor(s SpanProcessor) {
 
 // ForceFlush immediately exports all spans that have not yet been exported for
 // all the registered span processors.
+//
+// Every span processor is flushed, in the order they were registered, even if
+// flushing an earlier one fails. The first error is returned and any later
+// ones are sent to the global error handler. If ctx is done before all span
+// processors are flushed the remaining ones are skipped and ctx.Err() is
+// returned, so a deadline on ctx bounds the time ForceFlush takes.
+//
+// Environments that may suspend the process as soon as a unit of work
+// completes, like FaaS runtimes, should call ForceFlush before returning
+// from each invocation so no spans are lost.
 func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 	spss, ok := p.spanProcessors.Load().(spanProcessorStates)
 	if !ok {
@@ -193,6 +230,7 @@ func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 		return nil
 	}
 
+	var retErr error
 	for _, sps := range spss {
 		select {
 		case <-ctx.Done():
@@ -201,10 +239,14 @@ func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 		}
 
 		if err := sps.sp.ForceFlush(ctx); err != nil {
-			return err
+			if retErr == nil {
+				retErr = err
+			} else {
+				otel.Handle(err)
+			}
 		}
 	}
-	return nil
+	return retErr
 }
 
 // Shutdown shuts down the span processors in the order they were registered.
@@ -326,6 +368,37 @@ func WithSpanLimits(sl SpanLimits) TracerProviderOption {
 	})
 }
 
+// WithClock returns a TracerProviderOption that will configure the Clock c
+// as a TracerProvider's Clock. The configured Clock is used to timestamp the
+// start, end, and events of Spans when no explicit timestamp is provided.
+// It is intended for tests that need deterministic span durations.
+//
+// If this option is not used, the TracerProvider will use the system clock.
+func WithClock(c Clock) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg *tracerProviderConfig) {
+		if c != nil {
+			cfg.clock = c
+		}
+	})
+}
+
+// WithNewRootLinks returns a TracerProviderOption that configures the
+// TracerProvider to link the Spans started with trace.WithNewRoot to the
+// current Span of the context they are started with, if it is valid.
+//
+// The link is added before the links passed with trace.WithLinks, and
+// Samplers are passed it.  It allows a background job triggered by a
+// request to start a new trace while retaining the relation to the trace
+// of the request, instead of being its child.
+//
+// If this option is not used, Spans started as new roots are not linked
+// to their parent.
+func WithNewRootLinks() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg *tracerProviderConfig) {
+		cfg.linkNewRootsToParent = true
+	})
+}
+
 // ensureValidTracerProviderConfig ensures that given TracerProviderConfig is valid.
 func ensureValidTracerProviderConfig(cfg *tracerProviderConfig) {
 	if cfg.sampler == nil {
@@ -338,4 +411,7 @@ func ensureValidTracerProviderConfig(cfg *tracerProviderConfig) {
 	if cfg.resource == nil {
 		cfg.resource = resource.Default()
 	}
+	if cfg.clock == nil {
+		cfg.clock = realClock{}
+	}
 }
@@ -17,6 +17,7 @@ package trace
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,8 +26,10 @@ import (
 )
 
 type basicSpanProcesor struct {
-	running             bool
-	injectShutdownError error
+	running               bool
+	injectShutdownError   error
+	flushed               bool
+	injectForceFlushError error
 }
 
 func (t *basicSpanProcesor) Shutdown(context.Context) error {
@@ -37,7 +40,8 @@ func (t *basicSpanProcesor) Shutdown(context.Context) error {
 func (t *basicSpanProcesor) OnStart(context.Context, ReadWriteSpan) {}
 func (t *basicSpanProcesor) OnEnd(ReadOnlySpan)                     {}
 func (t *basicSpanProcesor) ForceFlush(context.Context) error {
-	return nil
+	t.flushed = true
+	return t.injectForceFlushError
 }
 
 func TestShutdownTraceProvider(t *testing.T) {
@@ -54,6 +58,37 @@ func TestShutdownTraceProvider(t *testing.T) {
 	}
 }
 
+func TestForceFlushTraceProvider(t *testing.T) {
+	handler.Reset()
+	stp := NewTracerProvider()
+	err1 := errors.New("first span processor flush failure")
+	err2 := errors.New("second span processor flush failure")
+	sp1 := &basicSpanProcesor{injectForceFlushError: err1}
+	sp2 := &basicSpanProcesor{injectForceFlushError: err2}
+	sp3 := &basicSpanProcesor{}
+	stp.RegisterSpanProcessor(sp1)
+	stp.RegisterSpanProcessor(sp2)
+	stp.RegisterSpanProcessor(sp3)
+
+	err := stp.ForceFlush(context.Background())
+	assert.Equal(t, err1, err)
+	assert.True(t, sp1.flushed)
+	assert.True(t, sp2.flushed)
+	assert.True(t, sp3.flushed)
+	assert.Contains(t, handler.errs, err2)
+}
+
+func TestForceFlushTraceProviderContextDone(t *testing.T) {
+	stp := NewTracerProvider()
+	sp := &basicSpanProcesor{}
+	stp.RegisterSpanProcessor(sp)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, stp.ForceFlush(ctx), context.Canceled)
+	assert.False(t, sp.flushed)
+}
+
 func TestFailedProcessorShutdown(t *testing.T) {
 	stp := NewTracerProvider()
 	spErr := errors.New("basic span processor shutdown failure")
@@ -85,6 +120,72 @@ func TestFailedProcessorShutdownInUnregister(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUnregisterUnknownSpanProcessor(t *testing.T) {
+	stp := NewTracerProvider()
+	sp := &basicSpanProcesor{running: true}
+	stp.RegisterSpanProcessor(sp)
+
+	unknown := &basicSpanProcesor{running: true}
+	stp.UnregisterSpanProcessor(unknown)
+
+	assert.True(t, unknown.running)
+	assert.True(t, sp.running)
+	assert.Len(t, stp.spanProcessors.Load().(spanProcessorStates), 1)
+}
+
+func TestRegisterSpanProcessorAfterStart(t *testing.T) {
+	stp := NewTracerProvider()
+	tr := stp.Tracer("TestRegisterSpanProcessorAfterStart")
+	_, before := tr.Start(context.Background(), "before")
+
+	te := NewTestExporter()
+	ssp := NewSimpleSpanProcessor(te)
+	stp.RegisterSpanProcessor(ssp)
+	_, during := tr.Start(context.Background(), "during")
+	before.End()
+	during.End()
+
+	var names []string
+	for _, s := range te.Spans() {
+		names = append(names, s.Name())
+	}
+	assert.ElementsMatch(t, []string{"before", "during"}, names)
+
+	stp.UnregisterSpanProcessor(ssp)
+	te.Reset()
+	_, after := tr.Start(context.Background(), "after")
+	after.End()
+	assert.Equal(t, 0, te.Len())
+}
+
+func TestRegisterSpanProcessorConcurrent(t *testing.T) {
+	stp := NewTracerProvider()
+	tr := stp.Tracer("TestRegisterSpanProcessorConcurrent")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				sp := NewSimpleSpanProcessor(NewTestExporter())
+				stp.RegisterSpanProcessor(sp)
+				stp.UnregisterSpanProcessor(sp)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, span := tr.Start(context.Background(), "span")
+				span.End()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Empty(t, stp.spanProcessors.Load().(spanProcessorStates))
+}
+
 func TestSchemaURL(t *testing.T) {
 	stp := NewTracerProvider()
 	schemaURL := "https://opentelemetry.io/schemas/1.2.0"
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket allowing up to rate operations per second.
+// It holds at most max(1, rate) tokens, the largest burst it allows, and
+// starts full.
+type rateLimiter struct {
+	rate float64
+	max  float64
+	now  func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	max := rate
+	if max < 1 {
+		max = 1
+	}
+	rl := &rateLimiter{rate: rate, max: max, now: time.Now, tokens: max}
+	rl.last = rl.now()
+	return rl
+}
+
+// allow reports whether an operation is allowed now and, if so, takes a
+// token for it.
+func (rl *rateLimiter) allow() bool {
+	if rl.rate <= 0 {
+		return false
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.tokens += now.Sub(rl.last).Seconds() * rl.rate
+	rl.last = now
+	if rl.tokens > rl.max {
+		rl.tokens = rl.max
+	}
+	if rl.tokens < 1 {
+		return false
+	}
+	rl.tokens--
+	return true
+}
@@ -146,6 +146,46 @@ func NeverSample() Sampler {
 	return alwaysOffSampler{}
 }
 
+type rateLimitedSampler struct {
+	limiter     *rateLimiter
+	description string
+}
+
+func (rs rateLimitedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	decision := Drop
+	if psc.IsValid() {
+		if psc.IsSampled() {
+			decision = RecordAndSample
+		}
+	} else if rs.limiter.allow() {
+		decision = RecordAndSample
+	}
+	return SamplingResult{
+		Decision:   decision,
+		Tracestate: psc.TraceState(),
+	}
+}
+
+func (rs rateLimitedSampler) Description() string {
+	return rs.description
+}
+
+// RateLimited returns a Sampler that samples at most n root spans per
+// second, using a token bucket that allows bursts of up to n root spans.
+// Spans with a parent follow the sampling decision of the parent and do not
+// count towards the limit. The sampler can be used on its own or as the root
+// Sampler of ParentBased. Values of n <= 0 sample no root spans.
+func RateLimited(n float64) Sampler {
+	if n < 0 {
+		n = 0
+	}
+	return rateLimitedSampler{
+		limiter:     newRateLimiter(n),
+		description: fmt.Sprintf("RateLimited{%g}", n),
+	}
+}
+
 // ParentBased returns a composite sampler which behaves differently,
 // based on the parent of the span. If the span has no parent,
 // the root(Sampler) is used to make sampling decision. If the span has
@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ComposeOperator defines how the decisions of composed Samplers are
+// combined.
+type ComposeOperator uint8
+
+const (
+	// And records a span only if all composed Samplers record it, and
+	// samples it only if all of them sample it.
+	And ComposeOperator = iota
+	// Or records a span if any of the composed Samplers records it, and
+	// samples it if any of them samples it.
+	Or
+)
+
+// String returns the name of the operator.
+func (op ComposeOperator) String() string {
+	switch op {
+	case And:
+		return "And"
+	case Or:
+		return "Or"
+	}
+	return fmt.Sprintf("ComposeOperator(%d)", uint8(op))
+}
+
+type composeSampler struct {
+	op       ComposeOperator
+	samplers []Sampler
+}
+
+// Compose returns a Sampler combining the decisions of samplers with op.
+// Samplers are consulted in order, stopping as soon as the decision is
+// known: with And at the first Sampler dropping the span, with Or at the
+// first Sampler sampling it. Composing no Samplers with And samples every
+// span, composing no Samplers with Or drops every span.
+//
+// If the span is recorded, the attributes returned by all consulted
+// Samplers are added to it. The Tracestate is the one returned by the last
+// consulted Sampler that changed the Tracestate of the parent.
+func Compose(op ComposeOperator, samplers ...Sampler) Sampler {
+	return composeSampler{
+		op:       op,
+		samplers: append([]Sampler(nil), samplers...),
+	}
+}
+
+func (cs composeSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	parentState := trace.SpanContextFromContext(p.ParentContext).TraceState()
+	result := SamplingResult{Tracestate: parentState}
+	if cs.op == And {
+		result.Decision = RecordAndSample
+	}
+
+	for _, s := range cs.samplers {
+		r := s.ShouldSample(p)
+		result.Attributes = append(result.Attributes, r.Attributes...)
+		if r.Tracestate.String() != parentState.String() {
+			result.Tracestate = r.Tracestate
+		}
+
+		if cs.op == And {
+			if r.Decision < result.Decision {
+				result.Decision = r.Decision
+			}
+			if result.Decision == Drop {
+				break
+			}
+		} else {
+			if r.Decision > result.Decision {
+				result.Decision = r.Decision
+			}
+			if result.Decision == RecordAndSample {
+				break
+			}
+		}
+	}
+
+	if result.Decision == Drop {
+		result.Attributes = nil
+	}
+	return result
+}
+
+func (cs composeSampler) Description() string {
+	d := make([]string, len(cs.samplers))
+	for i, s := range cs.samplers {
+		d[i] = s.Description()
+	}
+	return fmt.Sprintf("%s{%s}", cs.op, strings.Join(d, ","))
+}
+
+type attributeMatchSampler struct {
+	key   attribute.Key
+	match func(attribute.Value) bool
+}
+
+// AttributeMatches returns a Sampler that samples spans started with an
+// attribute key for which match returns true, and drops all other spans.
+// Only the attributes passed when the span is started are considered.
+//
+// It is meant to be composed with other Samplers, for example to sample
+// only some requests:
+//
+//	Compose(And,
+//		TraceIDRatioBased(0.1),
+//		AttributeMatches(semconv.HTTPTargetKey, func(v attribute.Value) bool {
+//			return strings.HasPrefix(v.AsString(), "/api/")
+//		}),
+//	)
+func AttributeMatches(key attribute.Key, match func(attribute.Value) bool) Sampler {
+	return attributeMatchSampler{key: key, match: match}
+}
+
+func (as attributeMatchSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	result := SamplingResult{
+		Decision:   Drop,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+	for _, kv := range p.Attributes {
+		if kv.Key == as.key && as.match(kv.Value) {
+			result.Decision = RecordAndSample
+			break
+		}
+	}
+	return result
+}
+
+func (as attributeMatchSampler) Description() string {
+	return fmt.Sprintf("AttributeMatches{%s}", as.key)
+}
+
+// annotationConfig is a group of options for an annotated sampler.
+type annotationConfig struct {
+	attributes []attribute.KeyValue
+	traceState []attribute.KeyValue
+}
+
+// AnnotationOption configures what an annotated sampler adds to the spans it
+// records.
+type AnnotationOption interface {
+	apply(*annotationConfig)
+}
+
+type annotationAttributesOption []attribute.KeyValue
+
+func (o annotationAttributesOption) apply(config *annotationConfig) {
+	config.attributes = append(config.attributes, o...)
+}
+
+// WithAnnotationAttributes adds attrs to the attributes of the recorded
+// spans.
+func WithAnnotationAttributes(attrs ...attribute.KeyValue) AnnotationOption {
+	return annotationAttributesOption(attrs)
+}
+
+type annotationTraceStateOption attribute.KeyValue
+
+func (o annotationTraceStateOption) apply(config *annotationConfig) {
+	config.traceState = append(config.traceState, attribute.KeyValue(o))
+}
+
+// WithAnnotationTraceState inserts the key-value pair into the TraceState of
+// the recorded spans. Pairs that are not valid TraceState members are
+// ignored.
+func WithAnnotationTraceState(key, value string) AnnotationOption {
+	return annotationTraceStateOption(attribute.String(key, value))
+}
+
+type annotatedSampler struct {
+	sampler Sampler
+	config  annotationConfig
+}
+
+// Annotated returns a Sampler that delegates the sampling decision to
+// sampler and, for every span it records, adds the attributes and
+// TraceState members configured with opts.
+func Annotated(sampler Sampler, opts ...AnnotationOption) Sampler {
+	var c annotationConfig
+	for _, o := range opts {
+		o.apply(&c)
+	}
+	return annotatedSampler{sampler: sampler, config: c}
+}
+
+func (as annotatedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	result := as.sampler.ShouldSample(p)
+	if result.Decision == Drop {
+		return result
+	}
+
+	if len(as.config.attributes) > 0 {
+		attrs := make([]attribute.KeyValue, 0, len(result.Attributes)+len(as.config.attributes))
+		attrs = append(attrs, result.Attributes...)
+		result.Attributes = append(attrs, as.config.attributes...)
+	}
+	for _, kv := range as.config.traceState {
+		if ts, err := result.Tracestate.Insert(string(kv.Key), kv.Value.AsString()); err == nil {
+			result.Tracestate = ts
+		}
+	}
+	return result
+}
+
+func (as annotatedSampler) Description() string {
+	return fmt.Sprintf("Annotated{%s}", as.sampler.Description())
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type fixedSampler struct {
+	result SamplingResult
+	calls  *int
+}
+
+func (fs fixedSampler) ShouldSample(SamplingParameters) SamplingResult {
+	if fs.calls != nil {
+		*fs.calls++
+	}
+	return fs.result
+}
+
+func (fs fixedSampler) Description() string {
+	return "Fixed"
+}
+
+func TestComposeDecision(t *testing.T) {
+	drop := fixedSampler{result: SamplingResult{Decision: Drop}}
+	record := fixedSampler{result: SamplingResult{Decision: RecordOnly}}
+	sample := fixedSampler{result: SamplingResult{Decision: RecordAndSample}}
+
+	testCases := []struct {
+		name     string
+		op       ComposeOperator
+		samplers []Sampler
+		want     SamplingDecision
+	}{
+		{"And/empty", And, nil, RecordAndSample},
+		{"And/sample", And, []Sampler{sample, sample}, RecordAndSample},
+		{"And/record", And, []Sampler{sample, record}, RecordOnly},
+		{"And/drop", And, []Sampler{sample, drop, record}, Drop},
+		{"Or/empty", Or, nil, Drop},
+		{"Or/drop", Or, []Sampler{drop, drop}, Drop},
+		{"Or/record", Or, []Sampler{drop, record}, RecordOnly},
+		{"Or/sample", Or, []Sampler{drop, record, sample}, RecordAndSample},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := SamplingParameters{ParentContext: context.Background()}
+			got := Compose(tc.op, tc.samplers...).ShouldSample(params)
+			assert.Equal(t, tc.want, got.Decision)
+		})
+	}
+}
+
+func TestComposeShortCircuits(t *testing.T) {
+	var calls int
+	counted := fixedSampler{result: SamplingResult{Decision: RecordAndSample}, calls: &calls}
+	params := SamplingParameters{ParentContext: context.Background()}
+
+	Compose(And, NeverSample(), counted).ShouldSample(params)
+	assert.Equal(t, 0, calls)
+	Compose(Or, AlwaysSample(), counted).ShouldSample(params)
+	assert.Equal(t, 0, calls)
+	Compose(And, AlwaysSample(), counted).ShouldSample(params)
+	assert.Equal(t, 1, calls)
+}
+
+func TestComposeMergesAnnotations(t *testing.T) {
+	parentState, err := trace.TraceState{}.Insert("k", "v")
+	assert.NoError(t, err)
+	params := SamplingParameters{
+		ParentContext: trace.ContextWithSpanContext(
+			context.Background(),
+			trace.NewSpanContext(trace.SpanContextConfig{TraceState: parentState}),
+		),
+	}
+
+	sampler := Compose(And,
+		Annotated(AlwaysSample(), WithAnnotationAttributes(attribute.String("a", "1"))),
+		Annotated(AlwaysSample(),
+			WithAnnotationAttributes(attribute.String("b", "2")),
+			WithAnnotationTraceState("sampler", "on"),
+		),
+		AlwaysSample(),
+	)
+	got := sampler.ShouldSample(params)
+	assert.Equal(t, RecordAndSample, got.Decision)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("a", "1"), attribute.String("b", "2")}, got.Attributes)
+	assert.Equal(t, "sampler=on,k=v", got.Tracestate.String())
+
+	dropped := Compose(And, sampler, NeverSample()).ShouldSample(params)
+	assert.Equal(t, Drop, dropped.Decision)
+	assert.Empty(t, dropped.Attributes)
+}
+
+func TestComposeDescription(t *testing.T) {
+	assert.Equal(t,
+		"And{AlwaysOnSampler,Or{AlwaysOffSampler,TraceIDRatioBased{0.5}}}",
+		Compose(And, AlwaysSample(), Compose(Or, NeverSample(), TraceIDRatioBased(.5))).Description(),
+	)
+}
+
+func TestAttributeMatches(t *testing.T) {
+	sampler := AttributeMatches("http.target", func(v attribute.Value) bool {
+		return v.AsString() == "/ping"
+	})
+
+	decide := func(attrs ...attribute.KeyValue) SamplingDecision {
+		return sampler.ShouldSample(SamplingParameters{
+			ParentContext: context.Background(),
+			Attributes:    attrs,
+		}).Decision
+	}
+	assert.Equal(t, RecordAndSample, decide(attribute.String("http.target", "/ping")))
+	assert.Equal(t, Drop, decide(attribute.String("http.target", "/users")))
+	assert.Equal(t, Drop, decide(attribute.String("http.route", "/ping")))
+	assert.Equal(t, Drop, decide())
+	assert.Equal(t, "AttributeMatches{http.target}", sampler.Description())
+}
+
+func TestAnnotated(t *testing.T) {
+	params := SamplingParameters{ParentContext: context.Background()}
+	opts := []AnnotationOption{
+		WithAnnotationAttributes(attribute.String("sampler", "annotated")),
+		WithAnnotationTraceState("key", "value"),
+		WithAnnotationTraceState("invalid key", "value"),
+	}
+
+	got := Annotated(AlwaysSample(), opts...).ShouldSample(params)
+	assert.Equal(t, RecordAndSample, got.Decision)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("sampler", "annotated")}, got.Attributes)
+	assert.Equal(t, "key=value", got.Tracestate.String())
+
+	got = Annotated(NeverSample(), opts...).ShouldSample(params)
+	assert.Equal(t, Drop, got.Decision)
+	assert.Empty(t, got.Attributes)
+	assert.Equal(t, 0, got.Tracestate.Len())
+
+	assert.Equal(t, "Annotated{AlwaysOffSampler}", Annotated(NeverSample()).Description())
+}
+
+func TestAnnotatedSpan(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSampler(Annotated(AlwaysSample(),
+			WithAnnotationAttributes(attribute.Bool("annotated", true)),
+			WithAnnotationTraceState("sampler", "annotated"),
+		)),
+	)
+	_, span := tp.Tracer("TestAnnotatedSpan").Start(context.Background(), "span")
+	span.End()
+
+	spans := te.Spans()
+	if assert.Len(t, spans, 1) {
+		assert.Contains(t, spans[0].Attributes(), attribute.Bool("annotated", true))
+		assert.Equal(t, "annotated", spans[0].SpanContext().TraceState().Get("sampler"))
+	}
+}
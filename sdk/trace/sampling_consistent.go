@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// otTraceStateKey is the TraceState key of the OpenTelemetry entry
+	// holding the r-value and p-value of consistent probability sampling.
+	otTraceStateKey = "ot"
+
+	// maxRValue is the largest r-value, the number of leading zeros of a
+	// 62-bit random number.
+	maxRValue = 62
+	// zeroAdjustedCountPValue is the p-value of spans that are sampled with
+	// a zero probability, and so do not count towards any span-to-metrics
+	// computation.
+	zeroAdjustedCountPValue = 63
+)
+
+// otTraceState is the parsed value of the "ot" TraceState entry. The r-value
+// and p-value are -1 when they are absent or invalid. Other fields are kept
+// as is.
+type otTraceState struct {
+	rValue int
+	pValue int
+	fields []string
+}
+
+func parseOTTraceState(value string) otTraceState {
+	ots := otTraceState{rValue: -1, pValue: -1}
+	if value == "" {
+		return ots
+	}
+	for _, field := range strings.Split(value, ";") {
+		switch {
+		case strings.HasPrefix(field, "r:"):
+			ots.rValue = parseSamplingValue(field[2:], maxRValue)
+		case strings.HasPrefix(field, "p:"):
+			ots.pValue = parseSamplingValue(field[2:], zeroAdjustedCountPValue)
+		case field != "":
+			ots.fields = append(ots.fields, field)
+		}
+	}
+	return ots
+}
+
+// parseSamplingValue returns the decimal value of s if it is between 0 and
+// max, and -1 otherwise.
+func parseSamplingValue(s string, max int) int {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 || v > max || s != strconv.Itoa(v) {
+		return -1
+	}
+	return v
+}
+
+func (ots otTraceState) String() string {
+	fields := make([]string, 0, len(ots.fields)+2)
+	if ots.pValue >= 0 {
+		fields = append(fields, "p:"+strconv.Itoa(ots.pValue))
+	}
+	if ots.rValue >= 0 {
+		fields = append(fields, "r:"+strconv.Itoa(ots.rValue))
+	}
+	return strings.Join(append(fields, ots.fields...), ";")
+}
+
+type consistentProbabilitySampler struct {
+	// pValue is used with probability pValueProb, pValue+1 otherwise.
+	pValue      int
+	pValueProb  float64
+	description string
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// ConsistentProbabilityBased returns a Sampler that samples a given fraction
+// of traces using consistent probability sampling. The sampling decisions are
+// recorded in the "ot" entry of the TraceState:
+//
+// - the r-value is a random number, propagated with the trace, that is the
+// same for all of its spans. It is generated for spans with no r-value in
+// their parent TraceState.
+// - the p-value encodes the sampling probability, a power of two, of sampled
+// spans. Fractions that are not a power of two are met on average by
+// randomly choosing one of the two closest powers of two.
+//
+// A span is sampled if its p-value is lower than or equal to its r-value.
+// Services sampling with different fractions so make consistent decisions:
+// all spans of a trace sampled with a fraction are also sampled by all higher
+// fractions. Fractions >= 1 will always sample, fractions < 2^-62 are
+// treated as zero.
+//
+// Like TraceIDRatioBased, the sampler ignores the sampling decision of the
+// parent. Use it as a delegate of ParentBased to respect it, in which case the
+// TraceState of the parent is propagated unchanged to its children.
+func ConsistentProbabilityBased(fraction float64) Sampler {
+	cs := &consistentProbabilitySampler{
+		description: fmt.Sprintf("ConsistentProbabilityBased{%g}", fraction),
+	}
+	switch {
+	case fraction >= 1:
+		cs.pValue, cs.pValueProb = 0, 1
+	case fraction < 0x1p-62:
+		cs.pValue, cs.pValueProb = zeroAdjustedCountPValue, 1
+	default:
+		// fraction = frac * 2^exp with frac in [0.5, 1), so fraction is
+		// between 2^(exp-1) and 2^exp, the probabilities of p-values 1-exp
+		// and -exp.
+		frac, exp := math.Frexp(fraction)
+		if frac == 0.5 {
+			cs.pValue, cs.pValueProb = 1-exp, 1
+		} else {
+			cs.pValue, cs.pValueProb = -exp, 2*frac-1
+		}
+	}
+
+	var rngSeed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
+	cs.rnd = rand.New(rand.NewSource(rngSeed))
+	return cs
+}
+
+func (cs *consistentProbabilitySampler) ShouldSample(p SamplingParameters) SamplingResult {
+	state := trace.SpanContextFromContext(p.ParentContext).TraceState()
+	ots := parseOTTraceState(state.Get(otTraceStateKey))
+
+	cs.mu.Lock()
+	if ots.rValue < 0 {
+		ots.rValue = bits.LeadingZeros64(cs.rnd.Uint64())
+		if ots.rValue > maxRValue {
+			ots.rValue = maxRValue
+		}
+	}
+	pValue := cs.pValue
+	if cs.pValueProb < 1 && cs.rnd.Float64() >= cs.pValueProb {
+		pValue++
+	}
+	cs.mu.Unlock()
+
+	decision := Drop
+	ots.pValue = -1
+	if pValue <= ots.rValue {
+		decision = RecordAndSample
+		ots.pValue = pValue
+	}
+
+	// The TraceState of the parent is kept if the entry cannot be inserted.
+	state, _ = state.Insert(otTraceStateKey, ots.String())
+	return SamplingResult{
+		Decision:   decision,
+		Tracestate: state,
+	}
+}
+
+func (cs *consistentProbabilitySampler) Description() string {
+	return cs.description
+}
+
+// AdjustedCount returns the number of spans represented by the span with sc,
+// the inverse of the probability it was sampled with, as recorded by a
+// ConsistentProbabilityBased sampler in its TraceState. It returns false if
+// the TraceState of sc holds no valid p-value, in which case the adjusted
+// count is unknown. Spans sampled with a zero probability have an adjusted
+// count of zero.
+func AdjustedCount(sc trace.SpanContext) (float64, bool) {
+	ots := parseOTTraceState(sc.TraceState().Get(otTraceStateKey))
+	switch {
+	case ots.pValue < 0:
+		return 0, false
+	case ots.pValue == zeroAdjustedCountPValue:
+		return 0, true
+	}
+	return math.Ldexp(1, ots.pValue), true
+}
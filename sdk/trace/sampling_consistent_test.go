@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func otParentContext(t *testing.T, ot string, sampled bool) context.Context {
+	state := trace.TraceState{}
+	if ot != "" {
+		var err error
+		state, err = state.Insert(otTraceStateKey, ot)
+		require.NoError(t, err)
+	}
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	var flags trace.TraceFlags
+	if sampled {
+		flags = trace.FlagsSampled
+	}
+	return trace.ContextWithRemoteSpanContext(
+		context.Background(),
+		trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: flags,
+			TraceState: state,
+		}),
+	)
+}
+
+func TestOTTraceState(t *testing.T) {
+	testCases := []struct {
+		value  string
+		r, p   int
+		output string
+	}{
+		{"", -1, -1, ""},
+		{"r:3;p:2", 3, 2, "p:2;r:3"},
+		{"p:63;r:62", 62, 63, "p:63;r:62"},
+		{"r:63;p:64", -1, -1, ""},
+		{"r:03;p:-1", -1, -1, ""},
+		{"r:x;k:v;p:1", -1, 1, "p:1;k:v"},
+	}
+
+	for _, tc := range testCases {
+		ots := parseOTTraceState(tc.value)
+		assert.Equal(t, tc.r, ots.rValue, tc.value)
+		assert.Equal(t, tc.p, ots.pValue, tc.value)
+		assert.Equal(t, tc.output, ots.String(), tc.value)
+	}
+}
+
+func TestConsistentProbabilityBasedPValues(t *testing.T) {
+	testCases := []struct {
+		fraction float64
+		pValue   int
+		prob     float64
+	}{
+		{2, 0, 1},
+		{1, 0, 1},
+		{0.5, 1, 1},
+		{0.75, 0, 0.5},
+		{0.1, 3, 0.6},
+		{0x1p-62, 62, 1},
+		{0x1p-63, zeroAdjustedCountPValue, 1},
+		{0, zeroAdjustedCountPValue, 1},
+		{-1, zeroAdjustedCountPValue, 1},
+	}
+
+	for _, tc := range testCases {
+		cs := ConsistentProbabilityBased(tc.fraction).(*consistentProbabilitySampler)
+		assert.Equal(t, tc.pValue, cs.pValue, "fraction %g", tc.fraction)
+		assert.InDelta(t, tc.prob, cs.pValueProb, 1e-9, "fraction %g", tc.fraction)
+	}
+	assert.Equal(t, "ConsistentProbabilityBased{0.25}", ConsistentProbabilityBased(.25).Description())
+}
+
+func TestConsistentProbabilityBasedUsesParentRValue(t *testing.T) {
+	sampler := ConsistentProbabilityBased(0.25)
+
+	params := SamplingParameters{ParentContext: otParentContext(t, "r:2;k:v", false)}
+	result := sampler.ShouldSample(params)
+	assert.Equal(t, RecordAndSample, result.Decision)
+	assert.Equal(t, "p:2;r:2;k:v", result.Tracestate.Get(otTraceStateKey))
+
+	// The p-value of a parent is replaced, and removed if not sampled.
+	params = SamplingParameters{ParentContext: otParentContext(t, "p:0;r:1", true)}
+	result = sampler.ShouldSample(params)
+	assert.Equal(t, Drop, result.Decision)
+	assert.Equal(t, "r:1", result.Tracestate.Get(otTraceStateKey))
+}
+
+func TestConsistentProbabilityBasedGeneratesRValue(t *testing.T) {
+	sampler := ConsistentProbabilityBased(1)
+	result := sampler.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	assert.Equal(t, RecordAndSample, result.Decision)
+
+	ots := parseOTTraceState(result.Tracestate.Get(otTraceStateKey))
+	assert.GreaterOrEqual(t, ots.rValue, 0)
+	assert.Equal(t, 0, ots.pValue)
+}
+
+func TestConsistentProbabilityBasedIsConsistent(t *testing.T) {
+	lower := ConsistentProbabilityBased(0.125)
+	higher := ConsistentProbabilityBased(0.5)
+	for r := 0; r <= maxRValue; r++ {
+		ot := otTraceState{rValue: r, pValue: -1}.String()
+		params := SamplingParameters{ParentContext: otParentContext(t, ot, false)}
+		if lower.ShouldSample(params).Decision == RecordAndSample {
+			assert.Equal(t, RecordAndSample, higher.ShouldSample(params).Decision, "r-value %d", r)
+		}
+	}
+}
+
+func TestConsistentProbabilityBasedFraction(t *testing.T) {
+	const (
+		fraction = 0.1
+		n        = 100000
+	)
+	sampler := ConsistentProbabilityBased(fraction).(*consistentProbabilitySampler)
+	sampler.rnd = rand.New(rand.NewSource(1))
+
+	params := SamplingParameters{ParentContext: context.Background()}
+	var sampled int
+	var adjusted float64
+	for i := 0; i < n; i++ {
+		result := sampler.ShouldSample(params)
+		if result.Decision != RecordAndSample {
+			continue
+		}
+		sampled++
+		count, ok := AdjustedCount(trace.NewSpanContext(trace.SpanContextConfig{TraceState: result.Tracestate}))
+		require.True(t, ok)
+		adjusted += count
+	}
+	assert.InDelta(t, fraction*n, sampled, 0.05*fraction*n)
+	assert.InDelta(t, n, adjusted, 0.05*n)
+}
+
+func TestConsistentProbabilityBasedParentBased(t *testing.T) {
+	sampler := ParentBased(ConsistentProbabilityBased(0))
+	params := SamplingParameters{ParentContext: otParentContext(t, "p:3;r:5", true)}
+	result := sampler.ShouldSample(params)
+	assert.Equal(t, RecordAndSample, result.Decision)
+	assert.Equal(t, "p:3;r:5", result.Tracestate.Get(otTraceStateKey))
+}
+
+func TestAdjustedCount(t *testing.T) {
+	testCases := []struct {
+		ot    string
+		count float64
+		ok    bool
+	}{
+		{"", 0, false},
+		{"r:4", 0, false},
+		{"p:0;r:4", 1, true},
+		{"p:3;r:4", 8, true},
+		{"p:63;r:4", 0, true},
+	}
+
+	for _, tc := range testCases {
+		sc := trace.SpanContextFromContext(otParentContext(t, tc.ot, true))
+		count, ok := AdjustedCount(sc)
+		assert.Equal(t, tc.count, count, tc.ot)
+		assert.Equal(t, tc.ok, ok, tc.ot)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -237,6 +238,10 @@ func TestTracestateIsPassed(t *testing.T) {
 			"traceIDRatioSampler",
 			TraceIDRatioBased(.5),
 		},
+		{
+			"rateLimitedSampler",
+			RateLimited(1),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -259,3 +264,62 @@ func TestTracestateIsPassed(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitedRootSpans(t *testing.T) {
+	now := time.Unix(0, 0)
+	sampler := RateLimited(2).(rateLimitedSampler)
+	sampler.limiter.now = func() time.Time { return now }
+	sampler.limiter.last = now
+	params := SamplingParameters{ParentContext: context.Background()}
+
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+	assert.Equal(t, Drop, sampler.ShouldSample(params).Decision)
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+	assert.Equal(t, Drop, sampler.ShouldSample(params).Decision)
+
+	// The bucket does not fill beyond the burst size.
+	now = now.Add(time.Hour)
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision)
+	assert.Equal(t, Drop, sampler.ShouldSample(params).Decision)
+}
+
+func TestRateLimitedFollowsParent(t *testing.T) {
+	sampler := RateLimited(1)
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	sampledCtx := trace.ContextWithSpanContext(
+		context.Background(),
+		trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+	)
+	notSampledCtx := trace.ContextWithSpanContext(
+		context.Background(),
+		trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+		}),
+	)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, RecordAndSample, sampler.ShouldSample(SamplingParameters{ParentContext: sampledCtx}).Decision)
+		assert.Equal(t, Drop, sampler.ShouldSample(SamplingParameters{ParentContext: notSampledCtx}).Decision)
+	}
+	// Children did not consume the budget of root spans.
+	assert.Equal(t, RecordAndSample, sampler.ShouldSample(SamplingParameters{ParentContext: context.Background()}).Decision)
+}
+
+func TestRateLimitedNonPositive(t *testing.T) {
+	for _, n := range []float64{0, -1} {
+		sampler := RateLimited(n)
+		assert.Equal(t, Drop, sampler.ShouldSample(SamplingParameters{ParentContext: context.Background()}).Decision)
+	}
+	assert.Equal(t, "RateLimited{0}", RateLimited(-1).Description())
+	assert.Equal(t, "RateLimited{2.5}", RateLimited(2.5).Description())
+}
@@ -26,22 +26,23 @@ import (
 // snapshot is an record of a spans state at a particular checkpointed time.
 // It is used as a read-only representation of that state.
 type snapshot struct {
-	name                   string
-	spanContext            trace.SpanContext
-	parent                 trace.SpanContext
-	spanKind               trace.SpanKind
-	startTime              time.Time
-	endTime                time.Time
-	attributes             []attribute.KeyValue
-	events                 []Event
-	links                  []trace.Link
-	status                 Status
-	childSpanCount         int
-	droppedAttributeCount  int
-	droppedEventCount      int
-	droppedLinkCount       int
-	resource               *resource.Resource
-	instrumentationLibrary instrumentation.Library
+	name                    string
+	spanContext             trace.SpanContext
+	parent                  trace.SpanContext
+	spanKind                trace.SpanKind
+	startTime               time.Time
+	endTime                 time.Time
+	attributes              []attribute.KeyValue
+	events                  []Event
+	links                   []trace.Link
+	status                  Status
+	childSpanCount          int
+	droppedAttributeCount   int
+	droppedEventCount       int
+	droppedLinkCount        int
+	truncatedAttributeCount int
+	resource                *resource.Resource
+	instrumentationLibrary  instrumentation.Library
 }
 
 var _ ReadOnlySpan = snapshot{}
@@ -131,6 +132,13 @@ func (s snapshot) DroppedEvents() int {
 	return s.droppedEventCount
 }
 
+// TruncatedAttributes returns the number of attribute values of the span,
+// and of its events and links, truncated to the attribute value length
+// limit.
+func (s snapshot) TruncatedAttributes() int {
+	return s.truncatedAttributeCount
+}
+
 // ChildSpanCount returns the count of spans that consider the span a
 // direct parent.
 func (s snapshot) ChildSpanCount() int {
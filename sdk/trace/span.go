@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -27,7 +29,6 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"go.opentelemetry.io/otel/sdk/instrumentation"
-	"go.opentelemetry.io/otel/sdk/internal"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -73,6 +74,10 @@ type ReadOnlySpan interface {
 	// DroppedEvents returns the number of events dropped by the span due to
 	// limits being reached.
 	DroppedEvents() int
+	// TruncatedAttributes returns the number of attribute values of the
+	// span, and of its events and links, truncated to the attribute value
+	// length limit.
+	TruncatedAttributes() int
 	// ChildSpanCount returns the count of spans that consider the span a
 	// direct parent.
 	ChildSpanCount() int
@@ -134,13 +139,27 @@ type span struct {
 
 	// attributes are capped at configured limit. When the capacity is reached
 	// an oldest entry is removed to create room for a new entry.
-	attributes *attributesMap
+	attributes attributesMap
 
 	// events are stored in FIFO queue capped by configured limit.
-	events *evictedQueue
+	events evictedQueue
+
+	// truncatedAttributeCount is the number of attribute values of the
+	// span, its events, and its links truncated to the attribute value
+	// length limit.
+	truncatedAttributeCount int
+
+	// pending holds the attribute and event writes that are not yet
+	// applied to attributes and events. They are applied with mu held by
+	// applyPendingWrites.
+	pending pendingWrites
+
+	// ended is set to 1 when the span is ended. It is accessed atomically
+	// so checking whether the span is recording does not acquire mu.
+	ended uint32
 
 	// links are stored in FIFO queue capped by configured limit.
-	links *evictedQueue
+	links evictedQueue
 
 	// executionTracerTaskEnd ends the execution tracer span.
 	executionTracerTaskEnd func()
@@ -168,10 +187,9 @@ func (s *span) IsRecording() bool {
 	if s == nil {
 		return false
 	}
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	return !s.startTime.IsZero() && s.endTime.IsZero()
+	// The start time of a recording span is set before it is returned by
+	// the tracer and not changed after.
+	return !s.startTime.IsZero() && atomic.LoadUint32(&s.ended) == 0
 }
 
 // SetStatus sets the status of the Span in the form of a code and a
@@ -196,14 +214,28 @@ func (s *span) SetStatus(code codes.Code, description string) {
 // SetAttributes sets attributes of this span.
 //
 // If a key from attributes already exists the value associated with that key
-// will be overwritten with the value contained in attributes.
+// will be overwritten with the value contained in attributes, so only the last
+// value set for each key is stored and exported.
 //
 // If this span is not being recorded than this method does nothing.
 func (s *span) SetAttributes(attributes ...attribute.KeyValue) {
-	if !s.IsRecording() {
+	if len(attributes) == 0 || !s.IsRecording() {
 		return
 	}
-	s.copyToCappedAttributes(attributes...)
+	if tryLock(&s.mu) {
+		// Uncontended, apply the attributes after the queued writes
+		// rather than queuing them.
+		if s.syncPendingWrites() {
+			s.applyAttributes(attributes)
+		} else {
+			s.discardAttributes(attributes)
+		}
+		s.mu.Unlock()
+		return
+	}
+	w := &pendingWrite{attributes: make([]attribute.KeyValue, len(attributes))}
+	copy(w.attributes, attributes)
+	s.write(w)
 }
 
 // End ends the span. This method does nothing if the span is already ended or
@@ -223,7 +255,7 @@ func (s *span) End(options ...trace.SpanEndOption) {
 
 	// Store the end time as soon as possible to avoid artificially increasing
 	// the span's duration in case some operation below takes a while.
-	et := internal.MonotonicEndTime(s.startTime)
+	et := endTime(s.tracer.provider.clock, s.startTime)
 
 	// Do relative expensive check now that we have an end time and see if we
 	// need to do any more processing.
@@ -250,12 +282,16 @@ func (s *span) End(options ...trace.SpanEndOption) {
 	config := trace.NewSpanEndConfig(options...)
 
 	s.mu.Lock()
-	// Setting endTime to non-zero marks the span as ended and not recording.
 	if config.Timestamp().IsZero() {
 		s.endTime = et
 	} else {
 		s.endTime = config.Timestamp()
 	}
+	// Setting ended marks the span as ended and not recording. The writes
+	// pending at that point are applied before the lock is released, the
+	// later ones are discarded by their writer.
+	atomic.StoreUint32(&s.ended, 1)
+	s.applyPendingWrites()
 	s.mu.Unlock()
 
 	sps, ok := s.tracer.provider.spanProcessors.Load().(spanProcessorStates)
@@ -280,6 +316,18 @@ func (s *span) RecordError(err error, opts ...trace.EventOption) {
 		semconv.ExceptionTypeKey.String(typeStr(err)),
 		semconv.ExceptionMessageKey.String(err.Error()),
 	))
+
+	c := trace.NewEventConfig(opts...)
+	if c.StackTrace() {
+		opts = append(opts, trace.WithAttributes(
+			semconv.ExceptionStacktraceKey.String(string(debug.Stack())),
+		))
+	}
+	if c.Escaped() {
+		opts = append(opts, trace.WithAttributes(
+			semconv.ExceptionEscapedKey.Bool(true),
+		))
+	}
 	s.addEvent(semconv.ExceptionEventName, opts...)
 }
 
@@ -302,23 +350,37 @@ func (s *span) AddEvent(name string, o ...trace.EventOption) {
 }
 
 func (s *span) addEvent(name string, o ...trace.EventOption) {
+	if clock := s.tracer.provider.clock; !isRealClock(clock) {
+		// Default the timestamp to the configured clock. Options passed by
+		// the caller are applied after and take precedence.
+		o = append([]trace.EventOption{trace.WithTimestamp(clock.Now())}, o...)
+	}
 	c := trace.NewEventConfig(o...)
 
-	// Discard over limited attributes
-	attributes := c.Attributes()
+	// Discard duplicate and over limited attributes
+	attributes := dedupAttrs(c.Attributes())
 	var discarded int
 	if len(attributes) > s.spanLimits.AttributePerEventCountLimit {
 		discarded = len(attributes) - s.spanLimits.AttributePerEventCountLimit
 		attributes = attributes[:s.spanLimits.AttributePerEventCountLimit]
 	}
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.events.add(Event{
+	attributes, truncated := truncateAttrs(s.spanLimits.AttributeValueLengthLimit, attributes)
+	e := Event{
 		Name:                  name,
 		Attributes:            attributes,
 		DroppedAttributeCount: discarded,
 		Time:                  c.Timestamp(),
-	})
+	}
+	if tryLock(&s.mu) {
+		if s.syncPendingWrites() {
+			s.applyEvent(e, truncated)
+		} else {
+			s.events.droppedCount++
+		}
+		s.mu.Unlock()
+		return
+	}
+	s.write(&pendingWrite{event: e, isEvent: true, truncated: truncated})
 }
 
 // SetName sets the name of this span. If this span is not being recorded than
@@ -373,6 +435,7 @@ func (s *span) EndTime() time.Time {
 func (s *span) Attributes() []attribute.KeyValue {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.applyPendingWrites()
 	if s.attributes.evictList.Len() == 0 {
 		return []attribute.KeyValue{}
 	}
@@ -393,6 +456,7 @@ func (s *span) Links() []trace.Link {
 func (s *span) Events() []Event {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.applyPendingWrites()
 	if len(s.events.queue) == 0 {
 		return []Event{}
 	}
@@ -422,6 +486,16 @@ func (s *span) Resource() *resource.Resource {
 	return s.resource
 }
 
+// AddLink adds link to the span. If this span is not being recorded, or the
+// link has neither a valid SpanContext nor attributes, this method does
+// nothing.
+func (s *span) AddLink(link trace.Link) {
+	if !link.SpanContext.IsValid() && len(link.Attributes) == 0 {
+		return
+	}
+	s.addLink(link)
+}
+
 func (s *span) addLink(link trace.Link) {
 	if !s.IsRecording() {
 		return
@@ -429,11 +503,15 @@ func (s *span) addLink(link trace.Link) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Discard over limited attributes
+	// Discard duplicate and over limited attributes
+	link.Attributes = dedupAttrs(link.Attributes)
 	if len(link.Attributes) > s.spanLimits.AttributePerLinkCountLimit {
 		link.DroppedAttributeCount = len(link.Attributes) - s.spanLimits.AttributePerLinkCountLimit
 		link.Attributes = link.Attributes[:s.spanLimits.AttributePerLinkCountLimit]
 	}
+	var truncated int
+	link.Attributes, truncated = truncateAttrs(s.spanLimits.AttributeValueLengthLimit, link.Attributes)
+	s.truncatedAttributeCount += truncated
 
 	s.links.add(link)
 }
@@ -443,9 +521,20 @@ func (s *span) addLink(link trace.Link) {
 func (s *span) DroppedAttributes() int {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.applyPendingWrites()
 	return s.attributes.droppedCount
 }
 
+// TruncatedAttributes returns the number of attribute values of the span,
+// and of its events and links, truncated to the attribute value length
+// limit.
+func (s *span) TruncatedAttributes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.applyPendingWrites()
+	return s.truncatedAttributeCount
+}
+
 // DroppedLinks returns the number of links dropped by the span due to limits
 // being reached.
 func (s *span) DroppedLinks() int {
@@ -459,6 +548,7 @@ func (s *span) DroppedLinks() int {
 func (s *span) DroppedEvents() int {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.applyPendingWrites()
 	return s.events.droppedCount
 }
 
@@ -475,6 +565,7 @@ func (s *span) snapshot() ReadOnlySpan {
 	var sd snapshot
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.syncPendingWrites()
 
 	sd.endTime = s.endTime
 	sd.instrumentationLibrary = s.instrumentationLibrary
@@ -486,6 +577,7 @@ func (s *span) snapshot() ReadOnlySpan {
 	sd.startTime = s.startTime
 	sd.status = s.status
 	sd.childSpanCount = s.childSpanCount
+	sd.truncatedAttributeCount = s.truncatedAttributeCount
 
 	if s.attributes.evictList.Len() > 0 {
 		sd.attributes = s.attributes.toKeyValue()
@@ -518,18 +610,238 @@ func (s *span) interfaceArrayToEventArray() []Event {
 	return eventArr
 }
 
-func (s *span) copyToCappedAttributes(attributes ...attribute.KeyValue) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// write queues w to be applied to the span without acquiring mu. It is
+// used when mu is held by another goroutine, otherwise writes are applied
+// directly. The pending writes are applied when the span is next read or
+// written with mu free, or by the writer once pendingWriteLimit writes are
+// pending.
+//
+// A write racing with End is part of the ended span if it was queued
+// before End marked the span as ended. Otherwise, it was queued after End
+// applied the pending writes: the writer discards it and counts it in the
+// dropped attribute or event count of the span.
+func (s *span) write(w *pendingWrite) {
+	n := s.pending.push(w)
+	if atomic.LoadUint32(&s.ended) == 1 {
+		// End applies the writes queued before ended was set while
+		// holding mu, only the writes queued after are pending once it
+		// is acquired.
+		s.mu.Lock()
+		s.discardPendingWrites()
+		s.mu.Unlock()
+		return
+	}
+	if n >= pendingWriteLimit {
+		s.mu.Lock()
+		s.applyPendingWrites()
+		s.mu.Unlock()
+	}
+}
+
+// syncPendingWrites applies the pending writes and returns true if the span
+// is not ended. Otherwise, End applied the writes queued before the span
+// ended, and the pending ones are discarded. It must be called with mu held.
+func (s *span) syncPendingWrites() bool {
+	if atomic.LoadUint32(&s.ended) == 1 {
+		s.discardPendingWrites()
+		return false
+	}
+	s.applyPendingWrites()
+	return true
+}
+
+// applyPendingWrites applies the pending writes to the attributes and events
+// of the span in the order they were made. It must be called with mu held.
+func (s *span) applyPendingWrites() {
+	for w := s.pending.drain(); w != nil; w = w.next {
+		if w.isEvent {
+			s.applyEvent(w.event, w.truncated)
+		} else {
+			s.applyAttributes(w.attributes)
+		}
+	}
+}
+
+// applyAttributes sets attributes on the span. It must be called with mu
+// held.
+func (s *span) applyAttributes(attributes []attribute.KeyValue) {
 	for _, a := range attributes {
 		// Ensure attributes conform to the specification:
 		// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.0.1/specification/common/common.md#attributes
+		if !a.Valid() {
+			continue
+		}
+		a, truncated := truncateAttr(s.spanLimits.AttributeValueLengthLimit, a)
+		if truncated {
+			s.truncatedAttributeCount++
+		}
+		s.attributes.add(a)
+	}
+}
+
+// applyEvent adds e, with truncated attribute values truncated, to the
+// span. It must be called with mu held.
+func (s *span) applyEvent(e Event, truncated int) {
+	s.events.add(e)
+	s.truncatedAttributeCount += truncated
+}
+
+// discardPendingWrites removes the pending writes of an ended span and
+// counts them as dropped. It must be called with mu held.
+func (s *span) discardPendingWrites() {
+	for w := s.pending.drain(); w != nil; w = w.next {
+		if w.isEvent {
+			s.events.droppedCount++
+		} else {
+			s.discardAttributes(w.attributes)
+		}
+	}
+}
+
+// discardAttributes counts the valid attributes written to an ended span
+// as dropped. It must be called with mu held.
+func (s *span) discardAttributes(attributes []attribute.KeyValue) {
+	for _, a := range attributes {
 		if a.Valid() {
-			s.attributes.add(a)
+			s.attributes.droppedCount++
 		}
 	}
 }
 
+// dedupScanLimit is the number of attributes up to which dedupAttrs looks
+// for duplicate keys by comparing each pair of attributes rather than by
+// indexing them in a map, which would allocate.
+const dedupScanLimit = 16
+
+// dedupAttrs returns attrs with only the last attribute of each key, in the
+// order of those last attributes. The passed slice is not modified, a copy is
+// returned if any key is duplicated.
+func dedupAttrs(attrs []attribute.KeyValue) []attribute.KeyValue {
+	if len(attrs) < 2 {
+		return attrs
+	}
+
+	var last map[attribute.Key]int
+	isLast := func(i int) bool {
+		if last != nil {
+			return last[attrs[i].Key] == i
+		}
+		for j := i + 1; j < len(attrs); j++ {
+			if attrs[j].Key == attrs[i].Key {
+				return false
+			}
+		}
+		return true
+	}
+
+	if len(attrs) > dedupScanLimit {
+		last = make(map[attribute.Key]int, len(attrs))
+		for i, a := range attrs {
+			last[a.Key] = i
+		}
+		if len(last) == len(attrs) {
+			return attrs
+		}
+	} else {
+		unique := true
+		for i := range attrs {
+			if !isLast(i) {
+				unique = false
+				break
+			}
+		}
+		if unique {
+			return attrs
+		}
+	}
+
+	deduped := make([]attribute.KeyValue, 0, len(attrs))
+	for i, a := range attrs {
+		if isLast(i) {
+			deduped = append(deduped, a)
+		}
+	}
+	return deduped
+}
+
+// truncateAttrs returns attrs with string values truncated to limit, and
+// the number of values truncated. The passed slice is not modified, a copy
+// is returned if any value needs to be truncated.
+func truncateAttrs(limit int, attrs []attribute.KeyValue) ([]attribute.KeyValue, int) {
+	if limit < 0 {
+		return attrs, 0
+	}
+	var (
+		truncated []attribute.KeyValue
+		n         int
+	)
+	for i, a := range attrs {
+		t, ok := truncateAttr(limit, a)
+		if !ok {
+			continue
+		}
+		if truncated == nil {
+			truncated = make([]attribute.KeyValue, len(attrs))
+			copy(truncated, attrs)
+		}
+		truncated[i] = t
+		n++
+	}
+	if truncated == nil {
+		return attrs, 0
+	}
+	return truncated, n
+}
+
+// truncateAttr returns attr with its string value, or the elements of its
+// string array value, truncated to limit characters, and whether it was
+// truncated. If limit is negative attr is returned unmodified.
+func truncateAttr(limit int, attr attribute.KeyValue) (attribute.KeyValue, bool) {
+	if limit < 0 {
+		return attr, false
+	}
+	switch attr.Value.Type() {
+	case attribute.STRING:
+		if v := attr.Value.AsString(); len(v) > limit {
+			return attr.Key.String(truncate(limit, v)), true
+		}
+	case attribute.ARRAY:
+		rv := reflect.ValueOf(attr.Value.AsArray())
+		if rv.Kind() != reflect.Array || rv.Type().Elem().Kind() != reflect.String {
+			return attr, false
+		}
+		var changed bool
+		vals := make([]string, rv.Len())
+		for i := range vals {
+			v := rv.Index(i).String()
+			if len(v) > limit {
+				v = truncate(limit, v)
+				changed = true
+			}
+			vals[i] = v
+		}
+		if changed {
+			return attr.Key.Array(vals), true
+		}
+	}
+	return attr, false
+}
+
+// truncate returns s truncated to at most limit characters.
+func truncate(limit int, s string) string {
+	if len(s) <= limit {
+		return s
+	}
+	var n int
+	for i := range s {
+		if n == limit {
+			return s[:i]
+		}
+		n++
+	}
+	return s
+}
+
 func (s *span) addChild() {
 	if !s.IsRecording() {
 		return
@@ -547,9 +859,14 @@ func startSpanInternal(ctx context.Context, tr *tracer, name string, o *trace.Sp
 	provider := tr.provider
 
 	// If told explicitly to make this a new root use a zero value SpanContext
-	// as a parent which contains an invalid trace ID and is not remote.
+	// as a parent which contains an invalid trace ID and is not remote. The
+	// sampler is passed a context without the parent as well, so the new
+	// root is not sampled based on it.
 	var psc trace.SpanContext
-	if !o.NewRoot() {
+	samplingCtx := ctx
+	if o.NewRoot() {
+		samplingCtx = trace.ContextWithSpanContext(ctx, psc)
+	} else {
 		psc = trace.SpanContextFromContext(ctx)
 	}
 
@@ -558,21 +875,25 @@ func startSpanInternal(ctx context.Context, tr *tracer, name string, o *trace.Sp
 	// on a unique span ID, even if the Span is non-recording.
 	var tid trace.TraceID
 	var sid trace.SpanID
+	flags := psc.TraceFlags()
 	if !psc.TraceID().IsValid() {
 		tid, sid = provider.idGenerator.NewIDs(ctx)
+		flags = flags.WithRandom(randomTraceIDs(provider.idGenerator))
 	} else {
 		tid = psc.TraceID()
 		sid = provider.idGenerator.NewSpanID(ctx, tid)
 	}
 
 	spanLimits := provider.spanLimits
-	span.attributes = newAttributesMap(spanLimits.AttributeCountLimit)
-	span.events = newEvictedQueue(spanLimits.EventCountLimit)
-	span.links = newEvictedQueue(spanLimits.LinkCountLimit)
+	// The attributes, events, and links are held by value and initialized
+	// on first use so a span is a single allocation.
+	span.attributes.capacity = spanLimits.AttributeCountLimit
+	span.events.capacity = spanLimits.EventCountLimit
+	span.links.capacity = spanLimits.LinkCountLimit
 	span.spanLimits = spanLimits
 
 	samplingResult := provider.sampler.ShouldSample(SamplingParameters{
-		ParentContext: ctx,
+		ParentContext: samplingCtx,
 		TraceID:       tid,
 		Name:          name,
 		Kind:          o.SpanKind(),
@@ -585,11 +906,7 @@ func startSpanInternal(ctx context.Context, tr *tracer, name string, o *trace.Sp
 		SpanID:     sid,
 		TraceState: samplingResult.Tracestate,
 	}
-	if isSampled(samplingResult) {
-		scc.TraceFlags = psc.TraceFlags() | trace.FlagsSampled
-	} else {
-		scc.TraceFlags = psc.TraceFlags() &^ trace.FlagsSampled
-	}
+	scc.TraceFlags = flags.WithSampled(isSampled(samplingResult))
 	span.spanContext = trace.NewSpanContext(scc)
 
 	if !isRecording(samplingResult) {
@@ -598,7 +915,7 @@ func startSpanInternal(ctx context.Context, tr *tracer, name string, o *trace.Sp
 
 	startTime := o.Timestamp()
 	if startTime.IsZero() {
-		startTime = time.Now()
+		startTime = provider.clock.Now()
 	}
 	span.startTime = startTime
 
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// multiExporter is a SpanExporter exporting spans to several
+// SpanExporters.
+type multiExporter struct {
+	exporters []SpanExporter
+}
+
+var _ SpanExporter = (*multiExporter)(nil)
+
+// NewMultiExporter returns a SpanExporter that exports spans to each of
+// exporters concurrently, for example to write to a new backend while
+// migrating from the current one.
+//
+// Every exporter is passed every batch of spans, even if other exporters
+// fail. The errors of the exporters that fail are combined in the error
+// returned. Use NewTimeoutExporter to bound the time spent exporting to
+// each exporter.
+func NewMultiExporter(exporters ...SpanExporter) SpanExporter {
+	return &multiExporter{exporters: exporters}
+}
+
+// ExportSpans exports spans to each exporter concurrently and waits for
+// all of them to return.
+func (e *multiExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	return e.each(func(exp SpanExporter) error {
+		return exp.ExportSpans(ctx, spans)
+	})
+}
+
+// Shutdown shuts down each exporter concurrently and waits for all of them
+// to return.
+func (e *multiExporter) Shutdown(ctx context.Context) error {
+	return e.each(func(exp SpanExporter) error {
+		return exp.Shutdown(ctx)
+	})
+}
+
+// each calls f for each exporter concurrently, and returns the errors it
+// returned.
+func (e *multiExporter) each(f func(SpanExporter) error) error {
+	errs := make([]error, len(e.exporters))
+	var wg sync.WaitGroup
+	for i, exp := range e.exporters {
+		wg.Add(1)
+		go func(i int, exp SpanExporter) {
+			defer wg.Done()
+			errs[i] = f(exp)
+		}(i, exp)
+	}
+	wg.Wait()
+
+	var msgs []string
+	for i, err := range errs {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("exporter %d: %v", i, err))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("multi exporter: %s", strings.Join(msgs, "; "))
+}
+
+// timeoutExporter bounds the time spent by a SpanExporter to export and
+// shut down.
+type timeoutExporter struct {
+	SpanExporter
+	timeout time.Duration
+}
+
+// NewTimeoutExporter returns a SpanExporter that exports spans with, and
+// shuts down, exporter with a context that is canceled after timeout, in
+// addition to the context it is passed.
+func NewTimeoutExporter(exporter SpanExporter, timeout time.Duration) SpanExporter {
+	return timeoutExporter{SpanExporter: exporter, timeout: timeout}
+}
+
+func (e timeoutExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	return e.SpanExporter.ExportSpans(ctx, spans)
+}
+
+func (e timeoutExporter) Shutdown(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	return e.SpanExporter.Shutdown(ctx)
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type failingExporter struct {
+	err error
+}
+
+func (e failingExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	return e.err
+}
+
+func (e failingExporter) Shutdown(context.Context) error {
+	return e.err
+}
+
+// blockingExporter blocks until the context it is passed is done.
+type blockingExporter struct{}
+
+func (blockingExporter) ExportSpans(ctx context.Context, _ []sdktrace.ReadOnlySpan) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (blockingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestMultiExporter(t *testing.T) {
+	exp1 := tracetest.NewInMemoryExporter()
+	exp2 := tracetest.NewInMemoryExporter()
+	multi := sdktrace.NewMultiExporter(exp1, exp2)
+
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+	require.NoError(t, multi.ExportSpans(context.Background(), spans))
+	assert.Len(t, exp1.GetSpans(), 1)
+	assert.Len(t, exp2.GetSpans(), 1)
+	assert.NoError(t, multi.Shutdown(context.Background()))
+}
+
+func TestMultiExporterErrors(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	multi := sdktrace.NewMultiExporter(
+		failingExporter{err: errors.New("unavailable")},
+		exp,
+		sdktrace.NewTimeoutExporter(blockingExporter{}, time.Millisecond),
+	)
+
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+	err := multi.ExportSpans(context.Background(), spans)
+	assert.EqualError(t, err, "multi exporter: exporter 0: unavailable; exporter 2: context deadline exceeded")
+	assert.Len(t, exp.GetSpans(), 1, "spans are exported when other exporters fail")
+
+	err = multi.Shutdown(context.Background())
+	assert.EqualError(t, err, "multi exporter: exporter 0: unavailable")
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// BaggageFilter reports whether a baggage entry should be copied to span
+// attributes by a SpanProcessor returned from NewBaggageSpanProcessor.
+type BaggageFilter func(attribute.KeyValue) bool
+
+// BaggageKeys returns a BaggageFilter that selects the baggage entries with
+// one of keys.
+func BaggageKeys(keys ...attribute.Key) BaggageFilter {
+	return BaggageFilter(attribute.NewAllowKeysFilter(keys...))
+}
+
+// baggageSpanProcessor is a SpanProcessor that copies baggage entries of
+// the parent context to the attributes of started spans.
+type baggageSpanProcessor struct {
+	filter BaggageFilter
+}
+
+var _ SpanProcessor = (*baggageSpanProcessor)(nil)
+
+// NewBaggageSpanProcessor returns a new SpanProcessor that, when a span
+// starts, copies the baggage entries of the parent context selected by
+// filter to the span attributes. If filter is nil all baggage entries are
+// copied. It allows cross-cutting dimensions that are propagated as
+// baggage, like a tenant ID, to appear on every span.
+//
+// The processor should be registered before the processors that export
+// spans. Baggage is often propagated from untrusted sources, so filter
+// should be used to only copy the expected entries.
+func NewBaggageSpanProcessor(filter BaggageFilter) SpanProcessor {
+	return &baggageSpanProcessor{filter: filter}
+}
+
+// OnStart sets the selected baggage entries of parent as attributes of s.
+func (b *baggageSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	set := baggage.Set(parent)
+	if set.Len() == 0 {
+		return
+	}
+	attrs := make([]attribute.KeyValue, 0, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		if b.filter == nil || b.filter(kv) {
+			attrs = append(attrs, kv)
+		}
+	}
+	s.SetAttributes(attrs...)
+}
+
+// OnEnd does nothing.
+func (b *baggageSpanProcessor) OnEnd(ReadOnlySpan) {}
+
+// Shutdown does nothing.
+func (b *baggageSpanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (b *baggageSpanProcessor) ForceFlush(context.Context) error { return nil }
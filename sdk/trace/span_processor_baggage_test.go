@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestBaggageSpanProcessor(t *testing.T) {
+	ctx := baggage.ContextWithValues(
+		context.Background(),
+		attribute.String("tenant.id", "acme"),
+		attribute.String("session.token", "secret"),
+	)
+
+	testCases := []struct {
+		name   string
+		filter sdktrace.BaggageFilter
+		want   []attribute.KeyValue
+	}{
+		{
+			name: "all",
+			want: []attribute.KeyValue{
+				attribute.String("session.token", "secret"),
+				attribute.String("tenant.id", "acme"),
+			},
+		},
+		{
+			name:   "keys",
+			filter: sdktrace.BaggageKeys("tenant.id", "missing"),
+			want:   []attribute.KeyValue{attribute.String("tenant.id", "acme")},
+		},
+		{
+			name: "predicate",
+			filter: func(kv attribute.KeyValue) bool {
+				return kv.Value.AsString() != "secret"
+			},
+			want: []attribute.KeyValue{attribute.String("tenant.id", "acme")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(
+				sdktrace.WithSpanProcessor(sdktrace.NewBaggageSpanProcessor(tc.filter)),
+				sdktrace.WithSpanProcessor(sr),
+			)
+			_, span := tp.Tracer("TestBaggageSpanProcessor").Start(ctx, "span")
+			span.End()
+
+			ended := sr.Ended()
+			require.Len(t, ended, 1)
+			assert.ElementsMatch(t, tc.want, ended[0].Attributes())
+		})
+	}
+}
+
+func TestBaggageSpanProcessorNoBaggage(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(sdktrace.NewBaggageSpanProcessor(nil)),
+		sdktrace.WithSpanProcessor(sr),
+	)
+	_, span := tp.Tracer("TestBaggageSpanProcessor").Start(context.Background(), "span")
+	span.End()
+
+	ended := sr.Ended()
+	require.Len(t, ended, 1)
+	assert.Empty(t, ended[0].Attributes())
+}
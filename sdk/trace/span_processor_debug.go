@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+)
+
+// NewDebugSpanProcessor returns a new SpanProcessor that exports ended spans
+// lasting at least threshold, or with an Error status, to exporter through
+// a batch span processor configured with options. It is meant to mirror
+// slow and failed spans to a secondary exporter, like a stdout exporter or
+// a different OTLP endpoint, to troubleshoot them.
+//
+// Spans are exported whether they are sampled or not, but span processors
+// only receive spans that are recorded. To mirror spans independently of
+// the sampling decision, the TracerProvider must use a Sampler that
+// records all spans, like the RecordAll Sampler of the
+// go.opentelemetry.io/otel/sdk/trace/spanmetrics package.
+func NewDebugSpanProcessor(exporter SpanExporter, threshold time.Duration, options ...BatchSpanProcessorOption) SpanProcessor {
+	options = append(options, func(o *BatchSpanProcessorOptions) {
+		o.exportUnsampled = true
+	})
+	return NewFilterProcessor(NewBatchSpanProcessor(exporter, options...), func(s ReadOnlySpan) bool {
+		return s.Status().Code == codes.Error || s.EndTime().Sub(s.StartTime()) >= threshold
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordOnlySampler records all spans without sampling them.
+type recordOnlySampler struct{}
+
+func (recordOnlySampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return sdktrace.SamplingResult{Decision: sdktrace.RecordOnly}
+}
+
+func (recordOnlySampler) Description() string { return "RecordOnly" }
+
+func TestDebugSpanProcessor(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	dp := sdktrace.NewDebugSpanProcessor(exp, 100*time.Millisecond)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(recordOnlySampler{}),
+		sdktrace.WithSpanProcessor(dp),
+	)
+	tr := tp.Tracer("TestDebugSpanProcessor")
+
+	ctx := context.Background()
+	start := time.Unix(0, 0)
+	_, span := tr.Start(ctx, "fast", trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(start.Add(time.Millisecond)))
+	_, span = tr.Start(ctx, "slow", trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(start.Add(time.Second)))
+	_, span = tr.Start(ctx, "failed", trace.WithTimestamp(start))
+	span.SetStatus(codes.Error, "failed")
+	span.End(trace.WithTimestamp(start.Add(time.Millisecond)))
+
+	require.NoError(t, tp.ForceFlush(ctx))
+	var names []string
+	for _, s := range exp.GetSpans() {
+		names = append(names, s.Name)
+		assert.False(t, s.SpanContext.IsSampled())
+	}
+	assert.Equal(t, []string{"slow", "failed"}, names)
+	assert.NoError(t, tp.Shutdown(ctx))
+}
+
+func TestBatchSpanProcessorDropsUnsampled(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(recordOnlySampler{}),
+		sdktrace.WithBatcher(exp),
+	)
+	_, span := tp.Tracer("TestBatchSpanProcessorDropsUnsampled").Start(context.Background(), "span")
+	span.End()
+
+	require.NoError(t, tp.ForceFlush(context.Background()))
+	assert.Len(t, exp.GetSpans(), 0)
+}
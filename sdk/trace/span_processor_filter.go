@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import "context"
+
+// filterSpanProcessor is a SpanProcessor that only passes ended spans
+// matching a predicate to another SpanProcessor.
+type filterSpanProcessor struct {
+	next SpanProcessor
+	pred func(ReadOnlySpan) bool
+}
+
+var _ SpanProcessor = (*filterSpanProcessor)(nil)
+
+// NewFilterProcessor returns a new SpanProcessor that passes ended spans to
+// the OnEnd method of next only if pred returns true for them. It can be
+// used to drop spans, like health-checks, short lived spans, or spans from
+// specific instrumentation libraries, before they reach an exporting
+// SpanProcessor.
+//
+// All other calls, including OnStart, are passed to next unchanged. If pred
+// is nil all spans are passed to next.
+func NewFilterProcessor(next SpanProcessor, pred func(ReadOnlySpan) bool) SpanProcessor {
+	return &filterSpanProcessor{next: next, pred: pred}
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (f *filterSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	f.next.OnStart(parent, s)
+}
+
+// OnEnd passes s to the wrapped SpanProcessor if it matches the predicate.
+func (f *filterSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if f.pred != nil && !f.pred(s) {
+		return
+	}
+	f.next.OnEnd(s)
+}
+
+// Shutdown shuts down the wrapped SpanProcessor.
+func (f *filterSpanProcessor) Shutdown(ctx context.Context) error {
+	return f.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped SpanProcessor.
+func (f *filterSpanProcessor) ForceFlush(ctx context.Context) error {
+	return f.next.ForceFlush(ctx)
+}
@@ -99,3 +99,15 @@ func ExampleSpanProcessor_filtered() {
 	_ = NewTracerProvider(WithSpanProcessor(filter))
 	// ...
 }
+
+func ExampleNewFilterProcessor() {
+	exportSP := NewSimpleSpanProcessor(noopExporter{})
+
+	// Drop the spans of health-check requests before they are exported.
+	filter := NewFilterProcessor(exportSP, func(s ReadOnlySpan) bool {
+		return s.Name() != "/healthz"
+	})
+
+	_ = NewTracerProvider(WithSpanProcessor(filter))
+	// ...
+}
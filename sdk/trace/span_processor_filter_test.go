@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestFilterProcessor(t *testing.T) {
+	next := &testSpanProcessor{}
+	fp := sdktrace.NewFilterProcessor(next, func(s sdktrace.ReadOnlySpan) bool {
+		return s.Name() != "health-check"
+	})
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(fp))
+	tr := tp.Tracer("TestFilterProcessor")
+
+	ctx := context.Background()
+	_, span := tr.Start(ctx, "health-check")
+	span.End()
+	_, span = tr.Start(ctx, "request")
+	span.End()
+
+	// All spans are started on the wrapped processor.
+	assert.Len(t, next.spansStarted, 2)
+	require.Len(t, next.spansEnded, 1)
+	assert.Equal(t, "request", next.spansEnded[0].Name())
+
+	require.NoError(t, tp.Shutdown(ctx))
+	assert.Equal(t, 1, next.shutdownCount)
+}
+
+func TestFilterProcessorNilPredicate(t *testing.T) {
+	next := &testSpanProcessor{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sdktrace.NewFilterProcessor(next, nil)))
+
+	_, span := tp.Tracer("TestFilterProcessorNilPredicate").Start(context.Background(), "span")
+	span.End()
+	assert.Len(t, next.spansEnded, 1)
+}
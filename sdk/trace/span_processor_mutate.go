@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SpanMutator rewrites the name and attributes of ended spans before they
+// are exported. It is used with NewMutatingSpanProcessor.
+type SpanMutator interface {
+	// Mutate modifies s. It is called once for each ended span, after all
+	// SpanMutators registered before it.
+	Mutate(s *MutableSpan)
+}
+
+// SpanMutatorFunc is an adapter to use an ordinary function as a
+// SpanMutator.
+type SpanMutatorFunc func(s *MutableSpan)
+
+var _ SpanMutator = SpanMutatorFunc(nil)
+
+// Mutate calls f(s).
+func (f SpanMutatorFunc) Mutate(s *MutableSpan) {
+	f(s)
+}
+
+// MutableSpan is a copy of the name and attributes of an ended span that a
+// SpanMutator can modify. All other properties of the span are available
+// from the ReadOnlySpan returned by Span.
+type MutableSpan struct {
+	span       ReadOnlySpan
+	name       string
+	attributes []attribute.KeyValue
+}
+
+// Span returns the ended span, without the modifications made to the
+// MutableSpan.
+func (s *MutableSpan) Span() ReadOnlySpan {
+	return s.span
+}
+
+// Name returns the current name of the span.
+func (s *MutableSpan) Name() string {
+	return s.name
+}
+
+// SetName renames the span.
+func (s *MutableSpan) SetName(name string) {
+	s.name = name
+}
+
+// Attributes returns the current attributes of the span. The returned
+// slice must not be modified.
+func (s *MutableSpan) Attributes() []attribute.KeyValue {
+	return s.attributes
+}
+
+// SetAttributes sets kv as attributes of the span. The value of an
+// attribute with the same key as one of kv is replaced, other attributes
+// are added.
+func (s *MutableSpan) SetAttributes(kv ...attribute.KeyValue) {
+	attrs := make([]attribute.KeyValue, len(s.attributes), len(s.attributes)+len(kv))
+	copy(attrs, s.attributes)
+	index := make(map[attribute.Key]int, len(attrs))
+	for i, a := range attrs {
+		index[a.Key] = i
+	}
+	for _, a := range kv {
+		if i, ok := index[a.Key]; ok {
+			attrs[i] = a
+			continue
+		}
+		index[a.Key] = len(attrs)
+		attrs = append(attrs, a)
+	}
+	s.attributes = attrs
+}
+
+// RemoveAttributes removes the attributes with any of keys from the span.
+func (s *MutableSpan) RemoveAttributes(keys ...attribute.Key) {
+	remove := make(map[attribute.Key]struct{}, len(keys))
+	for _, k := range keys {
+		remove[k] = struct{}{}
+	}
+	attrs := make([]attribute.KeyValue, 0, len(s.attributes))
+	for _, a := range s.attributes {
+		if _, ok := remove[a.Key]; !ok {
+			attrs = append(attrs, a)
+		}
+	}
+	s.attributes = attrs
+}
+
+// mutatingSpanProcessor is a SpanProcessor that applies SpanMutators to
+// ended spans before passing them to another SpanProcessor.
+type mutatingSpanProcessor struct {
+	next     SpanProcessor
+	mutators []SpanMutator
+}
+
+var _ SpanProcessor = (*mutatingSpanProcessor)(nil)
+
+// NewMutatingSpanProcessor returns a new SpanProcessor that applies
+// mutators, in order, to each ended span before passing the result to the
+// OnEnd method of next. It can be used to rename spans or enrich their
+// attributes in-process, for example before they are batched for export:
+//
+//	NewMutatingSpanProcessor(NewBatchSpanProcessor(exporter),
+//		SpanMutatorFunc(func(s *MutableSpan) {
+//			if strings.HasPrefix(s.Name(), "HTTP GET /users/") {
+//				s.SetName("HTTP GET /users/{id}")
+//			}
+//		}),
+//	)
+//
+// All other calls, including OnStart, are passed to next unchanged.
+func NewMutatingSpanProcessor(next SpanProcessor, mutators ...SpanMutator) SpanProcessor {
+	return &mutatingSpanProcessor{next: next, mutators: mutators}
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (m *mutatingSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	m.next.OnStart(parent, s)
+}
+
+// OnEnd passes a copy of s modified by the SpanMutators to the wrapped
+// SpanProcessor.
+func (m *mutatingSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if len(m.mutators) == 0 {
+		m.next.OnEnd(s)
+		return
+	}
+
+	ms := &MutableSpan{
+		span:       s,
+		name:       s.Name(),
+		attributes: s.Attributes(),
+	}
+	for _, mutator := range m.mutators {
+		mutator.Mutate(ms)
+	}
+	m.next.OnEnd(&mutatedSpan{
+		ReadOnlySpan: s,
+		name:         ms.name,
+		attributes:   ms.attributes,
+	})
+}
+
+// Shutdown shuts down the wrapped SpanProcessor.
+func (m *mutatingSpanProcessor) Shutdown(ctx context.Context) error {
+	return m.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped SpanProcessor.
+func (m *mutatingSpanProcessor) ForceFlush(ctx context.Context) error {
+	return m.next.ForceFlush(ctx)
+}
+
+// mutatedSpan is a ReadOnlySpan with the name and attributes set by
+// SpanMutators.
+type mutatedSpan struct {
+	ReadOnlySpan
+
+	name       string
+	attributes []attribute.KeyValue
+}
+
+// Name returns the mutated name of the span.
+func (s *mutatedSpan) Name() string { return s.name }
+
+// Attributes returns the mutated attributes of the span.
+func (s *mutatedSpan) Attributes() []attribute.KeyValue { return s.attributes }
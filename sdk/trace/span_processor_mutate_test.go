@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMutatingSpanProcessor(t *testing.T) {
+	next := &testSpanProcessor{}
+	mp := sdktrace.NewMutatingSpanProcessor(next,
+		sdktrace.SpanMutatorFunc(func(s *sdktrace.MutableSpan) {
+			if strings.HasPrefix(s.Name(), "GET /users/") {
+				s.SetName("GET /users/{id}")
+			}
+		}),
+		sdktrace.SpanMutatorFunc(func(s *sdktrace.MutableSpan) {
+			s.SetAttributes(
+				attribute.String("deployment.environment", "test"),
+				attribute.Int("http.status_code", 404),
+			)
+			s.RemoveAttributes("user.id")
+		}),
+		sdktrace.SpanMutatorFunc(func(s *sdktrace.MutableSpan) {
+			// Later mutators see the changes of earlier ones.
+			assert.Equal(t, "GET /users/{id}", s.Name())
+			assert.Equal(t, "GET /users/42", s.Span().Name())
+		}),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(mp))
+
+	_, span := tp.Tracer("TestMutatingSpanProcessor").Start(
+		context.Background(),
+		"GET /users/42",
+		trace.WithAttributes(
+			attribute.Int("http.status_code", 200),
+			attribute.String("user.id", "42"),
+		),
+	)
+	span.End()
+
+	require.Len(t, next.spansEnded, 1)
+	got := next.spansEnded[0]
+	assert.Equal(t, "GET /users/{id}", got.Name())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("http.status_code", 404),
+		attribute.String("deployment.environment", "test"),
+	}, got.Attributes())
+	assert.Equal(t, span.SpanContext(), got.SpanContext())
+}
+
+func TestMutatingSpanProcessorDoesNotModifySpan(t *testing.T) {
+	next := &testSpanProcessor{}
+	mp := sdktrace.NewMutatingSpanProcessor(next,
+		sdktrace.SpanMutatorFunc(func(s *sdktrace.MutableSpan) {
+			s.SetAttributes(attribute.Int("a", 2))
+			s.SetName("renamed")
+		}),
+	)
+	other := &testSpanProcessor{}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(mp),
+		sdktrace.WithSpanProcessor(other),
+	)
+
+	_, span := tp.Tracer("TestMutatingSpanProcessor").Start(
+		context.Background(),
+		"span",
+		trace.WithAttributes(attribute.Int("a", 1)),
+	)
+	span.End()
+
+	require.Len(t, other.spansEnded, 1)
+	assert.Equal(t, "span", other.spansEnded[0].Name())
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("a", 1)}, other.spansEnded[0].Attributes())
+	require.Len(t, next.spansEnded, 1)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("a", 2)}, next.spansEnded[0].Attributes())
+}
+
+func TestMutatingSpanProcessorWithoutMutators(t *testing.T) {
+	next := &testSpanProcessor{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sdktrace.NewMutatingSpanProcessor(next)))
+	_, span := tp.Tracer("TestMutatingSpanProcessor").Start(context.Background(), "span")
+	span.End()
+
+	require.Len(t, next.spansEnded, 1)
+	assert.Equal(t, "span", next.spansEnded[0].Name())
+}
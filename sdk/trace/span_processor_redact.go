@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"regexp"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AttributeRedaction describes how matching attributes are scrubbed by a
+// SpanProcessor returned from NewRedactionProcessor.
+//
+// An attribute matches if its key is one of Keys or matches KeyPattern. If
+// neither is set all attributes match.
+type AttributeRedaction struct {
+	// Keys are the attribute keys matched exactly.
+	Keys []attribute.Key
+	// KeyPattern matches attribute keys.
+	KeyPattern *regexp.Regexp
+
+	// Remove removes matching attributes entirely. Replacement and
+	// ValuePattern are ignored if it is set.
+	Remove bool
+	// ValuePattern, if set, limits the redaction of matching attributes to
+	// the parts of their string values it matches. Each match is replaced
+	// with Replacement and attributes with non-string values are left
+	// unchanged.
+	ValuePattern *regexp.Regexp
+	// Replacement replaces the value of matching attributes, or the parts of
+	// it matching ValuePattern.
+	Replacement string
+}
+
+func (r AttributeRedaction) matches(key attribute.Key) bool {
+	if len(r.Keys) == 0 && r.KeyPattern == nil {
+		return true
+	}
+	for _, k := range r.Keys {
+		if k == key {
+			return true
+		}
+	}
+	return r.KeyPattern != nil && r.KeyPattern.MatchString(string(key))
+}
+
+// apply returns kv redacted and whether it should be kept.
+func (r AttributeRedaction) apply(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+	if !r.matches(kv.Key) {
+		return kv, true
+	}
+	if r.Remove {
+		return kv, false
+	}
+	if r.ValuePattern == nil {
+		return kv.Key.String(r.Replacement), true
+	}
+	if kv.Value.Type() == attribute.STRING {
+		return kv.Key.String(r.ValuePattern.ReplaceAllString(kv.Value.AsString(), r.Replacement)), true
+	}
+	return kv, true
+}
+
+// redactionSpanProcessor is a SpanProcessor that scrubs the attributes of
+// ended spans before passing them to another SpanProcessor.
+type redactionSpanProcessor struct {
+	next       SpanProcessor
+	redactions []AttributeRedaction
+}
+
+var _ SpanProcessor = (*redactionSpanProcessor)(nil)
+
+// NewRedactionProcessor returns a new SpanProcessor that applies redactions,
+// in order, to the attributes of ended spans, their events and their links
+// before passing them to the OnEnd method of next. It can be used to remove
+// personally identifiable information, like authorization headers or URL
+// query strings, before spans are exported.
+//
+// For example, the following removes the query string from "http.url"
+// attributes and the "http.request.header.authorization" attribute.
+//
+//	NewRedactionProcessor(next,
+//		AttributeRedaction{
+//			Keys:         []attribute.Key{"http.url"},
+//			ValuePattern: regexp.MustCompile(`\?.*$`),
+//		},
+//		AttributeRedaction{
+//			Keys:   []attribute.Key{"http.request.header.authorization"},
+//			Remove: true,
+//		},
+//	)
+//
+// All other calls, including OnStart, are passed to next unchanged.
+func NewRedactionProcessor(next SpanProcessor, redactions ...AttributeRedaction) SpanProcessor {
+	return &redactionSpanProcessor{next: next, redactions: redactions}
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (r *redactionSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	r.next.OnStart(parent, s)
+}
+
+// OnEnd passes a redacted copy of s to the wrapped SpanProcessor.
+func (r *redactionSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if len(r.redactions) == 0 {
+		r.next.OnEnd(s)
+		return
+	}
+
+	rs := &redactedSpan{
+		ReadOnlySpan: s,
+		attributes:   r.redact(s.Attributes()),
+	}
+	if events := s.Events(); len(events) > 0 {
+		rs.events = make([]Event, len(events))
+		for i, e := range events {
+			e.Attributes = r.redact(e.Attributes)
+			rs.events[i] = e
+		}
+	}
+	if links := s.Links(); len(links) > 0 {
+		rs.links = make([]trace.Link, len(links))
+		for i, l := range links {
+			l.Attributes = r.redact(l.Attributes)
+			rs.links[i] = l
+		}
+	}
+	r.next.OnEnd(rs)
+}
+
+// redact returns a copy of attrs with all redactions applied.
+func (r *redactionSpanProcessor) redact(attrs []attribute.KeyValue) []attribute.KeyValue {
+	if len(attrs) == 0 {
+		return attrs
+	}
+	out := make([]attribute.KeyValue, 0, len(attrs))
+attrLoop:
+	for _, kv := range attrs {
+		for _, redaction := range r.redactions {
+			var keep bool
+			if kv, keep = redaction.apply(kv); !keep {
+				continue attrLoop
+			}
+		}
+		out = append(out, kv)
+	}
+	return out
+}
+
+// Shutdown shuts down the wrapped SpanProcessor.
+func (r *redactionSpanProcessor) Shutdown(ctx context.Context) error {
+	return r.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped SpanProcessor.
+func (r *redactionSpanProcessor) ForceFlush(ctx context.Context) error {
+	return r.next.ForceFlush(ctx)
+}
+
+// redactedSpan is a ReadOnlySpan with redacted attributes, events and links.
+type redactedSpan struct {
+	ReadOnlySpan
+
+	attributes []attribute.KeyValue
+	events     []Event
+	links      []trace.Link
+}
+
+// Attributes returns the redacted attributes of the span.
+func (s *redactedSpan) Attributes() []attribute.KeyValue { return s.attributes }
+
+// Events returns the events of the span with redacted attributes.
+func (s *redactedSpan) Events() []Event { return s.events }
+
+// Links returns the links of the span with redacted attributes.
+func (s *redactedSpan) Links() []trace.Link { return s.links }
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRedactionProcessor(t *testing.T) {
+	next := &testSpanProcessor{}
+	rp := sdktrace.NewRedactionProcessor(next,
+		sdktrace.AttributeRedaction{
+			Keys:         []attribute.Key{"http.url"},
+			ValuePattern: regexp.MustCompile(`\?.*$`),
+			Replacement:  "?REDACTED",
+		},
+		sdktrace.AttributeRedaction{
+			KeyPattern: regexp.MustCompile(`^http\.request\.header\.`),
+			Remove:     true,
+		},
+		sdktrace.AttributeRedaction{
+			Keys:        []attribute.Key{"user.id"},
+			Replacement: "REDACTED",
+		},
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rp))
+
+	link := trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{0x01},
+			SpanID:  trace.SpanID{0x01},
+		}),
+		Attributes: []attribute.KeyValue{attribute.Int("user.id", 42)},
+	}
+	_, span := tp.Tracer("TestRedactionProcessor").Start(
+		context.Background(),
+		"span",
+		trace.WithLinks(link),
+		trace.WithAttributes(
+			attribute.String("http.url", "https://example.com/path?token=secret"),
+			attribute.String("http.request.header.authorization", "Bearer secret"),
+			attribute.Int("http.status_code", 200),
+		),
+	)
+	span.AddEvent("event", trace.WithAttributes(attribute.String("user.id", "alice")))
+	span.End()
+
+	require.Len(t, next.spansEnded, 1)
+	got := next.spansEnded[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.url", "https://example.com/path?REDACTED"),
+		attribute.Int("http.status_code", 200),
+	}, got.Attributes())
+
+	var eventAttrs []attribute.KeyValue
+	for _, e := range got.Events() {
+		if e.Name == "event" {
+			eventAttrs = e.Attributes
+		}
+	}
+	assert.Equal(t, []attribute.KeyValue{attribute.String("user.id", "REDACTED")}, eventAttrs)
+
+	require.Len(t, got.Links(), 1)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("user.id", "REDACTED")}, got.Links()[0].Attributes)
+	assert.Equal(t, link.SpanContext, got.Links()[0].SpanContext)
+
+	// The original span is not modified.
+	assert.Contains(t, span.(sdktrace.ReadOnlySpan).Attributes(), attribute.String("http.request.header.authorization", "Bearer secret"))
+}
+
+func TestRedactionProcessorNonStringValuePattern(t *testing.T) {
+	next := &testSpanProcessor{}
+	rp := sdktrace.NewRedactionProcessor(next, sdktrace.AttributeRedaction{
+		ValuePattern: regexp.MustCompile(`[0-9]{16}`),
+		Replacement:  "****",
+	})
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rp))
+
+	_, span := tp.Tracer("TestRedactionProcessorNonStringValuePattern").Start(
+		context.Background(),
+		"span",
+		trace.WithAttributes(
+			attribute.String("card", "card 4111111111111111"),
+			attribute.Int64("count", 4111111111111111),
+		),
+	)
+	span.End()
+
+	require.Len(t, next.spansEnded, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("card", "card ****"),
+		attribute.Int64("count", 4111111111111111),
+	}, next.spansEnded[0].Attributes())
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanmetrics provides a SpanProcessor that derives request rate,
+// error, and duration (RED) metrics from ended spans.
+//
+// The metrics are keyed by span name, span kind, and status code. Spans
+// are only passed to span processors if they are recorded, so metrics are
+// only derived from sampled spans unless the TracerProvider uses a Sampler
+// returned by RecordAll. Spans that are recorded but not sampled are still
+// not exported.
+package spanmetrics // import "go.opentelemetry.io/otel/sdk/trace/spanmetrics"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/unit"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const instrumentationName = "go.opentelemetry.io/otel/sdk/trace/spanmetrics"
+
+// Names of the instruments used to record the span metrics.
+const (
+	// CallsName is the name of the counter of ended spans.
+	CallsName = "span.calls"
+	// ErrorsName is the name of the counter of ended spans with an Error
+	// status.
+	ErrorsName = "span.errors"
+	// DurationName is the name of the value recorder of span durations, in
+	// milliseconds.
+	DurationName = "span.duration"
+)
+
+// Labels of the span metrics.
+const (
+	SpanNameKey   = attribute.Key("span.name")
+	SpanKindKey   = attribute.Key("span.kind")
+	StatusCodeKey = attribute.Key("status.code")
+)
+
+// config contains the options of the span metrics SpanProcessor.
+type config struct {
+	dimensions []attribute.Key
+}
+
+// Option applies an option to the span metrics SpanProcessor.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(cfg *config) {
+	fn(cfg)
+}
+
+// WithDimensions adds the span attributes with keys as labels of the span
+// metrics. Spans without one of these attributes are recorded without the
+// label. Each dimension multiplies the cardinality of the metrics, so only
+// attributes with few distinct values, like "http.method", should be used.
+func WithDimensions(keys ...attribute.Key) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.dimensions = append(cfg.dimensions, keys...)
+	})
+}
+
+// spanProcessor records the span metrics of ended spans.
+type spanProcessor struct {
+	meter    metric.Meter
+	calls    metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64ValueRecorder
+
+	dimensions []attribute.Key
+}
+
+var _ sdktrace.SpanProcessor = (*spanProcessor)(nil)
+
+// NewSpanProcessor returns a SpanProcessor recording the span metrics of
+// ended spans with instruments of a Meter from mp.
+func NewSpanProcessor(mp metric.MeterProvider, opts ...Option) (sdktrace.SpanProcessor, error) {
+	cfg := config{}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	sp := &spanProcessor{
+		meter:      mp.Meter(instrumentationName),
+		dimensions: cfg.dimensions,
+	}
+	var err error
+	sp.calls, err = sp.meter.NewInt64Counter(
+		CallsName,
+		metric.WithDescription("Number of ended spans"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("spanmetrics: %w", err)
+	}
+	sp.errors, err = sp.meter.NewInt64Counter(
+		ErrorsName,
+		metric.WithDescription("Number of ended spans with an Error status"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("spanmetrics: %w", err)
+	}
+	sp.duration, err = sp.meter.NewFloat64ValueRecorder(
+		DurationName,
+		metric.WithUnit(unit.Milliseconds),
+		metric.WithDescription("Duration of ended spans"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("spanmetrics: %w", err)
+	}
+	return sp, nil
+}
+
+// OnStart does nothing.
+func (sp *spanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd records the span metrics of s.
+func (sp *spanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	labels := make([]attribute.KeyValue, 0, 3+len(sp.dimensions))
+	labels = append(labels,
+		SpanNameKey.String(s.Name()),
+		SpanKindKey.String(s.SpanKind().String()),
+		StatusCodeKey.String(s.Status().Code.String()),
+	)
+	if len(sp.dimensions) > 0 {
+		attrs := attribute.NewSet(s.Attributes()...)
+		for _, k := range sp.dimensions {
+			if v, ok := attrs.Value(k); ok {
+				labels = append(labels, attribute.KeyValue{Key: k, Value: v})
+			}
+		}
+	}
+
+	elapsed := float64(s.EndTime().Sub(s.StartTime())) / 1e6
+	measurements := []metric.Measurement{
+		sp.calls.Measurement(1),
+		sp.duration.Measurement(elapsed),
+	}
+	if s.Status().Code == codes.Error {
+		measurements = append(measurements, sp.errors.Measurement(1))
+	}
+	sp.meter.RecordBatch(context.Background(), labels, measurements...)
+}
+
+// Shutdown does nothing, the MeterProvider is not owned by the
+// SpanProcessor.
+func (sp *spanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing, the span metrics are recorded when spans end.
+func (sp *spanProcessor) ForceFlush(context.Context) error { return nil }
+
+// recordAllSampler records spans dropped by another Sampler.
+type recordAllSampler struct {
+	sampler sdktrace.Sampler
+}
+
+// RecordAll returns a Sampler that makes the same sampling decisions as s,
+// except that spans dropped by s are recorded without being sampled. This
+// passes all spans to span processors, like the one returned by
+// NewSpanProcessor, while only sampled spans are exported.
+//
+// Recording every span has a cost similar to sampling all of them, without
+// the cost of exporting them.
+func RecordAll(s sdktrace.Sampler) sdktrace.Sampler {
+	return recordAllSampler{sampler: s}
+}
+
+func (r recordAllSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	res := r.sampler.ShouldSample(p)
+	if res.Decision == sdktrace.Drop {
+		res.Decision = sdktrace.RecordOnly
+	}
+	return res
+}
+
+func (r recordAllSampler) Description() string {
+	return fmt.Sprintf("RecordAll{%s}", r.sampler.Description())
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetrics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/spanmetrics"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSpanProcessor(t *testing.T) {
+	impl, mp := oteltest.NewMeterProvider()
+	sp, err := spanmetrics.NewSpanProcessor(mp, spanmetrics.WithDimensions("http.method"))
+	require.NoError(t, err)
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(spanmetrics.RecordAll(sdktrace.NeverSample())),
+		sdktrace.WithSpanProcessor(sp),
+		sdktrace.WithSyncer(exp),
+	)
+	tracer := tp.Tracer("TestSpanProcessor")
+
+	start := time.Unix(0, 0)
+	_, span := tracer.Start(context.Background(), "GET /",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(attribute.String("http.method", "GET")),
+	)
+	span.End(trace.WithTimestamp(start.Add(20 * time.Millisecond)))
+
+	_, span = tracer.Start(context.Background(), "query", trace.WithTimestamp(start))
+	span.SetStatus(codes.Error, "failed")
+	span.End(trace.WithTimestamp(start.Add(5 * time.Millisecond)))
+
+	assert.Len(t, exp.GetSpans(), 0, "unsampled spans must not be exported")
+
+	got := oteltest.AsStructs(impl.MeasurementBatches)
+	server := oteltest.LabelsToMap(
+		spanmetrics.SpanNameKey.String("GET /"),
+		spanmetrics.SpanKindKey.String("server"),
+		spanmetrics.StatusCodeKey.String("Unset"),
+		attribute.String("http.method", "GET"),
+	)
+	internal := oteltest.LabelsToMap(
+		spanmetrics.SpanNameKey.String("query"),
+		spanmetrics.SpanKindKey.String("internal"),
+		spanmetrics.StatusCodeKey.String("Error"),
+	)
+	type measured struct {
+		name   string
+		labels map[attribute.Key]attribute.Value
+		value  float64
+	}
+	var values []measured
+	for _, m := range got {
+		assert.Equal(t, "go.opentelemetry.io/otel/sdk/trace/spanmetrics", m.InstrumentationName)
+		v := float64(m.Number.AsInt64())
+		if m.Name == spanmetrics.DurationName {
+			v = m.Number.AsFloat64()
+		}
+		values = append(values, measured{name: m.Name, labels: m.Labels, value: v})
+	}
+	assert.ElementsMatch(t, []measured{
+		{spanmetrics.CallsName, server, 1},
+		{spanmetrics.DurationName, server, 20},
+		{spanmetrics.CallsName, internal, 1},
+		{spanmetrics.DurationName, internal, 5},
+		{spanmetrics.ErrorsName, internal, 1},
+	}, values)
+}
+
+func TestRecordAll(t *testing.T) {
+	s := spanmetrics.RecordAll(sdktrace.TraceIDRatioBased(0))
+	assert.Equal(t, "RecordAll{TraceIDRatioBased{0}}", s.Description())
+	res := s.ShouldSample(sdktrace.SamplingParameters{})
+	assert.Equal(t, sdktrace.RecordOnly, res.Decision)
+
+	res = spanmetrics.RecordAll(sdktrace.AlwaysSample()).ShouldSample(sdktrace.SamplingParameters{})
+	assert.Equal(t, sdktrace.RecordAndSample, res.Decision)
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// TailSamplingPolicy decides whether a complete trace should be exported by
+// a tail sampling SpanProcessor.
+type TailSamplingPolicy interface {
+	// ShouldSample returns true if the trace made of spans should be
+	// exported. It is called synchronously and should not block.
+	ShouldSample(spans []ReadOnlySpan) bool
+	Description() string
+}
+
+type errorStatusPolicy struct{}
+
+func (errorStatusPolicy) ShouldSample(spans []ReadOnlySpan) bool {
+	for _, s := range spans {
+		if s.Status().Code == codes.Error {
+			return true
+		}
+	}
+	return false
+}
+
+func (errorStatusPolicy) Description() string {
+	return "ErrorStatus"
+}
+
+// ErrorStatusPolicy returns a TailSamplingPolicy that samples traces
+// containing at least one span with an Error status.
+func ErrorStatusPolicy() TailSamplingPolicy {
+	return errorStatusPolicy{}
+}
+
+type latencyPolicy struct {
+	threshold time.Duration
+}
+
+func (lp latencyPolicy) ShouldSample(spans []ReadOnlySpan) bool {
+	if len(spans) == 0 {
+		return false
+	}
+	start, end := spans[0].StartTime(), spans[0].EndTime()
+	for _, s := range spans[1:] {
+		if s.StartTime().Before(start) {
+			start = s.StartTime()
+		}
+		if s.EndTime().After(end) {
+			end = s.EndTime()
+		}
+	}
+	return end.Sub(start) >= lp.threshold
+}
+
+func (lp latencyPolicy) Description() string {
+	return fmt.Sprintf("Latency{%s}", lp.threshold)
+}
+
+// LatencyPolicy returns a TailSamplingPolicy that samples traces whose
+// duration, from the earliest span start to the latest span end, is at least
+// threshold.
+func LatencyPolicy(threshold time.Duration) TailSamplingPolicy {
+	return latencyPolicy{threshold: threshold}
+}
+
+type attributePolicy struct {
+	attrs []attribute.KeyValue
+}
+
+func (ap attributePolicy) ShouldSample(spans []ReadOnlySpan) bool {
+	for _, s := range spans {
+		for _, kv := range s.Attributes() {
+			for _, want := range ap.attrs {
+				if kv == want {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (ap attributePolicy) Description() string {
+	return fmt.Sprintf("Attribute{%v}", ap.attrs)
+}
+
+// AttributePolicy returns a TailSamplingPolicy that samples traces
+// containing at least one span with any of the attributes attrs, matching
+// both key and value.
+func AttributePolicy(attrs ...attribute.KeyValue) TailSamplingPolicy {
+	return attributePolicy{attrs: attrs}
+}
+
+type rateLimitPolicy struct {
+	limiter *rateLimiter
+}
+
+func (rp rateLimitPolicy) ShouldSample([]ReadOnlySpan) bool {
+	return rp.limiter.allow()
+}
+
+func (rp rateLimitPolicy) Description() string {
+	return fmt.Sprintf("RateLimit{%g}", rp.limiter.rate)
+}
+
+// RateLimitPolicy returns a TailSamplingPolicy that samples at most
+// tracesPerSecond traces per second. When combined with other policies it
+// only consumes its budget for the traces those evaluated before it did not
+// sample.
+func RateLimitPolicy(tracesPerSecond float64) TailSamplingPolicy {
+	return rateLimitPolicy{limiter: newRateLimiter(tracesPerSecond)}
+}
@@ -0,0 +1,333 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	DefaultDecisionWait = 30000 * time.Millisecond
+	DefaultMaxTraces    = 10000
+)
+
+type TailSamplingSpanProcessorOption func(o *TailSamplingSpanProcessorOptions)
+
+type TailSamplingSpanProcessorOptions struct {
+	// DecisionWait is the maximum duration spans of a trace are buffered
+	// waiting for its local root span to end. When it is reached the trace
+	// is evaluated with the spans received so far.
+	// The default value of DecisionWait is 30000 msec.
+	DecisionWait time.Duration
+
+	// MaxTraces is the maximum number of traces buffered at once. If it is
+	// reached the oldest trace is evaluated early to make room.
+	// The default value of MaxTraces is 10000.
+	MaxTraces int
+}
+
+// tailSamplingSpanProcessor is a SpanProcessor that buffers the spans of a
+// trace until it is complete and forwards them to another SpanProcessor only
+// if the complete trace is sampled by one of its policies.
+//
+// Policies are evaluated without holding mu so they may be slow, or end
+// spans themselves, without blocking other traces. Expired traces and
+// decisions are removed by a single goroutine checking them periodically.
+type tailSamplingSpanProcessor struct {
+	next     SpanProcessor
+	policies []TailSamplingPolicy
+	o        TailSamplingSpanProcessorOptions
+
+	mu      sync.Mutex
+	pending map[trace.TraceID]*pendingTrace
+	// order holds the pending traces, oldest first. As all of them wait
+	// the same DecisionWait it is also ordered by deadline.
+	order *list.List
+	// decisions holds the traces being evaluated and those evaluated
+	// recently, so late spans of those traces are handled the same way.
+	decisions map[trace.TraceID]*traceDecision
+	// decisionOrder holds the completed decisions, ordered by expiry.
+	decisionOrder *list.List
+	stopped       bool
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+type pendingTrace struct {
+	id       trace.TraceID
+	spans    []ReadOnlySpan
+	deadline time.Time
+	elem     *list.Element
+}
+
+// traceDecision is the sampling decision of a trace. Until it is done the
+// spans of the trace ending while its policies are evaluated are held in
+// late.
+type traceDecision struct {
+	id      trace.TraceID
+	done    bool
+	sampled bool
+	late    []ReadOnlySpan
+	expires time.Time
+}
+
+var _ SpanProcessor = (*tailSamplingSpanProcessor)(nil)
+
+// NewTailSamplingSpanProcessor returns a new SpanProcessor that buffers
+// ended spans by trace until the local root span of the trace ends, or the
+// decision wait is reached. The complete trace is then evaluated by
+// policies and, if any of them samples it, all its spans are passed to the
+// OnEnd method of next. Spans of a trace that end after it was evaluated are
+// handled according to the decision made for it.
+//
+// A trace is sampled if any of the policies samples it, policies are
+// evaluated in order and evaluation stops at the first one that does.
+//
+// The returned SpanProcessor runs a goroutine expiring buffered traces
+// until it is shut down.
+func NewTailSamplingSpanProcessor(next SpanProcessor, policies []TailSamplingPolicy, options ...TailSamplingSpanProcessorOption) SpanProcessor {
+	o := TailSamplingSpanProcessorOptions{
+		DecisionWait: DefaultDecisionWait,
+		MaxTraces:    DefaultMaxTraces,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	tsp := &tailSamplingSpanProcessor{
+		next:          next,
+		policies:      policies,
+		o:             o,
+		pending:       make(map[trace.TraceID]*pendingTrace),
+		order:         list.New(),
+		decisions:     make(map[trace.TraceID]*traceDecision),
+		decisionOrder: list.New(),
+		stopCh:        make(chan struct{}),
+	}
+
+	tsp.wg.Add(1)
+	go func() {
+		defer tsp.wg.Done()
+		tsp.processExpirations()
+	}()
+
+	return tsp
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (tsp *tailSamplingSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	tsp.next.OnStart(parent, s)
+}
+
+// OnEnd buffers s until its trace is evaluated.
+func (tsp *tailSamplingSpanProcessor) OnEnd(s ReadOnlySpan) {
+	id := s.SpanContext().TraceID()
+
+	tsp.mu.Lock()
+	if tsp.stopped {
+		tsp.mu.Unlock()
+		return
+	}
+	if d, ok := tsp.decisions[id]; ok {
+		if !d.done {
+			// The trace is being evaluated.
+			d.late = append(d.late, s)
+			tsp.mu.Unlock()
+			return
+		}
+		sampled := d.sampled
+		tsp.mu.Unlock()
+		if sampled {
+			tsp.next.OnEnd(s)
+		}
+		return
+	}
+
+	var ready []*pendingTrace
+	pt, ok := tsp.pending[id]
+	if !ok {
+		if tsp.o.MaxTraces > 0 && len(tsp.pending) >= tsp.o.MaxTraces {
+			ready = append(ready, tsp.detach(tsp.order.Front().Value.(*pendingTrace)))
+		}
+		pt = &pendingTrace{id: id, deadline: time.Now().Add(tsp.o.DecisionWait)}
+		pt.elem = tsp.order.PushBack(pt)
+		tsp.pending[id] = pt
+	}
+	pt.spans = append(pt.spans, s)
+
+	if parent := s.Parent(); !parent.IsValid() || parent.IsRemote() {
+		ready = append(ready, tsp.detach(pt))
+	}
+	tsp.mu.Unlock()
+
+	tsp.decide(ready)
+}
+
+// Shutdown evaluates all buffered traces and shuts down the wrapped
+// SpanProcessor.
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
+	tsp.mu.Lock()
+	if tsp.stopped {
+		tsp.mu.Unlock()
+		return nil
+	}
+	ready := tsp.detachAll()
+	tsp.stopped = true
+	tsp.mu.Unlock()
+
+	close(tsp.stopCh)
+	tsp.wg.Wait()
+
+	tsp.decide(ready)
+	return tsp.next.Shutdown(ctx)
+}
+
+// ForceFlush evaluates all buffered traces, even if incomplete, and flushes
+// the wrapped SpanProcessor.
+func (tsp *tailSamplingSpanProcessor) ForceFlush(ctx context.Context) error {
+	tsp.mu.Lock()
+	ready := tsp.detachAll()
+	tsp.mu.Unlock()
+
+	tsp.decide(ready)
+	return tsp.next.ForceFlush(ctx)
+}
+
+// WithDecisionWait sets the maximum duration spans of a trace are buffered.
+func WithDecisionWait(wait time.Duration) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.DecisionWait = wait
+	}
+}
+
+// WithMaxTraces sets the maximum number of traces buffered at once.
+func WithMaxTraces(n int) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.MaxTraces = n
+	}
+}
+
+// processExpirations evaluates the traces whose decision wait is reached
+// and forgets expired decisions until the processor is shut down. It
+// checks them a tenth of DecisionWait apart, at most once a millisecond and
+// at least once a second.
+func (tsp *tailSamplingSpanProcessor) processExpirations() {
+	period := tsp.o.DecisionWait / 10
+	if period > time.Second {
+		period = time.Second
+	}
+	if period < time.Millisecond {
+		period = time.Millisecond
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-tsp.stopCh:
+			return
+		case now := <-ticker.C:
+			tsp.decide(tsp.expire(now))
+		}
+	}
+}
+
+// expire forgets the decisions expired at now and detaches the pending
+// traces whose deadline is reached, which are returned.
+func (tsp *tailSamplingSpanProcessor) expire(now time.Time) []*pendingTrace {
+	tsp.mu.Lock()
+	defer tsp.mu.Unlock()
+
+	for e := tsp.decisionOrder.Front(); e != nil; e = tsp.decisionOrder.Front() {
+		d := e.Value.(*traceDecision)
+		if d.expires.After(now) {
+			break
+		}
+		tsp.decisionOrder.Remove(e)
+		delete(tsp.decisions, d.id)
+	}
+
+	var ready []*pendingTrace
+	for e := tsp.order.Front(); e != nil; e = tsp.order.Front() {
+		pt := e.Value.(*pendingTrace)
+		if pt.deadline.After(now) {
+			break
+		}
+		ready = append(ready, tsp.detach(pt))
+	}
+	return ready
+}
+
+// detach removes pt from the pending traces and registers its decision as
+// in progress, so spans of the trace ending while it is evaluated are held
+// back. It must be called while holding tsp.mu.
+func (tsp *tailSamplingSpanProcessor) detach(pt *pendingTrace) *pendingTrace {
+	tsp.order.Remove(pt.elem)
+	delete(tsp.pending, pt.id)
+	tsp.decisions[pt.id] = &traceDecision{id: pt.id}
+	return pt
+}
+
+// detachAll detaches all pending traces, oldest first. It must be called
+// while holding tsp.mu.
+func (tsp *tailSamplingSpanProcessor) detachAll() []*pendingTrace {
+	ready := make([]*pendingTrace, 0, tsp.order.Len())
+	for tsp.order.Len() > 0 {
+		ready = append(ready, tsp.detach(tsp.order.Front().Value.(*pendingTrace)))
+	}
+	return ready
+}
+
+// decide applies the policies to the spans of each detached trace and
+// passes the spans of sampled traces to the wrapped SpanProcessor. The
+// decision is remembered for the decision wait so spans of the trace ending
+// later are treated the same way. It must not be called while holding
+// tsp.mu.
+func (tsp *tailSamplingSpanProcessor) decide(ready []*pendingTrace) {
+	for _, pt := range ready {
+		sampled := false
+		for _, p := range tsp.policies {
+			if p.ShouldSample(pt.spans) {
+				sampled = true
+				break
+			}
+		}
+
+		tsp.mu.Lock()
+		d := tsp.decisions[pt.id]
+		d.done = true
+		d.sampled = sampled
+		d.expires = time.Now().Add(tsp.o.DecisionWait)
+		tsp.decisionOrder.PushBack(d)
+		late := d.late
+		d.late = nil
+		tsp.mu.Unlock()
+
+		if !sampled {
+			continue
+		}
+		for _, s := range pt.spans {
+			tsp.next.OnEnd(s)
+		}
+		for _, s := range late {
+			tsp.next.OnEnd(s)
+		}
+	}
+}
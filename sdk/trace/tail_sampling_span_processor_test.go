@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTailSamplingProvider(policies []sdktrace.TailSamplingPolicy, opts ...sdktrace.TailSamplingSpanProcessorOption) (*sdktrace.TracerProvider, *testExporter) {
+	te := &testExporter{}
+	tsp := sdktrace.NewTailSamplingSpanProcessor(sdktrace.NewSimpleSpanProcessor(te), policies, opts...)
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp)), te
+}
+
+func spanNames(spans []sdktrace.ReadOnlySpan) []string {
+	var names []string
+	for _, s := range spans {
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+func TestTailSamplingErrorStatus(t *testing.T) {
+	tp, te := newTailSamplingProvider([]sdktrace.TailSamplingPolicy{sdktrace.ErrorStatusPolicy()})
+	tr := tp.Tracer("TestTailSamplingErrorStatus")
+
+	ctx, root := tr.Start(context.Background(), "ok root")
+	_, child := tr.Start(ctx, "ok child")
+	child.End()
+	assert.Empty(t, te.spans, "spans exported before the root ended")
+	root.End()
+	assert.Empty(t, te.spans)
+
+	ctx, root = tr.Start(context.Background(), "error root")
+	_, child = tr.Start(ctx, "error child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	assert.Empty(t, te.spans, "spans exported before the root ended")
+	root.End()
+	assert.Equal(t, []string{"error child", "error root"}, spanNames(te.spans))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingLateSpans(t *testing.T) {
+	tp, te := newTailSamplingProvider([]sdktrace.TailSamplingPolicy{
+		sdktrace.AttributePolicy(attribute.Bool("keep", true)),
+	})
+	tr := tp.Tracer("TestTailSamplingLateSpans")
+
+	ctx, root := tr.Start(context.Background(), "root", trace.WithAttributes(attribute.Bool("keep", true)))
+	_, late := tr.Start(ctx, "late")
+	root.End()
+	assert.Equal(t, []string{"root"}, spanNames(te.spans))
+	late.End()
+	assert.Equal(t, []string{"root", "late"}, spanNames(te.spans))
+
+	ctx, root = tr.Start(context.Background(), "dropped root")
+	_, late = tr.Start(ctx, "dropped late")
+	root.End()
+	late.End()
+	assert.Equal(t, []string{"root", "late"}, spanNames(te.spans))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+// namesProcessor records the names of ended spans and is safe for
+// concurrent use.
+type namesProcessor struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (p *namesProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (p *namesProcessor) Shutdown(context.Context) error                  { return nil }
+func (p *namesProcessor) ForceFlush(context.Context) error                { return nil }
+
+func (p *namesProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.names = append(p.names, s.Name())
+}
+
+func (p *namesProcessor) Names() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.names...)
+}
+
+func TestTailSamplingDecisionWait(t *testing.T) {
+	np := &namesProcessor{}
+	tsp := sdktrace.NewTailSamplingSpanProcessor(
+		np,
+		[]sdktrace.TailSamplingPolicy{sdktrace.RateLimitPolicy(100)},
+		sdktrace.WithDecisionWait(10*time.Millisecond),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(tsp))
+	tr := tp.Tracer("TestTailSamplingDecisionWait")
+
+	ctx, root := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	assert.Eventually(t, func() bool {
+		return len(np.Names()) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"child"}, np.Names())
+	root.End()
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingMaxTraces(t *testing.T) {
+	tp, te := newTailSamplingProvider(
+		[]sdktrace.TailSamplingPolicy{sdktrace.LatencyPolicy(0)},
+		sdktrace.WithMaxTraces(1),
+	)
+	tr := tp.Tracer("TestTailSamplingMaxTraces")
+
+	ctx, root1 := tr.Start(context.Background(), "root1")
+	_, child1 := tr.Start(ctx, "child1")
+	child1.End()
+	ctx, root2 := tr.Start(context.Background(), "root2")
+	_, child2 := tr.Start(ctx, "child2")
+	child2.End()
+	assert.Equal(t, []string{"child1"}, spanNames(te.spans))
+
+	root1.End()
+	root2.End()
+	assert.Equal(t, []string{"child1", "root1", "child2", "root2"}, spanNames(te.spans))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingShutdown(t *testing.T) {
+	tp, te := newTailSamplingProvider([]sdktrace.TailSamplingPolicy{sdktrace.LatencyPolicy(0)})
+	tr := tp.Tracer("TestTailSamplingShutdown")
+
+	ctx, root := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	assert.Empty(t, te.spans)
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	assert.Equal(t, []string{"child"}, spanNames(te.spans))
+	assert.True(t, te.shutdown)
+	root.End()
+	assert.Equal(t, []string{"child"}, spanNames(te.spans))
+}
+
+func TestTailSamplingForceFlush(t *testing.T) {
+	tp, te := newTailSamplingProvider([]sdktrace.TailSamplingPolicy{sdktrace.LatencyPolicy(0)})
+	tr := tp.Tracer("TestTailSamplingForceFlush")
+
+	ctx, root := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	require.NoError(t, tp.ForceFlush(context.Background()))
+	assert.Equal(t, []string{"child"}, spanNames(te.spans))
+
+	root.End()
+	assert.Equal(t, []string{"child", "root"}, spanNames(te.spans))
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+// endingPolicy samples all traces and ends span while evaluating the first
+// one.
+type endingPolicy struct {
+	once sync.Once
+	span trace.Span
+}
+
+func (p *endingPolicy) ShouldSample([]sdktrace.ReadOnlySpan) bool {
+	p.once.Do(func() { p.span.End() })
+	return true
+}
+
+func (p *endingPolicy) Description() string { return "EndingPolicy" }
+
+func TestTailSamplingPolicyEndsSpan(t *testing.T) {
+	policy := &endingPolicy{}
+	tp, te := newTailSamplingProvider([]sdktrace.TailSamplingPolicy{policy})
+	tr := tp.Tracer("TestTailSamplingPolicyEndsSpan")
+
+	ctx, root := tr.Start(context.Background(), "root")
+	_, policy.span = tr.Start(ctx, "late")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		root.End()
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ending a span while evaluating policies deadlocked")
+	}
+	assert.Equal(t, []string{"root", "late"}, spanNames(te.spans))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestLatencyPolicy(t *testing.T) {
+	start := time.Now()
+	spans := tracetest.SpanStubs{
+		{StartTime: start.Add(time.Second), EndTime: start.Add(3 * time.Second)},
+		{StartTime: start, EndTime: start.Add(2 * time.Second)},
+	}.Snapshots()
+
+	assert.True(t, sdktrace.LatencyPolicy(3*time.Second).ShouldSample(spans))
+	assert.False(t, sdktrace.LatencyPolicy(3*time.Second+1).ShouldSample(spans))
+	assert.False(t, sdktrace.LatencyPolicy(0).ShouldSample(nil))
+}
+
+func TestAttributePolicy(t *testing.T) {
+	spans := tracetest.SpanStubs{
+		{Attributes: []attribute.KeyValue{attribute.String("http.method", "GET")}},
+		{Attributes: []attribute.KeyValue{attribute.Int("http.status_code", 500)}},
+	}.Snapshots()
+
+	assert.True(t, sdktrace.AttributePolicy(attribute.Int("http.status_code", 500)).ShouldSample(spans))
+	assert.True(t, sdktrace.AttributePolicy(
+		attribute.String("http.method", "POST"),
+		attribute.String("http.method", "GET"),
+	).ShouldSample(spans))
+	assert.False(t, sdktrace.AttributePolicy(attribute.Int("http.status_code", 200)).ShouldSample(spans))
+}
+
+func TestRateLimitPolicy(t *testing.T) {
+	p := sdktrace.RateLimitPolicy(2)
+	assert.True(t, p.ShouldSample(nil))
+	assert.True(t, p.ShouldSample(nil))
+	assert.False(t, p.ShouldSample(nil))
+
+	assert.False(t, sdktrace.RateLimitPolicy(0).ShouldSample(nil))
+}
@@ -46,15 +46,42 @@ import (
 const envVar = "OTEL_RESOURCE_ATTRIBUTES"
 
 type storingHandler struct {
-	errs []error
+	mu         sync.Mutex
+	errs       []error
+	classified []classifiedError
+}
+
+// classifiedError is an error passed to HandleClassified.
+type classifiedError struct {
+	component string
+	severity  otel.Severity
+	err       error
 }
 
 func (s *storingHandler) Handle(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, err)
+}
+
+func (s *storingHandler) HandleClassified(component string, sev otel.Severity, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.errs = append(s.errs, err)
+	s.classified = append(s.classified, classifiedError{component: component, severity: sev, err: err})
+}
+
+func (s *storingHandler) Classified() []classifiedError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]classifiedError(nil), s.classified...)
 }
 
 func (s *storingHandler) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.errs = nil
+	s.classified = nil
 }
 
 var (
@@ -376,6 +403,64 @@ func TestStartSpanWithParent(t *testing.T) {
 	}
 }
 
+func TestStartSpanNewRoot(t *testing.T) {
+	unsampled := sc.WithTraceFlags(0)
+	parentCtx := trace.ContextWithRemoteSpanContext(context.Background(), unsampled)
+
+	for _, linked := range []bool{false, true} {
+		t.Run(fmt.Sprintf("linked=%v", linked), func(t *testing.T) {
+			te := NewTestExporter()
+			opts := []TracerProviderOption{WithSyncer(te)}
+			if linked {
+				opts = append(opts, WithNewRootLinks())
+			}
+			tr := NewTracerProvider(opts...).Tracer("NewRoot")
+
+			link := trace.Link{SpanContext: sc, Attributes: []attribute.KeyValue{kv1}}
+			_, span := tr.Start(parentCtx, "root", trace.WithNewRoot(), trace.WithLinks(link))
+			psc := span.SpanContext()
+			assert.NotEqual(t, unsampled.TraceID(), psc.TraceID())
+			// The new root is sampled by the default ParentBased sampler
+			// regardless of its unsampled parent.
+			assert.True(t, psc.IsSampled())
+			span.End()
+
+			got, ok := te.GetSpan("root")
+			require.True(t, ok)
+			assert.False(t, got.Parent().IsValid())
+			want := []trace.Link{link}
+			if linked {
+				want = []trace.Link{{SpanContext: unsampled.WithRemote(true)}, link}
+			}
+			assert.Equal(t, want, got.Links())
+		})
+	}
+
+	t.Run("no parent", func(t *testing.T) {
+		te := NewTestExporter()
+		tr := NewTracerProvider(WithSyncer(te), WithNewRootLinks()).Tracer("NewRoot")
+		_, span := tr.Start(context.Background(), "root", trace.WithNewRoot())
+		span.End()
+
+		got, ok := te.GetSpan("root")
+		require.True(t, ok)
+		assert.Empty(t, got.Links())
+	})
+
+	t.Run("not a child", func(t *testing.T) {
+		te := NewTestExporter()
+		tr := NewTracerProvider(WithSyncer(te)).Tracer("NewRoot")
+		ctx, parent := tr.Start(context.Background(), "parent")
+		_, span := tr.Start(ctx, "root", trace.WithNewRoot())
+		span.End()
+		parent.End()
+
+		got, ok := te.GetSpan("parent")
+		require.True(t, ok)
+		assert.Equal(t, 0, got.ChildSpanCount())
+	})
+}
+
 func TestSetSpanAttributesOnStart(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -494,6 +579,181 @@ func TestSetSpanAttributesOverLimit(t *testing.T) {
 	}
 }
 
+func TestSetSpanAttributesLastValueWins(t *testing.T) {
+	te := NewTestExporter()
+	sampler := Annotated(AlwaysSample(), WithAnnotationAttributes(
+		attribute.String("sampler", "value"),
+		attribute.String("start", "sampler"),
+	))
+	tp := NewTracerProvider(WithSampler(sampler), WithSyncer(te), WithResource(resource.Empty()))
+
+	_, span := tp.Tracer("LastValueWins").Start(context.Background(), "span",
+		trace.WithAttributes(
+			attribute.String("start", "option"),
+			attribute.Int("key", 0),
+		),
+	)
+	span.SetAttributes(attribute.Int("key", 1), attribute.Int("key", 2))
+	span.SetAttributes(attribute.Int("key", 3), attribute.Bool("other", true))
+	span.End()
+
+	got := te.Spans()
+	if len(got) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(got))
+	}
+	want := []attribute.KeyValue{
+		attribute.String("sampler", "value"),
+		attribute.String("start", "option"),
+		attribute.Int("key", 3),
+		attribute.Bool("other", true),
+	}
+	if diff := cmp.Diff(want, got[0].Attributes(), cmp.AllowUnexported(attribute.Value{})); diff != "" {
+		t.Errorf("attributes: -want +got %s", diff)
+	}
+	if dropped := got[0].DroppedAttributes(); dropped != 0 {
+		t.Errorf("expected no dropped attributes, got %d", dropped)
+	}
+}
+
+func TestEventAndLinkAttributesDeduplicated(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSpanLimits(SpanLimits{AttributePerEventCountLimit: 2, AttributePerLinkCountLimit: 2}),
+		WithSyncer(te),
+		WithResource(resource.Empty()),
+	)
+
+	linkAttrs := []attribute.KeyValue{
+		attribute.String("a", "1"),
+		attribute.String("b", "1"),
+		attribute.String("a", "2"),
+	}
+	sc1 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 1}), SpanID: trace.SpanID{3}})
+	span := startSpan(tp, "Deduplicated", trace.WithLinks(trace.Link{SpanContext: sc1, Attributes: linkAttrs}))
+	span.AddEvent("event", trace.WithAttributes(
+		attribute.Int("x", 1),
+		attribute.Int("x", 2),
+		attribute.Int("y", 1),
+		attribute.Int("x", 3),
+	))
+	got, err := endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The link attributes passed in are not modified.
+	if linkAttrs[0] != attribute.String("a", "1") || len(linkAttrs) != 3 {
+		t.Errorf("link attributes modified: %v", linkAttrs)
+	}
+
+	links := got.Links()
+	if len(links) != 1 {
+		t.Fatalf("expected 1 link, got %d", len(links))
+	}
+	wantLink := []attribute.KeyValue{attribute.String("b", "1"), attribute.String("a", "2")}
+	if diff := cmp.Diff(wantLink, links[0].Attributes, cmp.AllowUnexported(attribute.Value{})); diff != "" {
+		t.Errorf("link attributes: -want +got %s", diff)
+	}
+	if links[0].DroppedAttributeCount != 0 {
+		t.Errorf("expected no dropped link attributes, got %d", links[0].DroppedAttributeCount)
+	}
+
+	events := got.Events()
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	wantEvent := []attribute.KeyValue{attribute.Int("y", 1), attribute.Int("x", 3)}
+	if diff := cmp.Diff(wantEvent, events[0].Attributes, cmp.AllowUnexported(attribute.Value{})); diff != "" {
+		t.Errorf("event attributes: -want +got %s", diff)
+	}
+	if events[0].DroppedAttributeCount != 0 {
+		t.Errorf("expected no dropped event attributes, got %d", events[0].DroppedAttributeCount)
+	}
+}
+
+func TestDedupAttrs(t *testing.T) {
+	many := func(n int, dup bool) []attribute.KeyValue {
+		kvs := make([]attribute.KeyValue, n)
+		for i := range kvs {
+			kvs[i] = attribute.Int(fmt.Sprint("k", i), i)
+		}
+		if dup {
+			kvs[n-1] = attribute.Int("k0", -1)
+		}
+		return kvs
+	}
+
+	for _, n := range []int{2, dedupScanLimit, dedupScanLimit + 1, 2 * dedupScanLimit} {
+		unique := many(n, false)
+		got := dedupAttrs(unique)
+		if len(got) != n || &got[0] != &unique[0] {
+			t.Errorf("%d unique attributes: expected the passed slice", n)
+		}
+
+		dups := many(n, true)
+		got = dedupAttrs(dups)
+		if len(got) != n-1 {
+			t.Fatalf("%d attributes with a duplicate: expected %d, got %d", n, n-1, len(got))
+		}
+		if got[len(got)-1] != attribute.Int("k0", -1) || (n > 2 && got[0].Key != "k1") {
+			t.Errorf("%d attributes with a duplicate: last value not kept in order: %v", n, got)
+		}
+		if dups[0] != attribute.Int("k0", 0) {
+			t.Errorf("%d attributes with a duplicate: passed slice modified", n)
+		}
+	}
+
+	unique := many(dedupScanLimit, false)
+	if allocs := testing.AllocsPerRun(100, func() { dedupAttrs(unique) }); allocs != 0 {
+		t.Errorf("expected no allocations for unique attributes, got %v", allocs)
+	}
+}
+
+func TestSpanAttributeValueLengthLimit(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSpanLimits(SpanLimits{AttributeValueLengthLimit: 3}),
+		WithSyncer(te),
+		WithResource(resource.Empty()),
+	)
+
+	linkAttrs := []attribute.KeyValue{attribute.String("link", "abcdef")}
+	_, span := tp.Tracer("AttributeValueLengthLimit").Start(
+		context.Background(),
+		"span0",
+		trace.WithLinks(trace.Link{SpanContext: sc, Attributes: linkAttrs}),
+	)
+	span.SetAttributes(
+		attribute.String("short", "ab"),
+		attribute.String("long", "abcdef"),
+		attribute.String("multibyte", "héllo"),
+		attribute.Array("array", []string{"a", "abcd"}),
+		attribute.Array("ints", []int{12345}),
+		attribute.Int64("int", 12345),
+	)
+	eventAttrs := []attribute.KeyValue{attribute.String("event", "abcdef")}
+	span.AddEvent("event", trace.WithAttributes(eventAttrs...))
+	span.End()
+
+	require.Len(t, te.Spans(), 1)
+	got := te.Spans()[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("short", "ab"),
+		attribute.String("long", "abc"),
+		attribute.String("multibyte", "hél"),
+		attribute.Array("array", []string{"a", "abc"}),
+		attribute.Array("ints", []int{12345}),
+		attribute.Int64("int", 12345),
+	}, got.Attributes())
+	assert.Equal(t, []attribute.KeyValue{attribute.String("event", "abc")}, got.Events()[0].Attributes)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("link", "abc")}, got.Links()[0].Attributes)
+	assert.Equal(t, 5, got.TruncatedAttributes(), "3 span, 1 event, and 1 link attribute values")
+
+	// The attributes passed by the user must not be modified.
+	assert.Equal(t, "abcdef", eventAttrs[0].Value.AsString())
+	assert.Equal(t, "abcdef", linkAttrs[0].Value.AsString())
+}
+
 func TestSetSpanAttributesWithInvalidKey(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSpanLimits(SpanLimits{}), WithSyncer(te), WithResource(resource.Empty()))
@@ -704,6 +964,54 @@ func TestLinksOverLimit(t *testing.T) {
 	}
 }
 
+func TestAddLink(t *testing.T) {
+	te := NewTestExporter()
+
+	sc1 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 1}), SpanID: trace.SpanID{3}})
+	sc2 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 1}), SpanID: trace.SpanID{4}})
+	sc3 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 1}), SpanID: trace.SpanID{5}})
+
+	tp := NewTracerProvider(WithSpanLimits(SpanLimits{LinkCountLimit: 2, AttributePerLinkCountLimit: 1}), WithSyncer(te), WithResource(resource.Empty()))
+
+	span := startSpan(tp, "AddLink",
+		trace.WithLinks(trace.Link{SpanContext: sc1}),
+	)
+	span.AddLink(trace.Link{SpanContext: sc2, Attributes: []attribute.KeyValue{attribute.String("key2", "value2")}})
+	span.AddLink(trace.Link{SpanContext: sc3, Attributes: []attribute.KeyValue{
+		attribute.String("key3", "value3"),
+		attribute.String("key4", "value4"),
+	}})
+	// Links without a valid SpanContext nor attributes are dropped.
+	span.AddLink(trace.Link{})
+
+	got, err := endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Links cannot be added once the span is ended.
+	span.AddLink(trace.Link{SpanContext: sc1})
+
+	want := &snapshot{
+		spanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    tid,
+			TraceFlags: 0x1,
+		}),
+		parent: sc.WithRemote(true),
+		name:   "span0",
+		links: []trace.Link{
+			{SpanContext: sc2, Attributes: []attribute.KeyValue{attribute.String("key2", "value2")}},
+			{SpanContext: sc3, Attributes: []attribute.KeyValue{attribute.String("key3", "value3")}, DroppedAttributeCount: 1},
+		},
+		droppedLinkCount:       1,
+		spanKind:               trace.SpanKindInternal,
+		instrumentationLibrary: instrumentation.Library{Name: "AddLink"},
+	}
+	if diff := cmpDiff(got, want); diff != "" {
+		t.Errorf("AddLink: -got +want %s", diff)
+	}
+	assert.Len(t, span.(ReadOnlySpan).Links(), 2)
+}
+
 func TestSetSpanName(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -1141,6 +1449,43 @@ func TestRecordError(t *testing.T) {
 	}
 }
 
+func TestRecordErrorWithStackTraceAndEscaped(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
+	span := startSpan(tp, "RecordError")
+
+	span.RecordError(errors.New("test error"), trace.WithStackTrace(true), trace.WithEscaped(true))
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	require.Len(t, got.Events(), 1)
+	attrs := attribute.NewSet(got.Events()[0].Attributes...)
+
+	typ, _ := attrs.Value(semconv.ExceptionTypeKey)
+	assert.Equal(t, "*errors.errorString", typ.AsString())
+	escaped, ok := attrs.Value(semconv.ExceptionEscapedKey)
+	assert.True(t, ok)
+	assert.True(t, escaped.AsBool())
+	stack, ok := attrs.Value(semconv.ExceptionStacktraceKey)
+	require.True(t, ok)
+	assert.Contains(t, stack.AsString(), "TestRecordErrorWithStackTraceAndEscaped")
+}
+
+func TestRecordErrorWithoutStackTrace(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
+	span := startSpan(tp, "RecordError")
+
+	span.RecordError(errors.New("test error"), trace.WithStackTrace(false), trace.WithEscaped(false))
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	require.Len(t, got.Events(), 1)
+	attrs := attribute.NewSet(got.Events()[0].Attributes...)
+	assert.False(t, attrs.HasValue(semconv.ExceptionStacktraceKey))
+	assert.False(t, attrs.HasValue(semconv.ExceptionEscapedKey))
+}
+
 func TestRecordErrorNil(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -1765,3 +2110,159 @@ func TestWithIDGenerator(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestConcurrentSpanWrites(t *testing.T) {
+	const (
+		writers = 8
+		// More writes than pendingWriteLimit so writers also apply them.
+		writesPerWriter = 2 * pendingWriteLimit
+	)
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSpanLimits(SpanLimits{
+			AttributeCountLimit: writers * writesPerWriter,
+			EventCountLimit:     writers * writesPerWriter,
+		}),
+		WithSyncer(te),
+	)
+	span := startSpan(tp, "TestConcurrentSpanWrites")
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < writesPerWriter; j++ {
+				span.SetAttributes(attribute.Int(fmt.Sprintf("key-%d-%d", i, j), j))
+				span.AddEvent("event", trace.WithAttributes(attribute.Int("writer", i)))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	assert.Len(t, got.Attributes(), writers*writesPerWriter)
+	assert.Len(t, got.Events(), writers*writesPerWriter)
+	assert.Equal(t, 0, got.DroppedAttributes())
+	assert.Equal(t, 0, got.DroppedEvents())
+}
+
+func TestSpanWritesAfterEndDropped(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	ts := startSpan(tp, "TestSpanWritesAfterEndDropped")
+	ts.SetAttributes(attribute.Int("before", 1))
+	ts.AddEvent("before")
+
+	got, err := endSpan(te, ts)
+	require.NoError(t, err)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("before", 1)}, got.Attributes())
+	assert.Len(t, got.Events(), 1)
+
+	// Writes of writers that checked the span was recording before it
+	// ended.
+	s := ts.(*span)
+	s.write(&pendingWrite{attributes: []attribute.KeyValue{
+		attribute.Int("after", 1),
+		attribute.Int("after", 2),
+	}})
+	s.write(&pendingWrite{event: Event{Name: "after"}, isEvent: true})
+
+	snap := s.snapshot()
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("before", 1)}, snap.Attributes())
+	assert.Equal(t, 2, snap.DroppedAttributes())
+	assert.Len(t, snap.Events(), 1)
+	assert.Equal(t, 1, snap.DroppedEvents())
+	assert.Nil(t, s.pending.drain())
+}
+
+func TestSpanWritesAppliedInOrder(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	span := startSpan(tp, "TestSpanWritesAppliedInOrder")
+
+	for i := 0; i < pendingWriteLimit+1; i++ {
+		span.SetAttributes(attribute.Int("key", i))
+	}
+	span.AddEvent("first")
+	span.AddEvent("second")
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("key", pendingWriteLimit)}, got.Attributes())
+	require.Len(t, got.Events(), 2)
+	assert.Equal(t, "first", got.Events()[0].Name)
+	assert.Equal(t, "second", got.Events()[1].Name)
+}
+
+func TestSpanWritesQueuedWhileLocked(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	ts := startSpan(tp, "TestSpanWritesQueuedWhileLocked")
+	s := ts.(*span)
+
+	// Writes made while the span lock is held are queued rather than
+	// waiting for it.
+	s.mu.Lock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ts.SetAttributes(attribute.Int("key", 1), attribute.Int("queued", 1))
+		ts.AddEvent("queued")
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("span writes waited for the span lock")
+	}
+	s.mu.Unlock()
+
+	// Writes made with the lock free are applied after the queued ones.
+	ts.SetAttributes(attribute.Int("key", 2))
+	ts.AddEvent("direct")
+	assert.Nil(t, s.pending.drain())
+
+	got, err := endSpan(te, ts)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.Int("key", 2),
+		attribute.Int("queued", 1),
+	}, got.Attributes())
+	require.Len(t, got.Events(), 2)
+	assert.Equal(t, "queued", got.Events()[0].Name)
+	assert.Equal(t, "direct", got.Events()[1].Name)
+}
+
+type failingExporter struct {
+	err error
+}
+
+func (e failingExporter) ExportSpans(context.Context, []ReadOnlySpan) error { return e.err }
+func (failingExporter) Shutdown(context.Context) error                      { return nil }
+
+func TestBatchSpanProcessorExportErrorClassified(t *testing.T) {
+	handler.Reset()
+	exportErr := errors.New("export failed")
+	// With a single concurrent export, batches are exported by the
+	// goroutine processing the queue.
+	tp := NewTracerProvider(WithBatcher(
+		failingExporter{err: exportErr},
+		WithMaxExportBatchSize(1),
+		WithMaxConcurrentExports(1),
+	))
+
+	_, span := tp.Tracer("TestBatchSpanProcessorExportErrorClassified").Start(context.Background(), "span")
+	span.End()
+
+	require.Eventually(t, func() bool {
+		return len(handler.Classified()) > 0
+	}, time.Second, 10*time.Millisecond)
+	got := handler.Classified()[0]
+	assert.Equal(t, "go.opentelemetry.io/otel/sdk/trace", got.component)
+	assert.Equal(t, otel.SeverityError, got.severity)
+	assert.ErrorIs(t, got.err, exportErr)
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
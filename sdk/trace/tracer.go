@@ -39,8 +39,15 @@ var _ trace.Tracer = &tracer{}
 func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
 	config := trace.NewSpanStartConfig(options...)
 
-	// For local spans created by this SDK, track child span count.
-	if p := trace.SpanFromContext(ctx); p != nil {
+	if config.NewRoot() {
+		// A new root is not a child of the current Span, but it may be
+		// linked to it.
+		if psc := trace.SpanContextFromContext(ctx); psc.IsValid() && tr.provider.linkNewRootsToParent {
+			options = append([]trace.SpanStartOption{trace.WithLinks(trace.Link{SpanContext: psc})}, options...)
+			config = trace.NewSpanStartConfig(options...)
+		}
+	} else if p := trace.SpanFromContext(ctx); p != nil {
+		// For local spans created by this SDK, track child span count.
 		if sdkSpan, ok := p.(*span); ok {
 			sdkSpan.addChild()
 		}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// MockClock is an sdktrace.Clock that only moves forward when Add is called.
+// Timers created by it fire synchronously from Add once their deadline is
+// reached.
+type MockClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*mockTimer
+}
+
+var _ sdktrace.Clock = (*MockClock)(nil)
+
+// NewMockClock returns a MockClock whose current time is start.
+func NewMockClock(start time.Time) *MockClock {
+	return &MockClock{now: start}
+}
+
+// Now returns the current time of the clock.
+func (c *MockClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a Timer that fires once the clock has been advanced by d.
+func (c *MockClock) NewTimer(d time.Duration) sdktrace.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &mockTimer{clock: c, c: make(chan time.Time, 1)}
+	t.resetLocked(d)
+	return t
+}
+
+// Add advances the clock by d and fires all timers that are due.
+func (c *MockClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+
+	active := c.timers[:0]
+	for _, t := range c.timers {
+		if t.deadline.After(c.now) {
+			active = append(active, t)
+			continue
+		}
+		t.active = false
+		select {
+		case t.c <- c.now:
+		default:
+		}
+	}
+	for i := len(active); i < len(c.timers); i++ {
+		c.timers[i] = nil
+	}
+	c.timers = active
+}
+
+type mockTimer struct {
+	clock    *MockClock
+	c        chan time.Time
+	deadline time.Time
+	active   bool
+}
+
+func (t *mockTimer) C() <-chan time.Time { return t.c }
+
+func (t *mockTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.stopLocked()
+}
+
+func (t *mockTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasActive := t.stopLocked()
+	t.resetLocked(d)
+	return wasActive
+}
+
+func (t *mockTimer) stopLocked() bool {
+	if !t.active {
+		return false
+	}
+	t.active = false
+	for i, other := range t.clock.timers {
+		if other == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (t *mockTimer) resetLocked(d time.Duration) {
+	t.deadline = t.clock.now.Add(d)
+	if d <= 0 {
+		select {
+		case t.c <- t.clock.now:
+		default:
+		}
+		return
+	}
+	t.active = true
+	t.clock.timers = append(t.clock.timers, t)
+}
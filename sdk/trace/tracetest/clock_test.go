@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockClockNow(t *testing.T) {
+	start := time.Unix(100, 0)
+	c := NewMockClock(start)
+	assert.Equal(t, start, c.Now())
+	c.Add(time.Minute)
+	assert.Equal(t, start.Add(time.Minute), c.Now())
+}
+
+func TestMockClockTimer(t *testing.T) {
+	c := NewMockClock(time.Unix(0, 0))
+	timer := c.NewTimer(time.Second)
+
+	c.Add(999 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	c.Add(time.Millisecond)
+	select {
+	case now := <-timer.C():
+		assert.Equal(t, time.Unix(1, 0), now)
+	default:
+		t.Fatal("timer did not fire")
+	}
+	assert.False(t, timer.Stop(), "fired timer should not be active")
+
+	assert.False(t, timer.Reset(time.Second))
+	assert.True(t, timer.Stop())
+	c.Add(time.Hour)
+	select {
+	case <-timer.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+}
+
+func TestMockClockTimerReset(t *testing.T) {
+	c := NewMockClock(time.Unix(0, 0))
+	timer := c.NewTimer(time.Second)
+	assert.True(t, timer.Reset(2*time.Second))
+
+	c.Add(time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("reset timer fired at original deadline")
+	default:
+	}
+	c.Add(time.Second)
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("timer did not fire")
+	}
+}
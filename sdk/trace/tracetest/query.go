@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Filter returns the spans of s for which f returns true, in order.
+func (s SpanStubs) Filter(f func(SpanStub) bool) SpanStubs {
+	var out SpanStubs
+	for _, span := range s {
+		if f(span) {
+			out = append(out, span)
+		}
+	}
+	return out
+}
+
+// WithName returns the spans of s named name.
+func (s SpanStubs) WithName(name string) SpanStubs {
+	return s.Filter(func(span SpanStub) bool {
+		return span.Name == name
+	})
+}
+
+// WithAttribute returns the spans of s that have the attribute kv, with the
+// same key and value.
+func (s SpanStubs) WithAttribute(kv attribute.KeyValue) SpanStubs {
+	return s.Filter(func(span SpanStub) bool {
+		for _, a := range span.Attributes {
+			if a.Key == kv.Key {
+				return a.Value == kv.Value
+			}
+		}
+		return false
+	})
+}
+
+// WithStatus returns the spans of s with the status code.
+func (s SpanStubs) WithStatus(code codes.Code) SpanStubs {
+	return s.Filter(func(span SpanStub) bool {
+		return span.Status.Code == code
+	})
+}
+
+// WithParent returns the spans of s that are children of the span
+// identified by parent. Passing an invalid SpanContext returns the root
+// spans of s.
+func (s SpanStubs) WithParent(parent trace.SpanContext) SpanStubs {
+	return s.Filter(func(span SpanStub) bool {
+		if !parent.IsValid() {
+			return !span.Parent.IsValid()
+		}
+		return span.Parent.TraceID() == parent.TraceID() &&
+			span.Parent.SpanID() == parent.SpanID()
+	})
+}
+
+// Names returns the names of the spans of s, in order.
+func (s SpanStubs) Names() []string {
+	if len(s) == 0 {
+		return nil
+	}
+	names := make([]string, len(s))
+	for i, span := range s {
+		names[i] = span.Name
+	}
+	return names
+}
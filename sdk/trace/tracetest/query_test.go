@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSpanStubsQueries(t *testing.T) {
+	rootSC := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	otherSC := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{2},
+		SpanID:  trace.SpanID{1},
+	})
+	spans := SpanStubs{
+		{Name: "root", SpanContext: rootSC},
+		{
+			Name:       "GET",
+			Parent:     rootSC,
+			Attributes: []attribute.KeyValue{attribute.Int("http.status_code", 200)},
+			Status:     tracesdk.Status{Code: codes.Ok},
+		},
+		{
+			Name:       "GET",
+			Parent:     rootSC,
+			Attributes: []attribute.KeyValue{attribute.Int("http.status_code", 500)},
+			Status:     tracesdk.Status{Code: codes.Error},
+		},
+		{Name: "other", Parent: otherSC},
+	}
+
+	assert.Equal(t, []string{"GET", "GET"}, spans.WithName("GET").Names())
+	assert.Nil(t, spans.WithName("missing"))
+	assert.Equal(t, spans[2:3], spans.WithAttribute(attribute.Int("http.status_code", 500)))
+	assert.Nil(t, spans.WithAttribute(attribute.String("http.status_code", "500")))
+	assert.Equal(t, spans[2:3], spans.WithStatus(codes.Error))
+	assert.Equal(t, spans[1:3], spans.WithParent(rootSC))
+	assert.Equal(t, spans[0:1], spans.WithParent(trace.SpanContext{}))
+	assert.Equal(t, spans[2:3], spans.WithParent(rootSC).WithStatus(codes.Error))
+	assert.Equal(t, spans[3:], spans.Filter(func(s SpanStub) bool {
+		return s.Parent.TraceID() == otherSC.TraceID()
+	}))
+}
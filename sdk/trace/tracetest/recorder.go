@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"context"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SpanRecorder records started and ended spans.
+type SpanRecorder struct {
+	mu      sync.Mutex
+	started []sdktrace.ReadWriteSpan
+	ended   []sdktrace.ReadOnlySpan
+	// changed is closed when a span ends, waking up Wait.
+	changed chan struct{}
+}
+
+var _ sdktrace.SpanProcessor = (*SpanRecorder)(nil)
+
+// NewSpanRecorder returns a new SpanRecorder. Register it with a
+// TracerProvider using the sdktrace.WithSpanProcessor option.
+func NewSpanRecorder() *SpanRecorder {
+	return new(SpanRecorder)
+}
+
+// OnStart records started spans.
+func (sr *SpanRecorder) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	sr.started = append(sr.started, s)
+}
+
+// OnEnd records completed spans.
+func (sr *SpanRecorder) OnEnd(s sdktrace.ReadOnlySpan) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	sr.ended = append(sr.ended, s)
+	if sr.changed != nil {
+		close(sr.changed)
+		sr.changed = nil
+	}
+}
+
+// Shutdown does nothing.
+func (sr *SpanRecorder) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush does nothing.
+func (sr *SpanRecorder) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Started returns a copy of all started spans that have been recorded.
+func (sr *SpanRecorder) Started() []sdktrace.ReadWriteSpan {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	dst := make([]sdktrace.ReadWriteSpan, len(sr.started))
+	copy(dst, sr.started)
+	return dst
+}
+
+// Ended returns a copy of all ended spans that have been recorded.
+func (sr *SpanRecorder) Ended() []sdktrace.ReadOnlySpan {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	dst := make([]sdktrace.ReadOnlySpan, len(sr.ended))
+	copy(dst, sr.ended)
+	return dst
+}
+
+// EndedStubs returns SpanStubs of all ended spans that have been recorded.
+// They can be queried with the methods of SpanStubs.
+func (sr *SpanRecorder) EndedStubs() SpanStubs {
+	return SpanStubsFromReadOnlySpans(sr.Ended())
+}
+
+// Reset clears all recorded spans.
+func (sr *SpanRecorder) Reset() {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	sr.started = nil
+	sr.ended = nil
+}
+
+// Wait blocks until at least n spans have ended or ctx is done, and returns
+// the ended spans. If ctx is done first, the spans ended so far are returned
+// with the context error. It is meant for tests of code ending spans in
+// other goroutines.
+func (sr *SpanRecorder) Wait(ctx context.Context, n int) ([]sdktrace.ReadOnlySpan, error) {
+	for {
+		sr.mu.Lock()
+		if len(sr.ended) >= n {
+			sr.mu.Unlock()
+			return sr.Ended(), nil
+		}
+		if sr.changed == nil {
+			sr.changed = make(chan struct{})
+		}
+		changed := sr.changed
+		sr.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return sr.Ended(), ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestSpanRecorder(t *testing.T) {
+	sr := NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	tracer := tp.Tracer(t.Name())
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+
+	require.Len(t, sr.Started(), 2)
+	assert.Equal(t, "parent", sr.Started()[0].Name())
+	require.Len(t, sr.Ended(), 1)
+	assert.Equal(t, "child", sr.Ended()[0].Name())
+
+	parent.End()
+	assert.Equal(t, []string{"child", "parent"}, sr.EndedStubs().Names())
+
+	sr.Reset()
+	assert.Len(t, sr.Started(), 0)
+	assert.Len(t, sr.Ended(), 0)
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestSpanRecorderWait(t *testing.T) {
+	sr := NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)).Tracer(t.Name())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, span := tracer.Start(context.Background(), "span")
+			span.End()
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	spans, err := sr.Wait(ctx, 3)
+	require.NoError(t, err)
+	assert.Len(t, spans, 3)
+	wg.Wait()
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	spans, err = sr.Wait(ctx, 4)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, spans, 3)
+}
+
+func TestInMemoryExporterWait(t *testing.T) {
+	imsb := NewInMemoryExporter()
+	bsp := sdktrace.NewBatchSpanProcessor(imsb, sdktrace.WithBatchTimeout(time.Millisecond))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(bsp))
+	defer func() { assert.NoError(t, tp.Shutdown(context.Background())) }()
+
+	_, span := tp.Tracer(t.Name()).Start(context.Background(), "span")
+	span.End()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	spans, err := imsb.Wait(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"span"}, spans.Names())
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	spans, err = imsb.Wait(ctx, 2)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, spans, 1)
+}
@@ -68,6 +68,7 @@ type SpanStub struct {
 	DroppedAttributes      int
 	DroppedEvents          int
 	DroppedLinks           int
+	TruncatedAttributes    int
 	ChildSpanCount         int
 	Resource               *resource.Resource
 	InstrumentationLibrary instrumentation.Library
@@ -93,6 +94,7 @@ func SpanStubFromReadOnlySpan(ro tracesdk.ReadOnlySpan) SpanStub {
 		DroppedAttributes:      ro.DroppedAttributes(),
 		DroppedEvents:          ro.DroppedEvents(),
 		DroppedLinks:           ro.DroppedLinks(),
+		TruncatedAttributes:    ro.TruncatedAttributes(),
 		ChildSpanCount:         ro.ChildSpanCount(),
 		Resource:               ro.Resource(),
 		InstrumentationLibrary: ro.InstrumentationLibrary(),
@@ -115,6 +117,7 @@ func (s SpanStub) Snapshot() tracesdk.ReadOnlySpan {
 		droppedAttributes:      s.DroppedAttributes,
 		droppedEvents:          s.DroppedEvents,
 		droppedLinks:           s.DroppedLinks,
+		truncatedAttributes:    s.TruncatedAttributes,
 		childSpanCount:         s.ChildSpanCount,
 		resource:               s.Resource,
 		instrumentationLibrary: s.InstrumentationLibrary,
@@ -138,6 +141,7 @@ type spanSnapshot struct {
 	droppedAttributes      int
 	droppedEvents          int
 	droppedLinks           int
+	truncatedAttributes    int
 	childSpanCount         int
 	resource               *resource.Resource
 	instrumentationLibrary instrumentation.Library
@@ -156,6 +160,7 @@ func (s spanSnapshot) Status() tracesdk.Status          { return s.status }
 func (s spanSnapshot) DroppedAttributes() int           { return s.droppedAttributes }
 func (s spanSnapshot) DroppedLinks() int                { return s.droppedLinks }
 func (s spanSnapshot) DroppedEvents() int               { return s.droppedEvents }
+func (s spanSnapshot) TruncatedAttributes() int         { return s.truncatedAttributes }
 func (s spanSnapshot) ChildSpanCount() int              { return s.childSpanCount }
 func (s spanSnapshot) Resource() *resource.Resource     { return s.resource }
 func (s spanSnapshot) InstrumentationLibrary() instrumentation.Library {
@@ -13,8 +13,9 @@
 // limitations under the License.
 
 // Package tracetest is a testing helper package for the SDK. User can
-// configure no-op or in-memory exporters to verify different SDK behaviors or
-// custom instrumentation.
+// configure no-op or in-memory exporters, or a SpanRecorder, to verify
+// different SDK behaviors or custom instrumentation. Recorded spans can be
+// queried with the methods of SpanStubs.
 package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 import (
@@ -52,6 +53,8 @@ func NewInMemoryExporter() *InMemoryExporter {
 type InMemoryExporter struct {
 	mu sync.Mutex
 	ss SpanStubs
+	// changed is closed when spans are stored, waking up Wait.
+	changed chan struct{}
 }
 
 // ExportSpans handles export of spans by storing them in memory.
@@ -59,6 +62,10 @@ func (imsb *InMemoryExporter) ExportSpans(_ context.Context, spans []trace.ReadO
 	imsb.mu.Lock()
 	defer imsb.mu.Unlock()
 	imsb.ss = append(imsb.ss, SpanStubsFromReadOnlySpans(spans)...)
+	if imsb.changed != nil {
+		close(imsb.changed)
+		imsb.changed = nil
+	}
 	return nil
 }
 
@@ -83,3 +90,28 @@ func (imsb *InMemoryExporter) GetSpans() SpanStubs {
 	copy(ret, imsb.ss)
 	return ret
 }
+
+// Wait blocks until at least n spans are stored or ctx is done, and returns
+// the stored spans. If ctx is done first, the spans stored so far are
+// returned with the context error. It is meant for tests where spans are
+// exported asynchronously, for example by a batch span processor.
+func (imsb *InMemoryExporter) Wait(ctx context.Context, n int) (SpanStubs, error) {
+	for {
+		imsb.mu.Lock()
+		if len(imsb.ss) >= n {
+			imsb.mu.Unlock()
+			return imsb.GetSpans(), nil
+		}
+		if imsb.changed == nil {
+			imsb.changed = make(chan struct{})
+		}
+		changed := imsb.changed
+		imsb.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return imsb.GetSpans(), ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestDBClientAttributes(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.name", "customers"),
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("db.statement", "SELECT * FROM orders WHERE id = ?"),
+	}, DBClientAttributes(DBSystemPostgreSQL, "customers", "SELECT", "SELECT * FROM orders WHERE id = ?"))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.system", "redis"),
+	}, DBClientAttributes(DBSystemRedis, "", "", ""))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.name", "customers"),
+	}, DBClientAttributes(attribute.String("system", "postgresql"), "customers", "", ""))
+	assert.Empty(t, DBClientAttributes(attribute.KeyValue{}, "", "", ""))
+}
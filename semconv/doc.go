@@ -21,4 +21,10 @@
 // OpenTelemetry semantic conventions are agreed standardized naming
 // patterns for OpenTelemetry things. This package aims to be the
 // centralized place to interact with these conventions.
+//
+// The conventions of each version of the specification are also provided by
+// versioned packages, such as go.opentelemetry.io/otel/semconv/v1.4.0, that
+// declare the schema URL of their version. Libraries using different
+// versions can be used in the same program, the OpenTelemetry schema of the
+// go.opentelemetry.io/otel/schema package translates telemetry between them.
 package semconv // import "go.opentelemetry.io/otel/semconv"
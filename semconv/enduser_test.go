@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestEndUserAttributes(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("enduser.id", "alice"),
+		attribute.String("enduser.role", "admin"),
+		attribute.String("enduser.scope", "read:message write:files"),
+	}, EndUserAttributes("alice", "admin", "read:message", "write:files"))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("enduser.id", "alice"),
+	}, EndUserAttributes("alice", ""))
+	assert.Empty(t, EndUserAttributes("", ""))
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv"
+
+import (
+	"net"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// NetPeerAttributesFromAddr generates attributes of the net namespace as
+// specified by the OpenTelemetry specification for a span calling a
+// remote service at address.  The network and address parameters are
+// strings that the net.Dial function from standard library can
+// understand, such as "tcp" and "db.example.com:5432".
+func NetPeerAttributesFromAddr(network, address string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+		attrs = append(attrs, NetTransportTCP)
+	case "udp", "udp4", "udp6":
+		attrs = append(attrs, NetTransportUDP)
+	case "ip", "ip4", "ip6":
+		attrs = append(attrs, NetTransportIP)
+	case "unix", "unixgram", "unixpacket":
+		attrs = append(attrs, NetTransportUnix)
+		if address != "" {
+			attrs = append(attrs, NetPeerNameKey.String(address))
+		}
+		return attrs
+	default:
+		attrs = append(attrs, NetTransportOther)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host, port = address, ""
+	}
+	if host != "" {
+		if ip := net.ParseIP(host); ip != nil {
+			attrs = append(attrs, NetPeerIPKey.String(ip.String()))
+		} else {
+			attrs = append(attrs, NetPeerNameKey.String(host))
+		}
+	}
+	if numPort, err := strconv.ParseUint(port, 10, 16); err == nil && numPort != 0 {
+		attrs = append(attrs, NetPeerPortKey.Int(int(numPort)))
+	}
+	return attrs
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestRPCAttributes(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("rpc.system", "java_rmi"),
+		attribute.String("rpc.service", "myservice.EchoService"),
+		attribute.String("rpc.method", "exampleMethod"),
+	}, RPCAttributes("java_rmi", "myservice.EchoService", "exampleMethod"))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+	}, RPCAttributes("grpc", "", ""))
+}
+
+func TestRPCAttributesFromGRPCFullMethod(t *testing.T) {
+	for _, tc := range []struct {
+		fullMethod string
+		expected   []attribute.KeyValue
+	}{
+		{
+			fullMethod: "/helloworld.Greeter/SayHello",
+			expected: []attribute.KeyValue{
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.service", "helloworld.Greeter"),
+				attribute.String("rpc.method", "SayHello"),
+			},
+		},
+		{
+			fullMethod: "helloworld.Greeter/SayHello",
+			expected: []attribute.KeyValue{
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.service", "helloworld.Greeter"),
+				attribute.String("rpc.method", "SayHello"),
+			},
+		},
+		{
+			fullMethod: "/SayHello",
+			expected: []attribute.KeyValue{
+				attribute.String("rpc.system", "grpc"),
+			},
+		},
+		{
+			fullMethod: "/helloworld.Greeter/",
+			expected: []attribute.KeyValue{
+				attribute.String("rpc.system", "grpc"),
+			},
+		},
+	} {
+		assert.Equal(t, tc.expected, RPCAttributesFromGRPCFullMethod(tc.fullMethod), tc.fullMethod)
+	}
+}
+
+func TestRPCAttributesFromGRPCStatusCode(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int64("rpc.grpc.status_code", 14),
+	}, RPCAttributesFromGRPCStatusCode(14))
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DBClientAttributes generates attributes of the db namespace as
+// specified by the OpenTelemetry specification for a span on the client
+// side of a database call.  The system is one of the DBSystem values,
+// such as DBSystemPostgreSQL.  The name is the name of the database, the
+// operation is the name of the operation executed, such as "SELECT" or
+// "findAndModify", and the statement is the statement executed.  The
+// statement should be sanitized of sensitive data.  Empty values and an
+// invalid system are omitted.
+func DBClientAttributes(system attribute.KeyValue, name, operation, statement string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if system.Key == DBSystemKey && system.Valid() {
+		attrs = append(attrs, system)
+	}
+	if name != "" {
+		attrs = append(attrs, DBNameKey.String(name))
+	}
+	if operation != "" {
+		attrs = append(attrs, DBOperationKey.String(operation))
+	}
+	if statement != "" {
+		attrs = append(attrs, DBStatementKey.String(statement))
+	}
+	return attrs
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestNetPeerAttributesFromAddr(t *testing.T) {
+	for _, tc := range []struct {
+		network  string
+		address  string
+		expected []attribute.KeyValue
+	}{
+		{
+			network: "tcp",
+			address: "db.example.com:5432",
+			expected: []attribute.KeyValue{
+				attribute.String("net.transport", "ip_tcp"),
+				attribute.String("net.peer.name", "db.example.com"),
+				attribute.Int("net.peer.port", 5432),
+			},
+		},
+		{
+			network: "udp6",
+			address: "[::1]:53",
+			expected: []attribute.KeyValue{
+				attribute.String("net.transport", "ip_udp"),
+				attribute.String("net.peer.ip", "::1"),
+				attribute.Int("net.peer.port", 53),
+			},
+		},
+		{
+			network: "tcp",
+			address: "10.0.0.1",
+			expected: []attribute.KeyValue{
+				attribute.String("net.transport", "ip_tcp"),
+				attribute.String("net.peer.ip", "10.0.0.1"),
+			},
+		},
+		{
+			network: "tcp",
+			address: "example.com:port",
+			expected: []attribute.KeyValue{
+				attribute.String("net.transport", "ip_tcp"),
+				attribute.String("net.peer.name", "example.com"),
+			},
+		},
+		{
+			network: "unix",
+			address: "/var/run/redis.sock",
+			expected: []attribute.KeyValue{
+				attribute.String("net.transport", "unix"),
+				attribute.String("net.peer.name", "/var/run/redis.sock"),
+			},
+		},
+		{
+			network: "",
+			address: "",
+			expected: []attribute.KeyValue{
+				attribute.String("net.transport", "other"),
+			},
+		},
+	} {
+		assert.Equal(t, tc.expected, NetPeerAttributesFromAddr(tc.network, tc.address), "%s %s", tc.network, tc.address)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.7.0"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MessagingProducerAttributes generates attributes of the messaging
+// namespace as specified by the OpenTelemetry specification for a span
+// sending messages.  The system identifies the messaging system, such as
+// "kafka" or "rabbitmq", and the destinationKind is one of the
+// MessagingDestinationKind values.  Empty values and an invalid
+// destinationKind are omitted.
+func MessagingProducerAttributes(system, destination string, destinationKind attribute.KeyValue) []attribute.KeyValue {
+	return messagingAttributes(system, destination, destinationKind)
+}
+
+// MessagingConsumerAttributes generates attributes of the messaging
+// namespace as specified by the OpenTelemetry specification for a span
+// receiving or processing messages.  The operation is one of the
+// MessagingOperation values.  Empty values and invalid destinationKind
+// and operation are omitted.
+func MessagingConsumerAttributes(system, destination string, destinationKind, operation attribute.KeyValue) []attribute.KeyValue {
+	attrs := messagingAttributes(system, destination, destinationKind)
+	if operation.Key == MessagingOperationKey && operation.Valid() {
+		attrs = append(attrs, operation)
+	}
+	return attrs
+}
+
+func messagingAttributes(system, destination string, destinationKind attribute.KeyValue) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if system != "" {
+		attrs = append(attrs, MessagingSystemKey.String(system))
+	}
+	if destination != "" {
+		attrs = append(attrs, MessagingDestinationKey.String(destination))
+	}
+	if destinationKind.Key == MessagingDestinationKindKey && destinationKind.Valid() {
+		attrs = append(attrs, destinationKind)
+	}
+	return attrs
+}
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMessagingProducerAttributes(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("messaging.system", "kafka"),
+		attribute.String("messaging.destination", "orders"),
+		attribute.String("messaging.destination_kind", "topic"),
+	}, MessagingProducerAttributes("kafka", "orders", MessagingDestinationKindTopic))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("messaging.system", "rabbitmq"),
+		attribute.String("messaging.destination", "tasks"),
+	}, MessagingProducerAttributes("rabbitmq", "tasks", attribute.KeyValue{}))
+}
+
+func TestMessagingConsumerAttributes(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("messaging.system", "rabbitmq"),
+		attribute.String("messaging.destination", "tasks"),
+		attribute.String("messaging.destination_kind", "queue"),
+		attribute.String("messaging.operation", "process"),
+	}, MessagingConsumerAttributes("rabbitmq", "tasks", MessagingDestinationKindQueue, MessagingOperationProcess))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("messaging.system", "kafka"),
+		attribute.String("messaging.destination", "orders"),
+	}, MessagingConsumerAttributes("kafka", "orders", MessagingOperationReceive, attribute.KeyValue{}))
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semconv implements OpenTelemetry semantic conventions.
+//
+// OpenTelemetry semantic conventions are agreed standardized naming
+// patterns for OpenTelemetry things. This package represents the conventions
+// as of the v1.8.0 version of the OpenTelemetry specification.
+//
+// The conventions of each version of the specification are in their own
+// package, so that libraries using different versions can be used in the
+// same program. The SchemaURL of the package identifies the version, and
+// the OpenTelemetry schema of the go.opentelemetry.io/otel/schema package
+// translates telemetry between the versions.
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.8.0"
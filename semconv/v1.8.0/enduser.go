@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.8.0"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// EndUserAttributes generates attributes of the enduser namespace as
+// specified by the OpenTelemetry specification for a span.  The id is
+// the username or client ID of the user, the role is their role, and
+// the scopes are the scopes or permissions granted to them.  Empty
+// values are omitted.
+func EndUserAttributes(id, role string, scopes ...string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if id != "" {
+		attrs = append(attrs, EnduserIDKey.String(id))
+	}
+	if role != "" {
+		attrs = append(attrs, EnduserRoleKey.String(role))
+	}
+	if len(scopes) > 0 {
+		attrs = append(attrs, EnduserScopeKey.String(strings.Join(scopes, " ")))
+	}
+	return attrs
+}
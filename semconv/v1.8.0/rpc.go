@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.8.0"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RPCSystemGRPC is the value of the rpc.system attribute for gRPC.
+var RPCSystemGRPC = RPCSystemKey.String("grpc")
+
+// RPCAttributes generates attributes of the rpc namespace as specified
+// by the OpenTelemetry specification for a span on the client or the
+// server side of a remote procedure call.  The system identifies the
+// remoting system, such as "grpc" or "java_rmi".  Empty values are
+// omitted.
+func RPCAttributes(system, service, method string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if system != "" {
+		attrs = append(attrs, RPCSystemKey.String(system))
+	}
+	if service != "" {
+		attrs = append(attrs, RPCServiceKey.String(service))
+	}
+	if method != "" {
+		attrs = append(attrs, RPCMethodKey.String(method))
+	}
+	return attrs
+}
+
+// RPCAttributesFromGRPCFullMethod generates attributes of the rpc
+// namespace as specified by the OpenTelemetry specification for a span
+// of a gRPC call.  The fullMethod parameter is the full name of the
+// method as passed to the interceptors of gRPC, such as
+// "/helloworld.Greeter/SayHello".  The service and method are omitted
+// if fullMethod is malformed.
+func RPCAttributesFromGRPCFullMethod(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{RPCSystemGRPC}
+	name := strings.TrimPrefix(fullMethod, "/")
+	if idx := strings.LastIndex(name, "/"); idx > 0 && idx < len(name)-1 {
+		attrs = append(attrs,
+			RPCServiceKey.String(name[:idx]),
+			RPCMethodKey.String(name[idx+1:]),
+		)
+	}
+	return attrs
+}
+
+// RPCAttributesFromGRPCStatusCode generates attributes of the rpc
+// namespace as specified by the OpenTelemetry specification for the
+// status code of a gRPC call.
+func RPCAttributesFromGRPCStatusCode(code uint32) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		RPCGRPCStatusCodeKey.Int64(int64(code)),
+	}
+}
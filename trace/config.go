@@ -139,6 +139,8 @@ type SpanEndOption interface {
 type EventConfig struct {
 	attributes []attribute.KeyValue
 	timestamp  time.Time
+	stackTrace bool
+	escaped    bool
 }
 
 // Attributes describe the associated qualities of an Event.
@@ -151,6 +153,18 @@ func (cfg *EventConfig) Timestamp() time.Time {
 	return cfg.timestamp
 }
 
+// StackTrace checks whether the stack trace is recorded with an exception
+// Event.
+func (cfg *EventConfig) StackTrace() bool {
+	return cfg.stackTrace
+}
+
+// Escaped checks whether the exception of an exception Event escapes the
+// scope of the Span.
+func (cfg *EventConfig) Escaped() bool {
+	return cfg.escaped
+}
+
 // NewEventConfig applies all the EventOptions to a returned SpanConfig. If no
 // timestamp option is passed, the returned SpanConfig will have a Timestamp
 // set to the call time, otherwise no validation is performed on the returned
@@ -229,6 +243,29 @@ func WithTimestamp(t time.Time) SpanEventOption {
 	return timestampOption(t)
 }
 
+type stackTraceOption bool
+
+func (o stackTraceOption) applyEvent(c *EventConfig) { c.stackTrace = bool(o) }
+
+// WithStackTrace sets the flag to capture the stack trace of the caller
+// in the exception.stacktrace attribute of an error recorded with
+// RecordError.
+func WithStackTrace(b bool) EventOption {
+	return stackTraceOption(b)
+}
+
+type escapedOption bool
+
+func (o escapedOption) applyEvent(c *EventConfig) { c.escaped = bool(o) }
+
+// WithEscaped sets the flag telling that an error recorded with
+// RecordError escapes the scope of the Span, e.g. when it is recorded
+// just before the Span is ended. The exception.escaped attribute is set
+// to true for these errors.
+func WithEscaped(b bool) EventOption {
+	return escapedOption(b)
+}
+
 // WithLinks adds links to a Span. The links are added to the existing Span
 // links, i.e. this does not overwrite.
 func WithLinks(links ...Link) SpanStartOption {
@@ -239,7 +276,7 @@ func WithLinks(links ...Link) SpanStartOption {
 
 // WithNewRoot specifies that the Span should be treated as a root Span. Any
 // existing parent span context will be ignored when defining the Span's trace
-// identifiers.
+// identifiers. Use WithLinks to retain a relation to the parent.
 func WithNewRoot() SpanStartOption {
 	return spanOptionFunc(func(cfg *SpanConfig) {
 		cfg.newRoot = true
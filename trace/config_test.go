@@ -219,3 +219,46 @@ func TestTracerConfig(t *testing.T) {
 		assert.Equal(t, test.expected, config)
 	}
 }
+
+func TestNewEventConfig(t *testing.T) {
+	timestamp := time.Unix(0, 0)
+	tests := []struct {
+		options  []EventOption
+		expected *EventConfig
+	}{
+		{
+			[]EventOption{
+				WithTimestamp(timestamp),
+				WithStackTrace(true),
+			},
+			&EventConfig{
+				timestamp:  timestamp,
+				stackTrace: true,
+			},
+		},
+		{
+			[]EventOption{
+				WithTimestamp(timestamp),
+				WithEscaped(true),
+			},
+			&EventConfig{
+				timestamp: timestamp,
+				escaped:   true,
+			},
+		},
+		{
+			[]EventOption{
+				// Multiple calls should overwrite.
+				WithTimestamp(timestamp),
+				WithStackTrace(true),
+				WithStackTrace(false),
+			},
+			&EventConfig{
+				timestamp: timestamp,
+			},
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, NewEventConfig(test.options...))
+	}
+}
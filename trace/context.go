@@ -14,7 +14,11 @@
 
 package trace // import "go.opentelemetry.io/otel/trace"
 
-import "context"
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+)
 
 type traceContextKeyType int
 
@@ -59,3 +63,27 @@ func SpanFromContext(ctx context.Context) Span {
 func SpanContextFromContext(ctx context.Context) SpanContext {
 	return SpanFromContext(ctx).SpanContext()
 }
+
+// DetachedContext returns a new context holding the current SpanContext
+// and the baggage of ctx, and no other values.  The returned context is
+// never canceled and has no deadline, whether or not ctx is.
+//
+// It is meant for fire-and-forget goroutines started while handling a
+// request: spans started with the returned context are children of the
+// current Span of ctx, and the baggage is propagated, but the goroutine
+// is not canceled when the request completes.  The current Span is
+// replaced by a non-recording Span wrapping its SpanContext, so the
+// goroutine cannot modify the Span of the request after it ended.
+func DetachedContext(ctx context.Context) context.Context {
+	detached := context.Background()
+	if ctx == nil {
+		return detached
+	}
+	if sc := SpanContextFromContext(ctx); sc.IsValid() {
+		detached = ContextWithSpanContext(detached, sc)
+	}
+	if m := baggage.MapFromContext(ctx); m.Len() > 0 {
+		detached = baggage.ContextWithMap(detached, m)
+	}
+	return detached
+}
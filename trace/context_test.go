@@ -17,8 +17,12 @@ package trace // import "go.opentelemetry.io/otel/trace"
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 )
 
 type testSpan struct {
@@ -91,3 +95,30 @@ func TestSpanFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestDetachedContext(t *testing.T) {
+	type otherKey struct{}
+
+	parent := context.WithValue(context.Background(), otherKey{}, "value")
+	parent = ContextWithSpan(parent, localSpan)
+	parent = baggage.ContextWithValues(parent, attribute.String("tenant", "t1"))
+	parent, cancel := context.WithTimeout(parent, time.Minute)
+	cancel()
+
+	detached := DetachedContext(parent)
+	assert.NoError(t, detached.Err())
+	_, hasDeadline := detached.Deadline()
+	assert.False(t, hasDeadline)
+	assert.Nil(t, detached.Value(otherKey{}))
+
+	assert.Equal(t, localSpan.SpanContext(), SpanContextFromContext(detached))
+	assert.Equal(t, nonRecordingSpan{sc: localSpan.SpanContext()}, SpanFromContext(detached))
+	assert.Equal(t, "t1", baggage.Value(detached, "tenant").AsString())
+
+	empty := DetachedContext(context.Background())
+	assert.Equal(t, emptySpan, SpanFromContext(empty))
+	emptyBaggage := baggage.Set(empty)
+	assert.Equal(t, 0, emptyBaggage.Len())
+
+	assert.Equal(t, context.Background(), DetachedContext(nil))
+}
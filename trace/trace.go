@@ -28,6 +28,10 @@ const (
 	// FlagsSampled is a bitmask with the sampled bit set. A SpanContext
 	// with the sampling bit set means the span is sampled.
 	FlagsSampled = TraceFlags(0x01)
+	// FlagsRandom is a bitmask with the random bit set. A SpanContext with
+	// the random bit set has a trace ID whose rightmost 7 bytes are random,
+	// as defined by the W3C Trace Context Level 2 specification.
+	FlagsRandom = TraceFlags(0x02)
 
 	errInvalidHexID errorConst = "trace-id and span-id can only contain [0-9a-f] characters, all lowercase"
 
@@ -168,6 +172,20 @@ func (tf TraceFlags) WithSampled(sampled bool) TraceFlags {
 	return tf &^ FlagsSampled
 }
 
+// IsRandom returns if the random bit is set in the TraceFlags.
+func (tf TraceFlags) IsRandom() bool {
+	return tf&FlagsRandom == FlagsRandom
+}
+
+// WithRandom sets the random bit in a new copy of the TraceFlags.
+func (tf TraceFlags) WithRandom(random bool) TraceFlags {
+	if random {
+		return tf | FlagsRandom
+	}
+
+	return tf &^ FlagsRandom
+}
+
 // MarshalJSON implements a custom marshal function to encode TraceFlags
 // as a hex string.
 func (tf TraceFlags) MarshalJSON() ([]byte, error) {
@@ -303,6 +321,7 @@ func (sc SpanContext) TraceState() TraceState {
 }
 
 // WithTraceState returns a new SpanContext with the TraceState replaced.
+// Use a TraceStateBuilder to derive state from the current TraceState.
 func (sc SpanContext) WithTraceState(state TraceState) SpanContext {
 	return SpanContext{
 		traceID:    sc.traceID,
@@ -347,6 +366,11 @@ type Span interface {
 	// AddEvent adds an event with the provided name and options.
 	AddEvent(name string, options ...EventOption)
 
+	// AddLink adds a link to the Span after it has been started, e.g. for
+	// the messages of a batch discovered while it is processed. The link
+	// is subject to the same limits as the links passed with WithLinks.
+	AddLink(link Link)
+
 	// IsRecording returns the recording state of the Span. It will return
 	// true if the Span is active and events can be recorded.
 	IsRecording() bool
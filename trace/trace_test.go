@@ -231,6 +231,44 @@ func TestTraceFlagsWithSampled(t *testing.T) {
 	}
 }
 
+func TestTraceFlagsRandom(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		start  TraceFlags
+		random bool
+		want   TraceFlags
+	}{
+		{
+			name:   "become random",
+			random: true,
+			want:   FlagsRandom,
+		}, {
+			name:   "sampled bit unchanged",
+			start:  FlagsSampled,
+			random: true,
+			want:   FlagsSampled | FlagsRandom,
+		}, {
+			name:   "random cleared",
+			start:  FlagsSampled | FlagsRandom,
+			random: false,
+			want:   FlagsSampled,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			have := testcase.start.WithRandom(testcase.random)
+			if have != testcase.want {
+				t.Errorf("Want: %v, but have: %v", testcase.want, have)
+			}
+			if have.IsRandom() != testcase.random {
+				t.Errorf("IsRandom: want %v, but have: %v", testcase.random, have.IsRandom())
+			}
+			if have.IsSampled() != testcase.start.IsSampled() {
+				t.Error("sampled bit changed")
+			}
+		})
+	}
+}
+
 func TestStringTraceID(t *testing.T) {
 	for _, testcase := range []struct {
 		name string
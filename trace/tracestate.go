@@ -24,6 +24,13 @@ import (
 var (
 	maxListMembers = 32
 
+	// maxTraceStateLen is the length in characters at which the W3C Trace
+	// Context specification permits a TraceState to be truncated.
+	maxTraceStateLen = 512
+	// largeMemberLen is the length above which a list-member is dropped
+	// first when truncating a TraceState to maxTraceStateLen.
+	largeMemberLen = 128
+
 	listDelimiter = ","
 
 	// based on the W3C Trace Context specification, see
@@ -77,6 +84,11 @@ func (m member) String() string {
 	return fmt.Sprintf("%s=%s", m.Key, m.Value)
 }
 
+// len returns the length of the encoded member.
+func (m member) len() int {
+	return len(m.Key) + 1 + len(m.Value)
+}
+
 // TraceState provides additional vendor-specific trace identification
 // information across different distributed tracing systems. It represents an
 // immutable list consisting of key/value pairs, each pair is referred to as a
@@ -178,8 +190,11 @@ func (ts TraceState) Insert(key, value string) (TraceState, error) {
 		return ts, err
 	}
 
-	cTS := ts.Delete(key)
-	if cTS.Len()+1 > maxListMembers {
+	n := len(ts.list)
+	if ts.index(key) >= 0 {
+		n--
+	}
+	if n+1 > maxListMembers {
 		// TODO (MrAlias): When the second version of the Trace Context
 		// specification is published this needs to not return an error.
 		// Instead it should drop the "right-most" member and insert the new
@@ -189,25 +204,26 @@ func (ts TraceState) Insert(key, value string) (TraceState, error) {
 		return ts, fmt.Errorf("failed to insert: %w", errMemberNumber)
 	}
 
-	cTS.list = append(cTS.list, member{})
-	copy(cTS.list[1:], cTS.list)
-	cTS.list[0] = m
-
-	return cTS, nil
+	members := make([]member, 1, n+1)
+	members[0] = m
+	for _, member := range ts.list {
+		if member.Key != key {
+			members = append(members, member)
+		}
+	}
+	return TraceState{list: members}, nil
 }
 
 // Delete returns a copy of the TraceState with the list-member identified by
 // key removed.
 func (ts TraceState) Delete(key string) TraceState {
-	members := make([]member, ts.Len())
-	copy(members, ts.list)
-	for i, member := range ts.list {
-		if member.Key == key {
-			members = append(members[:i], members[i+1:]...)
-			// TraceState should contain no duplicate members.
-			break
-		}
+	i := ts.index(key)
+	if i < 0 {
+		return ts
 	}
+	members := make([]member, 0, len(ts.list)-1)
+	members = append(members, ts.list[:i]...)
+	members = append(members, ts.list[i+1:]...)
 	return TraceState{list: members}
 }
 
@@ -215,3 +231,110 @@ func (ts TraceState) Delete(key string) TraceState {
 func (ts TraceState) Len() int {
 	return len(ts.list)
 }
+
+// index returns the position of the list-member identified by key, or -1 if
+// no such list-member exists.
+func (ts TraceState) index(key string) int {
+	for i, member := range ts.list {
+		if member.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// TraceStateBuilder builds a TraceState from a sequence of mutations. It is
+// intended for vendors updating their own list-members without having to
+// handle the limits of the W3C Trace Context specification themselves.
+//
+// Members set are moved to the front of the TraceState as the specification
+// requires. When Build is called the TraceState is truncated to fit the
+// specification limits: right-most list-members are dropped until no more
+// than 32 remain, and if the encoded TraceState exceeds 512 characters,
+// list-members longer than 128 characters are dropped, right-most first,
+// followed by the remaining right-most list-members until it fits.
+//
+// A TraceStateBuilder is not safe for concurrent use.
+type TraceStateBuilder struct {
+	// list is the members in order, with the most recently set first.
+	list []member
+	err  error
+}
+
+// NewTraceStateBuilder returns a TraceStateBuilder initialized with the
+// list-members of ts.
+func NewTraceStateBuilder(ts TraceState) *TraceStateBuilder {
+	list := make([]member, len(ts.list))
+	copy(list, ts.list)
+	return &TraceStateBuilder{list: list}
+}
+
+// Set adds or updates the list-member identified by key and moves it to the
+// front of the TraceState. If key or value are invalid the error is returned
+// from Build and the TraceState is left unchanged by this call.
+func (b *TraceStateBuilder) Set(key, value string) *TraceStateBuilder {
+	m, err := newMember(key, value)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b
+	}
+
+	b.remove(key)
+	b.list = append(b.list, member{})
+	copy(b.list[1:], b.list)
+	b.list[0] = m
+	return b
+}
+
+// Delete removes the list-member identified by key if it exists.
+func (b *TraceStateBuilder) Delete(key string) *TraceStateBuilder {
+	b.remove(key)
+	return b
+}
+
+func (b *TraceStateBuilder) remove(key string) {
+	for i, member := range b.list {
+		if member.Key == key {
+			b.list = append(b.list[:i], b.list[i+1:]...)
+			return
+		}
+	}
+}
+
+// Build returns the TraceState built, truncated to the specification
+// limits. If any key or value passed to Set was invalid the first such error
+// is returned along with the TraceState built from the valid mutations.
+func (b *TraceStateBuilder) Build() (TraceState, error) {
+	if len(b.list) == 0 {
+		return TraceState{}, b.err
+	}
+
+	members := make([]member, len(b.list))
+	copy(members, b.list)
+	if len(members) > maxListMembers {
+		members = members[:maxListMembers]
+	}
+	for i := len(members) - 1; i >= 0 && encodedLen(members) > maxTraceStateLen; i-- {
+		if members[i].len() > largeMemberLen {
+			members = append(members[:i], members[i+1:]...)
+		}
+	}
+	for len(members) > 0 && encodedLen(members) > maxTraceStateLen {
+		members = members[:len(members)-1]
+	}
+	return TraceState{list: members}, b.err
+}
+
+// encodedLen returns the length of the W3C Trace Context encoding of list.
+func encodedLen(list []member) int {
+	if len(list) == 0 {
+		return 0
+	}
+	n := len(list) - 1 // Delimiters.
+	for _, m := range list {
+		n += m.len()
+	}
+	return n
+}
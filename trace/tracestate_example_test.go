@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func ExampleTraceStateBuilder() {
+	ts, _ := trace.ParseTraceState("rojo=00f067aa0ba902b7,congo=t61rcWkgMzE")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceState: ts})
+
+	ts, err := trace.NewTraceStateBuilder(sc.TraceState()).
+		Set("congo", "ucfJifl5GOE").
+		Delete("rojo").
+		Set("vendor@tenant", "value").
+		Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sc = sc.WithTraceState(ts)
+
+	fmt.Println(sc.TraceState())
+	// Output: vendor@tenant=value,congo=ucfJifl5GOE
+}
@@ -17,6 +17,7 @@ package trace
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -504,3 +505,76 @@ func TestTraceStateImmutable(t *testing.T) {
 	assert.Equal(t, v0, ts2.Get(k0))
 	assert.Equal(t, "", ts3.Get(k0))
 }
+
+func TestTraceStateBuilder(t *testing.T) {
+	ts := TraceState{list: []member{
+		{Key: "key1", Value: "val1"},
+		{Key: "key2", Value: "val2"},
+	}}
+
+	got, err := NewTraceStateBuilder(ts).
+		Set("key2", "updated").
+		Set("key3", "val3").
+		Delete("key1").
+		Delete("unknown").
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "key3=val3,key2=updated", got.String())
+	// The original TraceState is not modified.
+	assert.Equal(t, "key1=val1,key2=val2", ts.String())
+
+	got, err = NewTraceStateBuilder(TraceState{}).Build()
+	require.NoError(t, err)
+	assert.Equal(t, TraceState{}, got)
+}
+
+func TestTraceStateBuilderInvalid(t *testing.T) {
+	got, err := NewTraceStateBuilder(TraceState{}).
+		Set("key1", "val1").
+		Set("Invalid", "val").
+		Set("key2", "invalid=").
+		Set("key3", "val3").
+		Build()
+	assert.ErrorIs(t, err, errInvalidKey)
+	assert.Equal(t, "key3=val3,key1=val1", got.String())
+}
+
+func TestTraceStateBuilderMaxMembers(t *testing.T) {
+	b := NewTraceStateBuilder(TraceState{})
+	for i := 0; i < maxListMembers+3; i++ {
+		b.Set(fmt.Sprintf("key%d", i), "val")
+	}
+	got, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, maxListMembers, got.Len())
+	// The oldest, right-most, members are dropped.
+	assert.Equal(t, "val", got.Get(fmt.Sprintf("key%d", maxListMembers+2)))
+	assert.Equal(t, "", got.Get("key0"))
+	assert.Equal(t, "", got.Get("key2"))
+	assert.Equal(t, "val", got.Get("key3"))
+}
+
+func TestTraceStateBuilderMaxLen(t *testing.T) {
+	large := strings.Repeat("a", largeMemberLen)
+	medium := strings.Repeat("b", 100)
+
+	b := NewTraceStateBuilder(TraceState{})
+	b.Set("large0", large)
+	for i := 0; i < 5; i++ {
+		b.Set(fmt.Sprintf("medium%d", i), medium)
+	}
+	b.Set("large1", large)
+	b.Set("small", "val")
+
+	got, err := b.Build()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(got.String()), maxTraceStateLen)
+	// Large members are dropped before any other, right-most first.
+	assert.Equal(t, "", got.Get("large0"))
+	assert.Equal(t, "", got.Get("large1"))
+	assert.Equal(t, "val", got.Get("small"))
+	// Remaining right-most members are dropped until the TraceState fits.
+	assert.Equal(t, medium, got.Get("medium4"))
+	assert.Equal(t, medium, got.Get("medium1"))
+	assert.Equal(t, "", got.Get("medium0"))
+}
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zpages provides in-process web pages to debug the spans of a
+// process without a tracing backend.
+//
+// A SpanProcessor registered with the TracerProvider collects the spans
+// currently running, samples of the ended spans grouped in latency buckets,
+// and samples of the ended spans with an error status. The handler returned
+// by NewTracezHandler serves them:
+//
+//	sp := zpages.NewSpanProcessor()
+//	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+//	http.Handle("/debug/tracez", zpages.NewTracezHandler(sp))
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package zpages // import "go.opentelemetry.io/otel/zpages"
@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages // import "go.opentelemetry.io/otel/zpages"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultSampleSize = 10
+
+// latencyBucketNames are the names of the latency buckets, matching
+// latencyBucketBounds.
+var latencyBucketNames = []string{
+	">0s", ">10µs", ">100µs", ">1ms", ">10ms", ">100ms", ">1s", ">10s", ">100s",
+}
+
+// latencyBucketBounds are the lower bounds of the latency buckets ended
+// spans are grouped in.
+var latencyBucketBounds = []time.Duration{
+	0,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+	100 * time.Second,
+}
+
+// latencyBucket returns the index of the latency bucket of d.
+func latencyBucket(d time.Duration) int {
+	return sort.Search(len(latencyBucketBounds), func(i int) bool {
+		return latencyBucketBounds[i] > d
+	}) - 1
+}
+
+type config struct {
+	sampleSize int
+}
+
+// Option configures a SpanProcessor.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (fn optionFunc) apply(c *config) {
+	fn(c)
+}
+
+// WithSampleSize sets the number of ended spans kept for each span name and
+// latency bucket, and for each span name with an error status. Older spans are
+// discarded first.
+// The default value is 10.
+func WithSampleSize(n int) Option {
+	return optionFunc(func(c *config) {
+		if n > 0 {
+			c.sampleSize = n
+		}
+	})
+}
+
+// sampleStore keeps the last spans added to it.
+type sampleStore struct {
+	spans []sdktrace.ReadOnlySpan
+	next  int
+}
+
+func newSampleStore(size int) *sampleStore {
+	return &sampleStore{spans: make([]sdktrace.ReadOnlySpan, 0, size)}
+}
+
+func (s *sampleStore) add(span sdktrace.ReadOnlySpan) {
+	if len(s.spans) < cap(s.spans) {
+		s.spans = append(s.spans, span)
+		return
+	}
+	s.spans[s.next] = span
+	s.next = (s.next + 1) % len(s.spans)
+}
+
+// samples returns the spans of the store, from the newest to the oldest.
+func (s *sampleStore) samples() []sdktrace.ReadOnlySpan {
+	out := make([]sdktrace.ReadOnlySpan, 0, len(s.spans))
+	for i := len(s.spans) - 1; i >= 0; i-- {
+		out = append(out, s.spans[(s.next+i)%len(s.spans)])
+	}
+	return out
+}
+
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+// spanNameData holds the spans of a span name.
+type spanNameData struct {
+	active         map[spanKey]sdktrace.ReadOnlySpan
+	latencyCounts  []int
+	latencySamples []*sampleStore
+	errorCount     int
+	errorSamples   *sampleStore
+}
+
+// spanNameSummary summarizes the spans of a span name.
+type spanNameSummary struct {
+	Name          string
+	Active        int
+	LatencyCounts []int
+	Errors        int
+}
+
+// SpanProcessor is a SpanProcessor collecting the spans served by the zPages
+// handlers. It keeps the running spans, and samples of the ended spans.
+type SpanProcessor struct {
+	sampleSize int
+
+	mu    sync.Mutex
+	names map[string]*spanNameData
+	// activeNames holds the names the running spans were started with.
+	activeNames map[spanKey]string
+}
+
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// NewSpanProcessor returns a new SpanProcessor to register with the
+// TracerProvider whose spans are served.
+func NewSpanProcessor(options ...Option) *SpanProcessor {
+	c := config{sampleSize: defaultSampleSize}
+	for _, o := range options {
+		o.apply(&c)
+	}
+	return &SpanProcessor{
+		sampleSize:  c.sampleSize,
+		names:       make(map[string]*spanNameData),
+		activeNames: make(map[spanKey]string),
+	}
+}
+
+// data returns the data of the span name, creating it if needed. It must be
+// called with sp.mu held.
+func (sp *SpanProcessor) data(name string) *spanNameData {
+	d, ok := sp.names[name]
+	if !ok {
+		d = &spanNameData{
+			active:         make(map[spanKey]sdktrace.ReadOnlySpan),
+			latencyCounts:  make([]int, len(latencyBucketBounds)),
+			latencySamples: make([]*sampleStore, len(latencyBucketBounds)),
+			errorSamples:   newSampleStore(sp.sampleSize),
+		}
+		for i := range d.latencySamples {
+			d.latencySamples[i] = newSampleStore(sp.sampleSize)
+		}
+		sp.names[name] = d
+	}
+	return d
+}
+
+func keyOf(span sdktrace.ReadOnlySpan) spanKey {
+	sc := span.SpanContext()
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+// OnStart adds s to the running spans.
+func (sp *SpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	key := keyOf(s)
+	sp.activeNames[key] = s.Name()
+	sp.data(s.Name()).active[key] = s
+}
+
+// OnEnd removes s from the running spans and samples it.
+func (sp *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	// The name of a span can be changed while it is running.
+	key := keyOf(s)
+	if name, ok := sp.activeNames[key]; ok {
+		delete(sp.names[name].active, key)
+		delete(sp.activeNames, key)
+	}
+
+	d := sp.data(s.Name())
+	if s.Status().Code == codes.Error {
+		d.errorCount++
+		d.errorSamples.add(s)
+		return
+	}
+	i := latencyBucket(s.EndTime().Sub(s.StartTime()))
+	if i < 0 {
+		i = 0
+	}
+	d.latencyCounts[i]++
+	d.latencySamples[i].add(s)
+}
+
+// ForceFlush does nothing.
+func (sp *SpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Shutdown does nothing, the collected spans are still served.
+func (sp *SpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+// summaries returns the summaries of all span names, sorted by name.
+func (sp *SpanProcessor) summaries() []spanNameSummary {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	out := make([]spanNameSummary, 0, len(sp.names))
+	for name, d := range sp.names {
+		out = append(out, spanNameSummary{
+			Name:          name,
+			Active:        len(d.active),
+			LatencyCounts: append([]int(nil), d.latencyCounts...),
+			Errors:        d.errorCount,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// activeSpans returns the running spans with name, from the oldest to the
+// newest.
+func (sp *SpanProcessor) activeSpans(name string) []sdktrace.ReadOnlySpan {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	d, ok := sp.names[name]
+	if !ok {
+		return nil
+	}
+	out := make([]sdktrace.ReadOnlySpan, 0, len(d.active))
+	for _, s := range d.active {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartTime().Before(out[j].StartTime()) })
+	return out
+}
+
+// latencySamples returns the sampled spans with name in the latency bucket,
+// from the newest to the oldest.
+func (sp *SpanProcessor) latencySamples(name string, bucket int) []sdktrace.ReadOnlySpan {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	d, ok := sp.names[name]
+	if !ok || bucket < 0 || bucket >= len(d.latencySamples) {
+		return nil
+	}
+	return d.latencySamples[bucket].samples()
+}
+
+// errorSamples returns the sampled spans with name and an error status, from
+// the newest to the oldest.
+func (sp *SpanProcessor) errorSamples(name string) []sdktrace.ReadOnlySpan {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	d, ok := sp.names[name]
+	if !ok {
+		return nil
+	}
+	return d.errorSamples.samples()
+}
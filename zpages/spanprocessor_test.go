@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLatencyBucket(t *testing.T) {
+	assert.Len(t, latencyBucketNames, len(latencyBucketBounds))
+	assert.Equal(t, 0, latencyBucket(0))
+	assert.Equal(t, 0, latencyBucket(9*time.Microsecond))
+	assert.Equal(t, 1, latencyBucket(10*time.Microsecond))
+	assert.Equal(t, 5, latencyBucket(500*time.Millisecond))
+	assert.Equal(t, 8, latencyBucket(time.Hour))
+	assert.Equal(t, -1, latencyBucket(-time.Second))
+}
+
+func TestSampleStore(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	tr := tp.Tracer("TestSampleStore")
+	var spans []sdktrace.ReadOnlySpan
+	for i := 0; i < 4; i++ {
+		_, s := tr.Start(context.Background(), "span")
+		spans = append(spans, s.(sdktrace.ReadOnlySpan))
+	}
+
+	store := newSampleStore(3)
+	store.add(spans[0])
+	store.add(spans[1])
+	assert.Equal(t, []sdktrace.ReadOnlySpan{spans[1], spans[0]}, store.samples())
+	store.add(spans[2])
+	store.add(spans[3])
+	assert.Equal(t, []sdktrace.ReadOnlySpan{spans[3], spans[2], spans[1]}, store.samples())
+}
+
+func TestSpanProcessor(t *testing.T) {
+	sp := NewSpanProcessor(WithSampleSize(2))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("TestSpanProcessor")
+	start := time.Now()
+
+	_, running := tr.Start(context.Background(), "running")
+	for i := 0; i < 3; i++ {
+		_, s := tr.Start(context.Background(), "fast", trace.WithTimestamp(start))
+		s.End(trace.WithTimestamp(start.Add(time.Millisecond)))
+	}
+	_, failed := tr.Start(context.Background(), "fast")
+	failed.SetStatus(codes.Error, "failure")
+	failed.End()
+	_, renamed := tr.Start(context.Background(), "before")
+	renamed.SetName("after")
+	renamed.End()
+
+	summaries := sp.summaries()
+	if assert.Len(t, summaries, 4) {
+		assert.Equal(t, "after", summaries[0].Name)
+		assert.Equal(t, 0, summaries[1].Active, "before")
+		assert.Equal(t, "fast", summaries[2].Name)
+		assert.Equal(t, 3, summaries[2].LatencyCounts[3])
+		assert.Equal(t, 1, summaries[2].Errors)
+		assert.Equal(t, "running", summaries[3].Name)
+		assert.Equal(t, 1, summaries[3].Active)
+	}
+
+	assert.Len(t, sp.activeSpans("running"), 1)
+	assert.Len(t, sp.latencySamples("fast", 3), 2)
+	assert.Empty(t, sp.latencySamples("fast", 4))
+	assert.Empty(t, sp.latencySamples("fast", 100))
+	assert.Len(t, sp.errorSamples("fast"), 1)
+	assert.Empty(t, sp.errorSamples("unknown"))
+
+	running.End()
+	assert.Empty(t, sp.activeSpans("running"))
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages // import "go.opentelemetry.io/otel/zpages"
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Query parameters of the tracez page selecting the spans to show.
+const (
+	spanNameQueryField      = "zspanname"
+	spanTypeQueryField      = "ztype"
+	latencyBucketQueryField = "zlatencybucket"
+
+	runningSpanType = "running"
+	latencySpanType = "latency"
+	errorSpanType   = "error"
+)
+
+var tracezTemplate = template.Must(template.New("tracez").Parse(`<!DOCTYPE html>
+<html>
+<head><title>TraceZ</title></head>
+<body>
+<h1>TraceZ Summary</h1>
+<table border="1">
+<tr><th>Span Name</th><th>Running</th>{{range .Buckets}}<th>{{.}}</th>{{end}}<th>Errors</th></tr>
+{{range $s := .Summaries}}<tr>
+<td>{{$s.Name}}</td>
+<td>{{if $s.Active}}<a href="?zspanname={{$s.Name}}&amp;ztype=running">{{$s.Active}}</a>{{else}}0{{end}}</td>
+{{range $i, $c := $s.LatencyCounts}}<td>{{if $c}}<a href="?zspanname={{$s.Name}}&amp;ztype=latency&amp;zlatencybucket={{$i}}">{{$c}}</a>{{else}}0{{end}}</td>
+{{end}}<td>{{if $s.Errors}}<a href="?zspanname={{$s.Name}}&amp;ztype=error">{{$s.Errors}}</a>{{else}}0{{end}}</td>
+</tr>
+{{end}}</table>
+{{with .Details}}<h2>{{.Title}}</h2>
+<table border="1">
+<tr><th>Start Time</th><th>Duration</th><th>Trace ID</th><th>Span ID</th><th>Parent Span ID</th><th>Status</th><th>Attributes</th><th>Events</th></tr>
+{{range .Spans}}<tr>
+<td>{{.Start.Format "2006-01-02T15:04:05.000000Z07:00"}}</td>
+<td>{{.Duration}}</td>
+<td>{{.TraceID}}</td>
+<td>{{.SpanID}}</td>
+<td>{{.ParentSpanID}}</td>
+<td>{{.Status}}</td>
+<td>{{range .Attributes}}{{.Key}}={{.Value.Emit}}<br>{{end}}</td>
+<td>{{range .Events}}{{.Time.Format "15:04:05.000000"}} {{.Name}}{{range .Attributes}} {{.Key}}={{.Value.Emit}}{{end}}<br>{{end}}</td>
+</tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
+
+type tracezPage struct {
+	Buckets   []string
+	Summaries []spanNameSummary
+	Details   *tracezDetails
+}
+
+type tracezDetails struct {
+	Title string
+	Spans []tracezSpan
+}
+
+type tracezSpan struct {
+	sdktrace.ReadOnlySpan
+	Start        time.Time
+	Duration     time.Duration
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Status       string
+}
+
+type tracezHandler struct {
+	sp  *SpanProcessor
+	now func() time.Time
+}
+
+// NewTracezHandler returns an http.Handler serving the spans collected by sp:
+// a summary of the running spans, ended spans per latency bucket, and ended
+// spans with an error status, for each span name, and the details of the
+// spans selected in the summary.
+func NewTracezHandler(sp *SpanProcessor) http.Handler {
+	return &tracezHandler{sp: sp, now: time.Now}
+}
+
+func (h *tracezHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	page := tracezPage{
+		Buckets:   latencyBucketNames,
+		Summaries: h.sp.summaries(),
+	}
+
+	query := r.URL.Query()
+	if name := query.Get(spanNameQueryField); name != "" {
+		var spans []sdktrace.ReadOnlySpan
+		details := &tracezDetails{}
+		switch query.Get(spanTypeQueryField) {
+		case runningSpanType:
+			details.Title = "Running spans of " + name
+			spans = h.sp.activeSpans(name)
+		case latencySpanType:
+			bucket, err := strconv.Atoi(query.Get(latencyBucketQueryField))
+			if err != nil || bucket < 0 || bucket >= len(latencyBucketBounds) {
+				http.Error(w, "invalid latency bucket", http.StatusBadRequest)
+				return
+			}
+			details.Title = "Spans of " + name + " with a latency " + page.Buckets[bucket]
+			spans = h.sp.latencySamples(name, bucket)
+		case errorSpanType:
+			details.Title = "Spans of " + name + " with an error"
+			spans = h.sp.errorSamples(name)
+		default:
+			http.Error(w, "invalid span type", http.StatusBadRequest)
+			return
+		}
+		details.Spans = h.tracezSpans(spans)
+		page.Details = details
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tracezTemplate.Execute(w, page); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *tracezHandler) tracezSpans(spans []sdktrace.ReadOnlySpan) []tracezSpan {
+	out := make([]tracezSpan, len(spans))
+	for i, s := range spans {
+		end := s.EndTime()
+		if end.IsZero() {
+			end = h.now()
+		}
+		sc := s.SpanContext()
+		ts := tracezSpan{
+			ReadOnlySpan: s,
+			Start:        s.StartTime(),
+			Duration:     end.Sub(s.StartTime()),
+			TraceID:      sc.TraceID().String(),
+			SpanID:       sc.SpanID().String(),
+			Status:       s.Status().Code.String(),
+		}
+		if p := s.Parent(); p.IsValid() {
+			ts.ParentSpanID = p.SpanID().String()
+		}
+		if st := s.Status(); st.Code == codes.Error && st.Description != "" {
+			ts.Status += ": " + st.Description
+		}
+		out[i] = ts
+	}
+	return out
+}
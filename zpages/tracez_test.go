@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func getTracez(t *testing.T, h http.Handler, query string) (int, string) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tracez"+query, nil))
+	body, err := ioutil.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestTracezHandler(t *testing.T) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	tr := tp.Tracer("TestTracezHandler")
+	h := NewTracezHandler(sp)
+
+	_, running := tr.Start(context.Background(), "GET /users")
+	defer running.End()
+	_, failed := tr.Start(context.Background(), "query<db>")
+	failed.SetAttributes(attribute.String("db.system", "postgresql"))
+	failed.SetStatus(codes.Error, "connection refused")
+	failed.End()
+	start := time.Now()
+	_, ended := tr.Start(context.Background(), "query<db>", trace.WithTimestamp(start))
+	ended.End(trace.WithTimestamp(start.Add(time.Second)))
+
+	code, body := getTracez(t, h, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "GET /users")
+	assert.Contains(t, body, "query&lt;db&gt;")
+	assert.Contains(t, body, `href="?zspanname=GET%20%2fusers&amp;ztype=running"`)
+	assert.NotContains(t, body, "<h2>")
+
+	code, body = getTracez(t, h, "?zspanname=GET+%2Fusers&ztype=running")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "<h2>Running spans of GET /users</h2>")
+	assert.Contains(t, body, running.SpanContext().SpanID().String())
+
+	code, body = getTracez(t, h, "?zspanname=query%3Cdb%3E&ztype=error")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, failed.SpanContext().SpanID().String())
+	assert.Contains(t, body, "Error: connection refused")
+	assert.Contains(t, body, "db.system=postgresql")
+	assert.NotContains(t, body, ended.SpanContext().SpanID().String())
+
+	code, body = getTracez(t, h, "?zspanname=query%3Cdb%3E&ztype=latency&zlatencybucket=6")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "<h2>Spans of query&lt;db&gt; with a latency &gt;1s</h2>")
+	assert.Contains(t, body, ended.SpanContext().SpanID().String())
+}
+
+func TestTracezHandlerInvalidQuery(t *testing.T) {
+	h := NewTracezHandler(NewSpanProcessor())
+	for _, query := range []string{
+		"?zspanname=span&ztype=unknown",
+		"?zspanname=span&ztype=latency",
+		"?zspanname=span&ztype=latency&zlatencybucket=9",
+	} {
+		code, _ := getTracez(t, h, query)
+		assert.Equal(t, http.StatusBadRequest, code, query)
+	}
+}